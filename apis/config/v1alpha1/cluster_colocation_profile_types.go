@@ -103,6 +103,64 @@ type ClusterColocationProfileSpec struct {
 
 // ClusterColocationProfileStatus represents information about the status of a ClusterColocationProfile.
 type ClusterColocationProfileStatus struct {
+	// ObservedGeneration is the most recent generation observed by the colocation profile controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// MutatedPods is the number of existing pods which have been mutated by the profile.
+	// It only counts the pods recorded with the profile in the annotation `config.koordinator.sh/colocation-profiles`,
+	// so the pods mutated before the annotation is introduced are not counted.
+	// +optional
+	MutatedPods int64 `json:"mutatedPods,omitempty"`
+	// LastMutationTime is the creation time of the latest existing pod mutated by the profile.
+	// It is not the exact mutation time for the pods mutated by the controller after the creation.
+	// +optional
+	LastMutationTime *metav1.Time `json:"lastMutationTime,omitempty"`
+	// MatchedNamespaces are the namespaces matched by the NamespaceSelector.
+	// +optional
+	MatchedNamespaces []string `json:"matchedNamespaces,omitempty"`
+	// Conflicts describes the other profiles which mutate the same pods with different values.
+	// +optional
+	Conflicts []ColocationProfileConflict `json:"conflicts,omitempty"`
+	// DryRun describes what the profile would mutate when it is in the dry-run mode.
+	// +optional
+	DryRun *ColocationProfileDryRunStatus `json:"dryRun,omitempty"`
+}
+
+// ColocationProfileConflict describes the conflicts between two profiles.
+type ColocationProfileConflict struct {
+	// Profile is the name of the conflicting profile.
+	Profile string `json:"profile"`
+	// Fields are the pod fields set to different values by the two profiles, e.g. labels/koordinator.sh/qosClass.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+	// Pods is the number of existing pods matched by both profiles.
+	// +optional
+	Pods int64 `json:"pods,omitempty"`
+}
+
+// ColocationProfileDryRunStatus describes the mutations previewed in the dry-run mode.
+type ColocationProfileDryRunStatus struct {
+	// MatchedPods is the number of existing pods matched by the profile.
+	// +optional
+	MatchedPods int64 `json:"matchedPods,omitempty"`
+	// AffectedPods is the number of matched pods which would be changed by the profile.
+	// +optional
+	AffectedPods int64 `json:"affectedPods,omitempty"`
+	// LastDryRunTime is the last time the dry-run preview was calculated.
+	// +optional
+	LastDryRunTime *metav1.Time `json:"lastDryRunTime,omitempty"`
+	// Samples are some of the affected pods and the fields would be changed.
+	// +optional
+	Samples []ColocationProfileDryRunSample `json:"samples,omitempty"`
+}
+
+// ColocationProfileDryRunSample describes the fields of a pod would be changed by the profile.
+type ColocationProfileDryRunSample struct {
+	// Pod is the namespaced name of the pod.
+	Pod string `json:"pod"`
+	// Fields are the pod fields would be changed.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
 }
 
 // +genclient
@@ -0,0 +1,249 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ClusterColocationStrategySpec is a description of a ClusterColocationStrategy.
+type ClusterColocationStrategySpec struct {
+	// NodeSelector selects the node pool which the strategy applies to.
+	// An empty LabelSelector matches all nodes, while a nil LabelSelector matches no node.
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector"`
+
+	// Priority decides which strategy applies to a node matched by multiple strategies.
+	// The strategy with the higher priority wins. When the priorities are equal, the one with
+	// the smaller name wins.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// Strategy is the colocation strategy of the node pool. It is merged into the cluster colocation strategy
+	// and the nodeConfigs in the slo-controller-config, and takes precedence over them.
+	// The node annotation node.koordinator.sh/colocation-strategy still takes precedence over it.
+	// Only the colocation strategy is rolled out. The NodeSLO strategies (resourceThreshold, resourceQOS,
+	// cpuBurst, systemStrategy and their extensions) are still configured in the slo-controller-config.
+	// +optional
+	Strategy ColocationStrategy `json:"strategy,omitempty"`
+
+	// Rollout describes how the strategy revisions are rolled out to the matched nodes.
+	// +optional
+	Rollout ColocationStrategyRollout `json:"rollout,omitempty"`
+}
+
+// ColocationStrategy is the typed colocation strategy of a node pool.
+// It mirrors the ColocationStrategy in the slo-controller-config, so the json fields must be kept consistent.
+type ColocationStrategy struct {
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MetricAggregateDurationSeconds *int64 `json:"metricAggregateDurationSeconds,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MetricReportIntervalSeconds *int64 `json:"metricReportIntervalSeconds,omitempty"`
+	// +optional
+	MetricAggregatePolicy *AggregatePolicy `json:"metricAggregatePolicy,omitempty"`
+	// +kubebuilder:validation:Enum=usageWithoutPageCache;usageWithHotPageCache;usageWithPageCache
+	// +optional
+	MetricMemoryCollectPolicy *string `json:"metricMemoryCollectPolicy,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	CPUReclaimThresholdPercent *int64 `json:"cpuReclaimThresholdPercent,omitempty"`
+	// +kubebuilder:validation:Enum=usage;maxUsageRequest;prediction
+	// +optional
+	CPUCalculatePolicy *string `json:"cpuCalculatePolicy,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MemoryReclaimThresholdPercent *int64 `json:"memoryReclaimThresholdPercent,omitempty"`
+	// +kubebuilder:validation:Enum=usage;request;maxUsageRequest;prediction
+	// +optional
+	MemoryCalculatePolicy *string `json:"memoryCalculatePolicy,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	DegradeTimeMinutes *int64 `json:"degradeTimeMinutes,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	UpdateTimeThresholdSeconds *int64 `json:"updateTimeThresholdSeconds,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:ExclusiveMinimum=true
+	// +kubebuilder:validation:Maximum=1
+	// +optional
+	ResourceDiffThreshold *float64 `json:"resourceDiffThreshold,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	EphemeralStorageReclaimThresholdPercent *int64 `json:"ephemeralStorageReclaimThresholdPercent,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	GPUReclaimThresholdPercent *int64 `json:"gpuReclaimThresholdPercent,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MidCPUThresholdPercent *int64 `json:"midCPUThresholdPercent,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MidMemoryThresholdPercent *int64 `json:"midMemoryThresholdPercent,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MidUnallocatedPercent *int64 `json:"midUnallocatedPercent,omitempty"`
+
+	// Extensions are the strategies for third-party extensions.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Extensions *runtime.RawExtension `json:"extensions,omitempty"`
+}
+
+// AggregatePolicy describes the durations of the aggregated node metrics.
+type AggregatePolicy struct {
+	// +optional
+	Durations []metav1.Duration `json:"durations,omitempty"`
+}
+
+// ColocationStrategyRollout describes the staged rollout of a ClusterColocationStrategy.
+type ColocationStrategyRollout struct {
+	// Paused indicates the rollout is paused. The updated nodes keep the new revision, and the other
+	// nodes keep their previous revision.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+	// StepPercent is the percentage of the matched nodes updated to the new revision in each step.
+	// Defaults to 100, which updates all matched nodes in one step.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	StepPercent *int32 `json:"stepPercent,omitempty"`
+	// StepIntervalSeconds is the minimum interval between two steps. Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StepIntervalSeconds *int64 `json:"stepIntervalSeconds,omitempty"`
+}
+
+type ColocationStrategyPhase string
+
+const (
+	// ColocationStrategyProgressing means the new revision is rolling out to the matched nodes.
+	ColocationStrategyProgressing ColocationStrategyPhase = "Progressing"
+	// ColocationStrategyCompleted means all matched nodes are updated to the current revision.
+	ColocationStrategyCompleted ColocationStrategyPhase = "Completed"
+	// ColocationStrategyPaused means the rollout is paused by the user.
+	ColocationStrategyPaused ColocationStrategyPhase = "Paused"
+	// ColocationStrategyHalted means the rollout is halted since some updated nodes are degraded, i.e. their
+	// NodeMetrics are not updated in time. The rollout continues after the degraded nodes recover.
+	ColocationStrategyHalted ColocationStrategyPhase = "Halted"
+	// ColocationStrategyInvalid means the strategy is invalid and is not rolled out.
+	ColocationStrategyInvalid ColocationStrategyPhase = "Invalid"
+)
+
+// ClusterColocationStrategyStatus represents information about the status of a ClusterColocationStrategy.
+type ClusterColocationStrategyStatus struct {
+	// ObservedGeneration is the most recent generation observed by the colocation strategy controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Phase is the rollout phase of the current revision.
+	// +optional
+	Phase ColocationStrategyPhase `json:"phase,omitempty"`
+	// Message is a human-readable message of the phase.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// CurrentRevision is the revision of the current strategy.
+	// +optional
+	CurrentRevision string `json:"currentRevision,omitempty"`
+	// MatchedNodes is the number of nodes which the strategy applies to.
+	// +optional
+	MatchedNodes int32 `json:"matchedNodes,omitempty"`
+	// UpdatedNodes is the number of matched nodes updated to the current revision.
+	// +optional
+	UpdatedNodes int32 `json:"updatedNodes,omitempty"`
+	// DegradedNodes is the number of updated nodes whose NodeMetrics are degraded.
+	// +optional
+	DegradedNodes int32 `json:"degradedNodes,omitempty"`
+	// LastStepTime is the last time a rollout step updated the nodes.
+	// +optional
+	LastStepTime *metav1.Time `json:"lastStepTime,omitempty"`
+	// Revisions are the numbers of matched nodes on each revision, sorted by the revision.
+	// +optional
+	Revisions []ColocationStrategyRevisionStatus `json:"revisions,omitempty"`
+	// UnreadyNodes are the degraded nodes and then the pending nodes which are not updated to the current
+	// revision, sorted by name. At most 20 nodes are listed.
+	// +optional
+	UnreadyNodes []ColocationStrategyNodeStatus `json:"unreadyNodes,omitempty"`
+}
+
+// ColocationStrategyRevisionStatus describes the number of matched nodes on a revision.
+type ColocationStrategyRevisionStatus struct {
+	// Revision is the revision applied on the nodes. It is empty for the nodes which no revision is applied on.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+	// Nodes is the number of matched nodes on the revision.
+	Nodes int32 `json:"nodes"`
+}
+
+// ColocationStrategyNodeStatus describes the revision applied on a node.
+type ColocationStrategyNodeStatus struct {
+	// Name is the name of the node.
+	Name string `json:"name"`
+	// Revision is the revision applied on the node. It is empty if no revision is applied yet.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+	// Degraded indicates whether the NodeMetric of the node is degraded.
+	// +optional
+	Degraded bool `json:"degraded,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Revision",type="string",JSONPath=".status.currentRevision"
+// +kubebuilder:printcolumn:name="Matched",type="integer",JSONPath=".status.matchedNodes"
+// +kubebuilder:printcolumn:name="Updated",type="integer",JSONPath=".status.updatedNodes"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterColocationStrategy is the Schema for the ClusterColocationStrategy API.
+// It configures the colocation strategy of a node pool and rolls it out in stages. The NodeSLO strategies are
+// not covered and are still configured in the slo-controller-config.
+type ClusterColocationStrategy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ClusterColocationStrategySpec   `json:"spec,omitempty"`
+	Status            ClusterColocationStrategyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterColocationStrategyList contains a list of ClusterColocationStrategy
+type ClusterColocationStrategyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterColocationStrategy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterColocationStrategy{}, &ClusterColocationStrategyList{})
+}
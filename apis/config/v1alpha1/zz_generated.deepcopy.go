@@ -26,13 +26,33 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AggregatePolicy) DeepCopyInto(out *AggregatePolicy) {
+	*out = *in
+	if in.Durations != nil {
+		in, out := &in.Durations, &out.Durations
+		*out = make([]v1.Duration, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AggregatePolicy.
+func (in *AggregatePolicy) DeepCopy() *AggregatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AggregatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterColocationProfile) DeepCopyInto(out *ClusterColocationProfile) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterColocationProfile.
@@ -159,6 +179,27 @@ func (in *ClusterColocationProfileSpec) DeepCopy() *ClusterColocationProfileSpec
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterColocationProfileStatus) DeepCopyInto(out *ClusterColocationProfileStatus) {
 	*out = *in
+	if in.LastMutationTime != nil {
+		in, out := &in.LastMutationTime, &out.LastMutationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MatchedNamespaces != nil {
+		in, out := &in.MatchedNamespaces, &out.MatchedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]ColocationProfileConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(ColocationProfileDryRunStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterColocationProfileStatus.
@@ -170,3 +211,339 @@ func (in *ClusterColocationProfileStatus) DeepCopy() *ClusterColocationProfileSt
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterColocationStrategy) DeepCopyInto(out *ClusterColocationStrategy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterColocationStrategy.
+func (in *ClusterColocationStrategy) DeepCopy() *ClusterColocationStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterColocationStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterColocationStrategy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterColocationStrategyList) DeepCopyInto(out *ClusterColocationStrategyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterColocationStrategy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterColocationStrategyList.
+func (in *ClusterColocationStrategyList) DeepCopy() *ClusterColocationStrategyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterColocationStrategyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterColocationStrategyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterColocationStrategySpec) DeepCopyInto(out *ClusterColocationStrategySpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Strategy.DeepCopyInto(&out.Strategy)
+	in.Rollout.DeepCopyInto(&out.Rollout)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterColocationStrategySpec.
+func (in *ClusterColocationStrategySpec) DeepCopy() *ClusterColocationStrategySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterColocationStrategySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterColocationStrategyStatus) DeepCopyInto(out *ClusterColocationStrategyStatus) {
+	*out = *in
+	if in.LastStepTime != nil {
+		in, out := &in.LastStepTime, &out.LastStepTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]ColocationStrategyRevisionStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnreadyNodes != nil {
+		in, out := &in.UnreadyNodes, &out.UnreadyNodes
+		*out = make([]ColocationStrategyNodeStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterColocationStrategyStatus.
+func (in *ClusterColocationStrategyStatus) DeepCopy() *ClusterColocationStrategyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterColocationStrategyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ColocationProfileConflict) DeepCopyInto(out *ColocationProfileConflict) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ColocationProfileConflict.
+func (in *ColocationProfileConflict) DeepCopy() *ColocationProfileConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(ColocationProfileConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ColocationProfileDryRunSample) DeepCopyInto(out *ColocationProfileDryRunSample) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ColocationProfileDryRunSample.
+func (in *ColocationProfileDryRunSample) DeepCopy() *ColocationProfileDryRunSample {
+	if in == nil {
+		return nil
+	}
+	out := new(ColocationProfileDryRunSample)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ColocationProfileDryRunStatus) DeepCopyInto(out *ColocationProfileDryRunStatus) {
+	*out = *in
+	if in.LastDryRunTime != nil {
+		in, out := &in.LastDryRunTime, &out.LastDryRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Samples != nil {
+		in, out := &in.Samples, &out.Samples
+		*out = make([]ColocationProfileDryRunSample, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ColocationProfileDryRunStatus.
+func (in *ColocationProfileDryRunStatus) DeepCopy() *ColocationProfileDryRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ColocationProfileDryRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ColocationStrategy) DeepCopyInto(out *ColocationStrategy) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MetricAggregateDurationSeconds != nil {
+		in, out := &in.MetricAggregateDurationSeconds, &out.MetricAggregateDurationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MetricReportIntervalSeconds != nil {
+		in, out := &in.MetricReportIntervalSeconds, &out.MetricReportIntervalSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MetricAggregatePolicy != nil {
+		in, out := &in.MetricAggregatePolicy, &out.MetricAggregatePolicy
+		*out = new(AggregatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricMemoryCollectPolicy != nil {
+		in, out := &in.MetricMemoryCollectPolicy, &out.MetricMemoryCollectPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.CPUReclaimThresholdPercent != nil {
+		in, out := &in.CPUReclaimThresholdPercent, &out.CPUReclaimThresholdPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CPUCalculatePolicy != nil {
+		in, out := &in.CPUCalculatePolicy, &out.CPUCalculatePolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.MemoryReclaimThresholdPercent != nil {
+		in, out := &in.MemoryReclaimThresholdPercent, &out.MemoryReclaimThresholdPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MemoryCalculatePolicy != nil {
+		in, out := &in.MemoryCalculatePolicy, &out.MemoryCalculatePolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.DegradeTimeMinutes != nil {
+		in, out := &in.DegradeTimeMinutes, &out.DegradeTimeMinutes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UpdateTimeThresholdSeconds != nil {
+		in, out := &in.UpdateTimeThresholdSeconds, &out.UpdateTimeThresholdSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ResourceDiffThreshold != nil {
+		in, out := &in.ResourceDiffThreshold, &out.ResourceDiffThreshold
+		*out = new(float64)
+		**out = **in
+	}
+	if in.EphemeralStorageReclaimThresholdPercent != nil {
+		in, out := &in.EphemeralStorageReclaimThresholdPercent, &out.EphemeralStorageReclaimThresholdPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GPUReclaimThresholdPercent != nil {
+		in, out := &in.GPUReclaimThresholdPercent, &out.GPUReclaimThresholdPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MidCPUThresholdPercent != nil {
+		in, out := &in.MidCPUThresholdPercent, &out.MidCPUThresholdPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MidMemoryThresholdPercent != nil {
+		in, out := &in.MidMemoryThresholdPercent, &out.MidMemoryThresholdPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MidUnallocatedPercent != nil {
+		in, out := &in.MidUnallocatedPercent, &out.MidUnallocatedPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ColocationStrategy.
+func (in *ColocationStrategy) DeepCopy() *ColocationStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ColocationStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ColocationStrategyNodeStatus) DeepCopyInto(out *ColocationStrategyNodeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ColocationStrategyNodeStatus.
+func (in *ColocationStrategyNodeStatus) DeepCopy() *ColocationStrategyNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ColocationStrategyNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ColocationStrategyRevisionStatus) DeepCopyInto(out *ColocationStrategyRevisionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ColocationStrategyRevisionStatus.
+func (in *ColocationStrategyRevisionStatus) DeepCopy() *ColocationStrategyRevisionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ColocationStrategyRevisionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ColocationStrategyRollout) DeepCopyInto(out *ColocationStrategyRollout) {
+	*out = *in
+	if in.StepPercent != nil {
+		in, out := &in.StepPercent, &out.StepPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StepIntervalSeconds != nil {
+		in, out := &in.StepIntervalSeconds, &out.StepIntervalSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ColocationStrategyRollout.
+func (in *ColocationStrategyRollout) DeepCopy() *ColocationStrategyRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ColocationStrategyRollout)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -198,6 +198,13 @@ const (
 	// It is the conservative policy where the resources are NOT over-committed between priority bands while HP's usage
 	// is also protected from the overcommitment.
 	CalculateByPodMaxUsageRequest CalculatePolicy = "maxUsageRequest"
+	// CalculateByPodPrediction is the calculate policy according to the predicted peak of the pod resource usage.
+	// When the policy="prediction", the low-priority (LP) resources are calculated according to the high-priority (HP)
+	// pods' and the system's predicted peaks reported by the koordlet, so LP pod can reclaim the resources unused by
+	// the HP pods in the long term. The pods without the predicted peaks (e.g. in the cold start) are calculated
+	// according to their usages.
+	// It is the policy to stabilize the LP resources when the HP usages fluctuate.
+	CalculateByPodPrediction CalculatePolicy = "prediction"
 )
 
 // +k8s:deepcopy-gen=true
@@ -237,16 +244,25 @@ type ColocationStrategy struct {
 
 	CPUReclaimThresholdPercent *int64 `json:"cpuReclaimThresholdPercent,omitempty" validate:"omitempty,min=0,max=100"`
 	// CPUCalculatePolicy determines the calculation policy of the CPU resources for the Batch pods.
-	// Supported: "usage" (default), "maxUsageRequest".
+	// Supported: "usage" (default), "maxUsageRequest", "prediction".
 	CPUCalculatePolicy            *CalculatePolicy `json:"cpuCalculatePolicy,omitempty"`
 	MemoryReclaimThresholdPercent *int64           `json:"memoryReclaimThresholdPercent,omitempty" validate:"omitempty,min=0,max=100"`
 	// MemoryCalculatePolicy determines the calculation policy of the memory resources for the Batch pods.
-	// Supported: "usage" (default), "request", "maxUsageRequest".
+	// Supported: "usage" (default), "request", "maxUsageRequest", "prediction".
 	MemoryCalculatePolicy      *CalculatePolicy `json:"memoryCalculatePolicy,omitempty"`
 	DegradeTimeMinutes         *int64           `json:"degradeTimeMinutes,omitempty" validate:"omitempty,min=1"`
 	UpdateTimeThresholdSeconds *int64           `json:"updateTimeThresholdSeconds,omitempty" validate:"omitempty,min=1"`
 	ResourceDiffThreshold      *float64         `json:"resourceDiffThreshold,omitempty" validate:"omitempty,gt=0,max=1"`
 
+	// EphemeralStorageReclaimThresholdPercent is the percentage of the node ephemeral storage capacity which can be
+	// used by the prod pods and the Batch pods in total.
+	// Allocatable[Batch-Ephemeral-Storage]' := Capacity * EphemeralStorageReclaimThresholdPercent - SystemUsed - HPUsed.
+	EphemeralStorageReclaimThresholdPercent *int64 `json:"ephemeralStorageReclaimThresholdPercent,omitempty" validate:"omitempty,min=0,max=100"`
+	// GPUReclaimThresholdPercent is the percentage of each GPU device which can be used by the prod pods and the Batch
+	// pods in total. It applies to both the GPU core and the GPU memory.
+	// Allocatable[Batch-GPU]' := sum(max(DeviceTotal * GPUReclaimThresholdPercent - HPUsed, 0)) for each device.
+	GPUReclaimThresholdPercent *int64 `json:"gpuReclaimThresholdPercent,omitempty" validate:"omitempty,min=0,max=100"`
+
 	// AllocatableCPU[Mid]' := min(Reclaimable[Mid], NodeAllocatable * MidCPUThresholdPercent) + Unallocated[Mid] * midUnallocatedRatio.
 	MidCPUThresholdPercent *int64 `json:"midCPUThresholdPercent,omitempty" validate:"omitempty,min=0,max=100"`
 	// AllocatableMemory[Mid]' := min(Reclaimable[Mid], NodeAllocatable * MidMemoryThresholdPercent) + Unallocated[Mid] * midUnallocatedRatio.
@@ -283,6 +299,23 @@ type CPUNormalizationStrategy struct {
 	// RatioModel defines the cpu normalization ratio of each CPU model.
 	// It maps the CPUModel of BasicInfo into the ratios.
 	RatioModel map[string]ModelRatioCfg `json:"ratioModel,omitempty"`
+	// BenchmarkRatio defines how to derive the ratios from the cpu benchmark scores reported by the nodes.
+	// The ratios in the RatioModel take precedence over the derived ones.
+	BenchmarkRatio *BenchmarkRatioCfg `json:"benchmarkRatio,omitempty"`
+}
+
+// BenchmarkRatioCfg defines the cpu normalization ratio derived from the cpu benchmark scores.
+// The ratio of a node is the average benchmark score of the nodes with the same CPU model, Hyper Thread and Turbo
+// status divided by the reference score.
+// +k8s:deepcopy-gen=true
+type BenchmarkRatioCfg struct {
+	// Enable defines whether to derive the ratios from the benchmark scores.
+	Enable *bool `json:"enable,omitempty"`
+	// ReferenceModel is the CPU model whose ratio is 1.0. The reference score is the average benchmark score of the
+	// nodes with the reference model. Since the ratio below 1.0 is not supported, it should be the slowest model.
+	ReferenceModel *string `json:"referenceModel,omitempty"`
+	// ReferenceScore is the benchmark score whose ratio is 1.0. It takes precedence over the ReferenceModel.
+	ReferenceScore *float64 `json:"referenceScore,omitempty" validate:"omitempty,gt=0"`
 }
 
 // ModelRatioCfg defines the cpu normalization ratio of a CPU model.
@@ -26,6 +26,36 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BenchmarkRatioCfg) DeepCopyInto(out *BenchmarkRatioCfg) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReferenceModel != nil {
+		in, out := &in.ReferenceModel, &out.ReferenceModel
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReferenceScore != nil {
+		in, out := &in.ReferenceScore, &out.ReferenceScore
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BenchmarkRatioCfg.
+func (in *BenchmarkRatioCfg) DeepCopy() *BenchmarkRatioCfg {
+	if in == nil {
+		return nil
+	}
+	out := new(BenchmarkRatioCfg)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUBurstCfg) DeepCopyInto(out *CPUBurstCfg) {
 	*out = *in
@@ -96,6 +126,11 @@ func (in *CPUNormalizationStrategy) DeepCopyInto(out *CPUNormalizationStrategy)
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.BenchmarkRatio != nil {
+		in, out := &in.BenchmarkRatio, &out.BenchmarkRatio
+		*out = new(BenchmarkRatioCfg)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUNormalizationStrategy.
@@ -194,6 +229,16 @@ func (in *ColocationStrategy) DeepCopyInto(out *ColocationStrategy) {
 		*out = new(float64)
 		**out = **in
 	}
+	if in.EphemeralStorageReclaimThresholdPercent != nil {
+		in, out := &in.EphemeralStorageReclaimThresholdPercent, &out.EphemeralStorageReclaimThresholdPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GPUReclaimThresholdPercent != nil {
+		in, out := &in.GPUReclaimThresholdPercent, &out.GPUReclaimThresholdPercent
+		*out = new(int64)
+		**out = **in
+	}
 	if in.MidCPUThresholdPercent != nil {
 		in, out := &in.MidCPUThresholdPercent, &out.MidCPUThresholdPercent
 		*out = new(int64)
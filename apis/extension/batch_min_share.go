@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// LabelBatchMinSharePercent declares the minimum percentage of the BE cpu pool on the node guaranteed for the
+	// BE pod. The value is an integer in (0, 100]. It can be set on the pod directly or injected by a
+	// ClusterColocationProfile with the labels.
+	// When the node suppresses the BE pods, the guaranteed pods keep the declared share of the suppressed cpu
+	// quota, and they are evicted after the non-guaranteed BE pods.
+	LabelBatchMinSharePercent = DomainPrefix + "batch-min-share-percent"
+)
+
+// GetBatchMinSharePercent returns the minimum share percentage of the BE pool declared by the pod labels.
+// It returns 0 if the pod declares no minimum share.
+func GetBatchMinSharePercent(labels map[string]string) (int64, error) {
+	s, ok := labels[LabelBatchMinSharePercent]
+	if !ok {
+		return 0, nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse batch min share percent failed, err: %w", err)
+	}
+	if v <= 0 || v > 100 {
+		return 0, fmt.Errorf("invalid batch min share percent %d, should be in (0, 100]", v)
+	}
+	return v, nil
+}
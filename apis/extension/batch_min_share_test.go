@@ -0,0 +1,59 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBatchMinSharePercent(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		want    int64
+		wantErr bool
+	}{
+		{
+			name: "no label",
+			want: 0,
+		},
+		{
+			name:   "valid percent",
+			labels: map[string]string{LabelBatchMinSharePercent: "30"},
+			want:   30,
+		},
+		{
+			name:    "invalid format",
+			labels:  map[string]string{LabelBatchMinSharePercent: "0.3"},
+			wantErr: true,
+		},
+		{
+			name:    "out of range",
+			labels:  map[string]string{LabelBatchMinSharePercent: "120"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetBatchMinSharePercent(tt.labels)
+			assert.Equal(t, tt.wantErr, err != nil, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -17,6 +17,10 @@ limitations under the License.
 package extension
 
 import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
 	configv1alpha1 "github.com/koordinator-sh/koordinator/apis/config/v1alpha1"
 )
 
@@ -26,6 +30,13 @@ const (
 	// LabelControllerManaged indicates whether the colocation profile should be reconciled by the controller.
 	// If not specified, the controller only reconciles the profile if ReconcileByDefault is set to true.
 	LabelControllerManaged = "config.koordinator.sh/controller-managed"
+
+	// AnnotationColocationProfileDryRun indicates the colocation profile is in the dry-run mode.
+	// The webhook and the controller only record what the profile would mutate without changing any pod.
+	AnnotationColocationProfileDryRun = "config.koordinator.sh/dry-run"
+
+	// AnnotationColocationProfiles records the names of the colocation profiles which mutated the pod, separated by commas.
+	AnnotationColocationProfiles = "config.koordinator.sh/colocation-profiles"
 )
 
 func ShouldSkipUpdateResource(profile *configv1alpha1.ClusterColocationProfile) bool {
@@ -39,3 +50,29 @@ func ShouldSkipUpdateResource(profile *configv1alpha1.ClusterColocationProfile)
 func ShouldReconcileProfile(profile *configv1alpha1.ClusterColocationProfile) bool {
 	return profile != nil && profile.Labels != nil && profile.Labels[LabelControllerManaged] == "true"
 }
+
+func IsColocationProfileDryRun(profile *configv1alpha1.ClusterColocationProfile) bool {
+	return profile != nil && profile.Annotations != nil && profile.Annotations[AnnotationColocationProfileDryRun] == "true"
+}
+
+// GetColocationProfilesOfPod returns the names of the colocation profiles which mutated the pod.
+func GetColocationProfilesOfPod(pod *corev1.Pod) []string {
+	if pod == nil || pod.Annotations == nil || pod.Annotations[AnnotationColocationProfiles] == "" {
+		return nil
+	}
+	return strings.Split(pod.Annotations[AnnotationColocationProfiles], ",")
+}
+
+// AddColocationProfileToPod appends the profile name into the pod annotation if it is not recorded yet.
+func AddColocationProfileToPod(pod *corev1.Pod, profileName string) {
+	profiles := GetColocationProfilesOfPod(pod)
+	for _, name := range profiles {
+		if name == profileName {
+			return
+		}
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[AnnotationColocationProfiles] = strings.Join(append(profiles, profileName), ",")
+}
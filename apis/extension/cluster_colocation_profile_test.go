@@ -0,0 +1,51 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1alpha1 "github.com/koordinator-sh/koordinator/apis/config/v1alpha1"
+)
+
+func TestIsColocationProfileDryRun(t *testing.T) {
+	assert.False(t, IsColocationProfileDryRun(nil))
+	assert.False(t, IsColocationProfileDryRun(&configv1alpha1.ClusterColocationProfile{}))
+	assert.True(t, IsColocationProfileDryRun(&configv1alpha1.ClusterColocationProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				AnnotationColocationProfileDryRun: "true",
+			},
+		},
+	}))
+}
+
+func TestAddColocationProfileToPod(t *testing.T) {
+	pod := &corev1.Pod{}
+	assert.Nil(t, GetColocationProfilesOfPod(pod))
+
+	AddColocationProfileToPod(pod, "profile-a")
+	assert.Equal(t, "profile-a", pod.Annotations[AnnotationColocationProfiles])
+	AddColocationProfileToPod(pod, "profile-b")
+	AddColocationProfileToPod(pod, "profile-a")
+	assert.Equal(t, "profile-a,profile-b", pod.Annotations[AnnotationColocationProfiles])
+	assert.Equal(t, []string{"profile-a", "profile-b"}, GetColocationProfilesOfPod(pod))
+}
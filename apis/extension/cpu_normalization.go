@@ -104,6 +104,9 @@ type CPUBasicInfo struct {
 	TurboEnabled       bool   `json:"turboEnabled,omitempty"`
 	CatL3CbmMask       string `json:"catL3CbmMask,omitempty"`
 	VendorID           string `json:"vendorID,omitempty"`
+	// BenchmarkScore is the single-core benchmark score of the cpu, which is higher for the faster cpu.
+	// It is zero when the benchmark is not reported.
+	BenchmarkScore float64 `json:"benchmarkScore,omitempty"`
 }
 
 func (c *CPUBasicInfo) Key() string {
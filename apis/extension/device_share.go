@@ -68,6 +68,18 @@ const (
 	LabelGPUIsolationProvider = DomainPrefix + "gpu-isolation-provider"
 )
 
+const (
+	// AnnotationNodeBatchGPUResources represents the GPU resources reclaimable by the batch pods on each device.
+	AnnotationNodeBatchGPUResources = NodeDomainPrefix + "/batch-gpu-resources"
+)
+
+// BatchGPUResourceNameMap maps the GPU resources to the batch GPU resources.
+var BatchGPUResourceNameMap = map[corev1.ResourceName]corev1.ResourceName{
+	ResourceGPUCore:        BatchGPUCore,
+	ResourceGPUMemory:      BatchGPUMemory,
+	ResourceGPUMemoryRatio: BatchGPUMemoryRatio,
+}
+
 const (
 	GPUVendorNVIDIA = "nvidia"
 	GPUVendorHuawei = "huawei"
@@ -274,6 +286,71 @@ func SetDeviceAllocations(obj metav1.Object, allocations DeviceAllocations) erro
 	return nil
 }
 
+// NodeBatchGPUResources is the batch GPU resources of each device on the node, which is annotated on the node
+// by the slo-controller.
+/*
+[
+  {
+    "minor": 0,
+    "resources": {
+      "koordinator.sh/gpu-core": 60,
+      "koordinator.sh/gpu-memory-ratio": 50,
+      "koordinator.sh/gpu-memory": "8Gi"
+    }
+  }
+]
+*/
+type NodeBatchGPUResources []NodeBatchGPUResource
+
+type NodeBatchGPUResource struct {
+	Minor int32 `json:"minor"`
+	// Resources is the reclaimable resources of the device in the GPU resource names, e.g. koordinator.sh/gpu-core.
+	Resources corev1.ResourceList `json:"resources,omitempty"`
+}
+
+// GetNodeBatchGPUResources parses the batch GPU resources from the node annotations.
+// It returns nil without an error when the annotation is missing.
+func GetNodeBatchGPUResources(annotations map[string]string) (NodeBatchGPUResources, error) {
+	data, ok := annotations[AnnotationNodeBatchGPUResources]
+	if !ok {
+		return nil, nil
+	}
+	var resources NodeBatchGPUResources
+	if err := json.Unmarshal([]byte(data), &resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+func SetNodeBatchGPUResources(obj metav1.Object, resources NodeBatchGPUResources) error {
+	data, err := json.Marshal(resources)
+	if err != nil {
+		return err
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[AnnotationNodeBatchGPUResources] = string(data)
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+// IsPodRequestBatchGPU checks if the pod requests the batch GPU resources.
+func IsPodRequestBatchGPU(pod *corev1.Pod) bool {
+	hasBatchGPU := func(containers []corev1.Container) bool {
+		for i := range containers {
+			for _, name := range BatchGPUResourceNameMap {
+				if q, ok := containers[i].Resources.Requests[name]; ok && !q.IsZero() {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return hasBatchGPU(pod.Spec.Containers) || hasBatchGPU(pod.Spec.InitContainers)
+}
+
 func SetDeviceAllocateHints(obj metav1.Object, hint DeviceAllocateHints) error {
 	if hint == nil {
 		return nil
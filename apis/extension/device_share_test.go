@@ -357,3 +357,61 @@ func TestGetNodeLevelGPUAllocatePolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeBatchGPUResources(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+		},
+	}
+	got, err := GetNodeBatchGPUResources(node.Annotations)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	resources := NodeBatchGPUResources{
+		{
+			Minor: 0,
+			Resources: corev1.ResourceList{
+				ResourceGPUCore:        resource.MustParse("60"),
+				ResourceGPUMemoryRatio: resource.MustParse("50"),
+				ResourceGPUMemory:      resource.MustParse("8Gi"),
+			},
+		},
+	}
+	err = SetNodeBatchGPUResources(node, resources)
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"minor":0,"resources":{"koordinator.sh/gpu-core":"60","koordinator.sh/gpu-memory":"8Gi","koordinator.sh/gpu-memory-ratio":"50"}}]`,
+		node.Annotations[AnnotationNodeBatchGPUResources])
+	got, err = GetNodeBatchGPUResources(node.Annotations)
+	assert.NoError(t, err)
+	assert.Equal(t, resources, got)
+
+	node.Annotations[AnnotationNodeBatchGPUResources] = "invalid"
+	got, err = GetNodeBatchGPUResources(node.Annotations)
+	assert.Error(t, err)
+	assert.Nil(t, got)
+}
+
+func TestIsPodRequestBatchGPU(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "main",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							ResourceGPUCore:        resource.MustParse("50"),
+							ResourceGPUMemoryRatio: resource.MustParse("50"),
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.False(t, IsPodRequestBatchGPU(pod))
+	pod.Spec.Containers[0].Resources.Requests = corev1.ResourceList{
+		BatchGPUCore:        resource.MustParse("50"),
+		BatchGPUMemoryRatio: resource.MustParse("50"),
+	}
+	assert.True(t, IsPodRequestBatchGPU(pod))
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// AnnotationNodeHostApplicationReservation denotes the resources reserved for the high-priority host applications
+	// on the node, which is the sum of their peak usages. It is annotated by the slo-controller, e.g.
+	//
+	//	annotations:
+	//	  node.koordinator.sh/host-application-reservation: '{"cpu":"2","memory":"4Gi"}'
+	AnnotationNodeHostApplicationReservation = NodeDomainPrefix + "/host-application-reservation"
+)
+
+// GetNodeHostApplicationReservation gets the host application reservation of node from annotations.
+// It returns nil without an error when the annotation is missing.
+func GetNodeHostApplicationReservation(annotations map[string]string) (corev1.ResourceList, error) {
+	s, ok := annotations[AnnotationNodeHostApplicationReservation]
+	if !ok {
+		return nil, nil
+	}
+
+	var reservation corev1.ResourceList
+	if err := json.Unmarshal([]byte(s), &reservation); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal node host application reservation: %w", err)
+	}
+
+	return reservation, nil
+}
+
+// SetNodeHostApplicationReservation sets the node annotation according to the host application reservation.
+func SetNodeHostApplicationReservation(node *corev1.Node, reservation corev1.ResourceList) {
+	s, _ := json.Marshal(reservation)
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[AnnotationNodeHostApplicationReservation] = string(s)
+}
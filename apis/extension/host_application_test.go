@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetNodeHostApplicationReservation(t *testing.T) {
+	tests := []struct {
+		name    string
+		node    *corev1.Node
+		want    corev1.ResourceList
+		wantErr bool
+	}{
+		{
+			name:    "node has no annotation",
+			node:    &corev1.Node{},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "node has valid host application reservation annotation",
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"xxx":                                    "yyy",
+						AnnotationNodeHostApplicationReservation: `{"cpu":"2","memory":"4Gi"}`,
+					},
+				},
+			},
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "node has invalid host application reservation annotation",
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						AnnotationNodeHostApplicationReservation: "invalid",
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotErr := GetNodeHostApplicationReservation(tt.node.Annotations)
+			assert.Equal(t, tt.wantErr, gotErr != nil)
+			assert.True(t, len(tt.want) == len(got))
+			for name, q := range tt.want {
+				assert.True(t, q.Equal(got[name]), name)
+			}
+		})
+	}
+}
+
+func TestSetNodeHostApplicationReservation(t *testing.T) {
+	node := &corev1.Node{}
+	reservation := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("1500m"),
+		corev1.ResourceMemory: resource.MustParse("2Gi"),
+	}
+	SetNodeHostApplicationReservation(node, reservation)
+	assert.Equal(t, `{"cpu":"1500m","memory":"2Gi"}`, node.Annotations[AnnotationNodeHostApplicationReservation])
+
+	got, err := GetNodeHostApplicationReservation(node.Annotations)
+	assert.NoError(t, err)
+	assert.Equal(t, reservation, got)
+}
@@ -21,6 +21,14 @@ const (
 	// The value is the ColocationStrategy. It takes precedence to the ColocationStrategy in the slo-controller-config.
 	// The illegal value will be ignored.
 	AnnotationNodeColocationStrategy = NodeDomainPrefix + "/colocation-strategy"
+	// AnnotationNodeClusterColocationStrategy denotes the annotation key of the colocation strategy rolled out by a
+	// ClusterColocationStrategy. The value is the ColocationStrategy. It takes precedence to the ColocationStrategy in
+	// the slo-controller-config, while the AnnotationNodeColocationStrategy takes precedence to it.
+	// It is maintained by the koord-manager and should not be modified manually.
+	AnnotationNodeClusterColocationStrategy = NodeDomainPrefix + "/cluster-colocation-strategy"
+	// AnnotationNodeColocationStrategyRevision denotes the ClusterColocationStrategy and its revision applied on the
+	// node. The value is in the format of "<name>/<revision>".
+	AnnotationNodeColocationStrategyRevision = NodeDomainPrefix + "/colocation-strategy-revision"
 
 	// LabelCPUReclaimRatio denotes the CPU reclaim ratio of a node. The value is a float number.
 	// It takes precedence to the CPUReclaimThresholdPercent in the slo-controller-config and the node annotations.
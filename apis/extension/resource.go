@@ -27,6 +27,16 @@ const (
 	BatchMemory corev1.ResourceName = ResourceDomainPrefix + "batch-memory"
 	MidCPU      corev1.ResourceName = ResourceDomainPrefix + "mid-cpu"
 	MidMemory   corev1.ResourceName = ResourceDomainPrefix + "mid-memory"
+
+	// BatchEphemeralStorage is the ephemeral storage reclaimed from the high-priority pods for the batch pods.
+	BatchEphemeralStorage corev1.ResourceName = ResourceDomainPrefix + "batch-ephemeral-storage"
+
+	// BatchGPUCore, BatchGPUMemory and BatchGPUMemoryRatio are the GPU resources reclaimed from the high-priority pods
+	// for the batch pods. The per-device reclaimable resources are annotated on the node with
+	// AnnotationNodeBatchGPUResources.
+	BatchGPUCore        corev1.ResourceName = ResourceDomainPrefix + "batch-gpu-core"
+	BatchGPUMemory      corev1.ResourceName = ResourceDomainPrefix + "batch-gpu-memory"
+	BatchGPUMemoryRatio corev1.ResourceName = ResourceDomainPrefix + "batch-gpu-memory-ratio"
 )
 
 const (
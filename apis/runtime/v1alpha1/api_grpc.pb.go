@@ -60,6 +60,21 @@ type RuntimeHookServiceClient interface {
 	// PreUpdateContainerResourcesHook calls RuntimeHookServer before container resource update to keep resource policy
 	// consistent
 	PreUpdateContainerResourcesHook(ctx context.Context, in *ContainerResourceHookRequest, opts ...grpc.CallOption) (*ContainerResourceHookResponse, error)
+	// PrePullImageHook calls RuntimeHookServer before image pulling. RuntimeHookServer could reject the image
+	// with node-local image policies or rewrite the image reference.
+	PrePullImageHook(ctx context.Context, in *ImageHookRequest, opts ...grpc.CallOption) (*ImageHookResponse, error)
+	// PostContainerStatsHook calls RuntimeHookServer after the container stats is returned by backend runtime
+	// engine. RuntimeHookServer could enrich the stats with additional annotations.
+	PostContainerStatsHook(ctx context.Context, in *ContainerStatsHookRequest, opts ...grpc.CallOption) (*ContainerStatsHookResponse, error)
+	// PreCheckpointContainerHook calls RuntimeHookServer before container checkpointing. RuntimeHookServer could
+	// reject the checkpoint or modify the location of the checkpoint archive.
+	PreCheckpointContainerHook(ctx context.Context, in *ContainerCheckpointHookRequest, opts ...grpc.CallOption) (*ContainerCheckpointHookResponse, error)
+	// PostCheckpointContainerHook calls RuntimeHookServer after container checkpointed. RuntimeHookServer could
+	// record or transfer the checkpoint archive.
+	PostCheckpointContainerHook(ctx context.Context, in *ContainerCheckpointHookRequest, opts ...grpc.CallOption) (*ContainerCheckpointHookResponse, error)
+	// PreRestoreContainerHook calls RuntimeHookServer before the container is restored from a checkpoint archive,
+	// which is called before PreCreateContainerHook. RuntimeHookServer could prepare the checkpoint archive.
+	PreRestoreContainerHook(ctx context.Context, in *ContainerCheckpointHookRequest, opts ...grpc.CallOption) (*ContainerCheckpointHookResponse, error)
 }
 
 type runtimeHookServiceClient struct {
@@ -133,6 +148,51 @@ func (c *runtimeHookServiceClient) PreUpdateContainerResourcesHook(ctx context.C
 	return out, nil
 }
 
+func (c *runtimeHookServiceClient) PrePullImageHook(ctx context.Context, in *ImageHookRequest, opts ...grpc.CallOption) (*ImageHookResponse, error) {
+	out := new(ImageHookResponse)
+	err := c.cc.Invoke(ctx, "/runtime.v1alpha1.RuntimeHookService/PrePullImageHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeHookServiceClient) PostContainerStatsHook(ctx context.Context, in *ContainerStatsHookRequest, opts ...grpc.CallOption) (*ContainerStatsHookResponse, error) {
+	out := new(ContainerStatsHookResponse)
+	err := c.cc.Invoke(ctx, "/runtime.v1alpha1.RuntimeHookService/PostContainerStatsHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeHookServiceClient) PreCheckpointContainerHook(ctx context.Context, in *ContainerCheckpointHookRequest, opts ...grpc.CallOption) (*ContainerCheckpointHookResponse, error) {
+	out := new(ContainerCheckpointHookResponse)
+	err := c.cc.Invoke(ctx, "/runtime.v1alpha1.RuntimeHookService/PreCheckpointContainerHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeHookServiceClient) PostCheckpointContainerHook(ctx context.Context, in *ContainerCheckpointHookRequest, opts ...grpc.CallOption) (*ContainerCheckpointHookResponse, error) {
+	out := new(ContainerCheckpointHookResponse)
+	err := c.cc.Invoke(ctx, "/runtime.v1alpha1.RuntimeHookService/PostCheckpointContainerHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeHookServiceClient) PreRestoreContainerHook(ctx context.Context, in *ContainerCheckpointHookRequest, opts ...grpc.CallOption) (*ContainerCheckpointHookResponse, error) {
+	out := new(ContainerCheckpointHookResponse)
+	err := c.cc.Invoke(ctx, "/runtime.v1alpha1.RuntimeHookService/PreRestoreContainerHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RuntimeHookServiceServer is the server API for RuntimeHookService service.
 // All implementations must embed UnimplementedRuntimeHookServiceServer
 // for forward compatibility
@@ -159,6 +219,21 @@ type RuntimeHookServiceServer interface {
 	// PreUpdateContainerResourcesHook calls RuntimeHookServer before container resource update to keep resource policy
 	// consistent
 	PreUpdateContainerResourcesHook(context.Context, *ContainerResourceHookRequest) (*ContainerResourceHookResponse, error)
+	// PrePullImageHook calls RuntimeHookServer before image pulling. RuntimeHookServer could reject the image
+	// with node-local image policies or rewrite the image reference.
+	PrePullImageHook(context.Context, *ImageHookRequest) (*ImageHookResponse, error)
+	// PostContainerStatsHook calls RuntimeHookServer after the container stats is returned by backend runtime
+	// engine. RuntimeHookServer could enrich the stats with additional annotations.
+	PostContainerStatsHook(context.Context, *ContainerStatsHookRequest) (*ContainerStatsHookResponse, error)
+	// PreCheckpointContainerHook calls RuntimeHookServer before container checkpointing. RuntimeHookServer could
+	// reject the checkpoint or modify the location of the checkpoint archive.
+	PreCheckpointContainerHook(context.Context, *ContainerCheckpointHookRequest) (*ContainerCheckpointHookResponse, error)
+	// PostCheckpointContainerHook calls RuntimeHookServer after container checkpointed. RuntimeHookServer could
+	// record or transfer the checkpoint archive.
+	PostCheckpointContainerHook(context.Context, *ContainerCheckpointHookRequest) (*ContainerCheckpointHookResponse, error)
+	// PreRestoreContainerHook calls RuntimeHookServer before the container is restored from a checkpoint archive,
+	// which is called before PreCreateContainerHook. RuntimeHookServer could prepare the checkpoint archive.
+	PreRestoreContainerHook(context.Context, *ContainerCheckpointHookRequest) (*ContainerCheckpointHookResponse, error)
 	mustEmbedUnimplementedRuntimeHookServiceServer()
 }
 
@@ -187,6 +262,21 @@ func (UnimplementedRuntimeHookServiceServer) PostStopContainerHook(context.Conte
 func (UnimplementedRuntimeHookServiceServer) PreUpdateContainerResourcesHook(context.Context, *ContainerResourceHookRequest) (*ContainerResourceHookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PreUpdateContainerResourcesHook not implemented")
 }
+func (UnimplementedRuntimeHookServiceServer) PrePullImageHook(context.Context, *ImageHookRequest) (*ImageHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrePullImageHook not implemented")
+}
+func (UnimplementedRuntimeHookServiceServer) PostContainerStatsHook(context.Context, *ContainerStatsHookRequest) (*ContainerStatsHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostContainerStatsHook not implemented")
+}
+func (UnimplementedRuntimeHookServiceServer) PreCheckpointContainerHook(context.Context, *ContainerCheckpointHookRequest) (*ContainerCheckpointHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreCheckpointContainerHook not implemented")
+}
+func (UnimplementedRuntimeHookServiceServer) PostCheckpointContainerHook(context.Context, *ContainerCheckpointHookRequest) (*ContainerCheckpointHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostCheckpointContainerHook not implemented")
+}
+func (UnimplementedRuntimeHookServiceServer) PreRestoreContainerHook(context.Context, *ContainerCheckpointHookRequest) (*ContainerCheckpointHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreRestoreContainerHook not implemented")
+}
 func (UnimplementedRuntimeHookServiceServer) mustEmbedUnimplementedRuntimeHookServiceServer() {}
 
 // UnsafeRuntimeHookServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -326,6 +416,96 @@ func _RuntimeHookService_PreUpdateContainerResourcesHook_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeHookService_PrePullImageHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeHookServiceServer).PrePullImageHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runtime.v1alpha1.RuntimeHookService/PrePullImageHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeHookServiceServer).PrePullImageHook(ctx, req.(*ImageHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeHookService_PostContainerStatsHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerStatsHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeHookServiceServer).PostContainerStatsHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runtime.v1alpha1.RuntimeHookService/PostContainerStatsHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeHookServiceServer).PostContainerStatsHook(ctx, req.(*ContainerStatsHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeHookService_PreCheckpointContainerHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerCheckpointHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeHookServiceServer).PreCheckpointContainerHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runtime.v1alpha1.RuntimeHookService/PreCheckpointContainerHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeHookServiceServer).PreCheckpointContainerHook(ctx, req.(*ContainerCheckpointHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeHookService_PostCheckpointContainerHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerCheckpointHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeHookServiceServer).PostCheckpointContainerHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runtime.v1alpha1.RuntimeHookService/PostCheckpointContainerHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeHookServiceServer).PostCheckpointContainerHook(ctx, req.(*ContainerCheckpointHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeHookService_PreRestoreContainerHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerCheckpointHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeHookServiceServer).PreRestoreContainerHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runtime.v1alpha1.RuntimeHookService/PreRestoreContainerHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeHookServiceServer).PreRestoreContainerHook(ctx, req.(*ContainerCheckpointHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RuntimeHookService_ServiceDesc is the grpc.ServiceDesc for RuntimeHookService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -361,6 +541,26 @@ var RuntimeHookService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PreUpdateContainerResourcesHook",
 			Handler:    _RuntimeHookService_PreUpdateContainerResourcesHook_Handler,
 		},
+		{
+			MethodName: "PrePullImageHook",
+			Handler:    _RuntimeHookService_PrePullImageHook_Handler,
+		},
+		{
+			MethodName: "PostContainerStatsHook",
+			Handler:    _RuntimeHookService_PostContainerStatsHook_Handler,
+		},
+		{
+			MethodName: "PreCheckpointContainerHook",
+			Handler:    _RuntimeHookService_PreCheckpointContainerHook_Handler,
+		},
+		{
+			MethodName: "PostCheckpointContainerHook",
+			Handler:    _RuntimeHookService_PostCheckpointContainerHook_Handler,
+		},
+		{
+			MethodName: "PreRestoreContainerHook",
+			Handler:    _RuntimeHookService_PreRestoreContainerHook_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
@@ -0,0 +1,159 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type DeschedulingReportSpec struct {
+	// Plugin is the name of the descheduling plugin that produced the report.
+	Plugin string `json:"plugin,omitempty"`
+
+	// NodePool is the name of the node pool processed by the plugin.
+	// +optional
+	NodePool string `json:"nodePool,omitempty"`
+
+	// DryRun indicates whether the plugin ran in dry run mode, i.e. the Pods in the report were not migrated actually.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+type DeschedulingReportStatus struct {
+	// StartTime is the time the descheduling round started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time the descheduling round completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message explains why the descheduling round migrated nothing, if so.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Nodes are the nodes processed in the descheduling round.
+	// +optional
+	Nodes []DeschedulingReportNode `json:"nodes,omitempty"`
+
+	// Pods are the candidate Pods selected to be migrated in the descheduling round.
+	// +optional
+	Pods []DeschedulingReportPod `json:"pods,omitempty"`
+}
+
+type DeschedulingNodeClassification string
+
+const (
+	DeschedulingNodeOverutilized          DeschedulingNodeClassification = "Overutilized"
+	DeschedulingNodeUnderutilized         DeschedulingNodeClassification = "Underutilized"
+	DeschedulingNodeProdOverutilized      DeschedulingNodeClassification = "ProdOverutilized"
+	DeschedulingNodeProdUnderutilized     DeschedulingNodeClassification = "ProdUnderutilized"
+	DeschedulingNodeBothUnderutilized     DeschedulingNodeClassification = "BothUnderutilized"
+	DeschedulingNodeAppropriatelyUtilized DeschedulingNodeClassification = "AppropriatelyUtilized"
+)
+
+type DeschedulingReportNode struct {
+	// Name is the name of the node.
+	Name string `json:"name"`
+
+	// Classification is how the node was classified according to the thresholds.
+	Classification DeschedulingNodeClassification `json:"classification,omitempty"`
+
+	// Usage is the resource usage of the node before the migration.
+	// +optional
+	Usage corev1.ResourceList `json:"usage,omitempty"`
+
+	// ExpectedUsage is the expected resource usage of the node after the candidate Pods are migrated.
+	// +optional
+	ExpectedUsage corev1.ResourceList `json:"expectedUsage,omitempty"`
+
+	// UsagePercentages is the resource usage percentages of the allocatable of the node before the migration.
+	// +optional
+	UsagePercentages map[corev1.ResourceName]int64 `json:"usagePercentages,omitempty"`
+
+	// ExpectedUsagePercentages is the expected resource usage percentages of the allocatable of the node
+	// after the candidate Pods are migrated.
+	// +optional
+	ExpectedUsagePercentages map[corev1.ResourceName]int64 `json:"expectedUsagePercentages,omitempty"`
+}
+
+type DeschedulingReportPod struct {
+	// Namespace is the namespace of the Pod.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the Pod.
+	Name string `json:"name"`
+
+	// NodeName is the node the Pod is running on.
+	NodeName string `json:"nodeName,omitempty"`
+
+	// TargetNodeName is the node that the Pod is expected to be migrated to, computed by NodeFit.
+	// It is empty if NodeFit is disabled.
+	// +optional
+	TargetNodeName string `json:"targetNodeName,omitempty"`
+
+	// Prod indicates whether the Pod was selected to reduce the Prod usage of the node.
+	// +optional
+	Prod bool `json:"prod,omitempty"`
+
+	// Usage is the resource usage of the Pod.
+	// +optional
+	Usage corev1.ResourceList `json:"usage,omitempty"`
+
+	// Reason is the reason the Pod is selected.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// DeschedulingReport is the result of a descheduling round, which lists the classified nodes,
+// the candidate Pods and the expected utilization after migration.
+// It is usually used to evaluate the descheduling strategy in dry run mode.
+
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +kubebuilder:resource:scope=Cluster,shortName=dsr
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Plugin",type="string",JSONPath=".spec.plugin",description="The plugin that produced the report"
+// +kubebuilder:printcolumn:name="NodePool",type="string",JSONPath=".spec.nodePool"
+// +kubebuilder:printcolumn:name="DryRun",type="boolean",JSONPath=".spec.dryRun"
+// +kubebuilder:printcolumn:name="CompletionTime",type="date",JSONPath=".status.completionTime"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.message",priority=1
+
+type DeschedulingReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeschedulingReportSpec   `json:"spec,omitempty"`
+	Status DeschedulingReportStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeschedulingReportList contains a list of DeschedulingReport
+type DeschedulingReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeschedulingReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DeschedulingReport{}, &DeschedulingReportList{})
+}
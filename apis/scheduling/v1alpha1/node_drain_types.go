@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type NodeDrainSpec struct {
+	// NodeName is the name of the node to be drained.
+	// +required
+	NodeName string `json:"nodeName"`
+
+	// Paused indicates whether to stop creating new PodMigrationJobs.
+	// The PodMigrationJobs already created are not affected.
+	// Default is false
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Abort indicates whether to abort the draining.
+	// The unfinished PodMigrationJobs are aborted, and the node is uncordoned if it was cordoned by the NodeDrain.
+	// Default is false
+	// +optional
+	Abort bool `json:"abort,omitempty"`
+
+	// Mode represents the operating mode of the PodMigrationJobs.
+	// Default is PodMigrationJobModeReservationFirst
+	// +optional
+	Mode PodMigrationJobMode `json:"mode,omitempty"`
+
+	// TTL controls the timeout duration of the PodMigrationJobs.
+	// Default is the DefaultJobTTL of the MigrationController.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// MaxMigrating is the maximum number of unfinished PodMigrationJobs of the NodeDrain at the same time.
+	// If not specified, the PodMigrationJobs are created for all the Pods at once,
+	// and the concurrency is limited by the arbitration of the MigrationController,
+	// e.g. MaxMigratingPerNode and MaxUnavailablePerWorkload.
+	// +optional
+	MaxMigrating *int32 `json:"maxMigrating,omitempty"`
+}
+
+type NodeDrainPhase string
+
+const (
+	// NodeDrainPending represents the initial status
+	NodeDrainPending NodeDrainPhase = "Pending"
+	// NodeDrainRunning represents the Pods on the node are being migrated
+	NodeDrainRunning NodeDrainPhase = "Running"
+	// NodeDrainPaused represents the NodeDrain stops creating new PodMigrationJobs
+	NodeDrainPaused NodeDrainPhase = "Paused"
+	// NodeDrainSucceeded represents all the Pods on the node are migrated
+	NodeDrainSucceeded NodeDrainPhase = "Succeeded"
+	// NodeDrainFailed represents some Pods on the node failed to be migrated
+	NodeDrainFailed NodeDrainPhase = "Failed"
+	// NodeDrainAborted represents the user aborted the NodeDrain
+	NodeDrainAborted NodeDrainPhase = "Aborted"
+)
+
+type NodeDrainStatus struct {
+	// Phase represents the phase of the NodeDrain.
+	Phase NodeDrainPhase `json:"phase,omitempty"`
+	// Message represents a human-readable message indicating details about why the NodeDrain is in this state.
+	Message string `json:"message,omitempty"`
+	// StartTime is the time the NodeDrain started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the NodeDrain finished.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// TotalPods is the number of Pods to be migrated.
+	TotalPods int32 `json:"totalPods,omitempty"`
+	// WaitingPods is the number of Pods whose PodMigrationJobs are not created.
+	WaitingPods int32 `json:"waitingPods,omitempty"`
+	// MigratingPods is the number of Pods being migrated.
+	MigratingPods int32 `json:"migratingPods,omitempty"`
+	// SucceededPods is the number of Pods migrated.
+	SucceededPods int32 `json:"succeededPods,omitempty"`
+	// FailedPods is the number of Pods failed to be migrated.
+	FailedPods int32 `json:"failedPods,omitempty"`
+	// Pods records the migration status of the Pods.
+	Pods []NodeDrainPodStatus `json:"pods,omitempty"`
+}
+
+type NodeDrainPodStatus struct {
+	// Namespace is the namespace of the Pod.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Pod.
+	Name string `json:"name"`
+	// UID is the UID of the Pod.
+	UID types.UID `json:"uid,omitempty"`
+	// JobName is the name of the PodMigrationJob of the Pod.
+	JobName string `json:"jobName,omitempty"`
+	// Phase is the migration phase of the Pod, which is empty if the PodMigrationJob is not created.
+	Phase PodMigrationJobPhase `json:"phase,omitempty"`
+	// Message represents a human-readable message indicating details about the migration.
+	Message string `json:"message,omitempty"`
+}
+
+// NodeDrain cordons the node and migrates the Pods on the node by PodMigrationJobs.
+
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:resource:scope=Cluster,shortName=nd
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".spec.nodeName",description="The node to be drained"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="The phase of NodeDrain"
+// +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=".status.totalPods"
+// +kubebuilder:printcolumn:name="Succeeded",type="integer",JSONPath=".status.succeededPods"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failedPods"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.message",priority=1
+
+type NodeDrain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeDrainSpec   `json:"spec,omitempty"`
+	Status NodeDrainStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodeDrainList contains a list of NodeDrain
+type NodeDrainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeDrain `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NodeDrain{}, &NodeDrainList{})
+}
@@ -165,6 +165,7 @@ const (
 	PodMigrationJobConditionReservationPodBoundReservation PodMigrationJobConditionType = "PodBoundReservation"
 	PodMigrationJobConditionBoundPodReady                  PodMigrationJobConditionType = "BoundPodReady"
 	PodMigrationJobConditionReservationBound               PodMigrationJobConditionType = "ReservationBound"
+	PodMigrationJobConditionMigrationWindow                PodMigrationJobConditionType = "MigrationWindow"
 )
 
 // These are valid reasons of PodMigrationJob.
@@ -183,6 +184,8 @@ const (
 	PodMigrationJobReasonEvictComplete             = "EvictComplete"
 	PodMigrationJobReasonWaitForPodBindReservation = "WaitForPodBindReservation"
 	PodMigrationJobReasonWaitForBoundPodReady      = "WaitForBoundPodReady"
+	PodMigrationJobReasonOutsideMigrationWindow    = "OutsideMigrationWindow"
+	PodMigrationJobReasonInMigrationWindow         = "InMigrationWindow"
 )
 
 type PodMigrationJobConditionStatus string
@@ -29,6 +29,8 @@ type HostApplicationSpec struct {
 	QoS extension.QoSClass `json:"qos,omitempty"`
 	// Optional, defines the host cgroup configuration, use default if not specified according to priority and qos
 	CgroupPath *CgroupPath `json:"cgroupPath,omitempty"`
+	// Optional, selects the processes of the application by the systemd unit or the command line
+	Selector *HostApplicationSelector `json:"selector,omitempty"`
 	// QoS Strategy of host application
 	Strategy *HostApplicationStrategy `json:"strategy,omitempty"`
 }
@@ -36,6 +38,45 @@ type HostApplicationSpec struct {
 type HostApplicationStrategy struct {
 }
 
+// HostApplicationSelector selects the processes of the host application which are not in a predictable cgroup.
+type HostApplicationSelector struct {
+	// SystemdUnit is the name of the systemd unit, e.g. "node-exporter.service". The cgroup of the unit is used as the
+	// cgroup of the application if the CgroupPath is not specified. The unit is considered in the "system.slice"
+	// unless the name has a parent slice, e.g. "monitor.slice/node-exporter.service".
+	SystemdUnit string `json:"systemdUnit,omitempty"`
+	// CmdlinePattern is the regular expression to match the command line of the processes, whose args are joined by
+	// the spaces, e.g. "^/usr/bin/fluent-bit ". Since the bare processes are not isolated, the matched processes are
+	// moved into the cgroup of the application, which is the CgroupPath or the default cgroup managed by koordlet.
+	// Only the processes in the root cgroup or the slices allowed by koordlet are moved. The processes of the systemd
+	// units, the pods and koordlet itself are never moved.
+	CmdlinePattern string `json:"cmdlinePattern,omitempty"`
+}
+
+type HostApplicationPhase string
+
+const (
+	// HostApplicationResolved means the cgroup of the application is found and the selected processes are in it.
+	HostApplicationResolved HostApplicationPhase = "Resolved"
+	// HostApplicationPending means some selected processes are not moved into the cgroup of the application yet.
+	HostApplicationPending HostApplicationPhase = "Pending"
+	// HostApplicationNotFound means the cgroup or the processes of the application are not found on node.
+	HostApplicationNotFound HostApplicationPhase = "NotFound"
+	// HostApplicationFailed means the application cannot be resolved, e.g. the selector is invalid.
+	HostApplicationFailed HostApplicationPhase = "Failed"
+)
+
+// HostApplicationStatus describes how the host application is resolved on node
+type HostApplicationStatus struct {
+	// Phase of the resolution
+	Phase HostApplicationPhase `json:"phase,omitempty"`
+	// CgroupDir is the resolved cgroup dir of the application relative to the cgroup root
+	CgroupDir string `json:"cgroupDir,omitempty"`
+	// Processes is the number of the processes in the cgroup of the application
+	Processes int32 `json:"processes,omitempty"`
+	// Message is a human-readable message of the phase
+	Message string `json:"message,omitempty"`
+}
+
 // CgroupPath decribes the cgroup path for out-of-band applications
 type CgroupPath struct {
 	// cgroup base dir, the format is various across cgroup drivers
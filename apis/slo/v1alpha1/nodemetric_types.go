@@ -45,6 +45,9 @@ type NodeMetricInfo struct {
 	// AggregatedSystemUsages will report only if there are enough samples
 	// Deleted pods will be excluded during aggregation
 	AggregatedSystemUsages []AggregatedUsage `json:"aggregatedSystemUsages,omitempty"`
+	// SystemPredictedPeak is the predicted peak usage of daemon processes and OS kernel, including the safety margin.
+	// It is reported only if the prediction is ready.
+	SystemPredictedPeak *ResourceMap `json:"systemPredictedPeak,omitempty"`
 }
 
 type AggregatedUsage struct {
@@ -56,6 +59,9 @@ type PodMetricInfo struct {
 	Name      string      `json:"name,omitempty"`
 	Namespace string      `json:"namespace,omitempty"`
 	PodUsage  ResourceMap `json:"podUsage,omitempty"`
+	// PredictedPeak is the predicted peak usage of the pod, including the safety margin.
+	// It is reported only for the non-batch pods which are out of the cold start.
+	PredictedPeak *ResourceMap `json:"predictedPeak,omitempty"`
 	// Priority class of the application
 	Priority apiext.PriorityClass `json:"priority,omitempty"`
 	// QoS class of the application
@@ -69,10 +75,14 @@ type HostApplicationMetricInfo struct {
 	Name string `json:"name,omitempty"`
 	// Resource usage of the host application
 	Usage ResourceMap `json:"usage,omitempty"`
+	// Peak resource usage of the host application in the reporting window, which is the p95 of the usage
+	PeakUsage *ResourceMap `json:"peakUsage,omitempty"`
 	// Priority class of the application
 	Priority apiext.PriorityClass `json:"priority,omitempty"`
 	// QoS class of the application
 	QoS apiext.QoSClass `json:"qos,omitempty"`
+	// Status of the resolution, which is reported for the application with the selector
+	Status *HostApplicationStatus `json:"status,omitempty"`
 }
 
 // NodeMetricSpec defines the desired state of NodeMetric
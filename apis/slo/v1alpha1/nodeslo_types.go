@@ -87,6 +87,23 @@ type MemoryQOS struct {
 	// +kubebuilder:validation:Minimum=0
 	ThrottlingPercent *int64 `json:"throttlingPercent,omitempty" validate:"omitempty,min=0,max=100"`
 
+	// swap (cgroups-v2 required)
+	// If set, the swap usage of the memcg is bounded by the agent, where the values are calculated from pod spec.
+	// 1. `memory.swap.max` := (memory.limit_in_bytes or node allocatable memory) * swapLimitFactor / 100
+	// 2. `memory.swap.high` := memory.swap.max * swapThrottlingFactor / 100
+	// SwapLimitPercent specifies the swapLimitFactor percentage to calculate `memory.swap.max`, which is the hard
+	// limit of the swap usage. Set 0 to disallow the memcg using swap.
+	// Close: nil (keep the current value).
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	SwapLimitPercent *int64 `json:"swapLimitPercent,omitempty" validate:"omitempty,min=0,max=100"`
+	// SwapThrottlingPercent specifies the swapThrottlingFactor percentage to calculate `memory.swap.high`, which
+	// throttles the memcg when its swap usage exceeds. Set 0 to disable the throttling.
+	// Close: 0.
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	SwapThrottlingPercent *int64 `json:"swapThrottlingPercent,omitempty" validate:"omitempty,min=0,max=100"`
+
 	// wmark_ratio (Anolis OS required)
 	// Async memory reclamation is triggered when cgroup memory usage exceeds `memory.wmark_high` and the reclamation
 	// stops when usage is below `memory.wmark_low`. Basically,
@@ -343,6 +360,37 @@ type ResourceThresholdStrategy struct {
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:validation:Minimum=0
 	MemoryEvictLowerPercent *int64 `json:"memoryEvictLowerPercent,omitempty" validate:"omitempty,min=0,max=100,ltfield=MemoryEvictThresholdPercent"`
+	// swap evict threshold percentage (0,100) of the node swap total, evict be pods when the node swap usage
+	// exceeds, default = nil (disabled)
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	MemoryEvictSwapThresholdPercent *int64 `json:"memoryEvictSwapThresholdPercent,omitempty" validate:"omitempty,min=0,max=100"`
+	// memory reclaim threshold percentage (0,100), proactively reclaim the cold memory of be pods when the node
+	// memory usage exceeds, which should be less than MemoryEvictThresholdPercent, default = nil (disabled)
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	MemoryReclaimThresholdPercent *int64 `json:"memoryReclaimThresholdPercent,omitempty" validate:"omitempty,min=0,max=100"`
+	// whether to reclaim the cold memory of ls pods after be pods, default = false
+	MemoryReclaimIncludeLS *bool `json:"memoryReclaimIncludeLS,omitempty"`
+	// upper: ephemeral storage evict threshold percentage (0,100) of the node ephemeral storage capacity, evict be pods
+	// when the node ephemeral storage usage exceeds, default = nil (disabled)
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	EphemeralStorageEvictThresholdPercent *int64 `json:"ephemeralStorageEvictThresholdPercent,omitempty" validate:"omitempty,min=0,max=100"`
+	// lower: ephemeral storage release util usage under EphemeralStorageEvictLowerPercent,
+	// default = EphemeralStorageEvictThresholdPercent - 2
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	EphemeralStorageEvictLowerPercent *int64 `json:"ephemeralStorageEvictLowerPercent,omitempty" validate:"omitempty,min=0,max=100"`
+	// upper: gpu evict threshold percentage (0,100) of each gpu device, evict be pods allocated on the device when
+	// the gpu core or memory usage of the device exceeds, default = nil (disabled)
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	GPUEvictThresholdPercent *int64 `json:"gpuEvictThresholdPercent,omitempty" validate:"omitempty,min=0,max=100"`
+	// lower: gpu release util usage under GPUEvictLowerPercent, default = GPUEvictThresholdPercent - 2
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	GPUEvictLowerPercent *int64 `json:"gpuEvictLowerPercent,omitempty" validate:"omitempty,min=0,max=100"`
 
 	// be.satisfactionRate = be.CPURealLimit/be.CPURequest
 	// if be.satisfactionRate > CPUEvictBESatisfactionUpperPercent/100, then stop to evict.
@@ -444,8 +492,115 @@ type NodeSLOSpec struct {
 
 // NodeSLOStatus defines the observed state of NodeSLO
 type NodeSLOStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// Extensions reports whether the extension strategies in the spec are accepted by the koordlet, which are
+	// checked with the registered extension schemas.
+	// +optional
+	Extensions []NodeSLOExtensionStatus `json:"extensions,omitempty"`
+	// LastReconcileTime is the last time the koordlet reconciled the NodeSLO and reported the status.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+	// Strategies reports the generation of the NodeSLO which is applied by each koordlet strategy.
+	// +optional
+	Strategies []NodeSLOStrategyStatus `json:"strategies,omitempty"`
+	// Features reports whether the QoS features are effective or unsupported on the node.
+	// +optional
+	Features []NodeSLOFeatureStatus `json:"features,omitempty"`
+	// Errors are the recent errors of enforcing the strategies on the node, aggregated by the resource.
+	// +optional
+	Errors []NodeSLOEnforcementError `json:"errors,omitempty"`
+}
+
+type NodeSLOStrategyState string
+
+const (
+	// NodeSLOStrategyApplied indicates the strategy applied the NodeSLO successfully in the latest reconciliation.
+	NodeSLOStrategyApplied NodeSLOStrategyState = "Applied"
+	// NodeSLOStrategySkipped indicates the strategy skipped the latest reconciliation, e.g. it is disabled.
+	NodeSLOStrategySkipped NodeSLOStrategyState = "Skipped"
+	// NodeSLOStrategyFailed indicates the strategy failed in the latest reconciliation.
+	NodeSLOStrategyFailed NodeSLOStrategyState = "Failed"
+)
+
+// NodeSLOStrategyStatus is the applied status of a koordlet strategy.
+type NodeSLOStrategyStatus struct {
+	// Name is the name of the koordlet strategy.
+	Name string `json:"name"`
+	// State is the result of the latest reconciliation of the strategy.
+	// +optional
+	State NodeSLOStrategyState `json:"state,omitempty"`
+	// Message is the reason why the strategy is skipped or failed in the latest reconciliation.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// AppliedGeneration is the generation of the NodeSLO applied by the strategy successfully most recently.
+	AppliedGeneration int64 `json:"appliedGeneration"`
+	// LastAppliedTime is the time when the strategy applied the AppliedGeneration successfully for the first time.
+	// +optional
+	LastAppliedTime *metav1.Time `json:"lastAppliedTime,omitempty"`
+}
+
+type NodeSLOFeature string
+
+const (
+	NodeSLOFeatureResctrl        NodeSLOFeature = "Resctrl"
+	NodeSLOFeatureCoreSched      NodeSLOFeature = "CoreSched"
+	NodeSLOFeatureGroupIdentity  NodeSLOFeature = "GroupIdentity"
+	NodeSLOFeatureMemoryQOSWmark NodeSLOFeature = "MemoryQOSWmark"
+	NodeSLOFeatureBlkIO          NodeSLOFeature = "BlkIO"
+)
+
+type NodeSLOFeatureState string
+
+const (
+	// NodeSLOFeatureEffective indicates the feature is enabled in the NodeSLO and supported by the node.
+	NodeSLOFeatureEffective NodeSLOFeatureState = "Effective"
+	// NodeSLOFeatureDisabled indicates the feature is supported by the node but not enabled in the NodeSLO.
+	NodeSLOFeatureDisabled NodeSLOFeatureState = "Disabled"
+	// NodeSLOFeatureUnsupported indicates the feature is not supported by the kernel of the node.
+	NodeSLOFeatureUnsupported NodeSLOFeatureState = "Unsupported"
+)
+
+// NodeSLOFeatureStatus is the status of a QoS feature on the node.
+type NodeSLOFeatureStatus struct {
+	Name  NodeSLOFeature      `json:"name"`
+	State NodeSLOFeatureState `json:"state"`
+	// Message is the reason why the feature is unsupported.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// NodeSLOEnforcementError is the aggregated error of updating a resource, e.g. a cgroup file.
+type NodeSLOEnforcementError struct {
+	// Resource is the name of the resource failed to update.
+	Resource string `json:"resource"`
+	// Message is the latest error message.
+	Message string `json:"message"`
+	// Count is the number of the failures during the recent period.
+	Count int64 `json:"count"`
+	// LastTime is the time of the latest failure.
+	LastTime metav1.Time `json:"lastTime"`
+}
+
+type NodeSLOExtensionState string
+
+const (
+	// NodeSLOExtensionAccepted indicates the extension strategy is decoded and validated successfully.
+	NodeSLOExtensionAccepted NodeSLOExtensionState = "Accepted"
+	// NodeSLOExtensionRejected indicates the extension strategy is invalid and is not applied.
+	NodeSLOExtensionRejected NodeSLOExtensionState = "Rejected"
+)
+
+// NodeSLOExtensionStatus is the status of an extension strategy in the NodeSLO spec.
+type NodeSLOExtensionStatus struct {
+	// Name is the key of the extension in the spec.
+	Name string `json:"name"`
+	// State is whether the extension strategy is accepted or rejected.
+	State NodeSLOExtensionState `json:"state"`
+	// Message is the reason why the extension strategy is rejected.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// ObservedGeneration is the generation of the NodeSLO when the extension strategy is checked.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 // +genclient
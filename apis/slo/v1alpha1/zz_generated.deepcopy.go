@@ -256,6 +256,16 @@ func (in *CgroupPath) DeepCopy() *CgroupPath {
 func (in *HostApplicationMetricInfo) DeepCopyInto(out *HostApplicationMetricInfo) {
 	*out = *in
 	in.Usage.DeepCopyInto(&out.Usage)
+	if in.PeakUsage != nil {
+		in, out := &in.PeakUsage, &out.PeakUsage
+		*out = new(ResourceMap)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(HostApplicationStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostApplicationMetricInfo.
@@ -268,6 +278,21 @@ func (in *HostApplicationMetricInfo) DeepCopy() *HostApplicationMetricInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostApplicationSelector) DeepCopyInto(out *HostApplicationSelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostApplicationSelector.
+func (in *HostApplicationSelector) DeepCopy() *HostApplicationSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(HostApplicationSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostApplicationSpec) DeepCopyInto(out *HostApplicationSpec) {
 	*out = *in
@@ -276,6 +301,11 @@ func (in *HostApplicationSpec) DeepCopyInto(out *HostApplicationSpec) {
 		*out = new(CgroupPath)
 		**out = **in
 	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(HostApplicationSelector)
+		**out = **in
+	}
 	if in.Strategy != nil {
 		in, out := &in.Strategy, &out.Strategy
 		*out = new(HostApplicationStrategy)
@@ -293,6 +323,21 @@ func (in *HostApplicationSpec) DeepCopy() *HostApplicationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostApplicationStatus) DeepCopyInto(out *HostApplicationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostApplicationStatus.
+func (in *HostApplicationStatus) DeepCopy() *HostApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostApplicationStrategy) DeepCopyInto(out *HostApplicationStrategy) {
 	*out = *in
@@ -421,6 +466,16 @@ func (in *MemoryQOS) DeepCopyInto(out *MemoryQOS) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.SwapLimitPercent != nil {
+		in, out := &in.SwapLimitPercent, &out.SwapLimitPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SwapThrottlingPercent != nil {
+		in, out := &in.SwapThrottlingPercent, &out.SwapThrottlingPercent
+		*out = new(int64)
+		**out = **in
+	}
 	if in.WmarkRatio != nil {
 		in, out := &in.WmarkRatio, &out.WmarkRatio
 		*out = new(int64)
@@ -621,6 +676,11 @@ func (in *NodeMetricInfo) DeepCopyInto(out *NodeMetricInfo) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SystemPredictedPeak != nil {
+		in, out := &in.SystemPredictedPeak, &out.SystemPredictedPeak
+		*out = new(ResourceMap)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMetricInfo.
@@ -742,7 +802,7 @@ func (in *NodeSLO) DeepCopyInto(out *NodeSLO) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSLO.
@@ -763,6 +823,52 @@ func (in *NodeSLO) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeSLOEnforcementError) DeepCopyInto(out *NodeSLOEnforcementError) {
+	*out = *in
+	in.LastTime.DeepCopyInto(&out.LastTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSLOEnforcementError.
+func (in *NodeSLOEnforcementError) DeepCopy() *NodeSLOEnforcementError {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSLOEnforcementError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeSLOExtensionStatus) DeepCopyInto(out *NodeSLOExtensionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSLOExtensionStatus.
+func (in *NodeSLOExtensionStatus) DeepCopy() *NodeSLOExtensionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSLOExtensionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeSLOFeatureStatus) DeepCopyInto(out *NodeSLOFeatureStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSLOFeatureStatus.
+func (in *NodeSLOFeatureStatus) DeepCopy() *NodeSLOFeatureStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSLOFeatureStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeSLOList) DeepCopyInto(out *NodeSLOList) {
 	*out = *in
@@ -844,6 +950,34 @@ func (in *NodeSLOSpec) DeepCopy() *NodeSLOSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeSLOStatus) DeepCopyInto(out *NodeSLOStatus) {
 	*out = *in
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]NodeSLOExtensionStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Strategies != nil {
+		in, out := &in.Strategies, &out.Strategies
+		*out = make([]NodeSLOStrategyStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]NodeSLOFeatureStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]NodeSLOEnforcementError, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSLOStatus.
@@ -856,6 +990,25 @@ func (in *NodeSLOStatus) DeepCopy() *NodeSLOStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeSLOStrategyStatus) DeepCopyInto(out *NodeSLOStrategyStatus) {
+	*out = *in
+	if in.LastAppliedTime != nil {
+		in, out := &in.LastAppliedTime, &out.LastAppliedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSLOStrategyStatus.
+func (in *NodeSLOStrategyStatus) DeepCopy() *NodeSLOStrategyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSLOStrategyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OriginAllocatable) DeepCopyInto(out *OriginAllocatable) {
 	*out = *in
@@ -898,6 +1051,11 @@ func (in *PodMemoryQOSConfig) DeepCopy() *PodMemoryQOSConfig {
 func (in *PodMetricInfo) DeepCopyInto(out *PodMetricInfo) {
 	*out = *in
 	in.PodUsage.DeepCopyInto(&out.PodUsage)
+	if in.PredictedPeak != nil {
+		in, out := &in.PredictedPeak, &out.PredictedPeak
+		*out = new(ResourceMap)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Extensions != nil {
 		in, out := &in.Extensions, &out.Extensions
 		*out = (*in).DeepCopy()
@@ -1148,6 +1306,41 @@ func (in *ResourceThresholdStrategy) DeepCopyInto(out *ResourceThresholdStrategy
 		*out = new(int64)
 		**out = **in
 	}
+	if in.MemoryEvictSwapThresholdPercent != nil {
+		in, out := &in.MemoryEvictSwapThresholdPercent, &out.MemoryEvictSwapThresholdPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MemoryReclaimThresholdPercent != nil {
+		in, out := &in.MemoryReclaimThresholdPercent, &out.MemoryReclaimThresholdPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MemoryReclaimIncludeLS != nil {
+		in, out := &in.MemoryReclaimIncludeLS, &out.MemoryReclaimIncludeLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EphemeralStorageEvictThresholdPercent != nil {
+		in, out := &in.EphemeralStorageEvictThresholdPercent, &out.EphemeralStorageEvictThresholdPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EphemeralStorageEvictLowerPercent != nil {
+		in, out := &in.EphemeralStorageEvictLowerPercent, &out.EphemeralStorageEvictLowerPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GPUEvictThresholdPercent != nil {
+		in, out := &in.GPUEvictThresholdPercent, &out.GPUEvictThresholdPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GPUEvictLowerPercent != nil {
+		in, out := &in.GPUEvictLowerPercent, &out.GPUEvictLowerPercent
+		*out = new(int64)
+		**out = **in
+	}
 	if in.CPUEvictBESatisfactionUpperPercent != nil {
 		in, out := &in.CPUEvictBESatisfactionUpperPercent, &out.CPUEvictBESatisfactionUpperPercent
 		*out = new(int64)
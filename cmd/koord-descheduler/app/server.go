@@ -64,6 +64,7 @@ import (
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/evictions"
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/fieldindex"
 	frameworkruntime "github.com/koordinator-sh/koordinator/pkg/descheduler/framework/runtime"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/utils/report"
 	"github.com/koordinator-sh/koordinator/pkg/util/transformer"
 )
 
@@ -255,6 +256,7 @@ func newHealthzAndMetricsHandler(config *deschedulerconfig.DeschedulerConfigurat
 	pathRecorderMux := mux.NewPathRecorderMux("koord-descheduler")
 	healthz.InstallHandler(pathRecorderMux, checks...)
 	installMetricHandler(pathRecorderMux)
+	report.InstallHandler(pathRecorderMux)
 	if config.EnableProfiling {
 		routes.Profiling{}.Install(pathRecorderMux)
 		if config.EnableContentionProfiling {
@@ -23,20 +23,23 @@ import (
 
 	"github.com/koordinator-sh/koordinator/pkg/controller/colocationprofile"
 	"github.com/koordinator-sh/koordinator/pkg/quota-controller/profile"
+	"github.com/koordinator-sh/koordinator/pkg/slo-controller/colocationstrategy"
 	"github.com/koordinator-sh/koordinator/pkg/slo-controller/nodemetric"
 	"github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource"
 	"github.com/koordinator-sh/koordinator/pkg/slo-controller/nodeslo"
 )
 
 var controllerInitFlags = map[string]func(*flag.FlagSet){
-	noderesource.Name:      noderesource.InitFlags,
-	colocationprofile.Name: colocationprofile.InitFlags,
+	noderesource.Name:       noderesource.InitFlags,
+	colocationprofile.Name:  colocationprofile.InitFlags,
+	colocationstrategy.Name: colocationstrategy.InitFlags,
 }
 
 var controllerAddFuncs = map[string]func(manager.Manager) error{
-	nodemetric.Name:        nodemetric.Add,
-	noderesource.Name:      noderesource.Add,
-	nodeslo.Name:           nodeslo.Add,
-	profile.Name:           profile.Add,
-	colocationprofile.Name: colocationprofile.Add,
+	nodemetric.Name:         nodemetric.Add,
+	noderesource.Name:       noderesource.Add,
+	nodeslo.Name:            nodeslo.Add,
+	profile.Name:            profile.Add,
+	colocationprofile.Name:  colocationprofile.Add,
+	colocationstrategy.Name: colocationstrategy.Add,
 }
@@ -18,16 +18,21 @@ package main
 
 import (
 	"flag"
+	"net/http"
 	"os"
 	"path/filepath"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/klog/v2"
 
 	"github.com/koordinator-sh/koordinator/cmd/koord-runtime-proxy/options"
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/metrics"
 	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/server/cri"
 	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/server/docker"
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/store"
 )
 
 func main() {
@@ -42,6 +47,14 @@ func main() {
 			"skip transferring cri events to hook server")
 	flag.StringVar(&options.RuntimeHookServerVal, "runtime-hook-server-val", options.DefaultHookServerVal,
 		"working combined with runtime-hook-server-key")
+	flag.StringVar(&options.MetricsBindAddress, "metrics-bind-address", "",
+		"the address to serve the metrics, e.g. :9318. The metrics are disabled if it is empty.")
+	flag.StringVar(&options.StateDir, "state-dir", options.DefaultStateDir,
+		"the dir to persist the pod and container infos, which are only kept in memory if it is empty.")
+	flag.StringVar(&options.CheckpointArchiveDir, "checkpoint-archive-dir", options.DefaultCheckpointArchiveDir,
+		"the dir of the checkpoint archives, containers created from which are regarded as restored. The restore hooks are disabled if it is empty.")
+	flag.BoolVar(&options.EnableContainerStatsHook, "enable-container-stats-hook", false,
+		"whether to call the hook servers for the container stats, which costs one hook call per container on every stats polling.")
 
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
 	pflag.Parse()
@@ -54,6 +67,23 @@ func main() {
 		klog.Fatalf("failed to mkdir %v: %v", filepath.Dir(options.RuntimeProxyEndpoint), err)
 	}
 
+	if options.StateDir != "" {
+		if err := store.Init(options.StateDir); err != nil {
+			klog.Fatalf("failed to init store in %v: %v", options.StateDir, err)
+		}
+	}
+
+	if options.MetricsBindAddress != "" {
+		metrics.Register(prometheus.DefaultRegisterer)
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", promhttp.Handler())
+			if err := http.ListenAndServe(options.MetricsBindAddress, mux); err != nil {
+				klog.Errorf("failed to serve metrics on %v: %v", options.MetricsBindAddress, err)
+			}
+		}()
+	}
+
 	switch options.BackendRuntimeMode {
 	case options.BackendRuntimeModeContainerd:
 		server := cri.NewRuntimeManagerCriServer()
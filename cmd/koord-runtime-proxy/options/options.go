@@ -27,6 +27,11 @@ const (
 
 	DefaultHookServerKey = "runtimeproxy.koordinator.sh/skip-hookserver"
 	DefaultHookServerVal = "true"
+
+	DefaultStateDir = "/var/lib/koord-runtimeproxy"
+
+	// DefaultCheckpointArchiveDir is the dir where kubelet stores the checkpoint archives.
+	DefaultCheckpointArchiveDir = "/var/lib/kubelet/checkpoints"
 )
 
 var (
@@ -38,4 +43,18 @@ var (
 
 	RuntimeHookServerKey string
 	RuntimeHookServerVal string
+
+	// MetricsBindAddress is the address to serve the metrics, which are disabled if it is empty.
+	MetricsBindAddress string
+
+	// StateDir is the dir to persist the pod and container infos, which are only kept in memory if it is empty.
+	StateDir string
+
+	// CheckpointArchiveDir is the dir of the checkpoint archives. A container created from an archive in it is
+	// regarded as restored, and the restore hooks are disabled if it is empty.
+	CheckpointArchiveDir string
+
+	// EnableContainerStatsHook indicates whether to call the hook servers for the container stats, which costs
+	// one hook call per container on every stats polling.
+	EnableContainerStatsHook bool
 )
@@ -33,6 +33,7 @@ import (
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/audit"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/config"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/metrics"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/runtimehooks/hooks"
 	metricsutil "github.com/koordinator-sh/koordinator/pkg/util/metrics"
 )
 
@@ -96,6 +97,8 @@ func installHTTPHandler() {
 	if features.DefaultKoordletFeatureGate.Enabled(features.AuditEventsHTTPHandler) {
 		mux.HandleFunc("/events", audit.HttpHandler())
 	}
+	mux.HandleFunc(hooks.HTTPPath, hooks.HTTPHandler())
+	mux.HandleFunc(hooks.DryRunHTTPPath, hooks.DryRunHTTPHandler())
 	// install extended HTTP handlers
 	options.InstallExtendedHTTPHandler(mux)
 	// http.HandleFunc("/healthz", d.HealthzHandler())
@@ -29,6 +29,7 @@ import (
 type ConfigV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	ClusterColocationProfilesGetter
+	ClusterColocationStrategiesGetter
 }
 
 // ConfigV1alpha1Client is used to interact with features provided by the config group.
@@ -40,6 +41,10 @@ func (c *ConfigV1alpha1Client) ClusterColocationProfiles() ClusterColocationProf
 	return newClusterColocationProfiles(c)
 }
 
+func (c *ConfigV1alpha1Client) ClusterColocationStrategies() ClusterColocationStrategyInterface {
+	return newClusterColocationStrategies(c)
+}
+
 // NewForConfig creates a new ConfigV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -28,10 +28,18 @@ type FakeSchedulingV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeSchedulingV1alpha1) DeschedulingReports() v1alpha1.DeschedulingReportInterface {
+	return &FakeDeschedulingReports{c}
+}
+
 func (c *FakeSchedulingV1alpha1) Devices() v1alpha1.DeviceInterface {
 	return &FakeDevices{c}
 }
 
+func (c *FakeSchedulingV1alpha1) NodeDrains() v1alpha1.NodeDrainInterface {
+	return &FakeNodeDrains{c}
+}
+
 func (c *FakeSchedulingV1alpha1) PodMigrationJobs() v1alpha1.PodMigrationJobInterface {
 	return &FakePodMigrationJobs{c}
 }
@@ -18,8 +18,12 @@ limitations under the License.
 
 package v1alpha1
 
+type DeschedulingReportExpansion interface{}
+
 type DeviceExpansion interface{}
 
+type NodeDrainExpansion interface{}
+
 type PodMigrationJobExpansion interface{}
 
 type ReservationExpansion interface{}
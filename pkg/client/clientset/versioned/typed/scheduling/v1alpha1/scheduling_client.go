@@ -28,7 +28,9 @@ import (
 
 type SchedulingV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	DeschedulingReportsGetter
 	DevicesGetter
+	NodeDrainsGetter
 	PodMigrationJobsGetter
 	ReservationsGetter
 }
@@ -38,10 +40,18 @@ type SchedulingV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *SchedulingV1alpha1Client) DeschedulingReports() DeschedulingReportInterface {
+	return newDeschedulingReports(c)
+}
+
 func (c *SchedulingV1alpha1Client) Devices() DeviceInterface {
 	return newDevices(c)
 }
 
+func (c *SchedulingV1alpha1Client) NodeDrains() NodeDrainInterface {
+	return newNodeDrains(c)
+}
+
 func (c *SchedulingV1alpha1Client) PodMigrationJobs() PodMigrationJobInterface {
 	return newPodMigrationJobs(c)
 }
@@ -26,6 +26,8 @@ import (
 type Interface interface {
 	// ClusterColocationProfiles returns a ClusterColocationProfileInformer.
 	ClusterColocationProfiles() ClusterColocationProfileInformer
+	// ClusterColocationStrategies returns a ClusterColocationStrategyInformer.
+	ClusterColocationStrategies() ClusterColocationStrategyInformer
 }
 
 type version struct {
@@ -43,3 +45,8 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (v *version) ClusterColocationProfiles() ClusterColocationProfileInformer {
 	return &clusterColocationProfileInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// ClusterColocationStrategies returns a ClusterColocationStrategyInformer.
+func (v *version) ClusterColocationStrategies() ClusterColocationStrategyInformer {
+	return &clusterColocationStrategyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
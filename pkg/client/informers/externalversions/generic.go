@@ -63,14 +63,20 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=config, Version=v1alpha1
 	case configv1alpha1.SchemeGroupVersion.WithResource("clustercolocationprofiles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Config().V1alpha1().ClusterColocationProfiles().Informer()}, nil
+	case configv1alpha1.SchemeGroupVersion.WithResource("clustercolocationstrategies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Config().V1alpha1().ClusterColocationStrategies().Informer()}, nil
 
 		// Group=quota, Version=v1alpha1
 	case quotav1alpha1.SchemeGroupVersion.WithResource("elasticquotaprofiles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Quota().V1alpha1().ElasticQuotaProfiles().Informer()}, nil
 
 		// Group=scheduling, Version=v1alpha1
+	case schedulingv1alpha1.SchemeGroupVersion.WithResource("deschedulingreports"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scheduling().V1alpha1().DeschedulingReports().Informer()}, nil
 	case schedulingv1alpha1.SchemeGroupVersion.WithResource("devices"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Scheduling().V1alpha1().Devices().Informer()}, nil
+	case schedulingv1alpha1.SchemeGroupVersion.WithResource("nodedrains"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scheduling().V1alpha1().NodeDrains().Informer()}, nil
 	case schedulingv1alpha1.SchemeGroupVersion.WithResource("podmigrationjobs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Scheduling().V1alpha1().PodMigrationJobs().Informer()}, nil
 	case schedulingv1alpha1.SchemeGroupVersion.WithResource("reservations"):
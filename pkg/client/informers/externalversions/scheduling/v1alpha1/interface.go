@@ -24,8 +24,12 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// DeschedulingReports returns a DeschedulingReportInformer.
+	DeschedulingReports() DeschedulingReportInformer
 	// Devices returns a DeviceInformer.
 	Devices() DeviceInformer
+	// NodeDrains returns a NodeDrainInformer.
+	NodeDrains() NodeDrainInformer
 	// PodMigrationJobs returns a PodMigrationJobInformer.
 	PodMigrationJobs() PodMigrationJobInformer
 	// Reservations returns a ReservationInformer.
@@ -43,11 +47,21 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// DeschedulingReports returns a DeschedulingReportInformer.
+func (v *version) DeschedulingReports() DeschedulingReportInformer {
+	return &deschedulingReportInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Devices returns a DeviceInformer.
 func (v *version) Devices() DeviceInformer {
 	return &deviceInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// NodeDrains returns a NodeDrainInformer.
+func (v *version) NodeDrains() NodeDrainInformer {
+	return &nodeDrainInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // PodMigrationJobs returns a PodMigrationJobInformer.
 func (v *version) PodMigrationJobs() PodMigrationJobInformer {
 	return &podMigrationJobInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
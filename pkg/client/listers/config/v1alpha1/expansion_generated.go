@@ -21,3 +21,7 @@ package v1alpha1
 // ClusterColocationProfileListerExpansion allows custom methods to be added to
 // ClusterColocationProfileLister.
 type ClusterColocationProfileListerExpansion interface{}
+
+// ClusterColocationStrategyListerExpansion allows custom methods to be added to
+// ClusterColocationStrategyLister.
+type ClusterColocationStrategyListerExpansion interface{}
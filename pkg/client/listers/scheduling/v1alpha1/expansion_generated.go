@@ -18,10 +18,18 @@ limitations under the License.
 
 package v1alpha1
 
+// DeschedulingReportListerExpansion allows custom methods to be added to
+// DeschedulingReportLister.
+type DeschedulingReportListerExpansion interface{}
+
 // DeviceListerExpansion allows custom methods to be added to
 // DeviceLister.
 type DeviceListerExpansion interface{}
 
+// NodeDrainListerExpansion allows custom methods to be added to
+// NodeDrainLister.
+type NodeDrainListerExpansion interface{}
+
 // PodMigrationJobListerExpansion allows custom methods to be added to
 // PodMigrationJobLister.
 type PodMigrationJobListerExpansion interface{}
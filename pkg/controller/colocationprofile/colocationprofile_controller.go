@@ -27,14 +27,21 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	configv1alpha1 "github.com/koordinator-sh/koordinator/apis/config/v1alpha1"
 	"github.com/koordinator-sh/koordinator/apis/extension"
 	"github.com/koordinator-sh/koordinator/pkg/features"
+	"github.com/koordinator-sh/koordinator/pkg/util"
 	utilfeature "github.com/koordinator-sh/koordinator/pkg/util/feature"
 )
 
@@ -68,6 +75,9 @@ func newReconciler(mgr ctrl.Manager) *Reconciler {
 	}
 }
 
+// +kubebuilder:rbac:groups=config.koordinator.sh,resources=clustercolocationprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=config.koordinator.sh,resources=clustercolocationprofiles/status,verbs=get;update;patch
+
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	profile := &configv1alpha1.ClusterColocationProfile{}
 	if err := r.Client.Get(ctx, req.NamespacedName, profile); err != nil {
@@ -83,11 +93,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	// the status is maintained for all profiles, and the failure should not block the pod updates
+	if err := r.updateProfileStatus(ctx, profile); err != nil {
+		klog.ErrorS(err, "failed to update status for ClusterColocationProfile", "profile", profile.Name)
+	}
+
+	if extension.IsColocationProfileDryRun(profile) {
+		// the dry-run profile never updates pods, the would-be mutations are recorded in the status.
+		// requeue to refresh the preview since the newly matched pods are not recorded with the profile.
+		klog.V(5).InfoS("skip update pods for dry-run clusterColocationProfile", "profile", profile.Name)
+		return ctrl.Result{RequeueAfter: ReconcileInterval}, nil
+	}
 	profileEnabled := extension.ShouldReconcileProfile(profile)
 	klog.V(5).InfoS("reconcile for clusterColocationProfile",
 		"profile", profile.Name, "defaultEnabled", ReconcileByDefault, "profileEnabled", profileEnabled)
 	if !ReconcileByDefault && !profileEnabled {
-		// should not handle the profile
+		// should not handle the profile, and the status is refreshed by the events of the recorded pods
 		return ctrl.Result{}, nil
 	}
 
@@ -153,11 +174,58 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&configv1alpha1.ClusterColocationProfile{}).
+		For(&configv1alpha1.ClusterColocationProfile{}, builder.WithPredicates(predicate.Or(
+			predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(mapPodToProfiles),
+			builder.WithPredicates(podProfileChangedPredicate())).
 		Named(Name).
 		Complete(r)
 }
 
+// mapPodToProfiles enqueues the profiles recorded on the pod to refresh their statuses.
+func mapPodToProfiles(ctx context.Context, obj client.Object) []reconcile.Request {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil
+	}
+	profiles := extension.GetColocationProfilesOfPod(pod)
+	requests := make([]reconcile.Request, 0, len(profiles))
+	for _, name := range profiles {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+	}
+	return requests
+}
+
+// podProfileChangedPredicate filters the pod events which can change the statuses of the recorded profiles.
+func podProfileChangedPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			pod, ok := e.Object.(*corev1.Pod)
+			return ok && len(extension.GetColocationProfilesOfPod(pod)) > 0
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldPod, okOld := e.ObjectOld.(*corev1.Pod)
+			newPod, okNew := e.ObjectNew.(*corev1.Pod)
+			if !okOld || !okNew {
+				return false
+			}
+			if len(extension.GetColocationProfilesOfPod(oldPod)) <= 0 && len(extension.GetColocationProfilesOfPod(newPod)) <= 0 {
+				return false
+			}
+			return oldPod.Annotations[extension.AnnotationColocationProfiles] != newPod.Annotations[extension.AnnotationColocationProfiles] ||
+				(oldPod.DeletionTimestamp == nil) != (newPod.DeletionTimestamp == nil) ||
+				util.IsPodTerminated(oldPod) != util.IsPodTerminated(newPod)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			pod, ok := e.Object.(*corev1.Pod)
+			return ok && len(extension.GetColocationProfilesOfPod(pod)) > 0
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
 func InitFlags(fs *flag.FlagSet) {
 	pflag.BoolVar(&ReconcileByDefault, "colocation-profile-reconcile-by-default", ReconcileByDefault, "Whether the colocation-profile controller reconciles ClusterColocationProfile by default.")
 	pflag.DurationVar(&ReconcileInterval, "colocation-profile-reconcile-interval", ReconcileInterval, "The interval to reconcile ClusterColocationProfile.")
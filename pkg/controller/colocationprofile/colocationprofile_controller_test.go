@@ -34,10 +34,13 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	configv1alpha1 "github.com/koordinator-sh/koordinator/apis/config/v1alpha1"
 	"github.com/koordinator-sh/koordinator/apis/extension"
@@ -45,6 +48,7 @@ import (
 	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
 	"github.com/koordinator-sh/koordinator/pkg/features"
 	utilfeature "github.com/koordinator-sh/koordinator/pkg/util/feature"
+	"github.com/koordinator-sh/koordinator/pkg/util/fieldindex"
 )
 
 func TestReconciler_Reconcile(t *testing.T) {
@@ -208,8 +212,9 @@ func TestReconciler_Reconcile(t *testing.T) {
 				extension.LabelPodPriority:   "1111",
 			},
 			Annotations: map[string]string{
-				"testAnnotationA":       "valueA",
-				"test-patch-annotation": "patch-b",
+				"testAnnotationA":                      "valueA",
+				"test-patch-annotation":                "patch-b",
+				extension.AnnotationColocationProfiles: "test-profile",
 			},
 			ResourceVersion: "1001",
 			UID:             "xxx",
@@ -256,8 +261,9 @@ func TestReconciler_Reconcile(t *testing.T) {
 				extension.LabelSchedulerName: "koordinator-scheduler",
 			},
 			Annotations: map[string]string{
-				"testAnnotationA":       "valueA",
-				"test-patch-annotation": "patch-b",
+				"testAnnotationA":                      "valueA",
+				"test-patch-annotation":                "patch-b",
+				extension.AnnotationColocationProfiles: "test-profile",
 			},
 			ResourceVersion: "1002",
 			UID:             "xxx",
@@ -291,9 +297,13 @@ func TestReconciler_Reconcile(t *testing.T) {
 		r := &Reconciler{
 			Client: fake.NewClientBuilder().WithScheme(scheme).
 				WithObjects(testPod, testUnmatchedPod, testUnmatchedPod2, testProfile).
+				WithStatusSubresource(&configv1alpha1.ClusterColocationProfile{}).
 				WithIndex(&corev1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
 					return []string{obj.(*corev1.Pod).Spec.NodeName}
 				}).
+				WithIndex(&corev1.Pod{}, fieldindex.IndexPodByColocationProfile, func(obj client.Object) []string {
+					return extension.GetColocationProfilesOfPod(obj.(*corev1.Pod))
+				}).
 				Build(),
 			Scheme:         scheme,
 			rateLimiter:    rate.NewLimiter(rate.Limit(1), 5),
@@ -465,6 +475,8 @@ type fakeManager struct {
 
 func (f *fakeManager) GetClient() client.Client { return nil }
 
+func (f *fakeManager) GetCache() cache.Cache { return nil }
+
 func (f *fakeManager) GetEventRecorderFor(name string) record.EventRecorder { return nil }
 
 func (f *fakeManager) GetScheme() *runtime.Scheme { return runtime.NewScheme() }
@@ -499,6 +511,34 @@ func TestAdd(t *testing.T) {
 	}
 }
 
+func Test_mapPodToProfiles(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "test-pod",
+		},
+	}
+	assert.Equal(t, []reconcile.Request{}, mapPodToProfiles(context.TODO(), pod))
+	assert.False(t, podProfileChangedPredicate().Create(event.CreateEvent{Object: pod}))
+
+	annotatedPod := pod.DeepCopy()
+	annotatedPod.Annotations = map[string]string{
+		extension.AnnotationColocationProfiles: "profile-a,profile-b",
+	}
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: "profile-a"}},
+		{NamespacedName: types.NamespacedName{Name: "profile-b"}},
+	}, mapPodToProfiles(context.TODO(), annotatedPod))
+	assert.True(t, podProfileChangedPredicate().Create(event.CreateEvent{Object: annotatedPod}))
+	assert.True(t, podProfileChangedPredicate().Update(event.UpdateEvent{ObjectOld: pod, ObjectNew: annotatedPod}))
+	assert.False(t, podProfileChangedPredicate().Update(event.UpdateEvent{ObjectOld: annotatedPod, ObjectNew: annotatedPod.DeepCopy()}))
+
+	terminatedPod := annotatedPod.DeepCopy()
+	terminatedPod.Status.Phase = corev1.PodSucceeded
+	assert.True(t, podProfileChangedPredicate().Update(event.UpdateEvent{ObjectOld: annotatedPod, ObjectNew: terminatedPod}))
+	assert.True(t, podProfileChangedPredicate().Delete(event.DeleteEvent{Object: terminatedPod}))
+}
+
 func TestInitFlags(t *testing.T) {
 	fs := flag.NewFlagSet("test", flag.PanicOnError)
 	InitFlags(fs)
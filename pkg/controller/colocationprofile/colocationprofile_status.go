@@ -0,0 +1,299 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colocationprofile
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1alpha1 "github.com/koordinator-sh/koordinator/apis/config/v1alpha1"
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	"github.com/koordinator-sh/koordinator/pkg/util"
+	utilclient "github.com/koordinator-sh/koordinator/pkg/util/client"
+	"github.com/koordinator-sh/koordinator/pkg/util/fieldindex"
+)
+
+// MaxDryRunSamples is the max number of the sample pods recorded in the dry-run status.
+const MaxDryRunSamples = 10
+
+var timeNowFn = time.Now
+
+func (r *Reconciler) updateProfileStatus(ctx context.Context, profile *configv1alpha1.ClusterColocationProfile) error {
+	newStatus, err := r.generateProfileStatus(ctx, profile)
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(&profile.Status, newStatus) {
+		klog.V(6).InfoS("skip update status for ClusterColocationProfile, status not changed", "profile", profile.Name)
+		return nil
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &configv1alpha1.ClusterColocationProfile{}
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(profile), latest); err != nil {
+			return err
+		}
+		latest.Status = *newStatus
+		return r.Client.Status().Update(ctx, latest)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update status, err: %w", err)
+	}
+	klog.V(5).InfoS("successfully update status for ClusterColocationProfile", "profile", profile.Name,
+		"mutatedPods", newStatus.MutatedPods, "conflicts", len(newStatus.Conflicts))
+	return nil
+}
+
+func (r *Reconciler) generateProfileStatus(ctx context.Context, profile *configv1alpha1.ClusterColocationProfile) (*configv1alpha1.ClusterColocationProfileStatus, error) {
+	status := &configv1alpha1.ClusterColocationProfileStatus{
+		ObservedGeneration: profile.Generation,
+	}
+
+	matchedNamespaces, err := r.listMatchedNamespaces(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+	if len(matchedNamespaces) > 0 {
+		status.MatchedNamespaces = sets.List(matchedNamespaces)
+	}
+
+	dryRun := extension.IsColocationProfileDryRun(profile)
+	podSelector, err := getProfilePodSelector(profile)
+	if err != nil {
+		return nil, err
+	}
+	podList := &corev1.PodList{}
+	listOpts := []client.ListOption{utilclient.DisableDeepCopy}
+	if dryRun {
+		// the dry-run profile never mutates pods, so list the pods matched by the selector
+		listOpts = append(listOpts, client.MatchingLabelsSelector{Selector: podSelector})
+	} else {
+		// only list the pods recorded with the profile via the index instead of all pods
+		listOpts = append(listOpts, client.MatchingFields{fieldindex.IndexPodByColocationProfile: profile.Name})
+	}
+	if err = r.Client.List(ctx, podList, listOpts...); err != nil {
+		return nil, fmt.Errorf("failed to list pods, err: %w", err)
+	}
+
+	// coAppliedPods counts the pods which are mutated by both the profile and the other profiles
+	coAppliedPods := map[string]int64{}
+	dryRunStatus := &configv1alpha1.ColocationProfileDryRunStatus{}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.DeletionTimestamp != nil || util.IsPodTerminated(pod) {
+			continue
+		}
+		appliedProfiles := extension.GetColocationProfilesOfPod(pod)
+		isApplied := false
+		if dryRun {
+			// the dry-run profile never mutates pods, so check the profile matches the pod or not
+			isApplied = matchedNamespaces.Has(pod.Namespace) && podSelector.Matches(labels.Set(pod.Labels))
+		} else {
+			for _, name := range appliedProfiles {
+				if name == profile.Name {
+					isApplied = true
+					break
+				}
+			}
+		}
+		if !isApplied {
+			continue
+		}
+		for _, name := range appliedProfiles {
+			if name != profile.Name {
+				coAppliedPods[name]++
+			}
+		}
+
+		if dryRun {
+			dryRunStatus.MatchedPods++
+			fields, err := r.getDryRunChangedFields(pod, profile)
+			if err != nil {
+				return nil, err
+			}
+			if len(fields) > 0 {
+				dryRunStatus.AffectedPods++
+				dryRunStatus.Samples = append(dryRunStatus.Samples, configv1alpha1.ColocationProfileDryRunSample{
+					Pod:    util.GetPodKey(pod),
+					Fields: fields,
+				})
+			}
+			continue
+		}
+		status.MutatedPods++
+		if status.LastMutationTime == nil || status.LastMutationTime.Before(&pod.CreationTimestamp) {
+			status.LastMutationTime = pod.CreationTimestamp.DeepCopy()
+		}
+	}
+
+	conflicts, err := r.getProfileConflicts(ctx, profile, coAppliedPods)
+	if err != nil {
+		return nil, err
+	}
+	status.Conflicts = conflicts
+
+	if dryRun {
+		sort.Slice(dryRunStatus.Samples, func(i, j int) bool {
+			return dryRunStatus.Samples[i].Pod < dryRunStatus.Samples[j].Pod
+		})
+		if len(dryRunStatus.Samples) > MaxDryRunSamples {
+			dryRunStatus.Samples = dryRunStatus.Samples[:MaxDryRunSamples]
+		}
+		// keep the last dry-run time if the preview is not changed to avoid the unnecessary status updates
+		oldDryRunStatus := profile.Status.DryRun.DeepCopy()
+		if oldDryRunStatus != nil {
+			dryRunStatus.LastDryRunTime = oldDryRunStatus.LastDryRunTime
+		}
+		if !reflect.DeepEqual(oldDryRunStatus, dryRunStatus) {
+			dryRunStatus.LastDryRunTime = &metav1.Time{Time: timeNowFn()}
+		}
+		status.DryRun = dryRunStatus
+	}
+
+	return status, nil
+}
+
+// listMatchedNamespaces returns the namespaces matched by the NamespaceSelector. It matches all namespaces if the
+// selector is nil, which keeps consistent with the pod mutating webhook.
+func (r *Reconciler) listMatchedNamespaces(ctx context.Context, profile *configv1alpha1.ClusterColocationProfile) (sets.Set[string], error) {
+	selector := labels.Everything()
+	if profile.Spec.NamespaceSelector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(profile.Spec.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace selector: %w", err)
+		}
+	}
+	namespaceList := &corev1.NamespaceList{}
+	if err := r.Client.List(ctx, namespaceList, &client.ListOptions{LabelSelector: selector}, utilclient.DisableDeepCopy); err != nil {
+		return nil, fmt.Errorf("failed to list namespaces, err: %w", err)
+	}
+	namespaces := sets.New[string]()
+	for i := range namespaceList.Items {
+		namespaces.Insert(namespaceList.Items[i].Name)
+	}
+	return namespaces, nil
+}
+
+func getProfilePodSelector(profile *configv1alpha1.ClusterColocationProfile) (labels.Selector, error) {
+	if profile.Spec.Selector == nil {
+		return labels.Everything(), nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(profile.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector: %w", err)
+	}
+	return selector, nil
+}
+
+// getDryRunChangedFields returns the fields of the pod would be changed by the profile.
+func (r *Reconciler) getDryRunChangedFields(pod *corev1.Pod, profile *configv1alpha1.ClusterColocationProfile) ([]string, error) {
+	simulatedPod := pod.DeepCopy()
+	if err := r.doMutateByColocationProfile(simulatedPod, profile); err != nil {
+		return nil, fmt.Errorf("failed to simulate mutating pod %s, err: %w", util.GetPodKey(pod), err)
+	}
+	// the fields below are only mutated by the webhook
+	if profile.Spec.SchedulerName != "" {
+		simulatedPod.Spec.SchedulerName = profile.Spec.SchedulerName
+	}
+	if profile.Spec.PriorityClassName != "" {
+		simulatedPod.Spec.PriorityClassName = profile.Spec.PriorityClassName
+	}
+	return util.GetPodChangedFields(pod, simulatedPod), nil
+}
+
+func (r *Reconciler) getProfileConflicts(ctx context.Context, profile *configv1alpha1.ClusterColocationProfile, coAppliedPods map[string]int64) ([]configv1alpha1.ColocationProfileConflict, error) {
+	if len(coAppliedPods) == 0 {
+		return nil, nil
+	}
+	profileList := &configv1alpha1.ClusterColocationProfileList{}
+	if err := r.Client.List(ctx, profileList, utilclient.DisableDeepCopy); err != nil {
+		return nil, fmt.Errorf("failed to list profiles, err: %w", err)
+	}
+	var conflicts []configv1alpha1.ColocationProfileConflict
+	for i := range profileList.Items {
+		other := &profileList.Items[i]
+		pods := coAppliedPods[other.Name]
+		if other.Name == profile.Name || pods <= 0 {
+			continue
+		}
+		fields := getConflictFields(profile, other)
+		if len(fields) <= 0 {
+			continue
+		}
+		conflicts = append(conflicts, configv1alpha1.ColocationProfileConflict{
+			Profile: other.Name,
+			Fields:  fields,
+			Pods:    pods,
+		})
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Profile < conflicts[j].Profile
+	})
+	return conflicts, nil
+}
+
+// getConflictFields returns the pod fields set to different values by the two profiles.
+func getConflictFields(a, b *configv1alpha1.ClusterColocationProfile) []string {
+	aLabels, bLabels := getProfileLabels(a), getProfileLabels(b)
+	var fields []string
+	for k, v := range aLabels {
+		if bV, ok := bLabels[k]; ok && bV != v {
+			fields = append(fields, "labels/"+k)
+		}
+	}
+	for k, v := range a.Spec.Annotations {
+		if bV, ok := b.Spec.Annotations[k]; ok && bV != v {
+			fields = append(fields, "annotations/"+k)
+		}
+	}
+	if a.Spec.SchedulerName != "" && b.Spec.SchedulerName != "" && a.Spec.SchedulerName != b.Spec.SchedulerName {
+		fields = append(fields, "spec.schedulerName")
+	}
+	if a.Spec.PriorityClassName != "" && b.Spec.PriorityClassName != "" && a.Spec.PriorityClassName != b.Spec.PriorityClassName {
+		fields = append(fields, "spec.priorityClassName")
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// getProfileLabels returns the pod labels set by the profile, including the QoSClass and the KoordinatorPriority.
+func getProfileLabels(profile *configv1alpha1.ClusterColocationProfile) map[string]string {
+	podLabels := map[string]string{}
+	for k, v := range profile.Spec.Labels {
+		podLabels[k] = v
+	}
+	if profile.Spec.QoSClass != "" {
+		podLabels[extension.LabelPodQoS] = profile.Spec.QoSClass
+	}
+	if profile.Spec.KoordinatorPriority != nil {
+		podLabels[extension.LabelPodPriority] = strconv.FormatInt(int64(*profile.Spec.KoordinatorPriority), 10)
+	}
+	return podLabels
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colocationprofile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1alpha1 "github.com/koordinator-sh/koordinator/apis/config/v1alpha1"
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	"github.com/koordinator-sh/koordinator/pkg/util/fieldindex"
+)
+
+func TestReconciler_updateProfileStatus(t *testing.T) {
+	testNow := time.Now().Truncate(time.Second)
+	originalFn := timeNowFn
+	timeNowFn = func() time.Time {
+		return testNow
+	}
+	defer func() {
+		timeNowFn = originalFn
+	}()
+
+	testNamespaces := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns-a",
+				Labels: map[string]string{
+					"enable-koordinator-colocation": "true",
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns-b",
+			},
+		},
+	}
+	testProfileA := &configv1alpha1.ClusterColocationProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "profile-a",
+			Generation: 2,
+		},
+		Spec: configv1alpha1.ClusterColocationProfileSpec{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"enable-koordinator-colocation": "true",
+				},
+			},
+			Labels: map[string]string{
+				"test-label": "a",
+			},
+			QoSClass: string(extension.QoSBE),
+		},
+	}
+	testProfileB := &configv1alpha1.ClusterColocationProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "profile-b",
+		},
+		Spec: configv1alpha1.ClusterColocationProfileSpec{
+			Labels: map[string]string{
+				"test-label": "b",
+			},
+			QoSClass: string(extension.QoSBE),
+		},
+	}
+	testDryRunProfile := &configv1alpha1.ClusterColocationProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "profile-c",
+			Labels: map[string]string{
+				extension.LabelControllerManaged: "true",
+			},
+			Annotations: map[string]string{
+				extension.AnnotationColocationProfileDryRun: "true",
+			},
+		},
+		Spec: configv1alpha1.ClusterColocationProfileSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": "test",
+				},
+			},
+			SchedulerName: "koord-scheduler",
+			QoSClass:      string(extension.QoSLS),
+		},
+	}
+	testPods := []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns-a",
+				Name:              "pod-1",
+				CreationTimestamp: metav1.NewTime(testNow.Add(-2 * time.Minute)),
+				Labels: map[string]string{
+					"app": "test",
+				},
+				Annotations: map[string]string{
+					extension.AnnotationColocationProfiles: "profile-a,profile-b",
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns-a",
+				Name:              "pod-2",
+				CreationTimestamp: metav1.NewTime(testNow.Add(-time.Minute)),
+				Labels: map[string]string{
+					"app": "test",
+				},
+				Annotations: map[string]string{
+					extension.AnnotationColocationProfiles: "profile-a",
+				},
+			},
+			Spec: corev1.PodSpec{
+				SchedulerName: "koord-scheduler",
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns-b",
+				Name:      "pod-3",
+				Labels: map[string]string{
+					"app":                 "test",
+					extension.LabelPodQoS: string(extension.QoSLS),
+				},
+			},
+			Spec: corev1.PodSpec{
+				SchedulerName: "koord-scheduler",
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns-b",
+				Name:      "pod-4",
+				Labels: map[string]string{
+					"app": "test",
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+			},
+		},
+	}
+	builder := fake.NewClientBuilder().WithScheme(getTestScheme()).
+		WithStatusSubresource(&configv1alpha1.ClusterColocationProfile{}).
+		WithIndex(&corev1.Pod{}, fieldindex.IndexPodByColocationProfile, func(obj client.Object) []string {
+			return extension.GetColocationProfilesOfPod(obj.(*corev1.Pod))
+		}).
+		WithObjects(testProfileA, testProfileB, testDryRunProfile)
+	for _, ns := range testNamespaces {
+		builder = builder.WithObjects(ns)
+	}
+	for _, pod := range testPods {
+		builder = builder.WithObjects(pod)
+	}
+	r := &Reconciler{
+		Client:         builder.Build(),
+		rateLimiter:    rate.NewLimiter(rate.Limit(1), 5),
+		podUpdateCache: *gocache.New(ReconcileInterval, time.Minute),
+	}
+
+	t.Run("profile mutating pods", func(t *testing.T) {
+		profile := &configv1alpha1.ClusterColocationProfile{}
+		assert.NoError(t, r.Client.Get(context.TODO(), client.ObjectKey{Name: testProfileA.Name}, profile))
+		err := r.updateProfileStatus(context.TODO(), profile)
+		assert.NoError(t, err)
+
+		got := &configv1alpha1.ClusterColocationProfile{}
+		assert.NoError(t, r.Client.Get(context.TODO(), client.ObjectKey{Name: testProfileA.Name}, got))
+		assert.Equal(t, configv1alpha1.ClusterColocationProfileStatus{
+			ObservedGeneration: 2,
+			MutatedPods:        2,
+			LastMutationTime:   &metav1.Time{Time: testNow.Add(-time.Minute)},
+			MatchedNamespaces:  []string{"ns-a"},
+			Conflicts: []configv1alpha1.ColocationProfileConflict{
+				{
+					Profile: "profile-b",
+					Fields:  []string{"labels/test-label"},
+					Pods:    1,
+				},
+			},
+		}, got.Status)
+	})
+
+	t.Run("profile in dry-run mode", func(t *testing.T) {
+		result, err := r.Reconcile(context.TODO(), ctrl.Request{
+			NamespacedName: types.NamespacedName{Name: testDryRunProfile.Name},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, ctrl.Result{RequeueAfter: ReconcileInterval}, result)
+
+		got := &configv1alpha1.ClusterColocationProfile{}
+		assert.NoError(t, r.Client.Get(context.TODO(), client.ObjectKey{Name: testDryRunProfile.Name}, got))
+		wantDryRunStatus := &configv1alpha1.ColocationProfileDryRunStatus{
+			MatchedPods:    3,
+			AffectedPods:   2,
+			LastDryRunTime: &metav1.Time{Time: testNow},
+			Samples: []configv1alpha1.ColocationProfileDryRunSample{
+				{
+					Pod:    "ns-a/pod-1",
+					Fields: []string{"labels/koordinator.sh/qosClass", "spec.schedulerName"},
+				},
+				{
+					Pod:    "ns-a/pod-2",
+					Fields: []string{"labels/koordinator.sh/qosClass"},
+				},
+			},
+		}
+		assert.Equal(t, wantDryRunStatus, got.Status.DryRun)
+		assert.Equal(t, []string{"ns-a", "ns-b"}, got.Status.MatchedNamespaces)
+		assert.Equal(t, int64(0), got.Status.MutatedPods)
+
+		// pods are not changed
+		for _, pod := range testPods {
+			gotPod := &corev1.Pod{}
+			assert.NoError(t, r.Client.Get(context.TODO(), client.ObjectKeyFromObject(pod), gotPod))
+			assert.Equal(t, pod.Labels, gotPod.Labels)
+			assert.Equal(t, pod.Annotations, gotPod.Annotations)
+		}
+
+		// the dry-run time keeps unchanged if the preview is not changed
+		timeNowFn = func() time.Time {
+			return testNow.Add(time.Minute)
+		}
+		_, err = r.Reconcile(context.TODO(), ctrl.Request{
+			NamespacedName: types.NamespacedName{Name: testDryRunProfile.Name},
+		})
+		assert.NoError(t, err)
+		got = &configv1alpha1.ClusterColocationProfile{}
+		assert.NoError(t, r.Client.Get(context.TODO(), client.ObjectKey{Name: testDryRunProfile.Name}, got))
+		assert.Equal(t, wantDryRunStatus, got.Status.DryRun)
+	})
+}
+
+func Test_getConflictFields(t *testing.T) {
+	a := &configv1alpha1.ClusterColocationProfile{
+		Spec: configv1alpha1.ClusterColocationProfileSpec{
+			Labels: map[string]string{
+				"label-a": "a",
+				"label-b": "b",
+			},
+			Annotations: map[string]string{
+				"annotation-a": "a",
+			},
+			QoSClass:      string(extension.QoSBE),
+			SchedulerName: "koord-scheduler",
+		},
+	}
+	b := &configv1alpha1.ClusterColocationProfile{
+		Spec: configv1alpha1.ClusterColocationProfileSpec{
+			Labels: map[string]string{
+				"label-a":             "a",
+				"label-b":             "c",
+				extension.LabelPodQoS: string(extension.QoSLS),
+			},
+			Annotations: map[string]string{
+				"annotation-a": "b",
+			},
+			PriorityClassName: "koord-batch",
+		},
+	}
+	got := getConflictFields(a, b)
+	assert.Equal(t, []string{"annotations/annotation-a", "labels/koordinator.sh/qosClass", "labels/label-b"}, got)
+	assert.Nil(t, getConflictFields(a, a))
+}
@@ -120,6 +120,8 @@ func (r *Reconciler) updatePodByClusterColocationProfile(ctx context.Context, pr
 	if reflect.DeepEqual(pod, modifiedPod) {
 		return false, nil
 	}
+	// record the profile only when it changes the pod, so the unchanged pods are not patched for the annotation
+	extension.AddColocationProfileToPod(modifiedPod, profile.Name)
 
 	err = util.RetryOnConflictOrTooManyRequests(func() error {
 		patchErr := r.Client.Patch(ctx, modifiedPod, client.MergeFrom(pod))
@@ -334,8 +334,9 @@ func Test_updatePodByClusterColocationProfile(t *testing.T) {
 						extension.LabelPodPriority:   "1111",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":       "valueA",
-						"test-patch-annotation": "patch-b",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
 					},
 					ResourceVersion: "1001",
 				},
@@ -460,10 +461,11 @@ func Test_updatePodByClusterColocationProfile(t *testing.T) {
 						extension.LabelSchedulerName:   "koordinator-scheduler",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":         "valueA",
-						"test-patch-annotation":   "patch-b",
-						"annotation-key-to-load":  "test-annotation-value",
-						"annotation-key-to-store": "test-annotation-value",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
+						"annotation-key-to-load":               "test-annotation-value",
+						"annotation-key-to-store":              "test-annotation-value",
 					},
 					ResourceVersion: "1001",
 				},
@@ -571,9 +573,10 @@ func Test_updatePodByClusterColocationProfile(t *testing.T) {
 						extension.LabelSchedulerName:   "koordinator-scheduler",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":         "valueA",
-						"test-patch-annotation":   "patch-b",
-						"annotation-key-to-store": "",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
+						"annotation-key-to-store":              "",
 					},
 					ResourceVersion: "1001",
 				},
@@ -692,9 +695,10 @@ func Test_updatePodByClusterColocationProfile(t *testing.T) {
 						extension.LabelSchedulerName:   "koordinator-scheduler",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":         "valueA",
-						"test-patch-annotation":   "patch-b",
-						"annotation-key-to-store": "",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
+						"annotation-key-to-store":              "",
 					},
 					ResourceVersion: "1001",
 				},
@@ -796,6 +800,56 @@ func Test_updatePodByClusterColocationProfile(t *testing.T) {
 			want:        false,
 			wantErr:     true,
 		},
+		{
+			name: "pod unchanged by the profile is not annotated",
+			pod: &corev1.Pod{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "v1",
+					Kind:       "Pod",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "test-pod-unchanged",
+					Labels: map[string]string{
+						"koordinator-colocation-pod": "true",
+						"testLabelA":                 "valueA",
+					},
+					ResourceVersion: "1000",
+				},
+			},
+			profile: &configv1alpha1.ClusterColocationProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-profile",
+				},
+				Spec: configv1alpha1.ClusterColocationProfileSpec{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"koordinator-colocation-pod": "true",
+						},
+					},
+					Labels: map[string]string{
+						"testLabelA": "valueA",
+					},
+				},
+			},
+			wantPod: &corev1.Pod{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "v1",
+					Kind:       "Pod",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "test-pod-unchanged",
+					Labels: map[string]string{
+						"koordinator-colocation-pod": "true",
+						"testLabelA":                 "valueA",
+					},
+					ResourceVersion: "1000",
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range testCases {
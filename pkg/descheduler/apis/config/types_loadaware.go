@@ -35,6 +35,12 @@ type LowNodeLoadArgs struct {
 	// Default is false
 	DryRun bool
 
+	// EnableReport means writing a DeschedulingReport for each node pool in every descheduling round,
+	// which lists the classified nodes, the candidate Pods, the target nodes and the expected utilization.
+	// It is usually used together with DryRun to evaluate the strategy before migrating Pods.
+	// Default is false
+	EnableReport bool
+
 	// NumberOfNodes can be configured to activate the strategy only when the number of under utilized nodes are above the configured value.
 	// This could be helpful in large clusters where a few nodes could go under utilized frequently or for a short period of time.
 	// This parameter includes the sum of nodes with low node utilization, low prod utilization, and both.
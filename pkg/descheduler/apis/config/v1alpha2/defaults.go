@@ -45,7 +45,9 @@ const (
 	defaultMigrationEvictBurst         = 1
 	defaultSchedulerSupportReservation = "koord-scheduler"
 	defaultArbitrationInterval         = 500 * time.Millisecond
+	defaultArbitrationSortPluginWeight = 1
 	defaultDetectorCacheTimeout        = 5 * time.Minute
+	defaultDelayRevokeTime             = 120 * time.Second
 )
 
 var (
@@ -254,6 +256,11 @@ func SetDefaults_MigrationControllerArgs(obj *MigrationControllerArgs) {
 	if obj.ArbitrationArgs.Interval == nil {
 		obj.ArbitrationArgs.Interval = &metav1.Duration{Duration: defaultArbitrationInterval}
 	}
+	for i := range obj.ArbitrationArgs.SortPlugins {
+		if obj.ArbitrationArgs.SortPlugins[i].Weight == 0 {
+			obj.ArbitrationArgs.SortPlugins[i].Weight = defaultArbitrationSortPluginWeight
+		}
+	}
 }
 
 func SetDefaults_LowNodeLoadArgs(obj *LowNodeLoadArgs) {
@@ -293,3 +300,9 @@ func SetDefaults_LowNodeLoadArgs(obj *LowNodeLoadArgs) {
 		}
 	}
 }
+
+func SetDefaults_ElasticQuotaRevokeArgs(obj *ElasticQuotaRevokeArgs) {
+	if obj.DelayRevokeTime == nil {
+		obj.DelayRevokeTime = &metav1.Duration{Duration: defaultDelayRevokeTime}
+	}
+}
@@ -126,3 +126,17 @@ func TestSetDefaults_LowNodeLoadArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestSetDefaults_ElasticQuotaRevokeArgs(t *testing.T) {
+	args := &ElasticQuotaRevokeArgs{}
+	SetDefaults_ElasticQuotaRevokeArgs(args)
+	assert.Equal(t, &ElasticQuotaRevokeArgs{
+		DelayRevokeTime: &metav1.Duration{Duration: defaultDelayRevokeTime},
+	}, args)
+
+	args = &ElasticQuotaRevokeArgs{
+		DelayRevokeTime: &metav1.Duration{Duration: time.Minute},
+	}
+	SetDefaults_ElasticQuotaRevokeArgs(args)
+	assert.Equal(t, time.Minute, args.DelayRevokeTime.Duration)
+}
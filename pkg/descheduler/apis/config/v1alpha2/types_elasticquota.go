@@ -0,0 +1,44 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ElasticQuotaRevokeArgs holds arguments used to configure the ElasticQuotaRevoke plugin.
+type ElasticQuotaRevokeArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// DryRun means only execute the entire deschedule logic but don't migrate Pod
+	// Default is false
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// DelayRevokeTime is the duration that the used of an ElasticQuota continuously exceeds its runtime
+	// before the borrowed resources are revoked.
+	// Default is 120 seconds.
+	DelayRevokeTime *metav1.Duration `json:"delayRevokeTime,omitempty"`
+
+	// QuotaSelector selects the ElasticQuotas whose borrowed resources can be revoked.
+	// By default, all ElasticQuotas are selected.
+	QuotaSelector *metav1.LabelSelector `json:"quotaSelector,omitempty"`
+
+	// EvictableNamespaces carries a list of included/excluded namespaces of the Pods that can be migrated.
+	EvictableNamespaces *Namespaces `json:"evictableNamespaces,omitempty"`
+}
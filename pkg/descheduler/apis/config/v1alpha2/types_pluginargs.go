@@ -118,6 +118,10 @@ type MigrationControllerArgs struct {
 
 	// ArbitrationArgs defines the control parameters of the Arbitration Mechanism.
 	ArbitrationArgs *ArbitrationArgs `json:"arbitrationArgs,omitempty"`
+
+	// MigrationWindowArgs defines the time windows in which PodMigrationJobs are allowed to start.
+	// Jobs outside the windows stay Pending until a window opens, and running jobs are not interrupted.
+	MigrationWindowArgs *MigrationWindowArgs `json:"migrationWindowArgs,omitempty"`
 }
 
 type MigrationLimitObjectType string
@@ -149,4 +153,62 @@ type ArbitrationArgs struct {
 	// Interval defines the running interval (ms) of the Arbitration Mechanism.
 	// Default is 500 ms
 	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// SortPlugins defines the sort plugins and their weights used to order the PodMigrationJobs to be arbitrated.
+	// Each plugin ranks the jobs, and the jobs are ordered by the weighted sum of the ranks.
+	// If empty, the default plugins CreationTime, Pod, Controller and MigratingNum are applied in turn.
+	SortPlugins []ArbitrationSortPlugin `json:"sortPlugins,omitempty"`
+
+	// FilterPlugins defines the names of the additional filter plugins checked before a PodMigrationJob passes arbitration.
+	// The jobs rejected by the filter plugins keep waiting for the next arbitration.
+	FilterPlugins []string `json:"filterPlugins,omitempty"`
+}
+
+// ArbitrationSortPlugin specifies a sort plugin of the Arbitration Mechanism.
+type ArbitrationSortPlugin struct {
+	// Name defines the name of the sort plugin.
+	Name string `json:"name"`
+
+	// Weight defines the weight of the sort plugin. Default is 1.
+	Weight int32 `json:"weight,omitempty"`
+}
+
+// MigrationWindowArgs holds arguments used to configure the time windows of migration.
+// The windows of a Pod are determined in the following order:
+// the annotation "descheduler.koordinator.sh/migration-windows" of the Pod, the Namespaces, and the Default.
+type MigrationWindowArgs struct {
+	// Default defines the windows applied to all Pods.
+	Default *MigrationWindows `json:"default,omitempty"`
+
+	// Namespaces defines the windows applied to the Pods in the specified namespaces, which override the Default.
+	Namespaces map[string]MigrationWindows `json:"namespaces,omitempty"`
+}
+
+// MigrationWindows defines when migration is allowed or forbidden.
+type MigrationWindows struct {
+	// TimeZone is the IANA time zone name used to interpret the windows, e.g. "Asia/Shanghai".
+	// Default is UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// AllowedWindows defines the windows in which migration is allowed.
+	// If empty, migration is allowed at any time except in the BlockedWindows.
+	AllowedWindows []MigrationTimeWindow `json:"allowedWindows,omitempty"`
+
+	// BlockedWindows defines the windows in which migration is forbidden.
+	// BlockedWindows take precedence over AllowedWindows.
+	BlockedWindows []MigrationTimeWindow `json:"blockedWindows,omitempty"`
+}
+
+// MigrationTimeWindow represents a daily time range.
+type MigrationTimeWindow struct {
+	// Weekdays represents the days of the week the window applies to, e.g. "Monday".
+	// If empty, the window applies to every day.
+	Weekdays []string `json:"weekdays,omitempty"`
+
+	// Start is the start time of the window in the format "15:04".
+	Start string `json:"start,omitempty"`
+
+	// End is the end time of the window in the format "15:04".
+	// If End is earlier than Start, the window crosses midnight. If End equals Start, the window covers the whole day.
+	End string `json:"end,omitempty"`
 }
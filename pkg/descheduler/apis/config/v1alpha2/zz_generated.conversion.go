@@ -50,6 +50,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ArbitrationSortPlugin)(nil), (*config.ArbitrationSortPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ArbitrationSortPlugin_To_config_ArbitrationSortPlugin(a.(*ArbitrationSortPlugin), b.(*config.ArbitrationSortPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ArbitrationSortPlugin)(nil), (*ArbitrationSortPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ArbitrationSortPlugin_To_v1alpha2_ArbitrationSortPlugin(a.(*config.ArbitrationSortPlugin), b.(*ArbitrationSortPlugin), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeschedulerProfile)(nil), (*config.DeschedulerProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_DeschedulerProfile_To_config_DeschedulerProfile(a.(*DeschedulerProfile), b.(*config.DeschedulerProfile), scope)
 	}); err != nil {
@@ -60,6 +70,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ElasticQuotaRevokeArgs)(nil), (*config.ElasticQuotaRevokeArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ElasticQuotaRevokeArgs_To_config_ElasticQuotaRevokeArgs(a.(*ElasticQuotaRevokeArgs), b.(*config.ElasticQuotaRevokeArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ElasticQuotaRevokeArgs)(nil), (*ElasticQuotaRevokeArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ElasticQuotaRevokeArgs_To_v1alpha2_ElasticQuotaRevokeArgs(a.(*config.ElasticQuotaRevokeArgs), b.(*ElasticQuotaRevokeArgs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LoadAnomalyCondition)(nil), (*config.LoadAnomalyCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_LoadAnomalyCondition_To_config_LoadAnomalyCondition(a.(*LoadAnomalyCondition), b.(*config.LoadAnomalyCondition), scope)
 	}); err != nil {
@@ -115,6 +135,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MigrationTimeWindow)(nil), (*config.MigrationTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_MigrationTimeWindow_To_config_MigrationTimeWindow(a.(*MigrationTimeWindow), b.(*config.MigrationTimeWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MigrationTimeWindow)(nil), (*MigrationTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MigrationTimeWindow_To_v1alpha2_MigrationTimeWindow(a.(*config.MigrationTimeWindow), b.(*MigrationTimeWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MigrationWindowArgs)(nil), (*config.MigrationWindowArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_MigrationWindowArgs_To_config_MigrationWindowArgs(a.(*MigrationWindowArgs), b.(*config.MigrationWindowArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MigrationWindowArgs)(nil), (*MigrationWindowArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MigrationWindowArgs_To_v1alpha2_MigrationWindowArgs(a.(*config.MigrationWindowArgs), b.(*MigrationWindowArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MigrationWindows)(nil), (*config.MigrationWindows)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_MigrationWindows_To_config_MigrationWindows(a.(*MigrationWindows), b.(*config.MigrationWindows), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MigrationWindows)(nil), (*MigrationWindows)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MigrationWindows_To_v1alpha2_MigrationWindows(a.(*config.MigrationWindows), b.(*MigrationWindows), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Namespaces)(nil), (*config.Namespaces)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_Namespaces_To_config_Namespaces(a.(*Namespaces), b.(*config.Namespaces), scope)
 	}); err != nil {
@@ -196,6 +246,8 @@ func RegisterConversions(s *runtime.Scheme) error {
 func autoConvert_v1alpha2_ArbitrationArgs_To_config_ArbitrationArgs(in *ArbitrationArgs, out *config.ArbitrationArgs, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	out.SortPlugins = *(*[]config.ArbitrationSortPlugin)(unsafe.Pointer(&in.SortPlugins))
+	out.FilterPlugins = *(*[]string)(unsafe.Pointer(&in.FilterPlugins))
 	return nil
 }
 
@@ -207,6 +259,8 @@ func Convert_v1alpha2_ArbitrationArgs_To_config_ArbitrationArgs(in *ArbitrationA
 func autoConvert_config_ArbitrationArgs_To_v1alpha2_ArbitrationArgs(in *config.ArbitrationArgs, out *ArbitrationArgs, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	out.SortPlugins = *(*[]ArbitrationSortPlugin)(unsafe.Pointer(&in.SortPlugins))
+	out.FilterPlugins = *(*[]string)(unsafe.Pointer(&in.FilterPlugins))
 	return nil
 }
 
@@ -215,6 +269,28 @@ func Convert_config_ArbitrationArgs_To_v1alpha2_ArbitrationArgs(in *config.Arbit
 	return autoConvert_config_ArbitrationArgs_To_v1alpha2_ArbitrationArgs(in, out, s)
 }
 
+func autoConvert_v1alpha2_ArbitrationSortPlugin_To_config_ArbitrationSortPlugin(in *ArbitrationSortPlugin, out *config.ArbitrationSortPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1alpha2_ArbitrationSortPlugin_To_config_ArbitrationSortPlugin is an autogenerated conversion function.
+func Convert_v1alpha2_ArbitrationSortPlugin_To_config_ArbitrationSortPlugin(in *ArbitrationSortPlugin, out *config.ArbitrationSortPlugin, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ArbitrationSortPlugin_To_config_ArbitrationSortPlugin(in, out, s)
+}
+
+func autoConvert_config_ArbitrationSortPlugin_To_v1alpha2_ArbitrationSortPlugin(in *config.ArbitrationSortPlugin, out *ArbitrationSortPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_config_ArbitrationSortPlugin_To_v1alpha2_ArbitrationSortPlugin is an autogenerated conversion function.
+func Convert_config_ArbitrationSortPlugin_To_v1alpha2_ArbitrationSortPlugin(in *config.ArbitrationSortPlugin, out *ArbitrationSortPlugin, s conversion.Scope) error {
+	return autoConvert_config_ArbitrationSortPlugin_To_v1alpha2_ArbitrationSortPlugin(in, out, s)
+}
+
 func autoConvert_v1alpha2_DeschedulerConfiguration_To_config_DeschedulerConfiguration(in *DeschedulerConfiguration, out *config.DeschedulerConfiguration, s conversion.Scope) error {
 	if err := v1alpha1.Convert_v1alpha1_LeaderElectionConfiguration_To_config_LeaderElectionConfiguration(&in.LeaderElection, &out.LeaderElection, s); err != nil {
 		return err
@@ -333,6 +409,36 @@ func Convert_config_DeschedulerProfile_To_v1alpha2_DeschedulerProfile(in *config
 	return autoConvert_config_DeschedulerProfile_To_v1alpha2_DeschedulerProfile(in, out, s)
 }
 
+func autoConvert_v1alpha2_ElasticQuotaRevokeArgs_To_config_ElasticQuotaRevokeArgs(in *ElasticQuotaRevokeArgs, out *config.ElasticQuotaRevokeArgs, s conversion.Scope) error {
+	if err := v1.Convert_Pointer_bool_To_bool(&in.DryRun, &out.DryRun, s); err != nil {
+		return err
+	}
+	out.DelayRevokeTime = (*v1.Duration)(unsafe.Pointer(in.DelayRevokeTime))
+	out.QuotaSelector = (*v1.LabelSelector)(unsafe.Pointer(in.QuotaSelector))
+	out.EvictableNamespaces = (*config.Namespaces)(unsafe.Pointer(in.EvictableNamespaces))
+	return nil
+}
+
+// Convert_v1alpha2_ElasticQuotaRevokeArgs_To_config_ElasticQuotaRevokeArgs is an autogenerated conversion function.
+func Convert_v1alpha2_ElasticQuotaRevokeArgs_To_config_ElasticQuotaRevokeArgs(in *ElasticQuotaRevokeArgs, out *config.ElasticQuotaRevokeArgs, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ElasticQuotaRevokeArgs_To_config_ElasticQuotaRevokeArgs(in, out, s)
+}
+
+func autoConvert_config_ElasticQuotaRevokeArgs_To_v1alpha2_ElasticQuotaRevokeArgs(in *config.ElasticQuotaRevokeArgs, out *ElasticQuotaRevokeArgs, s conversion.Scope) error {
+	if err := v1.Convert_bool_To_Pointer_bool(&in.DryRun, &out.DryRun, s); err != nil {
+		return err
+	}
+	out.DelayRevokeTime = (*v1.Duration)(unsafe.Pointer(in.DelayRevokeTime))
+	out.QuotaSelector = (*v1.LabelSelector)(unsafe.Pointer(in.QuotaSelector))
+	out.EvictableNamespaces = (*Namespaces)(unsafe.Pointer(in.EvictableNamespaces))
+	return nil
+}
+
+// Convert_config_ElasticQuotaRevokeArgs_To_v1alpha2_ElasticQuotaRevokeArgs is an autogenerated conversion function.
+func Convert_config_ElasticQuotaRevokeArgs_To_v1alpha2_ElasticQuotaRevokeArgs(in *config.ElasticQuotaRevokeArgs, out *ElasticQuotaRevokeArgs, s conversion.Scope) error {
+	return autoConvert_config_ElasticQuotaRevokeArgs_To_v1alpha2_ElasticQuotaRevokeArgs(in, out, s)
+}
+
 func autoConvert_v1alpha2_LoadAnomalyCondition_To_config_LoadAnomalyCondition(in *LoadAnomalyCondition, out *config.LoadAnomalyCondition, s conversion.Scope) error {
 	if err := v1.Convert_Pointer_v1_Duration_To_v1_Duration(&in.Timeout, &out.Timeout, s); err != nil {
 		return err
@@ -368,6 +474,9 @@ func autoConvert_v1alpha2_LowNodeLoadArgs_To_config_LowNodeLoadArgs(in *LowNodeL
 	if err := v1.Convert_Pointer_bool_To_bool(&in.DryRun, &out.DryRun, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableReport, &out.EnableReport, s); err != nil {
+		return err
+	}
 	if err := v1.Convert_Pointer_int32_To_int32(&in.NumberOfNodes, &out.NumberOfNodes, s); err != nil {
 		return err
 	}
@@ -417,6 +526,9 @@ func autoConvert_config_LowNodeLoadArgs_To_v1alpha2_LowNodeLoadArgs(in *config.L
 	if err := v1.Convert_bool_To_Pointer_bool(&in.DryRun, &out.DryRun, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableReport, &out.EnableReport, s); err != nil {
+		return err
+	}
 	if err := v1.Convert_int32_To_Pointer_int32(&in.NumberOfNodes, &out.NumberOfNodes, s); err != nil {
 		return err
 	}
@@ -572,6 +684,7 @@ func autoConvert_v1alpha2_MigrationControllerArgs_To_config_MigrationControllerA
 	out.EvictionPolicy = in.EvictionPolicy
 	out.DefaultDeleteOptions = (*v1.DeleteOptions)(unsafe.Pointer(in.DefaultDeleteOptions))
 	out.ArbitrationArgs = (*config.ArbitrationArgs)(unsafe.Pointer(in.ArbitrationArgs))
+	out.MigrationWindowArgs = (*config.MigrationWindowArgs)(unsafe.Pointer(in.MigrationWindowArgs))
 	return nil
 }
 
@@ -614,6 +727,7 @@ func autoConvert_config_MigrationControllerArgs_To_v1alpha2_MigrationControllerA
 	out.DefaultDeleteOptions = (*v1.DeleteOptions)(unsafe.Pointer(in.DefaultDeleteOptions))
 	out.SchedulerNames = *(*[]string)(unsafe.Pointer(&in.SchedulerNames))
 	out.ArbitrationArgs = (*ArbitrationArgs)(unsafe.Pointer(in.ArbitrationArgs))
+	out.MigrationWindowArgs = (*MigrationWindowArgs)(unsafe.Pointer(in.MigrationWindowArgs))
 	return nil
 }
 
@@ -646,6 +760,76 @@ func Convert_config_MigrationObjectLimiter_To_v1alpha2_MigrationObjectLimiter(in
 	return autoConvert_config_MigrationObjectLimiter_To_v1alpha2_MigrationObjectLimiter(in, out, s)
 }
 
+func autoConvert_v1alpha2_MigrationTimeWindow_To_config_MigrationTimeWindow(in *MigrationTimeWindow, out *config.MigrationTimeWindow, s conversion.Scope) error {
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1alpha2_MigrationTimeWindow_To_config_MigrationTimeWindow is an autogenerated conversion function.
+func Convert_v1alpha2_MigrationTimeWindow_To_config_MigrationTimeWindow(in *MigrationTimeWindow, out *config.MigrationTimeWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha2_MigrationTimeWindow_To_config_MigrationTimeWindow(in, out, s)
+}
+
+func autoConvert_config_MigrationTimeWindow_To_v1alpha2_MigrationTimeWindow(in *config.MigrationTimeWindow, out *MigrationTimeWindow, s conversion.Scope) error {
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_config_MigrationTimeWindow_To_v1alpha2_MigrationTimeWindow is an autogenerated conversion function.
+func Convert_config_MigrationTimeWindow_To_v1alpha2_MigrationTimeWindow(in *config.MigrationTimeWindow, out *MigrationTimeWindow, s conversion.Scope) error {
+	return autoConvert_config_MigrationTimeWindow_To_v1alpha2_MigrationTimeWindow(in, out, s)
+}
+
+func autoConvert_v1alpha2_MigrationWindowArgs_To_config_MigrationWindowArgs(in *MigrationWindowArgs, out *config.MigrationWindowArgs, s conversion.Scope) error {
+	out.Default = (*config.MigrationWindows)(unsafe.Pointer(in.Default))
+	out.Namespaces = *(*map[string]config.MigrationWindows)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_v1alpha2_MigrationWindowArgs_To_config_MigrationWindowArgs is an autogenerated conversion function.
+func Convert_v1alpha2_MigrationWindowArgs_To_config_MigrationWindowArgs(in *MigrationWindowArgs, out *config.MigrationWindowArgs, s conversion.Scope) error {
+	return autoConvert_v1alpha2_MigrationWindowArgs_To_config_MigrationWindowArgs(in, out, s)
+}
+
+func autoConvert_config_MigrationWindowArgs_To_v1alpha2_MigrationWindowArgs(in *config.MigrationWindowArgs, out *MigrationWindowArgs, s conversion.Scope) error {
+	out.Default = (*MigrationWindows)(unsafe.Pointer(in.Default))
+	out.Namespaces = *(*map[string]MigrationWindows)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_config_MigrationWindowArgs_To_v1alpha2_MigrationWindowArgs is an autogenerated conversion function.
+func Convert_config_MigrationWindowArgs_To_v1alpha2_MigrationWindowArgs(in *config.MigrationWindowArgs, out *MigrationWindowArgs, s conversion.Scope) error {
+	return autoConvert_config_MigrationWindowArgs_To_v1alpha2_MigrationWindowArgs(in, out, s)
+}
+
+func autoConvert_v1alpha2_MigrationWindows_To_config_MigrationWindows(in *MigrationWindows, out *config.MigrationWindows, s conversion.Scope) error {
+	out.TimeZone = in.TimeZone
+	out.AllowedWindows = *(*[]config.MigrationTimeWindow)(unsafe.Pointer(&in.AllowedWindows))
+	out.BlockedWindows = *(*[]config.MigrationTimeWindow)(unsafe.Pointer(&in.BlockedWindows))
+	return nil
+}
+
+// Convert_v1alpha2_MigrationWindows_To_config_MigrationWindows is an autogenerated conversion function.
+func Convert_v1alpha2_MigrationWindows_To_config_MigrationWindows(in *MigrationWindows, out *config.MigrationWindows, s conversion.Scope) error {
+	return autoConvert_v1alpha2_MigrationWindows_To_config_MigrationWindows(in, out, s)
+}
+
+func autoConvert_config_MigrationWindows_To_v1alpha2_MigrationWindows(in *config.MigrationWindows, out *MigrationWindows, s conversion.Scope) error {
+	out.TimeZone = in.TimeZone
+	out.AllowedWindows = *(*[]MigrationTimeWindow)(unsafe.Pointer(&in.AllowedWindows))
+	out.BlockedWindows = *(*[]MigrationTimeWindow)(unsafe.Pointer(&in.BlockedWindows))
+	return nil
+}
+
+// Convert_config_MigrationWindows_To_v1alpha2_MigrationWindows is an autogenerated conversion function.
+func Convert_config_MigrationWindows_To_v1alpha2_MigrationWindows(in *config.MigrationWindows, out *MigrationWindows, s conversion.Scope) error {
+	return autoConvert_config_MigrationWindows_To_v1alpha2_MigrationWindows(in, out, s)
+}
+
 func autoConvert_v1alpha2_Namespaces_To_config_Namespaces(in *Namespaces, out *config.Namespaces, s conversion.Scope) error {
 	out.Include = *(*[]string)(unsafe.Pointer(&in.Include))
 	out.Exclude = *(*[]string)(unsafe.Pointer(&in.Exclude))
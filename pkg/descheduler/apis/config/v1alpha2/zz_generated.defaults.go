@@ -30,6 +30,7 @@ import (
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&DeschedulerConfiguration{}, func(obj interface{}) { SetObjectDefaults_DeschedulerConfiguration(obj.(*DeschedulerConfiguration)) })
+	scheme.AddTypeDefaultingFunc(&ElasticQuotaRevokeArgs{}, func(obj interface{}) { SetObjectDefaults_ElasticQuotaRevokeArgs(obj.(*ElasticQuotaRevokeArgs)) })
 	scheme.AddTypeDefaultingFunc(&LowNodeLoadArgs{}, func(obj interface{}) { SetObjectDefaults_LowNodeLoadArgs(obj.(*LowNodeLoadArgs)) })
 	scheme.AddTypeDefaultingFunc(&MigrationControllerArgs{}, func(obj interface{}) { SetObjectDefaults_MigrationControllerArgs(obj.(*MigrationControllerArgs)) })
 	return nil
@@ -39,6 +40,10 @@ func SetObjectDefaults_DeschedulerConfiguration(in *DeschedulerConfiguration) {
 	SetDefaults_DeschedulerConfiguration(in)
 }
 
+func SetObjectDefaults_ElasticQuotaRevokeArgs(in *ElasticQuotaRevokeArgs) {
+	SetDefaults_ElasticQuotaRevokeArgs(in)
+}
+
 func SetObjectDefaults_LowNodeLoadArgs(in *LowNodeLoadArgs) {
 	SetDefaults_LowNodeLoadArgs(in)
 }
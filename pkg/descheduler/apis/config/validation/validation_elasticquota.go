@@ -0,0 +1,42 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	deschedulerconfig "github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
+)
+
+func ValidateElasticQuotaRevokeArgs(path *field.Path, args *deschedulerconfig.ElasticQuotaRevokeArgs) error {
+	var allErrs field.ErrorList
+
+	if args.DelayRevokeTime != nil && args.DelayRevokeTime.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("delayRevokeTime"), args.DelayRevokeTime, "must be greater than or equal to 0"))
+	}
+
+	if args.QuotaSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(args.QuotaSelector, metav1validation.LabelSelectorValidationOptions{}, path.Child("quotaSelector"))...)
+	}
+
+	if args.EvictableNamespaces != nil && len(args.EvictableNamespaces.Include) > 0 && len(args.EvictableNamespaces.Exclude) > 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("evictableNamespaces"), args.EvictableNamespaces, "only one of Include/Exclude namespaces can be set"))
+	}
+
+	return allErrs.ToAggregate()
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	deschedulerconfig "github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
+)
+
+func TestValidateElasticQuotaRevokeArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    *deschedulerconfig.ElasticQuotaRevokeArgs
+		wantErr bool
+	}{
+		{
+			name: "valid args",
+			args: &deschedulerconfig.ElasticQuotaRevokeArgs{
+				DelayRevokeTime: &metav1.Duration{Duration: 2 * time.Minute},
+				QuotaSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"quota.scheduling.koordinator.sh/revocable": "true"},
+				},
+			},
+		},
+		{
+			name: "negative delayRevokeTime",
+			args: &deschedulerconfig.ElasticQuotaRevokeArgs{
+				DelayRevokeTime: &metav1.Duration{Duration: -time.Minute},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid quotaSelector",
+			args: &deschedulerconfig.ElasticQuotaRevokeArgs{
+				QuotaSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "a", Operator: "invalid"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "both include and exclude namespaces",
+			args: &deschedulerconfig.ElasticQuotaRevokeArgs{
+				EvictableNamespaces: &deschedulerconfig.Namespaces{
+					Include: []string{"a"},
+					Exclude: []string{"b"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateElasticQuotaRevokeArgs(nil, tt.args)
+			assert.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
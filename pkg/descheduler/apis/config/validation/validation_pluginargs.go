@@ -18,9 +18,12 @@ package validation
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	sev1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
@@ -83,8 +86,91 @@ func ValidateMigrationControllerArgs(path *field.Path, args *deschedulerconfig.M
 		allErrs = append(allErrs, field.Invalid(path.Child("defaultJobTTL"), args.DefaultJobTTL, "defaultJobTTL should be positive or zero"))
 	}
 
+	if args.ArbitrationArgs != nil {
+		allErrs = append(allErrs, validateArbitrationArgs(path.Child("arbitrationArgs"), args.ArbitrationArgs)...)
+	}
+
+	if args.MigrationWindowArgs != nil {
+		allErrs = append(allErrs, validateMigrationWindowArgs(path.Child("migrationWindowArgs"), args.MigrationWindowArgs)...)
+	}
+
 	if len(allErrs) == 0 {
 		return nil
 	}
 	return allErrs.ToAggregate()
 }
+
+func validateArbitrationArgs(path *field.Path, args *deschedulerconfig.ArbitrationArgs) field.ErrorList {
+	var allErrs field.ErrorList
+	sortPlugins := sets.NewString()
+	for i, plugin := range args.SortPlugins {
+		if plugin.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("sortPlugins").Index(i).Child("name"), "name of sort plugin is required"))
+		} else if sortPlugins.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("sortPlugins").Index(i).Child("name"), plugin.Name))
+		}
+		sortPlugins.Insert(plugin.Name)
+		if plugin.Weight <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("sortPlugins").Index(i).Child("weight"), plugin.Weight, "weight should be greater than 0"))
+		}
+	}
+	filterPlugins := sets.NewString()
+	for i, name := range args.FilterPlugins {
+		if name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("filterPlugins").Index(i), "name of filter plugin is required"))
+		} else if filterPlugins.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("filterPlugins").Index(i), name))
+		}
+		filterPlugins.Insert(name)
+	}
+	return allErrs
+}
+
+func validateMigrationWindowArgs(path *field.Path, args *deschedulerconfig.MigrationWindowArgs) field.ErrorList {
+	var allErrs field.ErrorList
+	if args.Default != nil {
+		allErrs = append(allErrs, ValidateMigrationWindows(path.Child("default"), args.Default)...)
+	}
+	for namespace := range args.Namespaces {
+		windows := args.Namespaces[namespace]
+		allErrs = append(allErrs, ValidateMigrationWindows(path.Child("namespaces").Key(namespace), &windows)...)
+	}
+	return allErrs
+}
+
+// ValidateMigrationWindows validates the time zone and time windows of MigrationWindows.
+func ValidateMigrationWindows(path *field.Path, windows *deschedulerconfig.MigrationWindows) field.ErrorList {
+	var allErrs field.ErrorList
+	if windows.TimeZone != "" {
+		if _, err := time.LoadLocation(windows.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("timeZone"), windows.TimeZone, fmt.Sprintf("timeZone is invalid, err: %v", err)))
+		}
+	}
+	for i, w := range windows.AllowedWindows {
+		allErrs = append(allErrs, validateMigrationTimeWindow(path.Child("allowedWindows").Index(i), w)...)
+	}
+	for i, w := range windows.BlockedWindows {
+		allErrs = append(allErrs, validateMigrationTimeWindow(path.Child("blockedWindows").Index(i), w)...)
+	}
+	return allErrs
+}
+
+var validWeekdays = map[string]bool{
+	"sunday": true, "monday": true, "tuesday": true, "wednesday": true, "thursday": true, "friday": true, "saturday": true,
+}
+
+func validateMigrationTimeWindow(path *field.Path, window deschedulerconfig.MigrationTimeWindow) field.ErrorList {
+	var allErrs field.ErrorList
+	if _, err := time.Parse("15:04", window.Start); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("start"), window.Start, "start must be in the format HH:MM"))
+	}
+	if _, err := time.Parse("15:04", window.End); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("end"), window.End, "end must be in the format HH:MM"))
+	}
+	for i, day := range window.Weekdays {
+		if !validWeekdays[strings.ToLower(day)] {
+			allErrs = append(allErrs, field.Invalid(path.Child("weekdays").Index(i), day, "weekday must be one of Sunday, Monday, Tuesday, Wednesday, Thursday, Friday and Saturday"))
+		}
+	}
+	return allErrs
+}
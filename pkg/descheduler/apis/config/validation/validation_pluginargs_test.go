@@ -93,6 +93,94 @@ func TestValidateMigrationControllerArgs(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid migrationWindowArgs",
+			args: &v1alpha2.MigrationControllerArgs{
+				MigrationWindowArgs: &v1alpha2.MigrationWindowArgs{
+					Default: &v1alpha2.MigrationWindows{
+						TimeZone: "Asia/Shanghai",
+						AllowedWindows: []v1alpha2.MigrationTimeWindow{
+							{Start: "22:00", End: "06:00"},
+						},
+						BlockedWindows: []v1alpha2.MigrationTimeWindow{
+							{Weekdays: []string{"Saturday", "sunday"}, Start: "00:00", End: "00:00"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid migrationWindowArgs timeZone",
+			args: &v1alpha2.MigrationControllerArgs{
+				MigrationWindowArgs: &v1alpha2.MigrationWindowArgs{
+					Default: &v1alpha2.MigrationWindows{
+						TimeZone: "Mars/Olympus",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid migrationWindowArgs time window",
+			args: &v1alpha2.MigrationControllerArgs{
+				MigrationWindowArgs: &v1alpha2.MigrationWindowArgs{
+					Namespaces: map[string]v1alpha2.MigrationWindows{
+						"default": {
+							AllowedWindows: []v1alpha2.MigrationTimeWindow{
+								{Weekdays: []string{"Someday"}, Start: "25:00", End: "06:00"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid arbitrationArgs plugins",
+			args: &v1alpha2.MigrationControllerArgs{
+				ArbitrationArgs: &v1alpha2.ArbitrationArgs{
+					SortPlugins: []v1alpha2.ArbitrationSortPlugin{
+						{Name: "RestartCost", Weight: 2},
+						{Name: "MostLoadedNode"},
+					},
+					FilterPlugins: []string{"SingleMigrationPerGang"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid arbitrationArgs sort plugin weight",
+			args: &v1alpha2.MigrationControllerArgs{
+				ArbitrationArgs: &v1alpha2.ArbitrationArgs{
+					SortPlugins: []v1alpha2.ArbitrationSortPlugin{
+						{Name: "RestartCost", Weight: -1},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate arbitrationArgs sort plugins",
+			args: &v1alpha2.MigrationControllerArgs{
+				ArbitrationArgs: &v1alpha2.ArbitrationArgs{
+					SortPlugins: []v1alpha2.ArbitrationSortPlugin{
+						{Name: "RestartCost"},
+						{Name: "RestartCost"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid arbitrationArgs filter plugins",
+			args: &v1alpha2.MigrationControllerArgs{
+				ArbitrationArgs: &v1alpha2.ArbitrationArgs{
+					FilterPlugins: []string{""},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -36,6 +36,16 @@ func (in *ArbitrationArgs) DeepCopyInto(out *ArbitrationArgs) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.SortPlugins != nil {
+		in, out := &in.SortPlugins, &out.SortPlugins
+		*out = make([]ArbitrationSortPlugin, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterPlugins != nil {
+		in, out := &in.FilterPlugins, &out.FilterPlugins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -49,6 +59,22 @@ func (in *ArbitrationArgs) DeepCopy() *ArbitrationArgs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArbitrationSortPlugin) DeepCopyInto(out *ArbitrationSortPlugin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArbitrationSortPlugin.
+func (in *ArbitrationSortPlugin) DeepCopy() *ArbitrationSortPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(ArbitrationSortPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeschedulerConfiguration) DeepCopyInto(out *DeschedulerConfiguration) {
 	*out = *in
@@ -138,6 +164,46 @@ func (in *DeschedulerProfile) DeepCopy() *DeschedulerProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticQuotaRevokeArgs) DeepCopyInto(out *ElasticQuotaRevokeArgs) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DelayRevokeTime != nil {
+		in, out := &in.DelayRevokeTime, &out.DelayRevokeTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.QuotaSelector != nil {
+		in, out := &in.QuotaSelector, &out.QuotaSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EvictableNamespaces != nil {
+		in, out := &in.EvictableNamespaces, &out.EvictableNamespaces
+		*out = new(Namespaces)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticQuotaRevokeArgs.
+func (in *ElasticQuotaRevokeArgs) DeepCopy() *ElasticQuotaRevokeArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticQuotaRevokeArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ElasticQuotaRevokeArgs) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Float64OrString) DeepCopyInto(out *Float64OrString) {
 	*out = *in
@@ -429,6 +495,11 @@ func (in *MigrationControllerArgs) DeepCopyInto(out *MigrationControllerArgs) {
 		*out = new(ArbitrationArgs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MigrationWindowArgs != nil {
+		in, out := &in.MigrationWindowArgs, &out.MigrationWindowArgs
+		*out = new(MigrationWindowArgs)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -472,6 +543,85 @@ func (in *MigrationObjectLimiter) DeepCopy() *MigrationObjectLimiter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationTimeWindow) DeepCopyInto(out *MigrationTimeWindow) {
+	*out = *in
+	if in.Weekdays != nil {
+		in, out := &in.Weekdays, &out.Weekdays
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationTimeWindow.
+func (in *MigrationTimeWindow) DeepCopy() *MigrationTimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationTimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationWindowArgs) DeepCopyInto(out *MigrationWindowArgs) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(MigrationWindows)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make(map[string]MigrationWindows, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationWindowArgs.
+func (in *MigrationWindowArgs) DeepCopy() *MigrationWindowArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationWindowArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationWindows) DeepCopyInto(out *MigrationWindows) {
+	*out = *in
+	if in.AllowedWindows != nil {
+		in, out := &in.AllowedWindows, &out.AllowedWindows
+		*out = make([]MigrationTimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BlockedWindows != nil {
+		in, out := &in.BlockedWindows, &out.BlockedWindows
+		*out = make([]MigrationTimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationWindows.
+func (in *MigrationWindows) DeepCopy() *MigrationWindows {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationWindows)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Namespaces) DeepCopyInto(out *Namespaces) {
 	*out = *in
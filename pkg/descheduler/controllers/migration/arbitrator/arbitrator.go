@@ -32,8 +32,9 @@ import (
 
 	"github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/controllers/migration/util"
+	evictionsutil "github.com/koordinator-sh/koordinator/pkg/descheduler/evictions"
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/framework"
-	"github.com/koordinator-sh/koordinator/pkg/descheduler/utils/sorter"
 )
 
 const (
@@ -65,6 +66,8 @@ type arbitratorImpl struct {
 	sorts  []SortFn
 	filter *filter
 
+	migrationWindowArgs *config.MigrationWindowArgs
+
 	client        client.Client
 	eventRecorder events.EventRecorder
 	mu            sync.Mutex
@@ -76,20 +79,28 @@ func New(args *config.MigrationControllerArgs, options Options) (Arbitrator, err
 	if err != nil {
 		return nil, err
 	}
+	pluginOptions := PluginOptions{
+		Options:                   options,
+		Args:                      args,
+		CheckJobPassedArbitration: f.checkJobPassedArbitration,
+	}
+	if err = f.initFilterPlugins(args.ArbitrationArgs, pluginOptions); err != nil {
+		return nil, err
+	}
+	sorts, err := newSortFns(args.ArbitrationArgs, pluginOptions)
+	if err != nil {
+		return nil, err
+	}
 
 	arbitrator := &arbitratorImpl{
-		waitingCollection: map[types.UID]*v1alpha1.PodMigrationJob{},
-		interval:          args.ArbitrationArgs.Interval.Duration,
-		sorts: []SortFn{
-			SortJobsByCreationTime(),
-			SortJobsByPod(sorter.PodSorter().Sort),
-			SortJobsByController(),
-			SortJobsByMigratingNum(options.Client),
-		},
-		filter:        f,
-		client:        options.Client,
-		eventRecorder: options.EventRecorder,
-		mu:            sync.Mutex{},
+		waitingCollection:   map[types.UID]*v1alpha1.PodMigrationJob{},
+		interval:            args.ArbitrationArgs.Interval.Duration,
+		sorts:               sorts,
+		filter:              f,
+		migrationWindowArgs: args.MigrationWindowArgs,
+		client:              options.Client,
+		eventRecorder:       options.EventRecorder,
+		mu:                  sync.Mutex{},
 	}
 
 	err = options.Manager.Add(arbitrator)
@@ -205,6 +216,10 @@ func (a *arbitratorImpl) doOnceArbitrate() {
 	// filter
 	for _, job := range jobs {
 		pod := podOfJob[job]
+		if !a.checkMigrationWindow(job, pod) {
+			// keep the job waiting in the waitingCollection until the migration window opens
+			continue
+		}
 		isFailed, isPassed := a.filtering(pod)
 		if isFailed {
 			a.updateFailedJob(job, pod)
@@ -216,6 +231,36 @@ func (a *arbitratorImpl) doOnceArbitrate() {
 	}
 }
 
+// checkMigrationWindow checks whether the migration window of the pod is open,
+// and records the reason on the PodMigrationJob if it is not.
+func (a *arbitratorImpl) checkMigrationWindow(job *v1alpha1.PodMigrationJob, pod *corev1.Pod) bool {
+	if pod == nil || evictionsutil.HaveEvictAnnotation(job) {
+		return true
+	}
+	windows, err := util.GetMigrationWindows(a.migrationWindowArgs, pod)
+	if err != nil || windows == nil {
+		return true
+	}
+	allowed, next, err := util.InMigrationWindow(windows, time.Now())
+	if err != nil || allowed {
+		return true
+	}
+	if !util.IsWaitingForMigrationWindow(job) {
+		cond := util.NewMigrationWindowCondition(false, next)
+		util.UpdateCondition(&job.Status, cond)
+		job.Status.Phase = v1alpha1.PodMigrationJobPending
+		job.Status.Reason = cond.Reason
+		job.Status.Message = cond.Message
+		if err := a.client.Status().Update(context.TODO(), job); err != nil {
+			klog.ErrorS(err, "failed to update job", "job", klog.KObj(job))
+		} else {
+			a.eventRecorder.Eventf(job, nil, corev1.EventTypeNormal, v1alpha1.PodMigrationJobReasonOutsideMigrationWindow, "Migrating", job.Status.Message)
+		}
+	}
+	klog.V(4).InfoS("PodMigrationJob is waiting for the migration window", "job", klog.KObj(job), "pod", klog.KObj(pod), "next", next)
+	return false
+}
+
 // copyJobs copy jobs from waitingCollection
 func (a *arbitratorImpl) copyJobs() []*v1alpha1.PodMigrationJob {
 	a.mu.Lock()
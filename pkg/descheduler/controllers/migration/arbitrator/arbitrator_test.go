@@ -39,6 +39,7 @@ import (
 
 	"github.com/koordinator-sh/koordinator/apis/extension"
 	"github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
 )
 
 func TestSingleSortFn(t *testing.T) {
@@ -307,6 +308,82 @@ func TestRequeueJobIfRetryablePodFilterFailed(t *testing.T) {
 	assert.Equal(t, "", job.Status.Reason)
 }
 
+func TestHoldJobOutsideMigrationWindow(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithStatusSubresource(&v1alpha1.PodMigrationJob{}).WithScheme(scheme).Build()
+
+	job := &v1alpha1.PodMigrationJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test",
+			CreationTimestamp: metav1.Time{Time: time.Now()},
+		},
+		Spec: v1alpha1.PodMigrationJobSpec{
+			PodRef: &corev1.ObjectReference{
+				Namespace: "default",
+				Name:      "test-pod",
+			},
+		},
+	}
+	assert.Nil(t, fakeClient.Create(context.TODO(), job))
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "test-pod",
+		},
+	}
+	assert.Nil(t, fakeClient.Create(context.TODO(), pod))
+	enter := false
+
+	a := &arbitratorImpl{
+		waitingCollection: map[types.UID]*v1alpha1.PodMigrationJob{job.UID: job},
+		sorts: []SortFn{func(jobs []*v1alpha1.PodMigrationJob, podOfJob map[*v1alpha1.PodMigrationJob]*corev1.Pod) []*v1alpha1.PodMigrationJob {
+			return jobs
+		}},
+		filter: &filter{
+			nonRetryablePodFilter: func(pod *corev1.Pod) bool {
+				enter = true
+				return true
+			},
+			retryablePodFilter: func(pod *corev1.Pod) bool {
+				enter = true
+				return true
+			},
+			arbitratedPodMigrationJobs: map[types.UID]bool{},
+		},
+		migrationWindowArgs: &config.MigrationWindowArgs{
+			Namespaces: map[string]config.MigrationWindows{
+				"default": {
+					BlockedWindows: []config.MigrationTimeWindow{{Start: "00:00", End: "00:00"}},
+				},
+			},
+		},
+		client:        fakeClient,
+		mu:            sync.Mutex{},
+		eventRecorder: &events.FakeRecorder{},
+		interval:      0,
+	}
+
+	a.doOnceArbitrate()
+
+	assert.False(t, enter)
+	assert.Equal(t, 1, len(a.waitingCollection))
+	assert.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Name: job.Name}, job))
+	assert.Equal(t, v1alpha1.PodMigrationJobPending, job.Status.Phase)
+	assert.Equal(t, v1alpha1.PodMigrationJobReasonOutsideMigrationWindow, job.Status.Reason)
+	assert.Equal(t, 0, len(job.Annotations))
+
+	// the migration window opens
+	a.migrationWindowArgs = nil
+	a.doOnceArbitrate()
+
+	assert.True(t, enter)
+	assert.Equal(t, 0, len(a.waitingCollection))
+	assert.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Name: job.Name}, job))
+	assert.Equal(t, "true", job.Annotations[AnnotationPassedArbitration])
+}
+
 func TestAbortJobIfNonRetryablePodFilterFailed(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = v1alpha1.AddToScheme(scheme)
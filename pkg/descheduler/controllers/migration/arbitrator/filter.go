@@ -152,6 +152,22 @@ func (f *filter) initFilters(args *deschedulerconfig.MigrationControllerArgs, ha
 	return nil
 }
 
+// initFilterPlugins appends the filter plugins configured in ArbitrationArgs to the retryable filters.
+func (f *filter) initFilterPlugins(args *deschedulerconfig.ArbitrationArgs, options PluginOptions) error {
+	pluginFilter, err := newFilterFunc(args, options)
+	if err != nil || pluginFilter == nil {
+		return err
+	}
+	retryablePodFilter := f.retryablePodFilter
+	f.retryablePodFilter = func(pod *corev1.Pod) bool {
+		if !retryablePodFilter(pod) {
+			return false
+		}
+		return evictionsutil.HaveEvictAnnotation(pod) || pluginFilter(pod)
+	}
+	return nil
+}
+
 func (f *filter) reservationFilter(pod *corev1.Pod) bool {
 	if sev1alpha1.PodMigrationJobMode(f.args.DefaultJobMode) != sev1alpha1.PodMigrationJobModeReservationFirst {
 		return true
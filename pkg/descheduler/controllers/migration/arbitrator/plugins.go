@@ -0,0 +1,332 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package arbitrator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	"github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/fieldindex"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/framework"
+	podutil "github.com/koordinator-sh/koordinator/pkg/descheduler/pod"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/utils/sorter"
+	utilclient "github.com/koordinator-sh/koordinator/pkg/util/client"
+)
+
+// Names of the built-in arbitration plugins.
+const (
+	SortPluginCreationTime   = "CreationTime"
+	SortPluginPod            = "Pod"
+	SortPluginController     = "Controller"
+	SortPluginMigratingNum   = "MigratingNum"
+	SortPluginMostLoadedNode = "MostLoadedNode"
+	SortPluginRestartCost    = "RestartCost"
+
+	FilterPluginSingleMigrationPerGang = "SingleMigrationPerGang"
+)
+
+// PluginOptions holds the parameters used to build the arbitration plugins.
+type PluginOptions struct {
+	Options
+	Args *config.MigrationControllerArgs
+	// CheckJobPassedArbitration checks whether the PodMigrationJob has passed arbitration.
+	CheckJobPassedArbitration func(uid types.UID) bool
+}
+
+// SortPluginFactory builds a SortFn used to order the PodMigrationJobs to be arbitrated.
+type SortPluginFactory func(options PluginOptions) (SortFn, error)
+
+// FilterPluginFactory builds a FilterFunc checked before a PodMigrationJob passes arbitration.
+// The PodMigrationJobs whose Pods are rejected keep waiting for the next arbitration.
+type FilterPluginFactory func(options PluginOptions) (framework.FilterFunc, error)
+
+var (
+	sortPluginRegistry = map[string]SortPluginFactory{
+		SortPluginCreationTime: func(options PluginOptions) (SortFn, error) {
+			return SortJobsByCreationTime(), nil
+		},
+		SortPluginPod: func(options PluginOptions) (SortFn, error) {
+			return SortJobsByPod(sorter.PodSorter().Sort), nil
+		},
+		SortPluginController: func(options PluginOptions) (SortFn, error) {
+			return SortJobsByController(), nil
+		},
+		SortPluginMigratingNum: func(options PluginOptions) (SortFn, error) {
+			return SortJobsByMigratingNum(options.Client), nil
+		},
+		SortPluginMostLoadedNode: newMostLoadedNodeSortFn,
+		SortPluginRestartCost: func(options PluginOptions) (SortFn, error) {
+			return SortJobsByRestartCost(), nil
+		},
+	}
+	filterPluginRegistry = map[string]FilterPluginFactory{
+		FilterPluginSingleMigrationPerGang: newSingleMigrationPerGangFilter,
+	}
+	defaultSortPlugins = []string{
+		SortPluginCreationTime,
+		SortPluginPod,
+		SortPluginController,
+		SortPluginMigratingNum,
+	}
+)
+
+// RegisterSortPlugin adds a new sort plugin that can be enabled in ArbitrationArgs.
+// It should be called before the arbitrator is created. If a plugin with the same name exists, it returns an error.
+func RegisterSortPlugin(name string, factory SortPluginFactory) error {
+	if _, ok := sortPluginRegistry[name]; ok {
+		return fmt.Errorf("a sort plugin named %v already exists", name)
+	}
+	sortPluginRegistry[name] = factory
+	return nil
+}
+
+// RegisterFilterPlugin adds a new filter plugin that can be enabled in ArbitrationArgs.
+// It should be called before the arbitrator is created. If a plugin with the same name exists, it returns an error.
+func RegisterFilterPlugin(name string, factory FilterPluginFactory) error {
+	if _, ok := filterPluginRegistry[name]; ok {
+		return fmt.Errorf("a filter plugin named %v already exists", name)
+	}
+	filterPluginRegistry[name] = factory
+	return nil
+}
+
+type weightedSortFn struct {
+	sortFn SortFn
+	weight int64
+}
+
+// newSortFns builds the SortFns according to the ArbitrationArgs.
+func newSortFns(args *config.ArbitrationArgs, options PluginOptions) ([]SortFn, error) {
+	if args == nil || len(args.SortPlugins) == 0 {
+		var sorts []SortFn
+		for _, name := range defaultSortPlugins {
+			sortFn, err := sortPluginRegistry[name](options)
+			if err != nil {
+				return nil, err
+			}
+			sorts = append(sorts, sortFn)
+		}
+		return sorts, nil
+	}
+
+	var plugins []weightedSortFn
+	for _, plugin := range args.SortPlugins {
+		factory, ok := sortPluginRegistry[plugin.Name]
+		if !ok {
+			return nil, fmt.Errorf("sort plugin %q does not exist", plugin.Name)
+		}
+		sortFn, err := factory(options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build sort plugin %q, err: %w", plugin.Name, err)
+		}
+		plugins = append(plugins, weightedSortFn{sortFn: sortFn, weight: int64(plugin.Weight)})
+	}
+	return []SortFn{SortJobsByCreationTime(), sortJobsByWeightedRanks(plugins)}, nil
+}
+
+// newFilterFunc builds the FilterFunc of the filter plugins according to the ArbitrationArgs.
+func newFilterFunc(args *config.ArbitrationArgs, options PluginOptions) (framework.FilterFunc, error) {
+	if args == nil || len(args.FilterPlugins) == 0 {
+		return nil, nil
+	}
+	var filters []framework.FilterFunc
+	for _, name := range args.FilterPlugins {
+		factory, ok := filterPluginRegistry[name]
+		if !ok {
+			return nil, fmt.Errorf("filter plugin %q does not exist", name)
+		}
+		filterFn, err := factory(options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build filter plugin %q, err: %w", name, err)
+		}
+		filters = append(filters, filterFn)
+	}
+	return podutil.WrapFilterFuncs(filters...), nil
+}
+
+// sortJobsByWeightedRanks returns a SortFn that stably sorts PodMigrationJobs by the weighted sum of the ranks given by the plugins.
+// A job ranked at position i of n jobs by a plugin gets (n-i)*weight points from the plugin.
+func sortJobsByWeightedRanks(plugins []weightedSortFn) SortFn {
+	return func(jobs []*v1alpha1.PodMigrationJob, podOfJob map[*v1alpha1.PodMigrationJob]*corev1.Pod) []*v1alpha1.PodMigrationJob {
+		scores := map[*v1alpha1.PodMigrationJob]int64{}
+		for _, plugin := range plugins {
+			ranked := make([]*v1alpha1.PodMigrationJob, len(jobs))
+			copy(ranked, jobs)
+			ranked = plugin.sortFn(ranked, podOfJob)
+			for i, job := range ranked {
+				scores[job] += int64(len(ranked)-i) * plugin.weight
+			}
+		}
+		sort.SliceStable(jobs, func(i, j int) bool {
+			return scores[jobs[i]] > scores[jobs[j]]
+		})
+		return jobs
+	}
+}
+
+// SortJobsByRestartCost returns a SortFn that stably sorts PodMigrationJobs by the restart cost of their Pods.
+// The Pods with lower eviction cost, shorter running time and more container restarts are cheaper to restart.
+func SortJobsByRestartCost() SortFn {
+	return func(jobs []*v1alpha1.PodMigrationJob, podOfJob map[*v1alpha1.PodMigrationJob]*corev1.Pod) []*v1alpha1.PodMigrationJob {
+		sort.SliceStable(jobs, func(i, j int) bool {
+			podI, podJ := podOfJob[jobs[i]], podOfJob[jobs[j]]
+			if podI == nil || podJ == nil {
+				return podI != nil
+			}
+			costI, _ := extension.GetEvictionCost(podI.Annotations)
+			costJ, _ := extension.GetEvictionCost(podJ.Annotations)
+			if costI != costJ {
+				return costI < costJ
+			}
+			startI, startJ := getPodStartTime(podI), getPodStartTime(podJ)
+			if !startI.Equal(startJ) {
+				return startI.After(startJ)
+			}
+			return getPodRestartCount(podI) > getPodRestartCount(podJ)
+		})
+		return jobs
+	}
+}
+
+func getPodStartTime(pod *corev1.Pod) time.Time {
+	if pod.Status.StartTime != nil {
+		return pod.Status.StartTime.Time
+	}
+	return pod.CreationTimestamp.Time
+}
+
+func getPodRestartCount(pod *corev1.Pod) int32 {
+	var count int32
+	for _, status := range pod.Status.ContainerStatuses {
+		count += status.RestartCount
+	}
+	return count
+}
+
+// SortJobsByNodeLoad returns a SortFn that stably sorts PodMigrationJobs by the load of the nodes their Pods are on.
+// The PodMigrationJobs freeing the most loaded nodes are placed first.
+func SortJobsByNodeLoad(getNodeLoad func(nodeName string) float64) SortFn {
+	return func(jobs []*v1alpha1.PodMigrationJob, podOfJob map[*v1alpha1.PodMigrationJob]*corev1.Pod) []*v1alpha1.PodMigrationJob {
+		loadOfNodes := map[string]float64{}
+		loadOfJobs := map[*v1alpha1.PodMigrationJob]float64{}
+		for _, job := range jobs {
+			pod := podOfJob[job]
+			if pod == nil || pod.Spec.NodeName == "" {
+				continue
+			}
+			load, ok := loadOfNodes[pod.Spec.NodeName]
+			if !ok {
+				load = getNodeLoad(pod.Spec.NodeName)
+				loadOfNodes[pod.Spec.NodeName] = load
+			}
+			loadOfJobs[job] = load
+		}
+		sort.SliceStable(jobs, func(i, j int) bool {
+			return loadOfJobs[jobs[i]] > loadOfJobs[jobs[j]]
+		})
+		return jobs
+	}
+}
+
+// newMostLoadedNodeSortFn reads the NodeMetrics with the client of the manager,
+// whose informers are shared with the descheduler and stopped along with it.
+func newMostLoadedNodeSortFn(options PluginOptions) (SortFn, error) {
+	c := options.Client
+	nodeLister := options.Handle.SharedInformerFactory().Core().V1().Nodes().Lister()
+	return SortJobsByNodeLoad(func(nodeName string) float64 {
+		node, err := nodeLister.Get(nodeName)
+		if err != nil {
+			return 0
+		}
+		nodeMetric := &slov1alpha1.NodeMetric{}
+		if err = c.Get(context.TODO(), types.NamespacedName{Name: nodeName}, nodeMetric); err != nil || nodeMetric.Status.NodeMetric == nil {
+			return 0
+		}
+		return getNodeLoad(node, nodeMetric.Status.NodeMetric.NodeUsage.ResourceList)
+	}), nil
+}
+
+// getNodeLoad returns the highest usage ratio of cpu and memory on the node.
+func getNodeLoad(node *corev1.Node, usage corev1.ResourceList) float64 {
+	var load float64
+	for _, resourceName := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		allocatable := node.Status.Allocatable[resourceName]
+		used := usage[resourceName]
+		if allocatable.IsZero() {
+			continue
+		}
+		if ratio := float64(used.MilliValue()) / float64(allocatable.MilliValue()); ratio > load {
+			load = ratio
+		}
+	}
+	return load
+}
+
+// newSingleMigrationPerGangFilter only looks up the PodMigrationJobs of the Pods in the same gang,
+// so the cost of a check does not grow with the number of PodMigrationJobs in the cluster.
+func newSingleMigrationPerGangFilter(options PluginOptions) (framework.FilterFunc, error) {
+	c := options.Client
+	return func(pod *corev1.Pod) bool {
+		gangName := extension.GetGangName(pod)
+		if gangName == "" {
+			return true
+		}
+		podList := &corev1.PodList{}
+		opts := &client.ListOptions{FieldSelector: fields.OneTermEqualSelector(fieldindex.IndexPodByGang, fmt.Sprintf("%s/%s", pod.Namespace, gangName))}
+		if err := c.List(context.TODO(), podList, opts, utilclient.DisableDeepCopy); err != nil {
+			klog.ErrorS(err, "failed to list Pods of the gang", "namespace", pod.Namespace, "gang", gangName)
+			return false
+		}
+		for i := range podList.Items {
+			memberPod := &podList.Items[i]
+			if memberPod.UID == pod.UID || memberPod.Name == pod.Name {
+				continue
+			}
+			jobList := &v1alpha1.PodMigrationJobList{}
+			opts := &client.ListOptions{FieldSelector: fields.OneTermEqualSelector(fieldindex.IndexJobByPodUID, string(memberPod.UID))}
+			if err := c.List(context.TODO(), jobList, opts, utilclient.DisableDeepCopy); err != nil {
+				klog.ErrorS(err, "failed to list PodMigrationJobs", "pod", klog.KObj(memberPod))
+				return false
+			}
+			for j := range jobList.Items {
+				job := &jobList.Items[j]
+				migrating := job.Status.Phase == v1alpha1.PodMigrationJobRunning ||
+					((job.Status.Phase == "" || job.Status.Phase == v1alpha1.PodMigrationJobPending) &&
+						(job.Annotations[AnnotationPassedArbitration] == "true" ||
+							(options.CheckJobPassedArbitration != nil && options.CheckJobPassedArbitration(job.UID))))
+				if migrating {
+					klog.V(4).InfoS("Pod fails the following checks", "pod", klog.KObj(pod), "checks", FilterPluginSingleMigrationPerGang,
+						"gang", gangName, "migratingPod", klog.KObj(memberPod))
+					return false
+				}
+			}
+		}
+		return true
+	}, nil
+}
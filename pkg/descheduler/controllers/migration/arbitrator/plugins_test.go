@@ -0,0 +1,384 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package arbitrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	"github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/fieldindex"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/framework"
+)
+
+func TestSortJobsByRestartCost(t *testing.T) {
+	now := time.Now()
+	withAnnotation := func(key, value string) podDecoratorFn {
+		return func(pod *corev1.Pod) {
+			pod.Annotations[key] = value
+		}
+	}
+	withRestartCount := func(count int32) podDecoratorFn {
+		return func(pod *corev1.Pod) {
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{{RestartCount: count}}
+		}
+	}
+	pods := []*corev1.Pod{
+		makePod("pod-0", 0, extension.QoSNone, corev1.PodQOSBurstable, now.Add(-time.Hour)),
+		makePod("pod-1", 0, extension.QoSNone, corev1.PodQOSBurstable, now.Add(-time.Hour), withAnnotation(extension.AnnotationEvictionCost, "100")),
+		makePod("pod-2", 0, extension.QoSNone, corev1.PodQOSBurstable, now.Add(-time.Minute)),
+		makePod("pod-3", 0, extension.QoSNone, corev1.PodQOSBurstable, now.Add(-time.Hour), withRestartCount(3)),
+		makePod("pod-4", 0, extension.QoSNone, corev1.PodQOSBurstable, now.Add(-time.Hour), withAnnotation(extension.AnnotationEvictionCost, "-100")),
+	}
+	var jobs []*v1alpha1.PodMigrationJob
+	podOfJob := map[*v1alpha1.PodMigrationJob]*corev1.Pod{}
+	for i, pod := range pods {
+		job := makePodMigrationJob("job-"+pod.Name, now, pod)
+		jobs = append(jobs, job)
+		podOfJob[jobs[i]] = pod
+	}
+	jobs = SortJobsByRestartCost()(jobs, podOfJob)
+
+	var got []string
+	for _, job := range jobs {
+		got = append(got, podOfJob[job].Name)
+	}
+	assert.Equal(t, []string{"pod-4", "pod-2", "pod-3", "pod-0", "pod-1"}, got)
+}
+
+func TestSortJobsByNodeLoad(t *testing.T) {
+	now := time.Now()
+	onNode := func(nodeName string) podDecoratorFn {
+		return func(pod *corev1.Pod) {
+			pod.Spec.NodeName = nodeName
+		}
+	}
+	pods := []*corev1.Pod{
+		makePod("pod-0", 0, extension.QoSNone, corev1.PodQOSBurstable, now, onNode("node-0")),
+		makePod("pod-1", 0, extension.QoSNone, corev1.PodQOSBurstable, now, onNode("node-1")),
+		makePod("pod-2", 0, extension.QoSNone, corev1.PodQOSBurstable, now, onNode("node-2")),
+		makePod("pod-3", 0, extension.QoSNone, corev1.PodQOSBurstable, now, onNode("node-1")),
+	}
+	var jobs []*v1alpha1.PodMigrationJob
+	podOfJob := map[*v1alpha1.PodMigrationJob]*corev1.Pod{}
+	for _, pod := range pods {
+		job := makePodMigrationJob("job-"+pod.Name, now, pod)
+		jobs = append(jobs, job)
+		podOfJob[job] = pod
+	}
+	loads := map[string]float64{"node-0": 0.3, "node-1": 0.9, "node-2": 0.6}
+	calls := map[string]int{}
+	jobs = SortJobsByNodeLoad(func(nodeName string) float64 {
+		calls[nodeName]++
+		return loads[nodeName]
+	})(jobs, podOfJob)
+
+	var got []string
+	for _, job := range jobs {
+		got = append(got, podOfJob[job].Name)
+	}
+	assert.Equal(t, []string{"pod-1", "pod-3", "pod-2", "pod-0"}, got)
+	assert.Equal(t, map[string]int{"node-0": 1, "node-1": 1, "node-2": 1}, calls)
+}
+
+type fakeHandle struct {
+	framework.Handle
+	informerFactory informers.SharedInformerFactory
+}
+
+func (h *fakeHandle) SharedInformerFactory() informers.SharedInformerFactory {
+	return h.informerFactory
+}
+
+func TestMostLoadedNodeSortFn(t *testing.T) {
+	now := time.Now()
+	var nodes []runtime.Object
+	for _, name := range []string{"node-0", "node-1"} {
+		nodes = append(nodes, &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10"),
+					corev1.ResourceMemory: resource.MustParse("10Gi"),
+				},
+			},
+		})
+	}
+	informerFactory := informers.NewSharedInformerFactory(kubefake.NewSimpleClientset(nodes...), 0)
+	informerFactory.Core().V1().Nodes().Informer()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+
+	scheme := runtime.NewScheme()
+	_ = slov1alpha1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&slov1alpha1.NodeMetric{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: slov1alpha1.NodeMetricStatus{
+			NodeMetric: &slov1alpha1.NodeMetricInfo{
+				NodeUsage: slov1alpha1.ResourceMap{
+					ResourceList: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("8"),
+					},
+				},
+			},
+		},
+	}).Build()
+
+	sortFn, err := newMostLoadedNodeSortFn(PluginOptions{
+		Options: Options{
+			Client: fakeClient,
+			Handle: &fakeHandle{informerFactory: informerFactory},
+		},
+	})
+	assert.NoError(t, err)
+
+	onNode := func(nodeName string) podDecoratorFn {
+		return func(pod *corev1.Pod) {
+			pod.Spec.NodeName = nodeName
+		}
+	}
+	pods := []*corev1.Pod{
+		makePod("pod-0", 0, extension.QoSNone, corev1.PodQOSBurstable, now, onNode("node-0")),
+		makePod("pod-1", 0, extension.QoSNone, corev1.PodQOSBurstable, now, onNode("node-1")),
+	}
+	var jobs []*v1alpha1.PodMigrationJob
+	podOfJob := map[*v1alpha1.PodMigrationJob]*corev1.Pod{}
+	for _, pod := range pods {
+		job := makePodMigrationJob("job-"+pod.Name, now, pod)
+		jobs = append(jobs, job)
+		podOfJob[job] = pod
+	}
+	jobs = sortFn(jobs, podOfJob)
+	assert.Equal(t, "pod-1", podOfJob[jobs[0]].Name)
+	assert.Equal(t, "pod-0", podOfJob[jobs[1]].Name)
+}
+
+func TestGetNodeLoad(t *testing.T) {
+	node := &corev1.Node{
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10"),
+				corev1.ResourceMemory: resource.MustParse("10Gi"),
+			},
+		},
+	}
+	usage := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("5Gi"),
+	}
+	assert.Equal(t, 0.5, getNodeLoad(node, usage))
+	assert.Equal(t, float64(0), getNodeLoad(&corev1.Node{}, usage))
+}
+
+func TestSortJobsByWeightedRanks(t *testing.T) {
+	jobs := []*v1alpha1.PodMigrationJob{
+		makePodMigrationJob("job-0", time.Now(), nil),
+		makePodMigrationJob("job-1", time.Now(), nil),
+		makePodMigrationJob("job-2", time.Now(), nil),
+	}
+	orderBy := func(names ...string) SortFn {
+		return func(jobs []*v1alpha1.PodMigrationJob, _ map[*v1alpha1.PodMigrationJob]*corev1.Pod) []*v1alpha1.PodMigrationJob {
+			ranks := map[string]int{}
+			for i, name := range names {
+				ranks[name] = i
+			}
+			result := make([]*v1alpha1.PodMigrationJob, len(jobs))
+			for _, job := range jobs {
+				result[ranks[job.Name]] = job
+			}
+			return result
+		}
+	}
+	tests := []struct {
+		name    string
+		plugins []weightedSortFn
+		want    []string
+	}{
+		{
+			name: "equal weights keep the original order of ties",
+			plugins: []weightedSortFn{
+				{sortFn: orderBy("job-2", "job-1", "job-0"), weight: 1},
+				{sortFn: orderBy("job-1", "job-2", "job-0"), weight: 1},
+			},
+			want: []string{"job-1", "job-2", "job-0"},
+		},
+		{
+			name: "heavier plugin wins",
+			plugins: []weightedSortFn{
+				{sortFn: orderBy("job-2", "job-1", "job-0"), weight: 1},
+				{sortFn: orderBy("job-0", "job-1", "job-2"), weight: 3},
+			},
+			want: []string{"job-0", "job-1", "job-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := make([]*v1alpha1.PodMigrationJob, len(jobs))
+			copy(input, jobs)
+			sorted := sortJobsByWeightedRanks(tt.plugins)(input, nil)
+			var got []string
+			for _, job := range sorted {
+				got = append(got, job.Name)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewSortFns(t *testing.T) {
+	sortFns, err := newSortFns(nil, PluginOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, sortFns, len(defaultSortPlugins))
+
+	sortFns, err = newSortFns(&config.ArbitrationArgs{
+		SortPlugins: []config.ArbitrationSortPlugin{
+			{Name: SortPluginRestartCost, Weight: 2},
+			{Name: SortPluginController, Weight: 1},
+		},
+	}, PluginOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, sortFns, 2)
+
+	_, err = newSortFns(&config.ArbitrationArgs{
+		SortPlugins: []config.ArbitrationSortPlugin{{Name: "NotExist", Weight: 1}},
+	}, PluginOptions{})
+	assert.Error(t, err)
+}
+
+func TestNewFilterFunc(t *testing.T) {
+	filterFn, err := newFilterFunc(nil, PluginOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, filterFn)
+
+	_, err = newFilterFunc(&config.ArbitrationArgs{FilterPlugins: []string{"NotExist"}}, PluginOptions{})
+	assert.Error(t, err)
+}
+
+func TestRegisterPlugins(t *testing.T) {
+	assert.Error(t, RegisterSortPlugin(SortPluginRestartCost, nil))
+	assert.Error(t, RegisterFilterPlugin(FilterPluginSingleMigrationPerGang, nil))
+
+	assert.NoError(t, RegisterSortPlugin("TestSortPlugin", func(options PluginOptions) (SortFn, error) {
+		return SortJobsByCreationTime(), nil
+	}))
+	defer delete(sortPluginRegistry, "TestSortPlugin")
+	sortFns, err := newSortFns(&config.ArbitrationArgs{
+		SortPlugins: []config.ArbitrationSortPlugin{{Name: "TestSortPlugin", Weight: 1}},
+	}, PluginOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, sortFns, 2)
+}
+
+func TestSingleMigrationPerGangFilter(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&v1alpha1.PodMigrationJob{}).
+		WithIndex(&v1alpha1.PodMigrationJob{}, fieldindex.IndexJobByPodUID, func(obj client.Object) []string {
+			pmj := obj.(*v1alpha1.PodMigrationJob)
+			return []string{string(pmj.Spec.PodRef.UID)}
+		}).
+		WithIndex(&corev1.Pod{}, fieldindex.IndexPodByGang, func(obj client.Object) []string {
+			pod := obj.(*corev1.Pod)
+			if gangName := extension.GetGangName(pod); gangName != "" {
+				return []string{pod.Namespace + "/" + gangName}
+			}
+			return nil
+		}).
+		Build()
+
+	inGang := func(gangName string) podDecoratorFn {
+		return func(pod *corev1.Pod) {
+			pod.Annotations[extension.AnnotationGangName] = gangName
+		}
+	}
+	now := time.Now()
+	migratingPod := makePod("pod-0", 0, extension.QoSNone, corev1.PodQOSBurstable, now, inGang("gang-a"))
+	pendingPod := makePod("pod-1", 0, extension.QoSNone, corev1.PodQOSBurstable, now, inGang("gang-b"))
+	for _, pod := range []*corev1.Pod{migratingPod, pendingPod} {
+		assert.NoError(t, fakeClient.Create(context.TODO(), pod))
+	}
+	runningJob := makePodMigrationJob("job-0", now, migratingPod)
+	runningJob.Status.Phase = v1alpha1.PodMigrationJobRunning
+	assert.NoError(t, fakeClient.Create(context.TODO(), runningJob))
+	pendingJob := makePodMigrationJob("job-1", now, pendingPod)
+	assert.NoError(t, fakeClient.Create(context.TODO(), pendingJob))
+
+	passedJobs := map[types.UID]bool{}
+	filterFn, err := newSingleMigrationPerGangFilter(PluginOptions{
+		Options: Options{Client: fakeClient},
+		CheckJobPassedArbitration: func(uid types.UID) bool {
+			return passedJobs[uid]
+		},
+	})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want bool
+	}{
+		{
+			name: "pod without gang",
+			pod:  makePod("pod-2", 0, extension.QoSNone, corev1.PodQOSBurstable, now),
+			want: true,
+		},
+		{
+			name: "another pod of the gang is migrating",
+			pod:  makePod("pod-3", 0, extension.QoSNone, corev1.PodQOSBurstable, now, inGang("gang-a")),
+			want: false,
+		},
+		{
+			name: "another pod of the gang has not passed arbitration",
+			pod:  makePod("pod-4", 0, extension.QoSNone, corev1.PodQOSBurstable, now, inGang("gang-b")),
+			want: true,
+		},
+		{
+			name: "the pod itself is migrating",
+			pod:  migratingPod,
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, filterFn(tt.pod))
+		})
+	}
+
+	passedJobs[pendingJob.UID] = true
+	assert.False(t, filterFn(makePod("pod-5", 0, extension.QoSNone, corev1.PodQOSBurstable, now, inGang("gang-b"))))
+}
@@ -30,10 +30,14 @@ var (
 )
 
 type JobContext struct {
+	// Name is the name of the PodMigrationJob. A random name is generated if it is empty.
+	Name        string
 	Labels      map[string]string
 	Annotations map[string]string
 	Timeout     *time.Duration
 	Mode        sev1alpha1.PodMigrationJobMode
+	// OwnerReferences are set to the PodMigrationJob, e.g. the NodeDrain which the job belongs to.
+	OwnerReferences []metav1.OwnerReference
 }
 
 func WithContext(ctx context.Context, jobCtx *JobContext) context.Context {
@@ -49,6 +53,9 @@ func (c *JobContext) ApplyTo(job *sev1alpha1.PodMigrationJob) error {
 	if c == nil {
 		return nil
 	}
+	if c.Name != "" {
+		job.Name = c.Name
+	}
 	if len(c.Labels) > 0 {
 		if job.Labels == nil {
 			job.Labels = make(map[string]string)
@@ -73,5 +80,8 @@ func (c *JobContext) ApplyTo(job *sev1alpha1.PodMigrationJob) error {
 	if c.Mode != "" {
 		job.Spec.Mode = c.Mode
 	}
+	if len(c.OwnerReferences) > 0 {
+		job.OwnerReferences = append(job.OwnerReferences, c.OwnerReferences...)
+	}
 	return nil
 }
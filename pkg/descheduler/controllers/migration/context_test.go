@@ -30,6 +30,7 @@ import (
 func TestJobContext(t *testing.T) {
 	timeout := 1 * time.Minute
 	expectJobCtx := &JobContext{
+		Name: "test-job",
 		Labels: map[string]string{
 			"test-labels": "123",
 		},
@@ -48,6 +49,7 @@ func TestJobContext(t *testing.T) {
 	assert.NoError(t, err)
 	expectJob := &sev1alpha1.PodMigrationJob{
 		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-job",
 			Labels: map[string]string{
 				"test-labels": "123",
 			},
@@ -57,12 +57,17 @@ import (
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/controllers/options"
 	evictionsutil "github.com/koordinator-sh/koordinator/pkg/descheduler/evictions"
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/framework"
+	"github.com/koordinator-sh/koordinator/pkg/features"
 	utilclient "github.com/koordinator-sh/koordinator/pkg/util/client"
+	utilfeature "github.com/koordinator-sh/koordinator/pkg/util/feature"
 )
 
 const (
 	Name                = names.MigrationController
 	defaultRequeueAfter = 3 * time.Second
+	// maxMigrationWindowRequeueAfter bounds the requeue interval of jobs waiting for the migration window,
+	// so that changes of the window annotations can be picked up in time.
+	maxMigrationWindowRequeueAfter = 5 * time.Minute
 )
 
 var (
@@ -139,6 +144,12 @@ func New(args runtime.Object, handle framework.Handle) (framework.Plugin, error)
 		return nil, err
 	}
 	r.reconcilerUID = UUIDGenerateFn()
+
+	if utilfeature.DefaultFeatureGate.Enabled(features.NodeDrain) {
+		if err = newNodeDrainController(r); err != nil {
+			return nil, err
+		}
+	}
 	return r, nil
 }
 
@@ -202,11 +213,14 @@ func (r *Reconciler) doScavenge() {
 	}
 	for i := range jobList.Items {
 		v := &jobList.Items[i]
+		if util.IsWaitingForMigrationWindow(v) {
+			continue
+		}
 		timeoutDuration := 30 * time.Minute
 		if v.Spec.TTL != nil && v.Spec.TTL.Duration > 0 {
 			timeoutDuration = v.Spec.TTL.Duration + 5*time.Minute
 		}
-		if r.clock.Since(v.CreationTimestamp.Time) < timeoutDuration {
+		if r.clock.Since(util.GetJobStartTime(v)) < timeoutDuration {
 			continue
 		}
 		if err := r.deleteReservation(context.TODO(), v); err != nil {
@@ -292,6 +306,12 @@ func (r *Reconciler) doMigrate(ctx context.Context, job *sev1alpha1.PodMigration
 		return reconcile.Result{}, nil
 	}
 
+	if job.Status.Phase == "" || job.Status.Phase == sev1alpha1.PodMigrationJobPending {
+		if result, waiting, err := r.requeueJobIfOutsideMigrationWindow(ctx, job); err != nil || waiting {
+			return result, err
+		}
+	}
+
 	timeout, err := r.abortJobIfTimeout(ctx, job)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -538,13 +558,68 @@ func (r *Reconciler) requeueJobIfObjectLimiterFailed(ctx context.Context, job *s
 	return r.checkPodExceedObjectLimiter(pod)
 }
 
+// requeueJobIfOutsideMigrationWindow keeps the pending job waiting until the migration window of the Pod opens.
+func (r *Reconciler) requeueJobIfOutsideMigrationWindow(ctx context.Context, job *sev1alpha1.PodMigrationJob) (reconcile.Result, bool, error) {
+	if evictionsutil.HaveEvictAnnotation(job) {
+		return reconcile.Result{}, false, nil
+	}
+	pod, err := r.getPodByJob(ctx, job)
+	if err != nil {
+		// let the following steps handle the missing Pod
+		return reconcile.Result{}, false, nil
+	}
+	windows, err := util.GetMigrationWindows(r.args.MigrationWindowArgs, pod)
+	if err != nil {
+		klog.Errorf("Failed to get migration windows of Pod %q, MigrationJob: %s, err: %v", klog.KObj(pod), job.Name, err)
+		return reconcile.Result{}, false, nil
+	}
+	if windows == nil {
+		return reconcile.Result{}, false, nil
+	}
+	allowed, next, err := util.InMigrationWindow(windows, r.clock.Now())
+	if err != nil {
+		klog.Errorf("Failed to check migration windows of Pod %q, MigrationJob: %s, err: %v", klog.KObj(pod), job.Name, err)
+		return reconcile.Result{}, false, nil
+	}
+
+	waiting := util.IsWaitingForMigrationWindow(job)
+	cond := util.NewMigrationWindowCondition(allowed, next)
+	if allowed {
+		if waiting {
+			if err = r.updateCondition(ctx, job, cond); err != nil {
+				return reconcile.Result{}, true, err
+			}
+			r.eventRecorder.Eventf(job, nil, corev1.EventTypeNormal, sev1alpha1.PodMigrationJobReasonInMigrationWindow, "Migrating", "Migration window is open")
+		}
+		return reconcile.Result{}, false, nil
+	}
+
+	klog.V(4).Infof("MigrationJob %s is waiting for the migration window of Pod %q to open at %v", job.Name, klog.KObj(pod), next)
+	if !waiting {
+		job.Status.Phase = sev1alpha1.PodMigrationJobPending
+		if err = r.updateCondition(ctx, job, cond); err != nil {
+			return reconcile.Result{}, true, err
+		}
+		r.eventRecorder.Eventf(job, nil, corev1.EventTypeNormal, sev1alpha1.PodMigrationJobReasonOutsideMigrationWindow, "Migrating", cond.Message)
+	}
+	if job.Annotations[arbitrator.AnnotationPassedArbitration] != "true" {
+		// the arbitrator holds the job until the window opens and enqueues it after arbitration
+		return reconcile.Result{}, true, nil
+	}
+	requeueAfter := maxMigrationWindowRequeueAfter
+	if !next.IsZero() && next.Sub(r.clock.Now()) < requeueAfter {
+		requeueAfter = next.Sub(r.clock.Now())
+	}
+	return reconcile.Result{RequeueAfter: requeueAfter}, true, nil
+}
+
 func (r *Reconciler) abortJobIfTimeout(ctx context.Context, job *sev1alpha1.PodMigrationJob) (bool, error) {
 	if job.Spec.TTL == nil || job.Spec.TTL.Duration == 0 {
 		return false, nil
 	}
 
 	timeout := job.Spec.TTL.Duration
-	elapsed := r.clock.Since(job.CreationTimestamp.Time)
+	elapsed := r.clock.Since(util.GetJobStartTime(job))
 	if elapsed < timeout {
 		return false, nil
 	}
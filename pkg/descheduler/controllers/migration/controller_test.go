@@ -44,6 +44,7 @@ import (
 	sev1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
 	deschedulerconfig "github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config/v1alpha2"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/controllers/migration/arbitrator"
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/controllers/migration/controllerfinder"
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/controllers/migration/reservation"
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/controllers/migration/util"
@@ -187,6 +188,69 @@ func TestAbortJobIfTimeout(t *testing.T) {
 	assert.Equal(t, sev1alpha1.PodMigrationJobReasonTimeout, job.Status.Reason)
 }
 
+func TestRequeueJobIfOutsideMigrationWindow(t *testing.T) {
+	reconciler := newTestReconciler()
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "test-pod",
+			Annotations: map[string]string{
+				util.AnnotationMigrationWindows: `{"blockedWindows":[{"start":"00:00","end":"00:00"}]}`,
+			},
+		},
+	}
+	assert.Nil(t, reconciler.Client.Create(context.TODO(), pod))
+	job := &sev1alpha1.PodMigrationJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test",
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-60 * time.Minute)},
+		},
+		Spec: sev1alpha1.PodMigrationJobSpec{
+			PodRef: &corev1.ObjectReference{
+				Namespace: "default",
+				Name:      "test-pod",
+			},
+			TTL: &metav1.Duration{Duration: 5 * time.Minute},
+		},
+	}
+	assert.Nil(t, reconciler.Client.Create(context.TODO(), job))
+
+	// the job has not passed arbitration, so the arbitrator will enqueue it later
+	result, waiting, err := reconciler.requeueJobIfOutsideMigrationWindow(context.TODO(), job)
+	assert.Nil(t, err)
+	assert.True(t, waiting)
+	assert.Equal(t, reconcile.Result{}, result)
+	assert.Equal(t, sev1alpha1.PodMigrationJobPending, job.Status.Phase)
+	assert.Equal(t, sev1alpha1.PodMigrationJobReasonOutsideMigrationWindow, job.Status.Reason)
+	assert.True(t, util.IsWaitingForMigrationWindow(job))
+
+	job.Annotations = map[string]string{arbitrator.AnnotationPassedArbitration: "true"}
+	result, waiting, err = reconciler.requeueJobIfOutsideMigrationWindow(context.TODO(), job)
+	assert.Nil(t, err)
+	assert.True(t, waiting)
+	assert.Equal(t, reconcile.Result{RequeueAfter: maxMigrationWindowRequeueAfter}, result)
+
+	// the job waiting for the migration window does not time out
+	result, err = reconciler.doMigrate(context.TODO(), job)
+	assert.Nil(t, err)
+	assert.Equal(t, reconcile.Result{RequeueAfter: maxMigrationWindowRequeueAfter}, result)
+	assert.Equal(t, sev1alpha1.PodMigrationJobPending, job.Status.Phase)
+
+	// the migration window opens
+	pod.Annotations[util.AnnotationMigrationWindows] = `{"allowedWindows":[{"start":"00:00","end":"00:00"}]}`
+	assert.Nil(t, reconciler.Client.Update(context.TODO(), pod))
+	result, waiting, err = reconciler.requeueJobIfOutsideMigrationWindow(context.TODO(), job)
+	assert.Nil(t, err)
+	assert.False(t, waiting)
+	assert.Equal(t, reconcile.Result{}, result)
+	assert.False(t, util.IsWaitingForMigrationWindow(job))
+	assert.Equal(t, sev1alpha1.PodMigrationJobReasonInMigrationWindow, job.Status.Reason)
+
+	timeout, err := reconciler.abortJobIfTimeout(context.TODO(), job)
+	assert.Nil(t, err)
+	assert.False(t, timeout)
+}
+
 func TestAbortJobByMissingPod(t *testing.T) {
 	reconciler := newTestReconciler()
 	job := &sev1alpha1.PodMigrationJob{
@@ -68,7 +68,6 @@ func CreatePodMigrationJob(ctx context.Context, pod *corev1.Pod, evictOptions fr
 			Annotations: map[string]string{
 				evictor.AnnotationEvictReason:  evictOptions.Reason,
 				evictor.AnnotationEvictTrigger: evictOptions.PluginName,
-				AnnotationJobCreatedBy:         string(reconcilerUID),
 			},
 		},
 		Spec: sev1alpha1.PodMigrationJobSpec{
@@ -86,6 +85,11 @@ func CreatePodMigrationJob(ctx context.Context, pod *corev1.Pod, evictOptions fr
 		},
 	}
 
+	// the job created without reconcilerUID is handled by any MigrationController, even after restarts
+	if reconcilerUID != "" {
+		job.Annotations[AnnotationJobCreatedBy] = string(reconcilerUID)
+	}
+
 	jobCtx := FromContext(ctx)
 	if err := jobCtx.ApplyTo(job); err != nil {
 		klog.Errorf("Failed to apply JobContext to PodMigrationJob for Pod %s/%s, err: %v", pod.Namespace, pod.Name, err)
@@ -0,0 +1,486 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	sev1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	deschedulerconfig "github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/controllers/migration/reservation"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/controllers/options"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/fieldindex"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/framework"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/utils"
+)
+
+const (
+	NodeDrainControllerName = "NodeDrainController"
+
+	// LabelNodeDrain is the name of the NodeDrain which created the PodMigrationJob.
+	LabelNodeDrain = "descheduler.koordinator.sh/node-drain"
+	// AnnotationCordonedByNodeDrain is the name of the NodeDrain which cordoned the node.
+	AnnotationCordonedByNodeDrain = "descheduler.koordinator.sh/cordoned-by-node-drain"
+
+	nodeDrainReasonAborted = "NodeDrainAborted"
+
+	// defaultNodeDrainRequeueAfter is the interval to resync the NodeDrain, since the Pods leaving the node are not watched.
+	defaultNodeDrainRequeueAfter = 10 * time.Second
+)
+
+// +kubebuilder:rbac:groups=scheduling.koordinator.sh,resources=nodedrains,verbs=get;list;watch
+// +kubebuilder:rbac:groups=scheduling.koordinator.sh,resources=nodedrains/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=scheduling.koordinator.sh,resources=nodedrains/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch;patch
+
+// nodeDrainReconciler cordons the node of the NodeDrain and creates PodMigrationJobs for the Pods on it.
+// The PodMigrationJobs are processed by the MigrationController like the ones created by descheduling plugins,
+// so the arbitration and the limits of the MigrationController apply as well.
+type nodeDrainReconciler struct {
+	client.Client
+	args                   *deschedulerconfig.MigrationControllerArgs
+	eventRecorder          events.EventRecorder
+	reservationInterpreter reservation.Interpreter
+	clock                  clock.Clock
+}
+
+func newNodeDrainController(r *Reconciler) error {
+	dr := &nodeDrainReconciler{
+		Client:                 r.Client,
+		args:                   r.args,
+		eventRecorder:          r.eventRecorder,
+		reservationInterpreter: r.reservationInterpreter,
+		clock:                  r.clock,
+	}
+	c, err := controller.New(NodeDrainControllerName, options.Manager, controller.Options{Reconciler: dr, MaxConcurrentReconciles: 1})
+	if err != nil {
+		return err
+	}
+	if err = c.Watch(source.Kind(options.Manager.GetCache(), &sev1alpha1.NodeDrain{}), &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
+	return c.Watch(source.Kind(options.Manager.GetCache(), &sev1alpha1.PodMigrationJob{}), handler.EnqueueRequestsFromMapFunc(
+		func(ctx context.Context, obj client.Object) []reconcile.Request {
+			name := obj.GetLabels()[LabelNodeDrain]
+			if name == "" {
+				return nil
+			}
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name}}}
+		}))
+}
+
+func (r *nodeDrainReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	drain := &sev1alpha1.NodeDrain{}
+	if err := r.Get(ctx, request.NamespacedName, drain); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		klog.Errorf("Failed to Get NodeDrain %v, err: %v", request.NamespacedName, err)
+		return reconcile.Result{}, err
+	}
+	if isNodeDrainFinished(drain) {
+		return reconcile.Result{}, nil
+	}
+
+	status := drain.Status.DeepCopy()
+	if status.StartTime == nil {
+		status.StartTime = &metav1.Time{Time: r.clock.Now()}
+	}
+
+	node := &corev1.Node{}
+	if err := r.Get(ctx, types.NamespacedName{Name: drain.Spec.NodeName}, node); err != nil {
+		if !errors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+		r.finish(status, sev1alpha1.NodeDrainFailed, fmt.Sprintf("node %q not found", drain.Spec.NodeName))
+		return reconcile.Result{}, r.updateStatus(ctx, drain, status)
+	}
+
+	jobs, err := r.listJobs(ctx, drain)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if drain.Spec.Abort {
+		if err := r.abort(ctx, drain, node, jobs); err != nil {
+			return reconcile.Result{}, err
+		}
+		r.finish(status, sev1alpha1.NodeDrainAborted, "NodeDrain is aborted")
+		return reconcile.Result{}, r.updateStatus(ctx, drain, status)
+	}
+
+	if err := r.cordon(ctx, drain, node); err != nil {
+		klog.Errorf("Failed to cordon node %s for NodeDrain %s, err: %v", node.Name, drain.Name, err)
+		return reconcile.Result{}, err
+	}
+
+	pods, err := r.listPods(ctx, node.Name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := r.syncPods(ctx, drain, pods, jobs, status); err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := r.updateStatus(ctx, drain, status); err != nil {
+		return reconcile.Result{}, err
+	}
+	if status.CompletionTime != nil {
+		return reconcile.Result{}, nil
+	}
+	return reconcile.Result{RequeueAfter: defaultNodeDrainRequeueAfter}, nil
+}
+
+func isNodeDrainFinished(drain *sev1alpha1.NodeDrain) bool {
+	switch drain.Status.Phase {
+	case sev1alpha1.NodeDrainSucceeded, sev1alpha1.NodeDrainFailed, sev1alpha1.NodeDrainAborted:
+		return true
+	}
+	return false
+}
+
+func isJobUnfinished(job *sev1alpha1.PodMigrationJob) bool {
+	switch job.Status.Phase {
+	case "", sev1alpha1.PodMigrationJobPending, sev1alpha1.PodMigrationJobRunning:
+		return true
+	}
+	return false
+}
+
+func (r *nodeDrainReconciler) finish(status *sev1alpha1.NodeDrainStatus, phase sev1alpha1.NodeDrainPhase, message string) {
+	status.Phase = phase
+	status.Message = message
+	status.CompletionTime = &metav1.Time{Time: r.clock.Now()}
+}
+
+// listJobs returns the PodMigrationJobs created by the NodeDrain indexed by the Pod UID.
+func (r *nodeDrainReconciler) listJobs(ctx context.Context, drain *sev1alpha1.NodeDrain) (map[types.UID]*sev1alpha1.PodMigrationJob, error) {
+	jobList := &sev1alpha1.PodMigrationJobList{}
+	if err := r.List(ctx, jobList, client.MatchingLabels{LabelNodeDrain: drain.Name}); err != nil {
+		klog.Errorf("Failed to list PodMigrationJobs of NodeDrain %s, err: %v", drain.Name, err)
+		return nil, err
+	}
+	jobs := make(map[types.UID]*sev1alpha1.PodMigrationJob, len(jobList.Items))
+	for i := range jobList.Items {
+		job := &jobList.Items[i]
+		if job.Spec.PodRef == nil {
+			continue
+		}
+		if existing := jobs[job.Spec.PodRef.UID]; existing != nil && !isJobUnfinished(job) {
+			continue
+		}
+		jobs[job.Spec.PodRef.UID] = job
+	}
+	return jobs, nil
+}
+
+// getActiveJob returns the unfinished PodMigrationJob of the Pod created by others, e.g. the descheduling plugins.
+func (r *nodeDrainReconciler) getActiveJob(ctx context.Context, pod *corev1.Pod) (*sev1alpha1.PodMigrationJob, error) {
+	jobList := &sev1alpha1.PodMigrationJobList{}
+	if err := r.List(ctx, jobList, client.MatchingFields{fieldindex.IndexJobByPodUID: string(pod.UID)}); err != nil {
+		return nil, err
+	}
+	for i := range jobList.Items {
+		if isJobUnfinished(&jobList.Items[i]) {
+			return &jobList.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// listPods returns the Pods to be migrated on the node. DaemonSet Pods and static Pods are ignored.
+func (r *nodeDrainReconciler) listPods(ctx context.Context, nodeName string) ([]*corev1.Pod, error) {
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.MatchingFields{fieldindex.IndexPodByNodeName: nodeName}); err != nil {
+		klog.Errorf("Failed to list Pods on node %s, err: %v", nodeName, err)
+		return nil, err
+	}
+	var pods []*corev1.Pod
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if utils.IsDaemonsetPod(pod.OwnerReferences) || utils.IsMirrorPod(pod) || utils.IsStaticPod(pod) {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+		return pods[i].Name < pods[j].Name
+	})
+	return pods, nil
+}
+
+func (r *nodeDrainReconciler) cordon(ctx context.Context, drain *sev1alpha1.NodeDrain, node *corev1.Node) error {
+	if node.Spec.Unschedulable {
+		return nil
+	}
+	newNode := node.DeepCopy()
+	newNode.Spec.Unschedulable = true
+	if newNode.Annotations == nil {
+		newNode.Annotations = map[string]string{}
+	}
+	newNode.Annotations[AnnotationCordonedByNodeDrain] = drain.Name
+	if err := r.Patch(ctx, newNode, client.MergeFrom(node)); err != nil {
+		return err
+	}
+	r.eventRecorder.Eventf(drain, nil, corev1.EventTypeNormal, "Cordoned", "Draining", "Node %s is cordoned", node.Name)
+	return nil
+}
+
+// uncordon uncordons the node only if it was cordoned by the NodeDrain.
+func (r *nodeDrainReconciler) uncordon(ctx context.Context, drain *sev1alpha1.NodeDrain, node *corev1.Node) error {
+	if node.Annotations[AnnotationCordonedByNodeDrain] != drain.Name {
+		return nil
+	}
+	newNode := node.DeepCopy()
+	newNode.Spec.Unschedulable = false
+	delete(newNode.Annotations, AnnotationCordonedByNodeDrain)
+	return r.Patch(ctx, newNode, client.MergeFrom(node))
+}
+
+func (r *nodeDrainReconciler) abort(ctx context.Context, drain *sev1alpha1.NodeDrain, node *corev1.Node, jobs map[types.UID]*sev1alpha1.PodMigrationJob) error {
+	for _, job := range jobs {
+		if !isJobUnfinished(job) {
+			continue
+		}
+		if r.reservationInterpreter != nil && job.Spec.ReservationOptions != nil && job.Spec.ReservationOptions.ReservationRef != nil {
+			if err := r.reservationInterpreter.DeleteReservation(ctx, job.Spec.ReservationOptions.ReservationRef); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+		}
+		job = job.DeepCopy()
+		job.Status.Phase = sev1alpha1.PodMigrationJobAborted
+		job.Status.Reason = nodeDrainReasonAborted
+		job.Status.Message = fmt.Sprintf("Abort job caused by NodeDrain %s aborted", drain.Name)
+		if err := r.Status().Update(ctx, job); err != nil {
+			klog.Errorf("Failed to abort PodMigrationJob %s of NodeDrain %s, err: %v", job.Name, drain.Name, err)
+			return err
+		}
+	}
+	if err := r.uncordon(ctx, drain, node); err != nil {
+		klog.Errorf("Failed to uncordon node %s for NodeDrain %s, err: %v", node.Name, drain.Name, err)
+		return err
+	}
+	return nil
+}
+
+// syncPods creates PodMigrationJobs for the Pods on the node and updates the status of the NodeDrain.
+// The status of the Pods left the node is derived from their PodMigrationJobs.
+func (r *nodeDrainReconciler) syncPods(ctx context.Context, drain *sev1alpha1.NodeDrain, pods []*corev1.Pod, jobs map[types.UID]*sev1alpha1.PodMigrationJob, status *sev1alpha1.NodeDrainStatus) error {
+	var podStatuses []sev1alpha1.NodeDrainPodStatus
+	var waiting []int
+	migrating, failed := 0, 0
+	onNode := sets.New[types.UID]()
+	for _, pod := range pods {
+		onNode.Insert(pod.UID)
+		podStatus := sev1alpha1.NodeDrainPodStatus{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			UID:       pod.UID,
+		}
+		job := jobs[pod.UID]
+		if job == nil || !isJobUnfinished(job) {
+			activeJob, err := r.getActiveJob(ctx, pod)
+			if err != nil {
+				return err
+			}
+			if activeJob != nil {
+				job = activeJob
+			}
+		}
+		switch {
+		case job != nil:
+			podStatus.JobName = job.Name
+			podStatus.Phase = job.Status.Phase
+			if podStatus.Phase == "" {
+				podStatus.Phase = sev1alpha1.PodMigrationJobPending
+			}
+			podStatus.Message = job.Status.Message
+			if podStatus.Phase == sev1alpha1.PodMigrationJobFailed || podStatus.Phase == sev1alpha1.PodMigrationJobAborted {
+				failed++
+			} else {
+				// the Pod of a succeeded job may be still terminating
+				migrating++
+			}
+		case pod.DeletionTimestamp != nil:
+			podStatus.Message = "Pod is terminating"
+			migrating++
+		default:
+			waiting = append(waiting, len(podStatuses))
+		}
+		podStatuses = append(podStatuses, podStatus)
+	}
+
+	created := 0
+	if !drain.Spec.Paused {
+		for _, i := range waiting {
+			if drain.Spec.MaxMigrating != nil && migrating >= int(*drain.Spec.MaxMigrating) {
+				break
+			}
+			podStatus := &podStatuses[i]
+			jobName, err := r.createJob(ctx, drain, pods[i])
+			if err != nil {
+				podStatus.Message = fmt.Sprintf("Failed to create PodMigrationJob, err: %v", err)
+				continue
+			}
+			podStatus.JobName = jobName
+			podStatus.Phase = sev1alpha1.PodMigrationJobPending
+			created++
+			migrating++
+		}
+	}
+
+	succeeded := 0
+	for _, podStatus := range status.Pods {
+		if onNode.Has(podStatus.UID) {
+			continue
+		}
+		if err := r.syncLeftPodStatus(ctx, &podStatus); err != nil {
+			return err
+		}
+		switch podStatus.Phase {
+		case sev1alpha1.PodMigrationJobSucceeded:
+			succeeded++
+		case sev1alpha1.PodMigrationJobFailed, sev1alpha1.PodMigrationJobAborted:
+			failed++
+		default:
+			migrating++
+		}
+		podStatuses = append(podStatuses, podStatus)
+	}
+
+	status.Pods = podStatuses
+	status.TotalPods = int32(len(podStatuses))
+	status.WaitingPods = int32(len(waiting) - created)
+	status.MigratingPods = int32(migrating)
+	status.SucceededPods = int32(succeeded)
+	status.FailedPods = int32(failed)
+
+	switch {
+	case status.WaitingPods == 0 && status.MigratingPods == 0 && status.FailedPods > 0:
+		r.finish(status, sev1alpha1.NodeDrainFailed, fmt.Sprintf("%d Pods failed to migrate", status.FailedPods))
+		r.eventRecorder.Eventf(drain, nil, corev1.EventTypeWarning, string(status.Phase), "Draining", status.Message)
+	case status.WaitingPods == 0 && status.MigratingPods == 0:
+		r.finish(status, sev1alpha1.NodeDrainSucceeded, fmt.Sprintf("All Pods on node %s are migrated", drain.Spec.NodeName))
+		r.eventRecorder.Eventf(drain, nil, corev1.EventTypeNormal, string(status.Phase), "Draining", status.Message)
+	case drain.Spec.Paused:
+		status.Phase = sev1alpha1.NodeDrainPaused
+		status.Message = "NodeDrain is paused"
+	default:
+		status.Phase = sev1alpha1.NodeDrainRunning
+		status.Message = ""
+	}
+	return nil
+}
+
+// syncLeftPodStatus updates the status of the Pod which has left the node according to its PodMigrationJob.
+// The Pod left without a PodMigrationJob, e.g. deleted by the user, is considered migrated since the node has been drained of it.
+func (r *nodeDrainReconciler) syncLeftPodStatus(ctx context.Context, podStatus *sev1alpha1.NodeDrainPodStatus) error {
+	if podStatus.JobName == "" {
+		podStatus.Phase = sev1alpha1.PodMigrationJobSucceeded
+		podStatus.Message = "Pod left the node without PodMigrationJob"
+		return nil
+	}
+	job := &sev1alpha1.PodMigrationJob{}
+	if err := r.Get(ctx, types.NamespacedName{Name: podStatus.JobName}, job); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		// keep the last observed result if the finished job has been cleaned up
+		if podStatus.Phase != sev1alpha1.PodMigrationJobSucceeded && podStatus.Phase != sev1alpha1.PodMigrationJobFailed &&
+			podStatus.Phase != sev1alpha1.PodMigrationJobAborted {
+			podStatus.Phase = sev1alpha1.PodMigrationJobFailed
+			podStatus.Message = fmt.Sprintf("PodMigrationJob %s is not found", podStatus.JobName)
+		}
+		return nil
+	}
+	podStatus.Phase = job.Status.Phase
+	if podStatus.Phase == "" {
+		podStatus.Phase = sev1alpha1.PodMigrationJobPending
+	}
+	podStatus.Message = job.Status.Message
+	return nil
+}
+
+func (r *nodeDrainReconciler) createJob(ctx context.Context, drain *sev1alpha1.NodeDrain, pod *corev1.Pod) (string, error) {
+	jobCtx := &JobContext{
+		Name:   nodeDrainJobName(drain, pod),
+		Labels: map[string]string{LabelNodeDrain: drain.Name},
+		Mode:   drain.Spec.Mode,
+		OwnerReferences: []metav1.OwnerReference{
+			*metav1.NewControllerRef(drain, sev1alpha1.SchemeGroupVersion.WithKind("NodeDrain")),
+		},
+	}
+	if jobCtx.Mode == "" {
+		jobCtx.Mode = sev1alpha1.PodMigrationJobModeReservationFirst
+	}
+	if drain.Spec.TTL != nil {
+		jobCtx.Timeout = &drain.Spec.TTL.Duration
+	}
+	evictOptions := framework.EvictOptions{
+		PluginName: NodeDrainControllerName,
+		Reason:     fmt.Sprintf("node %s is drained by NodeDrain %s", drain.Spec.NodeName, drain.Name),
+	}
+	// The job is owned by the NodeDrain instead of stamped with the UID of the current MigrationController,
+	// so that it is still processed after koord-descheduler restarts.
+	err := CreatePodMigrationJob(WithContext(ctx, jobCtx), pod, evictOptions, r.Client, r.args, "")
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return "", err
+	}
+	return jobCtx.Name, nil
+}
+
+// nodeDrainJobName generates a stable name for the PodMigrationJob of the Pod,
+// so that the job will not be created twice even if the cache is not synced.
+func nodeDrainJobName(drain *sev1alpha1.NodeDrain, pod *corev1.Pod) string {
+	prefix := drain.Name
+	if maxLen := 253 - len(pod.UID) - 1; len(prefix) > maxLen {
+		prefix = prefix[:maxLen]
+	}
+	return fmt.Sprintf("%s-%s", prefix, pod.UID)
+}
+
+func (r *nodeDrainReconciler) updateStatus(ctx context.Context, drain *sev1alpha1.NodeDrain, status *sev1alpha1.NodeDrainStatus) error {
+	newDrain := drain.DeepCopy()
+	newDrain.Status = *status
+	if err := r.Status().Update(ctx, newDrain); err != nil {
+		klog.Errorf("Failed to update status of NodeDrain %s, err: %v", drain.Name, err)
+		return err
+	}
+	return nil
+}
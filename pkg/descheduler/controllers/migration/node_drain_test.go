@@ -0,0 +1,303 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	sev1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	deschedulerconfig "github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config/v1alpha2"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/fieldindex"
+)
+
+func newTestNodeDrainReconciler(objs ...client.Object) *nodeDrainReconciler {
+	scheme := runtime.NewScheme()
+	_ = sev1alpha1.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	var v1beta2args v1alpha2.MigrationControllerArgs
+	v1alpha2.SetDefaults_MigrationControllerArgs(&v1beta2args)
+	var args deschedulerconfig.MigrationControllerArgs
+	if err := v1alpha2.Convert_v1alpha2_MigrationControllerArgs_To_config_MigrationControllerArgs(&v1beta2args, &args, nil); err != nil {
+		panic(err)
+	}
+
+	runtimeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithStatusSubresource(&sev1alpha1.PodMigrationJob{}, &sev1alpha1.NodeDrain{}).
+		WithIndex(&corev1.Pod{}, fieldindex.IndexPodByNodeName, func(obj client.Object) []string {
+			return []string{obj.(*corev1.Pod).Spec.NodeName}
+		}).
+		WithIndex(&sev1alpha1.PodMigrationJob{}, fieldindex.IndexJobByPodUID, func(obj client.Object) []string {
+			job := obj.(*sev1alpha1.PodMigrationJob)
+			if job.Spec.PodRef == nil {
+				return nil
+			}
+			return []string{string(job.Spec.PodRef.UID)}
+		}).
+		WithObjects(objs...).Build()
+	recorder := record.NewBroadcaster().NewRecorder(scheme, corev1.EventSource{Component: NodeDrainControllerName})
+	return &nodeDrainReconciler{
+		Client:        runtimeClient,
+		args:          &args,
+		eventRecorder: record.NewEventRecorderAdapter(recorder),
+		clock:         clock.RealClock{},
+	}
+}
+
+func newTestDrainPod(name, nodeName string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			UID:       types.UID(name + "-uid"),
+		},
+		Spec: corev1.PodSpec{NodeName: nodeName},
+	}
+}
+
+func reconcileNodeDrain(t *testing.T, r *nodeDrainReconciler, name string) *sev1alpha1.NodeDrain {
+	_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+	assert.NoError(t, err)
+	drain := &sev1alpha1.NodeDrain{}
+	assert.NoError(t, r.Get(context.TODO(), types.NamespacedName{Name: name}, drain))
+	return drain
+}
+
+func TestNodeDrain(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	drain := &sev1alpha1.NodeDrain{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain-1"},
+		Spec: sev1alpha1.NodeDrainSpec{
+			NodeName:     "node-1",
+			MaxMigrating: pointer.Int32(1),
+		},
+	}
+	daemonSetPod := newTestDrainPod("ds-pod", "node-1")
+	daemonSetPod.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "ds", UID: "ds-uid", Controller: pointer.Bool(true)}}
+	pod1 := newTestDrainPod("pod-1", "node-1")
+	pod2 := newTestDrainPod("pod-2", "node-1")
+	otherPod := newTestDrainPod("pod-3", "node-2")
+	r := newTestNodeDrainReconciler(node, drain, daemonSetPod, pod1, pod2, otherPod)
+
+	got := reconcileNodeDrain(t, r, drain.Name)
+	assert.Equal(t, sev1alpha1.NodeDrainRunning, got.Status.Phase)
+	assert.NotNil(t, got.Status.StartTime)
+	assert.Equal(t, int32(2), got.Status.TotalPods)
+	assert.Equal(t, int32(1), got.Status.WaitingPods)
+	assert.Equal(t, int32(1), got.Status.MigratingPods)
+
+	gotNode := &corev1.Node{}
+	assert.NoError(t, r.Get(context.TODO(), types.NamespacedName{Name: node.Name}, gotNode))
+	assert.True(t, gotNode.Spec.Unschedulable)
+	assert.Equal(t, drain.Name, gotNode.Annotations[AnnotationCordonedByNodeDrain])
+
+	jobList := &sev1alpha1.PodMigrationJobList{}
+	assert.NoError(t, r.List(context.TODO(), jobList))
+	assert.Len(t, jobList.Items, 1)
+	job := &jobList.Items[0]
+	assert.Equal(t, pod1.UID, job.Spec.PodRef.UID)
+	assert.Equal(t, drain.Name, job.Labels[LabelNodeDrain])
+	assert.Equal(t, sev1alpha1.PodMigrationJobModeReservationFirst, job.Spec.Mode)
+	assert.Equal(t, nodeDrainJobName(drain, pod1), job.Name)
+	assert.NotContains(t, job.Annotations, AnnotationJobCreatedBy)
+	assert.Len(t, job.OwnerReferences, 1)
+	assert.Equal(t, "NodeDrain", job.OwnerReferences[0].Kind)
+	assert.Equal(t, drain.Name, job.OwnerReferences[0].Name)
+
+	// no more jobs are created until the previous one finished
+	got = reconcileNodeDrain(t, r, drain.Name)
+	assert.NoError(t, r.List(context.TODO(), jobList))
+	assert.Len(t, jobList.Items, 1)
+
+	// pod-1 is migrated, and the job of pod-2 failed
+	job.Status.Phase = sev1alpha1.PodMigrationJobSucceeded
+	assert.NoError(t, r.Status().Update(context.TODO(), job))
+	assert.NoError(t, r.Delete(context.TODO(), pod1))
+	got = reconcileNodeDrain(t, r, drain.Name)
+	assert.Equal(t, int32(1), got.Status.SucceededPods)
+	assert.Equal(t, int32(1), got.Status.MigratingPods)
+	assert.NoError(t, r.List(context.TODO(), jobList))
+	assert.Len(t, jobList.Items, 2)
+
+	job = &sev1alpha1.PodMigrationJob{}
+	assert.NoError(t, r.Get(context.TODO(), types.NamespacedName{Name: nodeDrainJobName(drain, pod2)}, job))
+	job.Status.Phase = sev1alpha1.PodMigrationJobFailed
+	job.Status.Message = "failed to evict"
+	assert.NoError(t, r.Status().Update(context.TODO(), job))
+	got = reconcileNodeDrain(t, r, drain.Name)
+	assert.Equal(t, sev1alpha1.NodeDrainFailed, got.Status.Phase)
+	assert.NotNil(t, got.Status.CompletionTime)
+	assert.Equal(t, int32(2), got.Status.TotalPods)
+	assert.Equal(t, int32(1), got.Status.SucceededPods)
+	assert.Equal(t, int32(1), got.Status.FailedPods)
+	for _, podStatus := range got.Status.Pods {
+		if podStatus.Name == pod2.Name {
+			assert.Equal(t, "failed to evict", podStatus.Message)
+		}
+	}
+}
+
+func TestNodeDrainPausedAndSucceeded(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}, Spec: corev1.NodeSpec{Unschedulable: true}}
+	drain := &sev1alpha1.NodeDrain{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain-1"},
+		Spec:       sev1alpha1.NodeDrainSpec{NodeName: "node-1", Paused: true},
+	}
+	pod := newTestDrainPod("pod-1", "node-1")
+	r := newTestNodeDrainReconciler(node, drain, pod)
+
+	got := reconcileNodeDrain(t, r, drain.Name)
+	assert.Equal(t, sev1alpha1.NodeDrainPaused, got.Status.Phase)
+	assert.Equal(t, int32(1), got.Status.WaitingPods)
+	jobList := &sev1alpha1.PodMigrationJobList{}
+	assert.NoError(t, r.List(context.TODO(), jobList))
+	assert.Empty(t, jobList.Items)
+	// the node cordoned by others is not annotated
+	gotNode := &corev1.Node{}
+	assert.NoError(t, r.Get(context.TODO(), types.NamespacedName{Name: node.Name}, gotNode))
+	assert.Empty(t, gotNode.Annotations[AnnotationCordonedByNodeDrain])
+
+	assert.NoError(t, r.Delete(context.TODO(), pod))
+	got = reconcileNodeDrain(t, r, drain.Name)
+	assert.Equal(t, sev1alpha1.NodeDrainSucceeded, got.Status.Phase)
+	assert.Equal(t, int32(1), got.Status.SucceededPods)
+}
+
+func TestNodeDrainPodLeftWithUnsucceededJob(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	drain := &sev1alpha1.NodeDrain{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain-1"},
+		Spec:       sev1alpha1.NodeDrainSpec{NodeName: "node-1"},
+	}
+	pod1 := newTestDrainPod("pod-1", "node-1")
+	pod2 := newTestDrainPod("pod-2", "node-1")
+	r := newTestNodeDrainReconciler(node, drain, pod1, pod2)
+
+	got := reconcileNodeDrain(t, r, drain.Name)
+	assert.Equal(t, int32(2), got.Status.MigratingPods)
+
+	// pod-1 is deleted while its job is still running, and the job of pod-2 is aborted after pod-2 is deleted
+	job := &sev1alpha1.PodMigrationJob{}
+	assert.NoError(t, r.Get(context.TODO(), types.NamespacedName{Name: nodeDrainJobName(drain, pod2)}, job))
+	job.Status.Phase = sev1alpha1.PodMigrationJobAborted
+	job.Status.Message = "aborted"
+	assert.NoError(t, r.Status().Update(context.TODO(), job))
+	assert.NoError(t, r.Delete(context.TODO(), pod1))
+	assert.NoError(t, r.Delete(context.TODO(), pod2))
+	got = reconcileNodeDrain(t, r, drain.Name)
+	assert.Equal(t, sev1alpha1.NodeDrainRunning, got.Status.Phase)
+	assert.Equal(t, int32(0), got.Status.SucceededPods)
+	assert.Equal(t, int32(1), got.Status.MigratingPods)
+	assert.Equal(t, int32(1), got.Status.FailedPods)
+
+	// the job of pod-1 is cleaned up before finished
+	assert.NoError(t, r.Delete(context.TODO(), &sev1alpha1.PodMigrationJob{ObjectMeta: metav1.ObjectMeta{Name: nodeDrainJobName(drain, pod1)}}))
+	got = reconcileNodeDrain(t, r, drain.Name)
+	assert.Equal(t, sev1alpha1.NodeDrainFailed, got.Status.Phase)
+	assert.Equal(t, int32(0), got.Status.SucceededPods)
+	assert.Equal(t, int32(2), got.Status.FailedPods)
+}
+
+func TestNodeDrainReuseActiveJob(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	drain := &sev1alpha1.NodeDrain{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain-1"},
+		Spec:       sev1alpha1.NodeDrainSpec{NodeName: "node-1"},
+	}
+	pod := newTestDrainPod("pod-1", "node-1")
+	job := &sev1alpha1.PodMigrationJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "created-by-plugin"},
+		Spec: sev1alpha1.PodMigrationJobSpec{
+			PodRef: &corev1.ObjectReference{Namespace: pod.Namespace, Name: pod.Name, UID: pod.UID},
+		},
+		Status: sev1alpha1.PodMigrationJobStatus{Phase: sev1alpha1.PodMigrationJobRunning},
+	}
+	r := newTestNodeDrainReconciler(node, drain, pod, job)
+
+	got := reconcileNodeDrain(t, r, drain.Name)
+	assert.Equal(t, int32(1), got.Status.MigratingPods)
+	assert.Equal(t, "created-by-plugin", got.Status.Pods[0].JobName)
+	jobList := &sev1alpha1.PodMigrationJobList{}
+	assert.NoError(t, r.List(context.TODO(), jobList))
+	assert.Len(t, jobList.Items, 1)
+}
+
+func TestNodeDrainAbort(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	drain := &sev1alpha1.NodeDrain{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain-1"},
+		Spec:       sev1alpha1.NodeDrainSpec{NodeName: "node-1"},
+	}
+	pod := newTestDrainPod("pod-1", "node-1")
+	r := newTestNodeDrainReconciler(node, drain, pod)
+
+	got := reconcileNodeDrain(t, r, drain.Name)
+	assert.Equal(t, int32(1), got.Status.MigratingPods)
+
+	got.Spec.Abort = true
+	assert.NoError(t, r.Update(context.TODO(), got))
+	got = reconcileNodeDrain(t, r, drain.Name)
+	assert.Equal(t, sev1alpha1.NodeDrainAborted, got.Status.Phase)
+	assert.NotNil(t, got.Status.CompletionTime)
+
+	job := &sev1alpha1.PodMigrationJob{}
+	assert.NoError(t, r.Get(context.TODO(), types.NamespacedName{Name: nodeDrainJobName(drain, pod)}, job))
+	assert.Equal(t, sev1alpha1.PodMigrationJobAborted, job.Status.Phase)
+	assert.Equal(t, nodeDrainReasonAborted, job.Status.Reason)
+
+	gotNode := &corev1.Node{}
+	assert.NoError(t, r.Get(context.TODO(), types.NamespacedName{Name: node.Name}, gotNode))
+	assert.False(t, gotNode.Spec.Unschedulable)
+	assert.Empty(t, gotNode.Annotations[AnnotationCordonedByNodeDrain])
+}
+
+func TestNodeDrainMissingNode(t *testing.T) {
+	drain := &sev1alpha1.NodeDrain{
+		ObjectMeta: metav1.ObjectMeta{Name: "drain-1"},
+		Spec:       sev1alpha1.NodeDrainSpec{NodeName: "node-1"},
+	}
+	r := newTestNodeDrainReconciler(drain)
+	got := reconcileNodeDrain(t, r, drain.Name)
+	assert.Equal(t, sev1alpha1.NodeDrainFailed, got.Status.Phase)
+}
+
+func TestNodeDrainJobName(t *testing.T) {
+	pod := newTestDrainPod("pod-1", "node-1")
+	drain := &sev1alpha1.NodeDrain{ObjectMeta: metav1.ObjectMeta{Name: "drain-1"}}
+	assert.Equal(t, "drain-1-pod-1-uid", nodeDrainJobName(drain, pod))
+
+	drain.Name = strings.Repeat("a", 300)
+	name := nodeDrainJobName(drain, pod)
+	assert.Len(t, name, 253)
+	assert.True(t, strings.HasSuffix(name, "-pod-1-uid"))
+}
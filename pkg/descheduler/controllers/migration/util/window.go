@@ -0,0 +1,234 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	sev1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	deschedulerconfig "github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
+)
+
+const (
+	// AnnotationMigrationWindows overrides the migration windows of the Pod, and is usually set in the pod template of the workload.
+	// The value is a JSON object in the format of MigrationWindows, e.g.
+	// {"timeZone":"Asia/Shanghai","allowedWindows":[{"start":"01:00","end":"05:00"}]}
+	AnnotationMigrationWindows = "descheduler.koordinator.sh/migration-windows"
+
+	migrationWindowTimeLayout = "15:04"
+)
+
+// GetMigrationWindows returns the migration windows of the Pod. It returns nil if no windows are configured.
+func GetMigrationWindows(args *deschedulerconfig.MigrationWindowArgs, pod *corev1.Pod) (*deschedulerconfig.MigrationWindows, error) {
+	if pod != nil {
+		if data, ok := pod.Annotations[AnnotationMigrationWindows]; ok {
+			windows := &deschedulerconfig.MigrationWindows{}
+			if err := json.Unmarshal([]byte(data), windows); err != nil {
+				return nil, fmt.Errorf("invalid annotation %s, err: %v", AnnotationMigrationWindows, err)
+			}
+			return windows, nil
+		}
+	}
+	if args == nil {
+		return nil, nil
+	}
+	if pod != nil {
+		if windows, ok := args.Namespaces[pod.Namespace]; ok {
+			return &windows, nil
+		}
+	}
+	return args.Default, nil
+}
+
+// InMigrationWindow checks whether migration is allowed at the time now,
+// and returns the next time the result changes. The zero next time means the result never changes.
+func InMigrationWindow(windows *deschedulerconfig.MigrationWindows, now time.Time) (allowed bool, next time.Time, err error) {
+	if windows == nil || (len(windows.AllowedWindows) == 0 && len(windows.BlockedWindows) == 0) {
+		return true, time.Time{}, nil
+	}
+	loc := time.UTC
+	if windows.TimeZone != "" {
+		loc, err = time.LoadLocation(windows.TimeZone)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+	}
+	allowedWindows, err := parseTimeWindows(windows.AllowedWindows)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	blockedWindows, err := parseTimeWindows(windows.BlockedWindows)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	isAllowed := func(t time.Time) bool {
+		for _, w := range blockedWindows {
+			if w.contains(t) {
+				return false
+			}
+		}
+		if len(allowedWindows) == 0 {
+			return true
+		}
+		for _, w := range allowedWindows {
+			if w.contains(t) {
+				return true
+			}
+		}
+		return false
+	}
+
+	now = now.In(loc)
+	allowed = isAllowed(now)
+
+	// the result can only change at the boundaries of the windows, so checking the boundaries in the following week is enough.
+	var boundaries []time.Time
+	allWindows := make([]timeWindow, 0, len(allowedWindows)+len(blockedWindows))
+	allWindows = append(allWindows, allowedWindows...)
+	allWindows = append(allWindows, blockedWindows...)
+	for _, w := range allWindows {
+		for day := 0; day <= 8; day++ {
+			for _, minutes := range []int{w.start, w.end} {
+				t := time.Date(now.Year(), now.Month(), now.Day()+day, minutes/60, minutes%60, 0, 0, loc)
+				if t.After(now) {
+					boundaries = append(boundaries, t)
+				}
+			}
+		}
+	}
+	sort.Slice(boundaries, func(i, j int) bool {
+		return boundaries[i].Before(boundaries[j])
+	})
+	for _, t := range boundaries {
+		if isAllowed(t) != allowed {
+			return allowed, t, nil
+		}
+	}
+	return allowed, time.Time{}, nil
+}
+
+// NewMigrationWindowCondition builds the MigrationWindow condition of PodMigrationJob.
+func NewMigrationWindowCondition(allowed bool, next time.Time) *sev1alpha1.PodMigrationJobCondition {
+	if allowed {
+		return &sev1alpha1.PodMigrationJobCondition{
+			Type:   sev1alpha1.PodMigrationJobConditionMigrationWindow,
+			Status: sev1alpha1.PodMigrationJobConditionStatusTrue,
+			Reason: sev1alpha1.PodMigrationJobReasonInMigrationWindow,
+		}
+	}
+	message := "Waiting for the migration window to open"
+	if !next.IsZero() {
+		message = fmt.Sprintf("Waiting for the migration window to open at %s", next.Format(time.RFC3339))
+	}
+	return &sev1alpha1.PodMigrationJobCondition{
+		Type:    sev1alpha1.PodMigrationJobConditionMigrationWindow,
+		Status:  sev1alpha1.PodMigrationJobConditionStatusFalse,
+		Reason:  sev1alpha1.PodMigrationJobReasonOutsideMigrationWindow,
+		Message: message,
+	}
+}
+
+// IsWaitingForMigrationWindow checks whether the PodMigrationJob is still waiting for the migration window to open.
+func IsWaitingForMigrationWindow(job *sev1alpha1.PodMigrationJob) bool {
+	_, cond := GetCondition(&job.Status, sev1alpha1.PodMigrationJobConditionMigrationWindow)
+	return cond != nil && cond.Status == sev1alpha1.PodMigrationJobConditionStatusFalse
+}
+
+// GetJobStartTime returns the time from which the TTL of PodMigrationJob is counted.
+// The TTL of a job that waited for the migration window is counted from the time the window opened.
+func GetJobStartTime(job *sev1alpha1.PodMigrationJob) time.Time {
+	_, cond := GetCondition(&job.Status, sev1alpha1.PodMigrationJobConditionMigrationWindow)
+	if cond != nil && cond.Status == sev1alpha1.PodMigrationJobConditionStatusTrue &&
+		cond.LastTransitionTime.After(job.CreationTimestamp.Time) {
+		return cond.LastTransitionTime.Time
+	}
+	return job.CreationTimestamp.Time
+}
+
+type timeWindow struct {
+	weekdays map[time.Weekday]bool
+	// start and end are the minutes of the day
+	start int
+	end   int
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+func parseTimeWindows(windows []deschedulerconfig.MigrationTimeWindow) ([]timeWindow, error) {
+	var result []timeWindow
+	for _, w := range windows {
+		start, err := time.Parse(migrationWindowTimeLayout, w.Start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start %q, err: %v", w.Start, err)
+		}
+		end, err := time.Parse(migrationWindowTimeLayout, w.End)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end %q, err: %v", w.End, err)
+		}
+		tw := timeWindow{
+			start: start.Hour()*60 + start.Minute(),
+			end:   end.Hour()*60 + end.Minute(),
+		}
+		if len(w.Weekdays) > 0 {
+			tw.weekdays = map[time.Weekday]bool{}
+			for _, v := range w.Weekdays {
+				day, ok := weekdays[strings.ToLower(v)]
+				if !ok {
+					return nil, fmt.Errorf("invalid weekday %q", v)
+				}
+				tw.weekdays[day] = true
+			}
+		}
+		result = append(result, tw)
+	}
+	return result, nil
+}
+
+func (w *timeWindow) matchWeekday(day time.Weekday) bool {
+	return w.weekdays == nil || w.weekdays[day]
+}
+
+func (w *timeWindow) contains(t time.Time) bool {
+	minutes := t.Hour()*60 + t.Minute()
+	switch {
+	case w.start == w.end:
+		return w.matchWeekday(t.Weekday())
+	case w.start < w.end:
+		return w.matchWeekday(t.Weekday()) && minutes >= w.start && minutes < w.end
+	default:
+		// the window crosses midnight, the part after midnight belongs to the previous day
+		if minutes >= w.start {
+			return w.matchWeekday(t.Weekday())
+		}
+		return minutes < w.end && w.matchWeekday(t.AddDate(0, 0, -1).Weekday())
+	}
+}
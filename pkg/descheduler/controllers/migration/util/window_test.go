@@ -0,0 +1,238 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sev1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	deschedulerconfig "github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
+)
+
+func TestGetMigrationWindows(t *testing.T) {
+	defaultWindows := &deschedulerconfig.MigrationWindows{
+		AllowedWindows: []deschedulerconfig.MigrationTimeWindow{{Start: "01:00", End: "05:00"}},
+	}
+	args := &deschedulerconfig.MigrationWindowArgs{
+		Default: defaultWindows,
+		Namespaces: map[string]deschedulerconfig.MigrationWindows{
+			"kube-system": {
+				BlockedWindows: []deschedulerconfig.MigrationTimeWindow{{Start: "00:00", End: "00:00"}},
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		args    *deschedulerconfig.MigrationWindowArgs
+		pod     *corev1.Pod
+		want    *deschedulerconfig.MigrationWindows
+		wantErr bool
+	}{
+		{
+			name: "no windows configured",
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"}},
+			want: nil,
+		},
+		{
+			name: "default windows",
+			args: args,
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"}},
+			want: defaultWindows,
+		},
+		{
+			name: "namespace windows",
+			args: args,
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "test"}},
+			want: &deschedulerconfig.MigrationWindows{
+				BlockedWindows: []deschedulerconfig.MigrationTimeWindow{{Start: "00:00", End: "00:00"}},
+			},
+		},
+		{
+			name: "pod annotation windows",
+			args: args,
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "kube-system",
+					Name:      "test",
+					Annotations: map[string]string{
+						AnnotationMigrationWindows: `{"timeZone":"Asia/Shanghai","allowedWindows":[{"weekdays":["Sunday"],"start":"02:00","end":"04:00"}]}`,
+					},
+				},
+			},
+			want: &deschedulerconfig.MigrationWindows{
+				TimeZone: "Asia/Shanghai",
+				AllowedWindows: []deschedulerconfig.MigrationTimeWindow{
+					{Weekdays: []string{"Sunday"}, Start: "02:00", End: "04:00"},
+				},
+			},
+		},
+		{
+			name: "invalid pod annotation",
+			args: args,
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "default",
+					Name:        "test",
+					Annotations: map[string]string{AnnotationMigrationWindows: "{"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetMigrationWindows(tt.args, tt.pod)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestInMigrationWindow(t *testing.T) {
+	// 2024-01-01 is Monday
+	monday := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name        string
+		windows     *deschedulerconfig.MigrationWindows
+		now         time.Time
+		wantAllowed bool
+		wantNext    time.Time
+		wantErr     bool
+	}{
+		{
+			name:        "no windows",
+			now:         monday(10, 0),
+			wantAllowed: true,
+		},
+		{
+			name: "in allowed window",
+			windows: &deschedulerconfig.MigrationWindows{
+				AllowedWindows: []deschedulerconfig.MigrationTimeWindow{{Start: "01:00", End: "05:00"}},
+			},
+			now:         monday(2, 0),
+			wantAllowed: true,
+			wantNext:    monday(5, 0),
+		},
+		{
+			name: "outside allowed window",
+			windows: &deschedulerconfig.MigrationWindows{
+				AllowedWindows: []deschedulerconfig.MigrationTimeWindow{{Start: "01:00", End: "05:00"}},
+			},
+			now:         monday(10, 0),
+			wantAllowed: false,
+			wantNext:    monday(25, 0),
+		},
+		{
+			name: "allowed window crosses midnight",
+			windows: &deschedulerconfig.MigrationWindows{
+				AllowedWindows: []deschedulerconfig.MigrationTimeWindow{{Weekdays: []string{"Sunday"}, Start: "22:00", End: "06:00"}},
+			},
+			now:         monday(3, 0),
+			wantAllowed: true,
+			wantNext:    monday(6, 0),
+		},
+		{
+			name: "allowed window on other weekdays",
+			windows: &deschedulerconfig.MigrationWindows{
+				AllowedWindows: []deschedulerconfig.MigrationTimeWindow{{Weekdays: []string{"Wednesday"}, Start: "01:00", End: "05:00"}},
+			},
+			now:         monday(3, 0),
+			wantAllowed: false,
+			wantNext:    monday(48+1, 0),
+		},
+		{
+			name: "blocked window takes precedence",
+			windows: &deschedulerconfig.MigrationWindows{
+				AllowedWindows: []deschedulerconfig.MigrationTimeWindow{{Start: "00:00", End: "00:00"}},
+				BlockedWindows: []deschedulerconfig.MigrationTimeWindow{{Start: "09:00", End: "21:00"}},
+			},
+			now:         monday(10, 0),
+			wantAllowed: false,
+			wantNext:    monday(21, 0),
+		},
+		{
+			name: "always blocked",
+			windows: &deschedulerconfig.MigrationWindows{
+				BlockedWindows: []deschedulerconfig.MigrationTimeWindow{{Start: "00:00", End: "00:00"}},
+			},
+			now:         monday(10, 0),
+			wantAllowed: false,
+		},
+		{
+			name: "windows in time zone",
+			windows: &deschedulerconfig.MigrationWindows{
+				TimeZone:       "Asia/Shanghai",
+				AllowedWindows: []deschedulerconfig.MigrationTimeWindow{{Start: "01:00", End: "05:00"}},
+			},
+			// 2024-01-01 02:00 in Asia/Shanghai
+			now:         monday(-6, 0),
+			wantAllowed: true,
+			wantNext:    monday(-3, 0),
+		},
+		{
+			name: "invalid time zone",
+			windows: &deschedulerconfig.MigrationWindows{
+				TimeZone:       "Mars/Olympus",
+				AllowedWindows: []deschedulerconfig.MigrationTimeWindow{{Start: "01:00", End: "05:00"}},
+			},
+			now:     monday(10, 0),
+			wantErr: true,
+		},
+		{
+			name: "invalid weekday",
+			windows: &deschedulerconfig.MigrationWindows{
+				AllowedWindows: []deschedulerconfig.MigrationTimeWindow{{Weekdays: []string{"Someday"}, Start: "01:00", End: "05:00"}},
+			},
+			now:     monday(10, 0),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, next, err := InMigrationWindow(tt.windows, tt.now)
+			assert.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				return
+			}
+			assert.Equal(t, tt.wantAllowed, allowed)
+			assert.True(t, tt.wantNext.Equal(next), "want next %v, got %v", tt.wantNext, next)
+		})
+	}
+}
+
+func TestGetJobStartTime(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	job := &sev1alpha1.PodMigrationJob{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: created}},
+	}
+	assert.Equal(t, created, GetJobStartTime(job))
+
+	UpdateCondition(&job.Status, NewMigrationWindowCondition(false, time.Now()))
+	assert.True(t, IsWaitingForMigrationWindow(job))
+	assert.Equal(t, created, GetJobStartTime(job))
+
+	UpdateCondition(&job.Status, NewMigrationWindowCondition(true, time.Time{}))
+	assert.False(t, IsWaitingForMigrationWindow(job))
+	assert.True(t, GetJobStartTime(job).After(created))
+}
@@ -25,12 +25,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/koordinator-sh/koordinator/apis/extension"
 	sev1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
 )
 
 const (
 	IndexPodByNodeName        = "pod.spec.nodeName"
 	IndexPodByOwnerRefUID     = "pod.ownerRefUID"
+	IndexPodByGang            = "pod.gang"
 	IndexJobByPodUID          = "job.pod.uid"
 	IndexJobPodNamespacedName = "job.pod.namespacedName"
 	IndexJobByPodNamespace    = "job.pod.namespace"
@@ -68,6 +70,21 @@ func RegisterFieldIndexes(c cache.Cache) error {
 			return
 		}
 
+		err = c.IndexField(context.TODO(), &corev1.Pod{}, IndexPodByGang, func(obj client.Object) []string {
+			pod, ok := obj.(*corev1.Pod)
+			if !ok {
+				return []string{}
+			}
+			gangName := extension.GetGangName(pod)
+			if gangName == "" {
+				return []string{}
+			}
+			return []string{fmt.Sprintf("%s/%s", pod.Namespace, gangName)}
+		})
+		if err != nil {
+			return
+		}
+
 		err = c.IndexField(context.Background(), &sev1alpha1.PodMigrationJob{}, IndexJobByPodUID, func(obj client.Object) []string {
 			migrationJob, ok := obj.(*sev1alpha1.PodMigrationJob)
 			if !ok {
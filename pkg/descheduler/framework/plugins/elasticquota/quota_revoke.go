@@ -0,0 +1,332 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticquota
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	quotav1 "k8s.io/apiserver/pkg/quota/v1"
+	"k8s.io/klog/v2"
+	resourcehelper "k8s.io/kubernetes/pkg/api/v1/resource"
+
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	schedv1alpha1 "github.com/koordinator-sh/koordinator/apis/thirdparty/scheduler-plugins/pkg/apis/scheduling/v1alpha1"
+	schedclientset "github.com/koordinator-sh/koordinator/apis/thirdparty/scheduler-plugins/pkg/generated/clientset/versioned"
+	schedinformers "github.com/koordinator-sh/koordinator/apis/thirdparty/scheduler-plugins/pkg/generated/informers/externalversions"
+	schedlister "github.com/koordinator-sh/koordinator/apis/thirdparty/scheduler-plugins/pkg/generated/listers/scheduling/v1alpha1"
+	deschedulerconfig "github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config/validation"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/framework"
+	podutil "github.com/koordinator-sh/koordinator/pkg/descheduler/pod"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/utils/sorter"
+)
+
+const (
+	ElasticQuotaRevokeName = "ElasticQuotaRevoke"
+)
+
+var _ framework.BalancePlugin = &ElasticQuotaRevoke{}
+
+// ElasticQuotaRevoke revokes the resources borrowed by the ElasticQuotas whose used continuously exceeds the runtime,
+// e.g. the lender quotas reclaim their resources.
+// Unlike the QuotaOverUsedRevokeController of koord-scheduler which evicts the Pods directly,
+// the Pods are migrated by the Evictor, i.e. the MigrationController creates PodMigrationJobs for them,
+// so that the reservation-first mode, the PDBs and the migration limits such as MaxMigratingPerWorkload are respected.
+type ElasticQuotaRevoke struct {
+	handle        framework.Handle
+	args          *deschedulerconfig.ElasticQuotaRevokeArgs
+	podFilter     framework.FilterFunc
+	quotaSelector labels.Selector
+	quotaLister   schedlister.ElasticQuotaLister
+	overUsedSince map[string]time.Time
+}
+
+// +kubebuilder:rbac:groups=scheduling.sigs.k8s.io,resources=elasticquotas,verbs=get;list;watch
+
+// NewElasticQuotaRevoke builds plugin from its arguments while passing a handle
+func NewElasticQuotaRevoke(args runtime.Object, handle framework.Handle) (framework.Plugin, error) {
+	revokeArgs, ok := args.(*deschedulerconfig.ElasticQuotaRevokeArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type ElasticQuotaRevokeArgs, got %T", args)
+	}
+	if err := validation.ValidateElasticQuotaRevokeArgs(nil, revokeArgs); err != nil {
+		return nil, err
+	}
+
+	var excludedNamespaces sets.String
+	var includedNamespaces sets.String
+	if revokeArgs.EvictableNamespaces != nil {
+		excludedNamespaces = sets.NewString(revokeArgs.EvictableNamespaces.Exclude...)
+		includedNamespaces = sets.NewString(revokeArgs.EvictableNamespaces.Include...)
+	}
+	podFilter, err := podutil.NewOptions().
+		WithFilter(handle.Evictor().Filter).
+		WithoutNamespaces(excludedNamespaces).
+		WithNamespaces(includedNamespaces).
+		BuildFilterFunc()
+	if err != nil {
+		return nil, fmt.Errorf("error initializing pod filter function: %v", err)
+	}
+
+	quotaSelector := labels.Everything()
+	if revokeArgs.QuotaSelector != nil {
+		quotaSelector, err = metav1.LabelSelectorAsSelector(revokeArgs.QuotaSelector)
+		if err != nil {
+			return nil, fmt.Errorf("error initializing quota selector: %v", err)
+		}
+	}
+
+	client, ok := handle.(schedclientset.Interface)
+	if !ok {
+		kubeConfig := *handle.KubeConfig()
+		kubeConfig.ContentType = runtime.ContentTypeJSON
+		kubeConfig.AcceptContentTypes = runtime.ContentTypeJSON
+		client, err = schedclientset.NewForConfig(&kubeConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+	schedSharedInformerFactory := schedinformers.NewSharedInformerFactory(client, 0)
+	quotaInformer := schedSharedInformerFactory.Scheduling().V1alpha1().ElasticQuotas()
+	quotaInformer.Informer()
+	schedSharedInformerFactory.Start(context.TODO().Done())
+	schedSharedInformerFactory.WaitForCacheSync(context.TODO().Done())
+
+	return &ElasticQuotaRevoke{
+		handle:        handle,
+		args:          revokeArgs,
+		podFilter:     podFilter,
+		quotaSelector: quotaSelector,
+		quotaLister:   quotaInformer.Lister(),
+		overUsedSince: map[string]time.Time{},
+	}, nil
+}
+
+// Name retrieves the plugin name
+func (pl *ElasticQuotaRevoke) Name() string {
+	return ElasticQuotaRevokeName
+}
+
+// Balance extension point implementation for the plugin
+func (pl *ElasticQuotaRevoke) Balance(ctx context.Context, nodes []*corev1.Node) *framework.Status {
+	quotas, err := pl.quotaLister.List(labels.Everything())
+	if err != nil {
+		return &framework.Status{Err: err}
+	}
+
+	overUsedQuotas := pl.getOverUsedQuotas(quotas, time.Now())
+	if len(overUsedQuotas) == 0 {
+		klog.V(4).InfoS("No ElasticQuota needs to revoke borrowed resources")
+		return nil
+	}
+
+	podsByQuota := map[string][]*corev1.Pod{}
+	namespaceQuotas := getNamespaceQuotas(quotas)
+	for _, node := range nodes {
+		pods, err := podutil.ListPodsOnANode(node.Name, pl.handle.GetPodsAssignedToNodeFunc(), nil)
+		if err != nil {
+			klog.ErrorS(err, "Failed to list pods on node", "node", klog.KObj(node))
+			continue
+		}
+		for _, pod := range pods {
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+			quotaName := getPodQuotaName(pod, namespaceQuotas)
+			if _, ok := overUsedQuotas[quotaName]; ok {
+				podsByQuota[quotaName] = append(podsByQuota[quotaName], pod)
+			}
+		}
+	}
+
+	quotaNames := make([]string, 0, len(overUsedQuotas))
+	for quotaName := range overUsedQuotas {
+		quotaNames = append(quotaNames, quotaName)
+	}
+	sort.Strings(quotaNames)
+	for _, quotaName := range quotaNames {
+		quota := overUsedQuotas[quotaName]
+		quotaRuntime, _ := extension.GetRuntime(quota)
+		used := quota.Status.Used
+		revokePods := pl.selectRevokePods(podsByQuota[quotaName], used, quotaRuntime)
+		if len(revokePods) == 0 {
+			klog.V(4).InfoS("No Pod can be migrated to revoke borrowed resources", "quota", quotaName, "used", used, "runtime", quotaRuntime)
+			continue
+		}
+		_, exceededResources := quotav1.LessThanOrEqual(used, quotaRuntime)
+		reason := fmt.Sprintf("ElasticQuota %s over used %v, revoke borrowed resources", quotaName, exceededResources)
+		for _, pod := range revokePods {
+			if pl.args.DryRun {
+				klog.InfoS("Evict pod in dry run mode", "pod", klog.KObj(pod), "quota", quotaName, "reason", reason)
+				continue
+			}
+			if !pl.handle.Evictor().Evict(ctx, pod, framework.EvictOptions{Reason: reason}) {
+				klog.InfoS("Failed to Evict Pod", "pod", klog.KObj(pod), "quota", quotaName)
+				continue
+			}
+			klog.InfoS("Evicted Pod", "pod", klog.KObj(pod), "quota", quotaName, "reason", reason)
+		}
+		// wait for another DelayRevokeTime to let the used of the quota be updated
+		pl.overUsedSince[quotaName] = time.Now()
+	}
+	return nil
+}
+
+// getOverUsedQuotas returns the quotas whose used exceeds the runtime for longer than DelayRevokeTime.
+func (pl *ElasticQuotaRevoke) getOverUsedQuotas(quotas []*schedv1alpha1.ElasticQuota, now time.Time) map[string]*schedv1alpha1.ElasticQuota {
+	overUsedSince := map[string]time.Time{}
+	overUsedQuotas := map[string]*schedv1alpha1.ElasticQuota{}
+	for _, quota := range quotas {
+		if quota.Name == extension.SystemQuotaName || quota.Name == extension.RootQuotaName || extension.IsParentQuota(quota) {
+			continue
+		}
+		if !pl.quotaSelector.Matches(labels.Set(quota.Labels)) {
+			continue
+		}
+		quotaRuntime, err := extension.GetRuntime(quota)
+		if err != nil || len(quotaRuntime) == 0 {
+			continue
+		}
+		if lessThanOrEqual, _ := quotav1.LessThanOrEqual(quota.Status.Used, quotaRuntime); lessThanOrEqual {
+			continue
+		}
+		since, ok := pl.overUsedSince[quota.Name]
+		if !ok {
+			since = now
+		}
+		overUsedSince[quota.Name] = since
+		if now.Sub(since) >= pl.args.DelayRevokeTime.Duration {
+			overUsedQuotas[quota.Name] = quota
+		}
+	}
+	pl.overUsedSince = overUsedSince
+	return overUsedQuotas
+}
+
+// selectRevokePods selects the Pods to be migrated so that the used of the quota is less than or equal to the runtime.
+// The Pods with lower priority, more borrowed resources and shorter running time are selected first.
+// Like the QuotaOverUsedRevokeController of koord-scheduler, it tries to revoke the Pods until used <= runtime first,
+// and then tries to assign back the Pods from the most important one.
+func (pl *ElasticQuotaRevoke) selectRevokePods(pods []*corev1.Pod, used, quotaRuntime corev1.ResourceList) []*corev1.Pod {
+	overUsed := quotav1.RemoveZeros(quotav1.SubtractWithNonNegativeResult(used, quotaRuntime))
+	candidates := make([]*corev1.Pod, 0, len(pods))
+	for _, pod := range pods {
+		if extension.IsPodNonPreemptible(pod) || !pl.podFilter(pod) {
+			continue
+		}
+		candidates = append(candidates, pod)
+	}
+	sorter.OrderedBy(
+		sorter.Priority,
+		sorter.Reverse(borrowedResources(overUsed)),
+		sorter.PodCreationTimestamp,
+	).Sort(candidates)
+
+	used = used.DeepCopy()
+	resourceNames := quotav1.ResourceNames(used)
+	var tryRevokePods []*corev1.Pod
+	for _, pod := range candidates {
+		if lessThanOrEqual, _ := quotav1.LessThanOrEqual(used, quotaRuntime); lessThanOrEqual {
+			break
+		}
+		used = quotav1.Mask(quotav1.Subtract(used, podRequests(pod)), resourceNames)
+		tryRevokePods = append(tryRevokePods, pod)
+	}
+	if lessThanOrEqual, _ := quotav1.LessThanOrEqual(used, quotaRuntime); !lessThanOrEqual {
+		return tryRevokePods
+	}
+
+	var revokePods []*corev1.Pod
+	for i := len(tryRevokePods) - 1; i >= 0; i-- {
+		pod := tryRevokePods[i]
+		requests := podRequests(pod)
+		used = quotav1.Mask(quotav1.Add(used, requests), resourceNames)
+		if canAssignBack, _ := quotav1.LessThanOrEqual(used, quotaRuntime); !canAssignBack {
+			used = quotav1.Mask(quotav1.Subtract(used, requests), resourceNames)
+			revokePods = append(revokePods, pod)
+		}
+	}
+	return revokePods
+}
+
+// borrowedResources compares the Pods by the max share of their requests in the over used resources of the quota.
+func borrowedResources(overUsed corev1.ResourceList) sorter.CompareFn {
+	share := func(pod *corev1.Pod) float64 {
+		requests := podRequests(pod)
+		var maxShare float64
+		for resourceName, quantity := range overUsed {
+			request, ok := requests[resourceName]
+			if !ok || quantity.IsZero() {
+				continue
+			}
+			if s := float64(request.MilliValue()) / float64(quantity.MilliValue()); s > maxShare {
+				maxShare = s
+			}
+		}
+		return maxShare
+	}
+	return func(p1, p2 *corev1.Pod) int {
+		s1, s2 := share(p1), share(p2)
+		if s1 == s2 {
+			return 0
+		}
+		if s1 > s2 {
+			return 1
+		}
+		return -1
+	}
+}
+
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	return resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{})
+}
+
+// getNamespaceQuotas returns the quota names associated with the namespaces.
+// The quota with the same name as the namespace takes precedence over the quota declaring the namespace in the annotation.
+func getNamespaceQuotas(quotas []*schedv1alpha1.ElasticQuota) map[string]string {
+	namespaceQuotas := map[string]string{}
+	for _, quota := range quotas {
+		for _, namespace := range extension.GetAnnotationQuotaNamespaces(quota) {
+			namespaceQuotas[namespace] = quota.Name
+		}
+	}
+	for _, quota := range quotas {
+		if quota.Name == quota.Namespace {
+			namespaceQuotas[quota.Namespace] = quota.Name
+		}
+	}
+	return namespaceQuotas
+}
+
+// getPodQuotaName returns the quota name of the Pod in the same way as the ElasticQuota plugin of koord-scheduler.
+func getPodQuotaName(pod *corev1.Pod, namespaceQuotas map[string]string) string {
+	if quotaName := extension.GetQuotaName(pod); quotaName != "" {
+		return quotaName
+	}
+	if quotaName, ok := namespaceQuotas[pod.Namespace]; ok {
+		return quotaName
+	}
+	return extension.DefaultQuotaName
+}
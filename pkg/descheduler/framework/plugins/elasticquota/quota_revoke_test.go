@@ -0,0 +1,281 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticquota
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	schedv1alpha1 "github.com/koordinator-sh/koordinator/apis/thirdparty/scheduler-plugins/pkg/apis/scheduling/v1alpha1"
+	schedclientset "github.com/koordinator-sh/koordinator/apis/thirdparty/scheduler-plugins/pkg/generated/clientset/versioned"
+	schedfake "github.com/koordinator-sh/koordinator/apis/thirdparty/scheduler-plugins/pkg/generated/clientset/versioned/fake"
+	deschedulerconfig "github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/framework"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/test"
+)
+
+type fakeEvictor struct {
+	evicted []string
+}
+
+func (e *fakeEvictor) Filter(pod *corev1.Pod) bool {
+	return true
+}
+
+func (e *fakeEvictor) PreEvictionFilter(pod *corev1.Pod) bool {
+	return true
+}
+
+func (e *fakeEvictor) Evict(ctx context.Context, pod *corev1.Pod, evictOptions framework.EvictOptions) bool {
+	e.evicted = append(e.evicted, pod.Name)
+	return true
+}
+
+type fakeFrameworkHandle struct {
+	framework.Handle
+	schedclientset.Interface
+	evictor *fakeEvictor
+	pods    []*corev1.Pod
+}
+
+func (f *fakeFrameworkHandle) Evictor() framework.Evictor {
+	return f.evictor
+}
+
+func (f *fakeFrameworkHandle) GetPodsAssignedToNodeFunc() framework.GetPodsAssignedToNodeFunc {
+	return func(nodeName string, filter framework.FilterFunc) ([]*corev1.Pod, error) {
+		var pods []*corev1.Pod
+		for _, pod := range f.pods {
+			if pod.Spec.NodeName == nodeName && (filter == nil || filter(pod)) {
+				pods = append(pods, pod)
+			}
+		}
+		return pods, nil
+	}
+}
+
+func newTestQuota(name string, used, runtime corev1.ResourceList) *schedv1alpha1.ElasticQuota {
+	data, _ := json.Marshal(runtime)
+	return &schedv1alpha1.ElasticQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			Annotations: map[string]string{
+				extension.AnnotationRuntime: string(data),
+			},
+		},
+		Status: schedv1alpha1.ElasticQuotaStatus{
+			Used: used,
+		},
+	}
+}
+
+func newTestPod(name string, cpu int64, priority int32, quotaName string, apply func(pod *corev1.Pod)) *corev1.Pod {
+	return test.BuildTestPod(name, cpu, 0, "node-1", func(pod *corev1.Pod) {
+		test.SetRSOwnerRef(pod)
+		test.SetPodPriority(pod, priority)
+		pod.Labels = map[string]string{extension.LabelQuotaName: quotaName}
+		if apply != nil {
+			apply(pod)
+		}
+	})
+}
+
+func cpuList(milliCPU int64) corev1.ResourceList {
+	return corev1.ResourceList{corev1.ResourceCPU: *resource.NewMilliQuantity(milliCPU, resource.DecimalSI)}
+}
+
+func TestElasticQuotaRevoke(t *testing.T) {
+	tests := []struct {
+		name        string
+		dryRun      bool
+		quotas      []*schedv1alpha1.ElasticQuota
+		pods        []*corev1.Pod
+		wantEvicted []string
+	}{
+		{
+			name: "revoke the pods with lower priority and more borrowed resources",
+			quotas: []*schedv1alpha1.ElasticQuota{
+				newTestQuota("test-quota", cpuList(10000), cpuList(4000)),
+			},
+			pods: []*corev1.Pod{
+				newTestPod("p1", 2000, 100, "test-quota", nil),
+				newTestPod("p2", 4000, 100, "test-quota", nil),
+				newTestPod("p3", 4000, 200, "test-quota", nil),
+				newTestPod("p4", 2000, 0, "test-quota", func(pod *corev1.Pod) {
+					pod.Labels[extension.LabelPreemptible] = "false"
+				}),
+				newTestPod("p5", 1000, 0, "other-quota", nil),
+			},
+			wantEvicted: []string{"p1", "p2"},
+		},
+		{
+			name: "quota is not over used",
+			quotas: []*schedv1alpha1.ElasticQuota{
+				newTestQuota("test-quota", cpuList(4000), cpuList(4000)),
+			},
+			pods: []*corev1.Pod{
+				newTestPod("p1", 2000, 100, "test-quota", nil),
+				newTestPod("p2", 2000, 100, "test-quota", nil),
+			},
+		},
+		{
+			name:   "dry run",
+			dryRun: true,
+			quotas: []*schedv1alpha1.ElasticQuota{
+				newTestQuota("test-quota", cpuList(10000), cpuList(4000)),
+			},
+			pods: []*corev1.Pod{
+				newTestPod("p1", 6000, 100, "test-quota", nil),
+			},
+		},
+		{
+			name: "pods associated with the quota by namespace",
+			quotas: []*schedv1alpha1.ElasticQuota{
+				newTestQuota("default", cpuList(6000), cpuList(4000)),
+			},
+			pods: []*corev1.Pod{
+				newTestPod("p1", 2000, 100, "", func(pod *corev1.Pod) {
+					pod.Labels = nil
+				}),
+			},
+			wantEvicted: []string{"p1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := schedfake.NewSimpleClientset()
+			for _, quota := range tt.quotas {
+				_, err := client.SchedulingV1alpha1().ElasticQuotas(quota.Namespace).Create(context.TODO(), quota, metav1.CreateOptions{})
+				assert.NoError(t, err)
+			}
+			handle := &fakeFrameworkHandle{
+				Interface: client,
+				evictor:   &fakeEvictor{},
+				pods:      tt.pods,
+			}
+			pl, err := NewElasticQuotaRevoke(&deschedulerconfig.ElasticQuotaRevokeArgs{
+				DryRun:          tt.dryRun,
+				DelayRevokeTime: &metav1.Duration{},
+			}, handle)
+			assert.NoError(t, err)
+
+			nodes := []*corev1.Node{test.BuildTestNode("node-1", 16000, 32, 100, nil)}
+			status := pl.(framework.BalancePlugin).Balance(context.TODO(), nodes)
+			assert.Nil(t, status)
+			assert.ElementsMatch(t, tt.wantEvicted, handle.evictor.evicted)
+		})
+	}
+}
+
+func TestGetOverUsedQuotas(t *testing.T) {
+	pl := &ElasticQuotaRevoke{
+		args: &deschedulerconfig.ElasticQuotaRevokeArgs{
+			DelayRevokeTime: &metav1.Duration{Duration: time.Minute},
+		},
+		quotaSelector: labels.Everything(),
+		overUsedSince: map[string]time.Time{},
+	}
+	overUsedQuota := newTestQuota("over-used", cpuList(8000), cpuList(4000))
+	normalQuota := newTestQuota("normal", cpuList(2000), cpuList(4000))
+	parentQuota := newTestQuota("parent", cpuList(8000), cpuList(4000))
+	parentQuota.Labels = map[string]string{extension.LabelQuotaIsParent: "true"}
+	quotas := []*schedv1alpha1.ElasticQuota{overUsedQuota, normalQuota, parentQuota}
+
+	now := time.Now()
+	assert.Empty(t, pl.getOverUsedQuotas(quotas, now))
+	assert.Empty(t, pl.getOverUsedQuotas(quotas, now.Add(30*time.Second)))
+	got := pl.getOverUsedQuotas(quotas, now.Add(time.Minute))
+	assert.Len(t, got, 1)
+	assert.Equal(t, overUsedQuota, got["over-used"])
+
+	// the quota becomes normal, and it should wait for another DelayRevokeTime when it's over used again.
+	overUsedQuota.Status.Used = cpuList(4000)
+	assert.Empty(t, pl.getOverUsedQuotas(quotas, now.Add(2*time.Minute)))
+	overUsedQuota.Status.Used = cpuList(8000)
+	assert.Empty(t, pl.getOverUsedQuotas(quotas, now.Add(3*time.Minute)))
+	assert.Len(t, pl.getOverUsedQuotas(quotas, now.Add(4*time.Minute)), 1)
+}
+
+func TestSelectRevokePods(t *testing.T) {
+	pl := &ElasticQuotaRevoke{
+		podFilter: func(pod *corev1.Pod) bool {
+			return pod.Name != "filtered"
+		},
+	}
+	small := newTestPod("small", 1000, 0, "test-quota", nil)
+	big := newTestPod("big", 4000, 100, "test-quota", nil)
+	filtered := newTestPod("filtered", 4000, 0, "test-quota", nil)
+	got := pl.selectRevokePods([]*corev1.Pod{big, filtered, small}, cpuList(10000), cpuList(7000))
+	// small is assigned back since revoking big is enough
+	assert.Equal(t, []*corev1.Pod{big}, got)
+
+	// revoke all candidates if they are not enough
+	got = pl.selectRevokePods([]*corev1.Pod{big, filtered, small}, cpuList(20000), cpuList(7000))
+	assert.Equal(t, []*corev1.Pod{small, big}, got)
+}
+
+func TestGetPodQuotaName(t *testing.T) {
+	quotas := []*schedv1alpha1.ElasticQuota{
+		newTestQuota("ns-1", nil, nil),
+		newTestQuota("shared", nil, nil),
+	}
+	quotas[0].Namespace = "ns-1"
+	quotas[1].Annotations[extension.AnnotationQuotaNamespaces] = `["ns-1","ns-2"]`
+	namespaceQuotas := getNamespaceQuotas(quotas)
+
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want string
+	}{
+		{
+			name: "quota label",
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Labels: map[string]string{extension.LabelQuotaName: "shared"}}},
+			want: "shared",
+		},
+		{
+			name: "quota with the same name as the namespace",
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1"}},
+			want: "ns-1",
+		},
+		{
+			name: "quota namespaces annotation",
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-2"}},
+			want: "shared",
+		},
+		{
+			name: "default quota",
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-3"}},
+			want: extension.DefaultQuotaName,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, getPodQuotaName(tt.pod, namespaceQuotas))
+		})
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"k8s.io/klog/v2"
 
 	koordclientset "github.com/koordinator-sh/koordinator/pkg/client/clientset/versioned"
+	schedulingv1alpha1 "github.com/koordinator-sh/koordinator/pkg/client/clientset/versioned/typed/scheduling/v1alpha1"
 	koordinformers "github.com/koordinator-sh/koordinator/pkg/client/informers/externalversions"
 	koordslolisters "github.com/koordinator-sh/koordinator/pkg/client/listers/slo/v1alpha1"
 	deschedulerconfig "github.com/koordinator-sh/koordinator/pkg/descheduler/apis/config"
@@ -40,6 +41,7 @@ import (
 	nodeutil "github.com/koordinator-sh/koordinator/pkg/descheduler/node"
 	podutil "github.com/koordinator-sh/koordinator/pkg/descheduler/pod"
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/utils/anomaly"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/utils/report"
 )
 
 const (
@@ -52,6 +54,7 @@ var _ framework.BalancePlugin = &LowNodeLoad{}
 // Note that the plugin refers to the actual usage of the node.
 type LowNodeLoad struct {
 	handle               framework.Handle
+	koordClientSet       koordclientset.Interface
 	podFilter            framework.FilterFunc
 	nodeMetricLister     koordslolisters.NodeMetricLister
 	args                 *deschedulerconfig.LowNodeLoadArgs
@@ -112,6 +115,7 @@ func NewLowNodeLoad(args runtime.Object, handle framework.Handle) (framework.Plu
 
 	return &LowNodeLoad{
 		handle:               handle,
+		koordClientSet:       koordClientSet,
 		nodeMetricLister:     nodeMetricInformer.Lister(),
 		args:                 loadLoadUtilizationArgs,
 		podFilter:            podFilter,
@@ -164,17 +168,25 @@ func (pl *LowNodeLoad) processOneNodePool(ctx context.Context, nodePool *desched
 		return nil
 	}
 
+	var poolReport *nodePoolReport
+	if pl.args.EnableReport {
+		poolReport = newNodePoolReport(nodePool.Name, pl.args.DryRun)
+		defer pl.saveReport(ctx, poolReport)
+	}
+
 	lowThresholds, highThresholds, prodLowThresholds, prodHighThresholds := newThresholds(nodePool.UseDeviationThresholds, nodePool.LowThresholds, nodePool.HighThresholds, nodePool.ProdLowThresholds, nodePool.ProdHighThresholds)
 	resourceNames := getResourceNames(lowThresholds)
 	nodeUsages := getNodeUsage(nodes, resourceNames, pl.nodeMetricLister, pl.handle.GetPodsAssignedToNodeFunc(), pl.args.NodeMetricExpirationSeconds)
 	nodeThresholds := getNodeThresholds(nodeUsages, lowThresholds, highThresholds, prodLowThresholds, prodHighThresholds, resourceNames, nodePool.UseDeviationThresholds)
 	lowNodes, sourceNodes, prodLowNodes, prodHighNodes, bothLowNodes := classifyNodes(nodeUsages, nodeThresholds, lowThresholdFilter, highThresholdFilter, prodLowThresholdFilter, prodHighThresholdFilter)
+	poolReport.setNodes(nodeUsages, lowNodes, sourceNodes, prodLowNodes, prodHighNodes, bothLowNodes)
 
 	logUtilizationCriteria(nodePool.Name, "Criteria for nodes under low thresholds and above high thresholds", lowThresholds, highThresholds,
 		prodLowThresholds, prodHighThresholds, len(lowNodes), len(sourceNodes), len(prodLowNodes), len(prodHighNodes), len(bothLowNodes), len(nodes))
 
 	if len(sourceNodes) == 0 && len(prodHighNodes) == 0 {
 		klog.V(4).InfoS("All nodes are under target utilization, nothing to do here", "nodePool", nodePool.Name)
+		poolReport.setMessage("All nodes are under target utilization")
 		return nil
 	}
 
@@ -182,11 +194,13 @@ func (pl *LowNodeLoad) processOneNodePool(ctx context.Context, nodePool *desched
 	abnormalProdNodes := filterRealAbnormalNodes(prodHighNodes, pl.prodAnomalyDetectors, nodePool.AnomalyCondition)
 	if len(abnormalNodes) == 0 && len(abnormalProdNodes) == 0 {
 		klog.V(4).InfoS("None of the nodes were detected as anomalous, nothing to do here", "nodePool", nodePool.Name)
+		poolReport.setMessage("None of the nodes were detected as anomalous")
 		return nil
 	}
 
 	if len(lowNodes) == 0 && len(prodLowNodes) == 0 && len(bothLowNodes) == 0 {
 		klog.V(4).InfoS("No nodes are underutilized, nothing to do here, you might tune your thresholds further", "nodePool", nodePool.Name)
+		poolReport.setMessage("No nodes are underutilized")
 		return nil
 	}
 
@@ -198,11 +212,13 @@ func (pl *LowNodeLoad) processOneNodePool(ctx context.Context, nodePool *desched
 	if allLowNodes <= int(pl.args.NumberOfNodes) {
 		klog.V(4).InfoS("Number of nodes underutilized is less or equal than NumberOfNodes, nothing to do here",
 			"underutilizedNodes", allLowNodes, "numberOfNodes", pl.args.NumberOfNodes, "nodePool", nodePool.Name)
+		poolReport.setMessage("Number of nodes underutilized is less or equal than NumberOfNodes")
 		return nil
 	}
 
 	if allLowNodes == len(nodes) {
 		klog.V(4).InfoS("All nodes are underutilized, nothing to do here", "nodePool", nodePool.Name)
+		poolReport.setMessage("All nodes are underutilized")
 		return nil
 	}
 
@@ -256,6 +272,7 @@ func (pl *LowNodeLoad) processOneNodePool(ctx context.Context, nodePool *desched
 		resourceNames,
 		continueEvictionCond,
 		overUtilizedEvictionReason(highThresholds, prodHighThresholds),
+		poolReport,
 	)
 	tryMarkNodesAsNormal(abnormalNodes, pl.nodeAnomalyDetectors)
 	tryMarkNodesAsNormal(abnormalProdNodes, pl.prodAnomalyDetectors)
@@ -265,6 +282,20 @@ func (pl *LowNodeLoad) processOneNodePool(ctx context.Context, nodePool *desched
 	return nil
 }
 
+func (pl *LowNodeLoad) saveReport(ctx context.Context, poolReport *nodePoolReport) {
+	deschedulingReport := poolReport.complete()
+	var client schedulingv1alpha1.DeschedulingReportsGetter
+	if pl.koordClientSet != nil {
+		client = pl.koordClientSet.SchedulingV1alpha1()
+	}
+	if err := report.Save(ctx, client, deschedulingReport); err != nil {
+		klog.ErrorS(err, "Failed to save DeschedulingReport", "report", deschedulingReport.Name)
+		return
+	}
+	klog.V(4).InfoS("Saved DeschedulingReport", "report", deschedulingReport.Name,
+		"nodes", len(deschedulingReport.Status.Nodes), "pods", len(deschedulingReport.Status.Pods))
+}
+
 func resetNodesAsNormal(lowNodes []NodeInfo, nodeAnomalyDetectors *gocache.Cache) {
 	for _, v := range lowNodes {
 		if obj, ok := nodeAnomalyDetectors.Get(v.node.Name); ok {
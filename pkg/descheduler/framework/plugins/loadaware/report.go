@@ -0,0 +1,192 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadaware
+
+import (
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sev1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/utils/report"
+)
+
+// nodePoolReport collects the result of processing a node pool. All methods are no-op on a nil nodePoolReport,
+// so that the callers needn't check whether the report is enabled.
+type nodePoolReport struct {
+	report      *sev1alpha1.DeschedulingReport
+	allocatable map[string]corev1.ResourceList
+	usages      map[string]corev1.ResourceList
+	targets     map[types.NamespacedName]string
+}
+
+func newNodePoolReport(nodePoolName string, dryRun bool) *nodePoolReport {
+	return &nodePoolReport{
+		report: &sev1alpha1.DeschedulingReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: report.GenerateName(LowNodeLoadName, nodePoolName),
+			},
+			Spec: sev1alpha1.DeschedulingReportSpec{
+				Plugin:   LowNodeLoadName,
+				NodePool: nodePoolName,
+				DryRun:   dryRun,
+			},
+			Status: sev1alpha1.DeschedulingReportStatus{
+				StartTime: &metav1.Time{Time: time.Now()},
+			},
+		},
+		allocatable: map[string]corev1.ResourceList{},
+		usages:      map[string]corev1.ResourceList{},
+		targets:     map[types.NamespacedName]string{},
+	}
+}
+
+// setMessage records why the node pool is skipped.
+func (r *nodePoolReport) setMessage(message string) {
+	if r == nil {
+		return
+	}
+	r.report.Status.Message = message
+}
+
+// setNodes records the classification and the usage of the nodes before migration.
+func (r *nodePoolReport) setNodes(nodeUsages map[string]*NodeUsage, lowNodes, highNodes, prodLowNodes, prodHighNodes, bothLowNodes []NodeInfo) {
+	if r == nil {
+		return
+	}
+	classifications := map[string]sev1alpha1.DeschedulingNodeClassification{}
+	for _, v := range []struct {
+		nodes          []NodeInfo
+		classification sev1alpha1.DeschedulingNodeClassification
+	}{
+		{nodes: lowNodes, classification: sev1alpha1.DeschedulingNodeUnderutilized},
+		{nodes: highNodes, classification: sev1alpha1.DeschedulingNodeOverutilized},
+		{nodes: prodLowNodes, classification: sev1alpha1.DeschedulingNodeProdUnderutilized},
+		{nodes: prodHighNodes, classification: sev1alpha1.DeschedulingNodeProdOverutilized},
+		{nodes: bothLowNodes, classification: sev1alpha1.DeschedulingNodeBothUnderutilized},
+	} {
+		for _, nodeInfo := range v.nodes {
+			classifications[nodeInfo.node.Name] = v.classification
+		}
+	}
+
+	nodeNames := make([]string, 0, len(nodeUsages))
+	for nodeName := range nodeUsages {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Strings(nodeNames)
+	r.report.Status.Nodes = make([]sev1alpha1.DeschedulingReportNode, 0, len(nodeNames))
+	for _, nodeName := range nodeNames {
+		nodeUsage := nodeUsages[nodeName]
+		classification, ok := classifications[nodeName]
+		if !ok {
+			classification = sev1alpha1.DeschedulingNodeAppropriatelyUtilized
+		}
+		usage := usageToResourceList(nodeUsage.usage).DeepCopy()
+		r.allocatable[nodeName] = nodeUsage.node.Status.Allocatable
+		r.usages[nodeName] = usage
+		r.report.Status.Nodes = append(r.report.Status.Nodes, sev1alpha1.DeschedulingReportNode{
+			Name:             nodeName,
+			Classification:   classification,
+			Usage:            usage,
+			UsagePercentages: usagePercentages(usage, nodeUsage.node.Status.Allocatable),
+		})
+	}
+}
+
+// setTargetNode records the node which the Pod fits computed by NodeFit.
+func (r *nodePoolReport) setTargetNode(pod *corev1.Pod, nodeName string) {
+	if r == nil {
+		return
+	}
+	r.targets[types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}] = nodeName
+}
+
+// addPod records the candidate Pod selected to be migrated.
+func (r *nodePoolReport) addPod(pod *corev1.Pod, nodeInfo NodeInfo, prod bool, usage corev1.ResourceList, reason string) {
+	if r == nil {
+		return
+	}
+	r.report.Status.Pods = append(r.report.Status.Pods, sev1alpha1.DeschedulingReportPod{
+		Namespace:      pod.Namespace,
+		Name:           pod.Name,
+		NodeName:       nodeInfo.node.Name,
+		TargetNodeName: r.targets[types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}],
+		Prod:           prod,
+		Usage:          usage.DeepCopy(),
+		Reason:         reason,
+	})
+}
+
+// complete computes the expected usage of the nodes after the candidate Pods are migrated, and returns the report.
+func (r *nodePoolReport) complete() *sev1alpha1.DeschedulingReport {
+	if r == nil {
+		return nil
+	}
+	expectedUsages := map[string]corev1.ResourceList{}
+	for nodeName, usage := range r.usages {
+		expectedUsages[nodeName] = usage.DeepCopy()
+	}
+	for _, pod := range r.report.Status.Pods {
+		for resourceName, quantity := range pod.Usage {
+			if usage, ok := expectedUsages[pod.NodeName]; ok {
+				if used, ok := usage[resourceName]; ok {
+					used.Sub(quantity)
+					usage[resourceName] = used
+				}
+			}
+			if usage, ok := expectedUsages[pod.TargetNodeName]; ok {
+				used := usage[resourceName]
+				used.Add(quantity)
+				usage[resourceName] = used
+			}
+		}
+	}
+	for i := range r.report.Status.Nodes {
+		node := &r.report.Status.Nodes[i]
+		node.ExpectedUsage = expectedUsages[node.Name]
+		node.ExpectedUsagePercentages = usagePercentages(node.ExpectedUsage, r.allocatable[node.Name])
+	}
+	r.report.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	return r.report
+}
+
+func usagePercentages(usage, allocatable corev1.ResourceList) map[corev1.ResourceName]int64 {
+	percentages := map[corev1.ResourceName]int64{}
+	for resourceName, used := range usage {
+		total, ok := allocatable[resourceName]
+		if !ok || total.IsZero() {
+			continue
+		}
+		percentages[resourceName] = used.MilliValue() * 100 / total.MilliValue()
+	}
+	return percentages
+}
+
+func podUsage(podMetricUsage corev1.ResourceList, resourceNames map[corev1.ResourceName]*resource.Quantity) corev1.ResourceList {
+	usage := corev1.ResourceList{}
+	for resourceName := range resourceNames {
+		if quantity, ok := podMetricUsage[resourceName]; ok {
+			usage[resourceName] = quantity.DeepCopy()
+		}
+	}
+	return usage
+}
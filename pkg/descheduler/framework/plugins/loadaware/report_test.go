@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadaware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sev1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+)
+
+func TestNodePoolReport(t *testing.T) {
+	newNodeUsage := func(name string, allocatableCPU, usedCPU int64) *NodeUsage {
+		return &NodeUsage{
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status: corev1.NodeStatus{
+					Allocatable: corev1.ResourceList{
+						corev1.ResourceCPU: *resource.NewMilliQuantity(allocatableCPU, resource.DecimalSI),
+					},
+				},
+			},
+			usage: map[corev1.ResourceName]*resource.Quantity{
+				corev1.ResourceCPU: resource.NewMilliQuantity(usedCPU, resource.DecimalSI),
+			},
+		}
+	}
+	nodeUsages := map[string]*NodeUsage{
+		"node-3": newNodeUsage("node-3", 4000, 2000),
+		"node-1": newNodeUsage("node-1", 4000, 3600),
+		"node-2": newNodeUsage("node-2", 4000, 400),
+	}
+	highNodes := []NodeInfo{{NodeUsage: nodeUsages["node-1"]}}
+	lowNodes := []NodeInfo{{NodeUsage: nodeUsages["node-2"]}}
+
+	r := newNodePoolReport("test-pool", true)
+	assert.Equal(t, "lownodeload-test-pool", r.report.Name)
+	r.setNodes(nodeUsages, lowNodes, highNodes, nil, nil, nil)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod-1"}}
+	r.setTargetNode(pod, "node-2")
+	podMetricUsage := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("1"),
+		corev1.ResourceMemory: resource.MustParse("1Gi"),
+	}
+	usage := podUsage(podMetricUsage, map[corev1.ResourceName]*resource.Quantity{
+		corev1.ResourceCPU: resource.NewMilliQuantity(0, resource.DecimalSI),
+	})
+	r.addPod(pod, highNodes[0], false, usage, "node is overutilized")
+
+	report := r.complete()
+	assert.NotNil(t, report.Status.CompletionTime)
+	assert.True(t, report.Spec.DryRun)
+	assert.Equal(t, "test-pool", report.Spec.NodePool)
+	assert.Equal(t, []sev1alpha1.DeschedulingReportPod{
+		{
+			Namespace:      "default",
+			Name:           "pod-1",
+			NodeName:       "node-1",
+			TargetNodeName: "node-2",
+			Usage: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("1"),
+			},
+			Reason: "node is overutilized",
+		},
+	}, report.Status.Pods)
+
+	expectedNodes := []struct {
+		name                     string
+		classification           sev1alpha1.DeschedulingNodeClassification
+		usagePercentages         int64
+		expectedUsagePercentages int64
+	}{
+		{name: "node-1", classification: sev1alpha1.DeschedulingNodeOverutilized, usagePercentages: 90, expectedUsagePercentages: 65},
+		{name: "node-2", classification: sev1alpha1.DeschedulingNodeUnderutilized, usagePercentages: 10, expectedUsagePercentages: 35},
+		{name: "node-3", classification: sev1alpha1.DeschedulingNodeAppropriatelyUtilized, usagePercentages: 50, expectedUsagePercentages: 50},
+	}
+	assert.Len(t, report.Status.Nodes, len(expectedNodes))
+	for i, expected := range expectedNodes {
+		node := report.Status.Nodes[i]
+		assert.Equal(t, expected.name, node.Name)
+		assert.Equal(t, expected.classification, node.Classification)
+		assert.Equal(t, expected.usagePercentages, node.UsagePercentages[corev1.ResourceCPU])
+		assert.Equal(t, expected.expectedUsagePercentages, node.ExpectedUsagePercentages[corev1.ResourceCPU])
+	}
+	// the usage before migration must not be changed
+	assert.Equal(t, int64(3600), report.Status.Nodes[0].Usage.Cpu().MilliValue())
+	assert.Equal(t, int64(3600), nodeUsages["node-1"].usage[corev1.ResourceCPU].MilliValue())
+}
+
+func TestNilNodePoolReport(t *testing.T) {
+	var r *nodePoolReport
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod-1"}}
+	assert.NotPanics(t, func() {
+		r.setMessage("test")
+		r.setNodes(nil, nil, nil, nil, nil, nil)
+		r.setTargetNode(pod, "node-1")
+		r.addPod(pod, NodeInfo{}, false, nil, "")
+		assert.Nil(t, r.complete())
+	})
+}
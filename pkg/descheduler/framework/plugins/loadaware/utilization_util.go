@@ -321,6 +321,7 @@ func evictPodsFromSourceNodes(
 	resourceNames []corev1.ResourceName,
 	continueEviction continueEvictionCond,
 	evictionReasonGenerator evictionReasonGeneratorFn,
+	report *nodePoolReport,
 ) {
 	totalAvailableUsages, targetNodes := targetAvailableUsage(destinationNodes, resourceNames, false)
 	prodAvailableUsages, prodTargetNodes := targetAvailableUsage(prodDestinationNodes, resourceNames, true)
@@ -351,7 +352,7 @@ func evictPodsFromSourceNodes(
 	targetNodes = append(targetNodes, bothTotalNodes...)
 	balancePods(ctx, nodePoolName, sourceNodes, targetNodes, nodeUsages, nodeThresholds,
 		nodeTotalAvailableUsages, dryRun, nodeFit, false, resourceWeights, podEvictor,
-		podFilter, nodeIndexer, continueEviction, evictionReasonGenerator)
+		podFilter, nodeIndexer, continueEviction, evictionReasonGenerator, report)
 
 	// bothLowNode will be used by nodeHigh and prodHigh nodes, needs sub resources used by pods on nodeHigh.
 	for _, resourceName := range resourceNames {
@@ -390,7 +391,7 @@ func evictPodsFromSourceNodes(
 	klog.V(4).InfoS("Total prod usage capacity to be moved", prodKeysAndValues...)
 	balancePods(ctx, nodePoolName, prodSourceNodes, prodTargetNodes, nodeUsages, nodeThresholds,
 		prodTotalAvailableUsages, dryRun, nodeFit, true, resourceWeights, podEvictor,
-		podFilter, nodeIndexer, continueEviction, evictionReasonGenerator)
+		podFilter, nodeIndexer, continueEviction, evictionReasonGenerator, report)
 }
 
 func newAvailableUsage(resourceNames []corev1.ResourceName) map[corev1.ResourceName]*resource.Quantity {
@@ -424,7 +425,8 @@ func balancePods(ctx context.Context,
 	podFilter framework.FilterFunc,
 	nodeIndexer podutil.GetPodsAssignedToNodeFunc,
 	continueEviction continueEvictionCond,
-	evictionReasonGenerator evictionReasonGeneratorFn) {
+	evictionReasonGenerator evictionReasonGeneratorFn,
+	report *nodePoolReport) {
 	for _, srcNode := range sourceNodes {
 		var allPods []*corev1.Pod
 		if prod {
@@ -444,7 +446,12 @@ func balancePods(ctx context.Context,
 					klog.V(4).InfoS("Failed to find PodMetric", "pod", klog.KObj(pod), "node", klog.KObj(srcNode.node), "nodePool", nodePoolName)
 					return false
 				}
-				return podFitsAnyNodeWithThreshold(nodeIndexer, pod, targetNodes, nodeUsages, nodeThresholds, prod, podMetric)
+				targetNode := findFitNodeWithThreshold(nodeIndexer, pod, targetNodes, nodeUsages, nodeThresholds, prod, podMetric)
+				if targetNode == nil {
+					return false
+				}
+				report.setTargetNode(pod, targetNode.Name)
+				return true
 			}),
 		)
 		klog.V(4).InfoS("Evicting pods from node",
@@ -457,7 +464,7 @@ func balancePods(ctx context.Context,
 		}
 		sortPodsOnOneOverloadedNode(srcNode, removablePods, resourceWeights, prod)
 
-		evictPods(ctx, nodePoolName, dryRun, prod, removablePods, srcNode, totalAvailableUsages, podEvictor, podFilter, continueEviction, evictionReasonGenerator)
+		evictPods(ctx, nodePoolName, dryRun, prod, removablePods, srcNode, totalAvailableUsages, podEvictor, podFilter, continueEviction, evictionReasonGenerator, report)
 	}
 }
 
@@ -505,6 +512,7 @@ func evictPods(
 	podFilter framework.FilterFunc,
 	continueEviction continueEvictionCond,
 	evictionReasonGenerator evictionReasonGeneratorFn,
+	report *nodePoolReport,
 ) {
 	for _, pod := range inputPods {
 		if !continueEviction(nodeInfo, totalAvailableUsages, prod) {
@@ -515,11 +523,12 @@ func evictPods(
 			klog.V(4).InfoS("Pod aborted eviction because it was filtered by filters", "pod", klog.KObj(pod), "node", klog.KObj(nodeInfo.node), "nodePool", nodePoolName)
 			continue
 		}
+		reason := evictionReasonGenerator(nodeInfo, prod)
 		if dryRun {
 			klog.InfoS("Evict pod in dry run mode", "pod", klog.KObj(pod), "node", klog.KObj(nodeInfo.node), "nodePool", nodePoolName)
 		} else {
 			evictionOptions := framework.EvictOptions{
-				Reason: evictionReasonGenerator(nodeInfo, prod),
+				Reason: reason,
 			}
 			if !podEvictor.Evict(ctx, pod, evictionOptions) {
 				klog.InfoS("Failed to Evict Pod", "pod", klog.KObj(pod), "node", klog.KObj(nodeInfo.node), "nodePool", nodePoolName)
@@ -529,6 +538,13 @@ func evictPods(
 		}
 
 		podMetric := nodeInfo.podMetrics[types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}]
+		if report != nil {
+			var usage corev1.ResourceList
+			if podMetric != nil {
+				usage = podUsage(podMetric.ResourceList, totalAvailableUsages)
+			}
+			report.addPod(pod, nodeInfo, prod, usage, reason)
+		}
 		if podMetric == nil {
 			klog.V(4).InfoS("Failed to find PodMetric", "pod", klog.KObj(pod), "node", klog.KObj(nodeInfo.node), "nodePool", nodePoolName)
 			continue
@@ -730,6 +746,13 @@ func sortPodsOnOneOverloadedNode(srcNode NodeInfo, removablePods []*corev1.Pod,
 // utilization will exceed the threshold after this pod was scheduled on it.
 func podFitsAnyNodeWithThreshold(nodeIndexer podutil.GetPodsAssignedToNodeFunc, pod *corev1.Pod, nodes []*corev1.Node,
 	nodeUsages map[string]*NodeUsage, nodeThresholds map[string]NodeThresholds, prod bool, podMetric *slov1alpha1.ResourceMap) bool {
+	return findFitNodeWithThreshold(nodeIndexer, pod, nodes, nodeUsages, nodeThresholds, prod, podMetric) != nil
+}
+
+// findFitNodeWithThreshold returns the first node of the given nodes that the given pod fits,
+// and the node utilization will not exceed the threshold after this pod was scheduled on it.
+func findFitNodeWithThreshold(nodeIndexer podutil.GetPodsAssignedToNodeFunc, pod *corev1.Pod, nodes []*corev1.Node,
+	nodeUsages map[string]*NodeUsage, nodeThresholds map[string]NodeThresholds, prod bool, podMetric *slov1alpha1.ResourceMap) *corev1.Node {
 	for _, node := range nodes {
 		errors := nodeutil.NodeFit(nodeIndexer, pod, node)
 		if len(errors) == 0 {
@@ -770,10 +793,10 @@ func podFitsAnyNodeWithThreshold(nodeIndexer podutil.GetPodsAssignedToNodeFunc,
 				}
 			}
 			klog.V(4).InfoS("Pod fits on node", "pod", klog.KObj(pod), "node", klog.KObj(node))
-			return true
+			return node
 		} else {
 			klog.V(4).InfoS("Pod does not fit on node", "pod", klog.KObj(pod), "node", klog.KObj(node), "errors", utilerrors.NewAggregate(errors))
 		}
 	}
-	return false
+	return nil
 }
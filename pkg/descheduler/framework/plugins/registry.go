@@ -17,6 +17,7 @@ limitations under the License.
 package plugins
 
 import (
+	"github.com/koordinator-sh/koordinator/pkg/descheduler/framework/plugins/elasticquota"
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/framework/plugins/kubernetes"
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/framework/plugins/loadaware"
 	"github.com/koordinator-sh/koordinator/pkg/descheduler/framework/runtime"
@@ -24,7 +25,8 @@ import (
 
 func NewInTreeRegistry() runtime.Registry {
 	registry := runtime.Registry{
-		loadaware.LowNodeLoadName: loadaware.NewLowNodeLoad,
+		loadaware.LowNodeLoadName:           loadaware.NewLowNodeLoad,
+		elasticquota.ElasticQuotaRevokeName: elasticquota.NewElasticQuotaRevoke,
 	}
 	kubernetes.SetupK8sDeschedulerPlugins(registry)
 	return registry
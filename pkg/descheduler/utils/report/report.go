@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	sev1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	schedulingv1alpha1 "github.com/koordinator-sh/koordinator/pkg/client/clientset/versioned/typed/scheduling/v1alpha1"
+)
+
+const (
+	// DebugPath is the path of the debug endpoint to retrieve the latest DeschedulingReports.
+	// GET DebugPath lists the names of the reports, and GET DebugPath/{name} returns the report.
+	DebugPath = "/debug/descheduling-reports"
+)
+
+var defaultStore = NewStore()
+
+// Store keeps the latest DeschedulingReports in memory.
+type Store struct {
+	lock    sync.RWMutex
+	reports map[string]*sev1alpha1.DeschedulingReport
+}
+
+func NewStore() *Store {
+	return &Store{
+		reports: map[string]*sev1alpha1.DeschedulingReport{},
+	}
+}
+
+func (s *Store) Set(report *sev1alpha1.DeschedulingReport) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.reports[report.Name] = report.DeepCopy()
+}
+
+func (s *Store) Get(name string) *sev1alpha1.DeschedulingReport {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if report := s.reports[name]; report != nil {
+		return report.DeepCopy()
+	}
+	return nil
+}
+
+func (s *Store) Names() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	names := make([]string, 0, len(s.reports))
+	for name := range s.reports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GenerateName generates the name of the DeschedulingReport of the plugin and the node pool.
+func GenerateName(plugin, nodePool string) string {
+	name := strings.ToLower(plugin)
+	if nodePool != "" {
+		name += "-" + strings.ToLower(nodePool)
+	}
+	name = strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' || r == '.' {
+			return r
+		}
+		return '-'
+	}, name)
+	for strings.Contains(name, "--") {
+		name = strings.ReplaceAll(name, "--", "-")
+	}
+	name = strings.Trim(name, "-.")
+	if len(name) > validation.DNS1123SubdomainMaxLength {
+		name = strings.Trim(name[:validation.DNS1123SubdomainMaxLength], "-.")
+	}
+	return name
+}
+
+// +kubebuilder:rbac:groups=scheduling.koordinator.sh,resources=deschedulingreports,verbs=get;list;watch;create;update
+
+// Save saves the DeschedulingReport in the default store, and creates or updates it in the apiserver.
+func Save(ctx context.Context, client schedulingv1alpha1.DeschedulingReportsGetter, report *sev1alpha1.DeschedulingReport) error {
+	defaultStore.Set(report)
+	if client == nil {
+		return nil
+	}
+	reports := client.DeschedulingReports()
+	existing, err := reports.Get(ctx, report.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		_, err = reports.Create(ctx, report, metav1.CreateOptions{})
+		return err
+	}
+	newReport := report.DeepCopy()
+	newReport.ResourceVersion = existing.ResourceVersion
+	_, err = reports.Update(ctx, newReport, metav1.UpdateOptions{})
+	return err
+}
+
+type mux interface {
+	Handle(string, http.Handler)
+	HandlePrefix(string, http.Handler)
+}
+
+// InstallHandler registers the debug endpoint of the DeschedulingReports in the default store.
+func InstallHandler(mux mux) {
+	handler := NewHandler(defaultStore)
+	mux.Handle(DebugPath, handler)
+	mux.HandlePrefix(DebugPath+"/", handler)
+}
+
+// NewHandler returns the http.Handler serving the DeschedulingReports in the store.
+func NewHandler(store *Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		name := strings.Trim(strings.TrimPrefix(r.URL.Path, DebugPath), "/")
+		var data interface{}
+		if name == "" {
+			data = store.Names()
+		} else {
+			report := store.Get(name)
+			if report == nil {
+				http.Error(w, "descheduling report "+name+" not found", http.StatusNotFound)
+				return
+			}
+			data = report
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(data); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sev1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	koordfake "github.com/koordinator-sh/koordinator/pkg/client/clientset/versioned/fake"
+)
+
+func TestGenerateName(t *testing.T) {
+	tests := []struct {
+		name     string
+		plugin   string
+		nodePool string
+		want     string
+	}{
+		{
+			name:   "without node pool",
+			plugin: "LowNodeLoad",
+			want:   "lownodeload",
+		},
+		{
+			name:     "default node pool",
+			plugin:   "LowNodeLoad",
+			nodePool: "__default_node_pool__",
+			want:     "lownodeload-default-node-pool",
+		},
+		{
+			name:     "too long",
+			plugin:   "LowNodeLoad",
+			nodePool: strings.Repeat("a", 300),
+			want:     "lownodeload-" + strings.Repeat("a", 253-len("lownodeload-")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, GenerateName(tt.plugin, tt.nodePool))
+		})
+	}
+}
+
+func TestSave(t *testing.T) {
+	client := koordfake.NewSimpleClientset().SchedulingV1alpha1()
+	report := &sev1alpha1.DeschedulingReport{
+		ObjectMeta: metav1.ObjectMeta{Name: "lownodeload-test"},
+		Spec:       sev1alpha1.DeschedulingReportSpec{Plugin: "LowNodeLoad", DryRun: true},
+		Status:     sev1alpha1.DeschedulingReportStatus{Message: "first"},
+	}
+	assert.NoError(t, Save(context.TODO(), client, report))
+	got, err := client.DeschedulingReports().Get(context.TODO(), report.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "first", got.Status.Message)
+
+	report = report.DeepCopy()
+	report.Status.Message = "second"
+	assert.NoError(t, Save(context.TODO(), client, report))
+	got, err = client.DeschedulingReports().Get(context.TODO(), report.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "second", got.Status.Message)
+	assert.Equal(t, "second", defaultStore.Get(report.Name).Status.Message)
+
+	report = report.DeepCopy()
+	report.Name = "lownodeload-without-client"
+	assert.NoError(t, Save(context.TODO(), nil, report))
+	assert.NotNil(t, defaultStore.Get(report.Name))
+}
+
+func TestHandler(t *testing.T) {
+	store := NewStore()
+	store.Set(&sev1alpha1.DeschedulingReport{
+		ObjectMeta: metav1.ObjectMeta{Name: "lownodeload-b"},
+	})
+	store.Set(&sev1alpha1.DeschedulingReport{
+		ObjectMeta: metav1.ObjectMeta{Name: "lownodeload-a"},
+		Status:     sev1alpha1.DeschedulingReportStatus{Message: "All nodes are underutilized"},
+	})
+	handler := NewHandler(store)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, DebugPath, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var names []string
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &names))
+	assert.Equal(t, []string{"lownodeload-a", "lownodeload-b"}, names)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, DebugPath+"/lownodeload-a", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var report sev1alpha1.DeschedulingReport
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	assert.Equal(t, "All nodes are underutilized", report.Status.Message)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, DebugPath+"/not-exist", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, DebugPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
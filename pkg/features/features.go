@@ -96,6 +96,17 @@ const (
 
 	// DevicePluginAdaption enables adaption for third party device plugins within device share scheduling.
 	DevicePluginAdaption featuregate.Feature = "DevicePluginAdaption"
+
+	// BatchEphemeralStorage enables the pod mutating webhook to replace the ephemeral-storage of the Batch pods with
+	// the batch-ephemeral-storage.
+	BatchEphemeralStorage featuregate.Feature = "BatchEphemeralStorage"
+
+	// BatchGPU enables the pod mutating webhook to replace the shared GPU resources of the Batch pods with
+	// the batch GPU resources.
+	BatchGPU featuregate.Feature = "BatchGPU"
+
+	// ColocationStrategyController enables the staged rollout of the ClusterColocationStrategy.
+	ColocationStrategyController featuregate.Feature = "ColocationStrategyController"
 )
 
 var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
@@ -120,14 +131,22 @@ var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	ColocationProfileController:            {Default: false, PreRelease: featuregate.Alpha},
 	ValidatePodDeviceResource:              {Default: false, PreRelease: featuregate.Alpha},
 	DevicePluginAdaption:                   {Default: false, PreRelease: featuregate.Alpha},
+	BatchEphemeralStorage:                  {Default: false, PreRelease: featuregate.Alpha},
+	BatchGPU:                               {Default: false, PreRelease: featuregate.Alpha},
+	ColocationStrategyController:           {Default: false, PreRelease: featuregate.Alpha},
 }
 
 const (
 	DisablePVCReservation featuregate.Feature = "DisablePVCReservation"
+
+	// NodeDrain enables the NodeDrain controller in the MigrationController,
+	// which cordons the node and migrates the Pods on the node by PodMigrationJobs.
+	NodeDrain featuregate.Feature = "NodeDrain"
 )
 
 var defaultDeschedulerFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	DisablePVCReservation: {Default: false, PreRelease: featuregate.Beta},
+	NodeDrain:             {Default: false, PreRelease: featuregate.Alpha},
 }
 
 const (
@@ -64,6 +64,28 @@ const (
 	// BEMemoryEvict evict best-effort pod based on node memory usage.
 	BEMemoryEvict featuregate.Feature = "BEMemoryEvict"
 
+	// alpha: v1.6
+	//
+	// BEMemoryReclaim proactively reclaims the cold memory of best-effort pod based on node memory usage.
+	BEMemoryReclaim featuregate.Feature = "BEMemoryReclaim"
+
+	// alpha: v1.6
+	//
+	// BEEphemeralStorageEvict evicts best-effort pod based on node ephemeral storage usage.
+	BEEphemeralStorageEvict featuregate.Feature = "BEEphemeralStorageEvict"
+
+	// alpha: v1.6
+	//
+	// BEGPUEvict evicts best-effort pod based on the gpu usage of each device.
+	BEGPUEvict featuregate.Feature = "BEGPUEvict"
+
+	// alpha: v1.6
+	//
+	// BEMinShare guarantees the minimum share of the BE cpu pool for the BE pods declaring the batch min share.
+	// The BECPUSuppress distributes the suppressed cpu by weights, and the BECPUEvict evicts the non-guaranteed
+	// BE pods first.
+	BEMinShare featuregate.Feature = "BEMinShare"
+
 	// owner: @saintube @zwzhang0107
 	// alpha: v0.2
 	// beta: v1.1
@@ -140,6 +162,18 @@ const (
 	// BlkIOReconcile enables block I/O QoS feature of koordlet.
 	BlkIOReconcile featuregate.Feature = "BlkIOReconcile"
 
+	// alpha: v1.6
+	//
+	// EphemeralStorageCollector enables the collector of the node and pod ephemeral storage usages, which are used
+	// by the batch ephemeral storage overcommitment.
+	EphemeralStorageCollector featuregate.Feature = "EphemeralStorageCollector"
+
+	// alpha: v1.6
+	//
+	// CPUBenchmarkReport enables the koordlet to report the cpu benchmark score in the CPUBasicInfo, which is used
+	// by the slo-controller to derive the cpu normalization ratios.
+	CPUBenchmarkReport featuregate.Feature = "CPUBenchmarkReport"
+
 	// owner: @BUPT-wxq
 	// alpha v1.4
 	//
@@ -171,27 +205,33 @@ var (
 	DefaultKoordletFeatureGate        featuregate.FeatureGate        = DefaultMutableKoordletFeatureGate
 
 	defaultKoordletFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
-		AuditEvents:            {Default: false, PreRelease: featuregate.Alpha},
-		AuditEventsHTTPHandler: {Default: false, PreRelease: featuregate.Alpha},
-		BECPUSuppress:          {Default: true, PreRelease: featuregate.Beta},
-		BECPUManager:           {Default: false, PreRelease: featuregate.Alpha},
-		BECPUEvict:             {Default: false, PreRelease: featuregate.Alpha},
-		BEMemoryEvict:          {Default: false, PreRelease: featuregate.Alpha},
-		CPUBurst:               {Default: true, PreRelease: featuregate.Beta},
-		SystemConfig:           {Default: false, PreRelease: featuregate.Alpha},
-		RdtResctrl:             {Default: true, PreRelease: featuregate.Beta},
-		ResctrlCollector:       {Default: false, PreRelease: featuregate.Alpha},
-		CgroupReconcile:        {Default: false, PreRelease: featuregate.Alpha},
-		NodeTopologyReport:     {Default: true, PreRelease: featuregate.Beta},
-		Accelerators:           {Default: false, PreRelease: featuregate.Alpha},
-		RDMADevices:            {Default: false, PreRelease: featuregate.Alpha},
-		CPICollector:           {Default: false, PreRelease: featuregate.Alpha},
-		Libpfm4:                {Default: false, PreRelease: featuregate.Alpha},
-		PSICollector:           {Default: false, PreRelease: featuregate.Alpha},
-		BlkIOReconcile:         {Default: false, PreRelease: featuregate.Alpha},
-		ColdPageCollector:      {Default: false, PreRelease: featuregate.Alpha},
-		HugePageReport:         {Default: false, PreRelease: featuregate.Alpha},
-		PodResourcesProxy:      {Default: false, PreRelease: featuregate.Alpha},
+		AuditEvents:               {Default: false, PreRelease: featuregate.Alpha},
+		AuditEventsHTTPHandler:    {Default: false, PreRelease: featuregate.Alpha},
+		BECPUSuppress:             {Default: true, PreRelease: featuregate.Beta},
+		BECPUManager:              {Default: false, PreRelease: featuregate.Alpha},
+		BECPUEvict:                {Default: false, PreRelease: featuregate.Alpha},
+		BEMemoryEvict:             {Default: false, PreRelease: featuregate.Alpha},
+		BEMemoryReclaim:           {Default: false, PreRelease: featuregate.Alpha},
+		BEEphemeralStorageEvict:   {Default: false, PreRelease: featuregate.Alpha},
+		BEGPUEvict:                {Default: false, PreRelease: featuregate.Alpha},
+		BEMinShare:                {Default: false, PreRelease: featuregate.Alpha},
+		CPUBurst:                  {Default: true, PreRelease: featuregate.Beta},
+		CPUBenchmarkReport:        {Default: false, PreRelease: featuregate.Alpha},
+		SystemConfig:              {Default: false, PreRelease: featuregate.Alpha},
+		RdtResctrl:                {Default: true, PreRelease: featuregate.Beta},
+		ResctrlCollector:          {Default: false, PreRelease: featuregate.Alpha},
+		CgroupReconcile:           {Default: false, PreRelease: featuregate.Alpha},
+		NodeTopologyReport:        {Default: true, PreRelease: featuregate.Beta},
+		Accelerators:              {Default: false, PreRelease: featuregate.Alpha},
+		RDMADevices:               {Default: false, PreRelease: featuregate.Alpha},
+		CPICollector:              {Default: false, PreRelease: featuregate.Alpha},
+		Libpfm4:                   {Default: false, PreRelease: featuregate.Alpha},
+		PSICollector:              {Default: false, PreRelease: featuregate.Alpha},
+		BlkIOReconcile:            {Default: false, PreRelease: featuregate.Alpha},
+		ColdPageCollector:         {Default: false, PreRelease: featuregate.Alpha},
+		EphemeralStorageCollector: {Default: false, PreRelease: featuregate.Alpha},
+		HugePageReport:            {Default: false, PreRelease: featuregate.Alpha},
+		PodResourcesProxy:         {Default: false, PreRelease: featuregate.Alpha},
 	}
 )
 
@@ -203,7 +243,7 @@ func IsFeatureDisabled(nodeSLO *slov1alpha1.NodeSLO, feature featuregate.Feature
 
 	spec := nodeSLO.Spec
 	switch feature {
-	case BECPUSuppress, BEMemoryEvict, BECPUEvict:
+	case BECPUSuppress, BEMemoryEvict, BEMemoryReclaim, BECPUEvict, BEEphemeralStorageEvict, BEGPUEvict:
 		if spec.ResourceUsedThresholdWithBE == nil || spec.ResourceUsedThresholdWithBE.Enable == nil {
 			return true, fmt.Errorf("cannot parse feature config for invalid nodeSLO %v", nodeSLO)
 		}
@@ -24,4 +24,6 @@ type NodeCPUInfo util.LocalCPUInfo
 
 type NodeLocalStorageInfo util.LocalStorageInfo
 
+type NodeEphemeralStorageUsage util.EphemeralStorageUsage
+
 type Devices util.Devices
@@ -23,6 +23,8 @@ var (
 	NodeCPUUsageMetric                 = defaultMetricFactory.New(NodeMetricCPUUsage)
 	NodeMemoryUsageMetric              = defaultMetricFactory.New(NodeMetricMemoryUsage)
 	NodeMemoryUsageWithPageCacheMetric = defaultMetricFactory.New(NodeMemoryWithPageCacheUsage)
+	NodeMemorySwapUsageMetric          = defaultMetricFactory.New(NodeMetricMemorySwapUsage)
+	NodeMemorySwapTotalMetric          = defaultMetricFactory.New(NodeMetricMemorySwapTotal)
 	NodeGPUCoreUsageMetric             = defaultMetricFactory.New(NodeMetricGPUCoreUsage).withPropertySchema(MetricPropertyGPUMinor, MetricPropertyGPUDeviceUUID)
 	NodeGPUMemUsageMetric              = defaultMetricFactory.New(NodeMetricGPUMemUsage).withPropertySchema(MetricPropertyGPUMinor, MetricPropertyGPUDeviceUUID)
 	NodeGPUMemTotalMetric              = defaultMetricFactory.New(NodeMetricGPUMemTotal).withPropertySchema(MetricPropertyGPUMinor, MetricPropertyGPUDeviceUUID)
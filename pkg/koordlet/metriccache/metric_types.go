@@ -23,9 +23,10 @@ import (
 )
 
 const (
-	NodeCPUInfoKey          = "node_cpu_info"
-	NodeNUMAInfoKey         = "node_numa_info"
-	NodeLocalStorageInfoKey = "node_local_storage_info"
+	NodeCPUInfoKey               = "node_cpu_info"
+	NodeNUMAInfoKey              = "node_numa_info"
+	NodeLocalStorageInfoKey      = "node_local_storage_info"
+	NodeEphemeralStorageUsageKey = "node_ephemeral_storage_usage"
 )
 
 const (
@@ -40,6 +41,8 @@ const (
 	NodeMetricCPUUsage           MetricKind = "node_cpu_usage"
 	NodeMetricMemoryUsage        MetricKind = "node_memory_usage"
 	NodeMemoryWithPageCacheUsage MetricKind = "node_memory_usage_with_page_cache"
+	NodeMetricMemorySwapUsage    MetricKind = "node_memory_swap_usage"
+	NodeMetricMemorySwapTotal    MetricKind = "node_memory_swap_total"
 	NodeMetricGPUCoreUsage       MetricKind = "node_gpu_core_usage"
 	NodeMetricGPUMemUsage        MetricKind = "node_gpu_memory_usage"
 	NodeMetricGPUMemTotal        MetricKind = "node_gpu_memory_total"
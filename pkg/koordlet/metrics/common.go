@@ -47,6 +47,12 @@ var (
 		Help:      "the count of CollectNodeLocalStorageInfo status",
 	}, []string{NodeKey, StatusKey})
 
+	CollectNodeEphemeralStorageStatus = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: KoordletSubsystem,
+		Name:      "collect_node_ephemeral_storage_status",
+		Help:      "the count of CollectNodeEphemeralStorage status",
+	}, []string{NodeKey, StatusKey})
+
 	PodEviction = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Subsystem: KoordletSubsystem,
 		Name:      "pod_eviction",
@@ -76,6 +82,7 @@ var (
 		CollectNodeCPUInfoStatus,
 		CollectNodeNUMAInfoStatus,
 		CollectNodeLocalStorageInfoStatus,
+		CollectNodeEphemeralStorageStatus,
 		PodEviction,
 		PodEvictionDetail.GetCounterVec(),
 		NodeUsedCPU,
@@ -126,6 +133,18 @@ func RecordCollectNodeLocalStorageInfoStatus(err error) {
 	CollectNodeLocalStorageInfoStatus.With(labels).Inc()
 }
 
+func RecordCollectNodeEphemeralStorageStatus(err error) {
+	labels := genNodeLabels()
+	if labels == nil {
+		return
+	}
+	labels[StatusKey] = StatusSucceed
+	if err != nil {
+		labels[StatusKey] = StatusFailed
+	}
+	CollectNodeEphemeralStorageStatus.With(labels).Inc()
+}
+
 func RecordPodEviction(namespace, podName, reasonType string) {
 	labels := genNodeLabels()
 	if labels == nil {
@@ -45,4 +45,6 @@ func init() {
 	internalMustRegister(KubeletStubCollector...)
 	internalMustRegister(RuntimeHookCollectors...)
 	internalMustRegister(HostApplicationCollectors...)
+	internalMustRegister(MemoryQOSCollectors...)
+	internalMustRegister(MemoryReclaimCollectors...)
 }
@@ -0,0 +1,49 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	MemoryQOSFieldKey = "field"
+)
+
+var (
+	MemoryQOSSupported = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: KoordletSubsystem,
+		Name:      "memory_qos_supported",
+		Help:      "Whether the memory qos field is supported by the node kernel, 1 for supported and 0 for unsupported.",
+	}, []string{NodeKey, MemoryQOSFieldKey, ResourceKey})
+
+	MemoryQOSCollectors = []prometheus.Collector{
+		MemoryQOSSupported,
+	}
+)
+
+func RecordMemoryQOSSupported(field, resource string, supported bool) {
+	labels := genNodeLabels()
+	if labels == nil {
+		return
+	}
+	labels[MemoryQOSFieldKey] = field
+	labels[ResourceKey] = resource
+	value := float64(0)
+	if supported {
+		value = 1
+	}
+	MemoryQOSSupported.With(labels).Set(value)
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	MemoryReclaimQoSKey = "qos"
+)
+
+var (
+	MemoryColdReclaimedBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: KoordletSubsystem,
+		Name:      "memory_cold_reclaimed_bytes",
+		Help:      "The total bytes of cold memory proactively reclaimed from the containers by koordlet",
+	}, []string{NodeKey, MemoryReclaimQoSKey})
+
+	MemoryReclaimCollectors = []prometheus.Collector{
+		MemoryColdReclaimedBytes,
+	}
+)
+
+func RecordMemoryColdReclaimedBytes(qos string, bytes int64) {
+	labels := genNodeLabels()
+	if labels == nil {
+		return
+	}
+	labels[MemoryReclaimQoSKey] = qos
+	MemoryColdReclaimedBytes.With(labels).Add(float64(bytes))
+}
@@ -136,6 +136,8 @@ func TestCommonCollectors(t *testing.T) {
 		RecordCollectNodeNUMAInfoStatus(nil)
 		RecordCollectNodeLocalStorageInfoStatus(testingErr)
 		RecordCollectNodeLocalStorageInfoStatus(nil)
+		RecordCollectNodeEphemeralStorageStatus(testingErr)
+		RecordCollectNodeEphemeralStorageStatus(nil)
 		RecordBESuppressCores("cfsQuota", float64(1000))
 		RecordBESuppressLSUsedCPU(1.0)
 		RecordBESuppressBEUsedCPU(1.0)
@@ -36,16 +36,18 @@ const (
 
 // TODO more ut is needed for this plugin
 type nodeInfoCollector struct {
-	collectInterval time.Duration
-	storage         metriccache.KVStorage
-	started         *atomic.Bool
+	collectInterval        time.Duration
+	cpuBenchmarkResultFile string
+	storage                metriccache.KVStorage
+	started                *atomic.Bool
 }
 
 func New(opt *framework.Options) framework.Collector {
 	return &nodeInfoCollector{
-		collectInterval: opt.Config.CollectNodeCPUInfoInterval,
-		storage:         opt.MetricCache,
-		started:         atomic.NewBool(false),
+		collectInterval:        opt.Config.CollectNodeCPUInfoInterval,
+		cpuBenchmarkResultFile: opt.Config.CPUBenchmarkResultFile,
+		storage:                opt.MetricCache,
+		started:                atomic.NewBool(false),
 	}
 }
 
@@ -96,6 +98,15 @@ func (n *nodeInfoCollector) collectNodeCPUInfo() error {
 		ProcessorInfos: localCPUInfo.ProcessorInfos,
 		TotalInfo:      localCPUInfo.TotalInfo,
 	}
+	if features.DefaultKoordletFeatureGate.Enabled(features.CPUBenchmarkReport) {
+		// the benchmark failure should not block the report of the other cpu info
+		score, err := koordletutil.GetCPUBenchmarkScore(n.cpuBenchmarkResultFile)
+		if err != nil {
+			klog.V(4).Infof("failed to get cpu benchmark score, err: %s", err)
+		} else {
+			nodeCPUInfo.BasicInfo.BenchmarkScore = score
+		}
+	}
 	klog.V(6).Infof("collect cpu info finished, info: %+v", nodeCPUInfo)
 
 	n.storage.Set(metriccache.NodeCPUInfoKey, nodeCPUInfo)
@@ -107,6 +107,18 @@ func (n *nodeResourceCollector) collectNodeResUsed() {
 	}
 	nodeMetrics = append(nodeMetrics, memUsageMetrics)
 
+	swapUsageMetrics, err := metriccache.NodeMemorySwapUsageMetric.GenerateSample(nil, collectTime, float64(memInfo.SwapUsageBytes()))
+	if err != nil {
+		klog.Warningf("generate node swap usage metrics failed, err %v", err)
+		return
+	}
+	swapTotalMetrics, err := metriccache.NodeMemorySwapTotalMetric.GenerateSample(nil, collectTime, float64(memInfo.SwapTotalBytes()))
+	if err != nil {
+		klog.Warningf("generate node swap total metrics failed, err %v", err)
+		return
+	}
+	nodeMetrics = append(nodeMetrics, swapUsageMetrics, swapTotalMetrics)
+
 	lastCPUStat := n.lastNodeCPUStat
 	n.lastNodeCPUStat = &framework.CPUStat{
 		CPUTick:   currentCPUTick,
@@ -35,31 +35,49 @@ const (
 )
 
 type nodeInfoCollector struct {
-	collectInterval time.Duration
-	storage         metriccache.KVStorage
-	started         *atomic.Bool
+	collectInterval                 time.Duration
+	collectEphemeralStorageInterval time.Duration
+	storage                         metriccache.KVStorage
+	localStorageStarted             *atomic.Bool
+	ephemeralStorageStarted         *atomic.Bool
 }
 
 func New(opt *framework.Options) framework.Collector {
 	return &nodeInfoCollector{
-		collectInterval: opt.Config.CollectNodeStorageInfoInterval,
-		storage:         opt.MetricCache,
-		started:         atomic.NewBool(false),
+		collectInterval:                 opt.Config.CollectNodeStorageInfoInterval,
+		collectEphemeralStorageInterval: opt.Config.CollectEphemeralStorageInterval,
+		storage:                         opt.MetricCache,
+		localStorageStarted:             atomic.NewBool(false),
+		ephemeralStorageStarted:         atomic.NewBool(false),
 	}
 }
 
 func (n *nodeInfoCollector) Enabled() bool {
-	return features.DefaultKoordletFeatureGate.Enabled(features.BlkIOReconcile)
+	return isLocalStorageInfoEnabled() || isEphemeralStorageEnabled()
 }
 
 func (n *nodeInfoCollector) Setup(s *framework.Context) {}
 
 func (n *nodeInfoCollector) Run(stopCh <-chan struct{}) {
-	go wait.Until(n.collectNodeLocalStorageInfo, n.collectInterval, stopCh)
+	if isLocalStorageInfoEnabled() {
+		go wait.Until(n.collectNodeLocalStorageInfo, n.collectInterval, stopCh)
+	}
+	if isEphemeralStorageEnabled() {
+		go wait.Until(n.collectEphemeralStorageUsage, n.collectEphemeralStorageInterval, stopCh)
+	}
 }
 
 func (n *nodeInfoCollector) Started() bool {
-	return n.started.Load()
+	return (!isLocalStorageInfoEnabled() || n.localStorageStarted.Load()) &&
+		(!isEphemeralStorageEnabled() || n.ephemeralStorageStarted.Load())
+}
+
+func isLocalStorageInfoEnabled() bool {
+	return features.DefaultKoordletFeatureGate.Enabled(features.BlkIOReconcile)
+}
+
+func isEphemeralStorageEnabled() bool {
+	return features.DefaultKoordletFeatureGate.Enabled(features.EphemeralStorageCollector)
 }
 
 func (n *nodeInfoCollector) collectNodeLocalStorageInfo() {
@@ -82,6 +100,25 @@ func (n *nodeInfoCollector) collectNodeLocalStorageInfo() {
 
 	klog.V(6).Infof("collect node local storage info finished, nodeCPUInfo %v", localStorageInfo)
 	n.storage.Set(metriccache.NodeLocalStorageInfoKey, nodeLocalStorageInfo)
-	n.started.Store(true)
+	n.localStorageStarted.Store(true)
 	metrics.RecordCollectNodeLocalStorageInfoStatus(nil)
 }
+
+// collectEphemeralStorageUsage collects the usages of the node ephemeral storage and the pods' local volumes.
+func (n *nodeInfoCollector) collectEphemeralStorageUsage() {
+	klog.V(6).Info("start collect node ephemeral storage usage")
+
+	ephemeralStorageUsage, err := koordletutil.GetEphemeralStorageUsage()
+	if err != nil {
+		klog.Warningf("failed to collect node ephemeral storage usage, err: %s", err)
+		metrics.RecordCollectNodeEphemeralStorageStatus(err)
+		return
+	}
+
+	nodeEphemeralStorageUsage := metriccache.NodeEphemeralStorageUsage(*ephemeralStorageUsage)
+	klog.V(6).Infof("collect node ephemeral storage usage finished, capacity %v, used %v, pods %v",
+		ephemeralStorageUsage.Capacity, ephemeralStorageUsage.Used, len(ephemeralStorageUsage.PodsUsed))
+	n.storage.Set(metriccache.NodeEphemeralStorageUsageKey, &nodeEphemeralStorageUsage)
+	n.ephemeralStorageStarted.Store(true)
+	metrics.RecordCollectNodeEphemeralStorageStatus(nil)
+}
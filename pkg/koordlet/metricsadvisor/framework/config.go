@@ -31,6 +31,7 @@ type Config struct {
 	CollectSysMetricOutdatedInterval time.Duration
 	CollectNodeCPUInfoInterval       time.Duration
 	CollectNodeStorageInfoInterval   time.Duration
+	CollectEphemeralStorageInterval  time.Duration
 	CPICollectorInterval             time.Duration
 	PSICollectorInterval             time.Duration
 	CPICollectorTimeWindow           time.Duration
@@ -38,6 +39,7 @@ type Config struct {
 	ResctrlCollectorInterval         time.Duration
 	EnablePageCacheCollector         bool
 	EnableResctrlCollector           bool
+	CPUBenchmarkResultFile           string
 }
 
 func NewDefaultConfig() *Config {
@@ -46,6 +48,7 @@ func NewDefaultConfig() *Config {
 		CollectSysMetricOutdatedInterval: 10 * time.Second,
 		CollectNodeCPUInfoInterval:       60 * time.Second,
 		CollectNodeStorageInfoInterval:   1 * time.Second,
+		CollectEphemeralStorageInterval:  60 * time.Second,
 		CPICollectorInterval:             60 * time.Second,
 		PSICollectorInterval:             10 * time.Second,
 		CPICollectorTimeWindow:           10 * time.Second,
@@ -61,6 +64,7 @@ func (c *Config) InitFlags(fs *flag.FlagSet) {
 	fs.DurationVar(&c.CollectSysMetricOutdatedInterval, "collect-sys-metric-outdated-interval", c.CollectSysMetricOutdatedInterval, "Collecy system metrics outdated interval. Node or pods metrics whose timestamps are before the interval will be ignored.")
 	fs.DurationVar(&c.CollectNodeCPUInfoInterval, "collect-node-cpu-info-interval", c.CollectNodeCPUInfoInterval, "Collect node cpu info interval. Non-zero values should contain a corresponding time unit (e.g. 1s, 2m, 3h).")
 	fs.DurationVar(&c.CollectNodeStorageInfoInterval, "collect-node-storage-info-interval", c.CollectNodeStorageInfoInterval, "Collect node storage info interval. Non-zero values should contain a corresponding time unit (e.g. 1s, 2m, 3h).")
+	fs.DurationVar(&c.CollectEphemeralStorageInterval, "collect-ephemeral-storage-interval", c.CollectEphemeralStorageInterval, "Collect node and pod ephemeral storage usage interval. Non-zero values should contain a corresponding time unit (e.g. 1s, 2m, 3h).")
 	fs.DurationVar(&c.CPICollectorInterval, "cpi-collector-interval", c.CPICollectorInterval, "Collect cpi interval. Non-zero values should contain a corresponding time unit (e.g. 1s, 2m, 3h).")
 	fs.DurationVar(&c.PSICollectorInterval, "psi-collector-interval", c.PSICollectorInterval, "Collect psi interval. Non-zero values should contain a corresponding time unit (e.g. 1s, 2m, 3h).")
 	fs.DurationVar(&c.CPICollectorTimeWindow, "collect-cpi-timewindow", c.CPICollectorTimeWindow, "Collect cpi time window. Non-zero values should contain a corresponding time unit (e.g. 1s, 2m, 3h).")
@@ -68,4 +72,5 @@ func (c *Config) InitFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&c.EnablePageCacheCollector, "enable-pagecache-collector", c.EnablePageCacheCollector, "Enable cache collector of node, pods and containers")
 	fs.BoolVar(&c.EnableResctrlCollector, "enable-resctrl-collector", c.EnableResctrlCollector, "Enable RDT(resource director technology) collector for QoS groups (LSR/LS/BE)")
 	fs.DurationVar(&c.ResctrlCollectorInterval, "resctrl-collector-interval", c.ResctrlCollectorInterval, "Collect RDT metrics interval. Non-zero values should contain a corresponding time unit (e.g. 1s, 2m, 3h).")
+	fs.StringVar(&c.CPUBenchmarkResultFile, "cpu-benchmark-result-file", c.CPUBenchmarkResultFile, "The file of the cpu benchmark score produced by an external benchmark. If empty, the built-in benchmark is used to report the score.")
 }
@@ -30,6 +30,7 @@ func Test_NewDefaultConfig(t *testing.T) {
 		CollectSysMetricOutdatedInterval: 10 * time.Second,
 		CollectNodeCPUInfoInterval:       60 * time.Second,
 		CollectNodeStorageInfoInterval:   1 * time.Second,
+		CollectEphemeralStorageInterval:  60 * time.Second,
 		CPICollectorInterval:             60 * time.Second,
 		PSICollectorInterval:             10 * time.Second,
 		CPICollectorTimeWindow:           10 * time.Second,
@@ -48,11 +49,13 @@ func Test_InitFlags(t *testing.T) {
 		"--collect-sys-metric-outdated-interval=9s",
 		"--collect-node-cpu-info-interval=90s",
 		"--collect-node-storage-info-interval=4s",
+		"--collect-ephemeral-storage-interval=120s",
 		"--cpi-collector-interval=90s",
 		"--psi-collector-interval=5s",
 		"--collect-cpi-timewindow=15s",
 		"--coldpage-collector-interval=15s",
 		"--resctrl-collector-interval=90s",
+		"--cpu-benchmark-result-file=/etc/koordlet/cpu-benchmark",
 	}
 	fs := flag.NewFlagSet(cmdArgs[0], flag.ExitOnError)
 
@@ -61,11 +64,13 @@ func Test_InitFlags(t *testing.T) {
 		CollectSysMetricOutdatedInterval time.Duration
 		CollectNodeCPUInfoInterval       time.Duration
 		CollectNodeStorageInfoInterval   time.Duration
+		CollectEphemeralStorageInterval  time.Duration
 		CPICollectorInterval             time.Duration
 		PSICollectorInterval             time.Duration
 		CPICollectorTimeWindow           time.Duration
 		ColdPageCollectorInterval        time.Duration
 		ResctrlCollectorInterval         time.Duration
+		CPUBenchmarkResultFile           string
 	}
 	type args struct {
 		fs *flag.FlagSet
@@ -82,11 +87,13 @@ func Test_InitFlags(t *testing.T) {
 				CollectSysMetricOutdatedInterval: 9 * time.Second,
 				CollectNodeCPUInfoInterval:       90 * time.Second,
 				CollectNodeStorageInfoInterval:   4 * time.Second,
+				CollectEphemeralStorageInterval:  120 * time.Second,
 				CPICollectorInterval:             90 * time.Second,
 				PSICollectorInterval:             5 * time.Second,
 				CPICollectorTimeWindow:           15 * time.Second,
 				ColdPageCollectorInterval:        15 * time.Second,
 				ResctrlCollectorInterval:         90 * time.Second,
+				CPUBenchmarkResultFile:           "/etc/koordlet/cpu-benchmark",
 			},
 			args: args{fs: fs},
 		},
@@ -98,11 +105,13 @@ func Test_InitFlags(t *testing.T) {
 				CollectSysMetricOutdatedInterval: tt.fields.CollectSysMetricOutdatedInterval,
 				CollectNodeCPUInfoInterval:       tt.fields.CollectNodeCPUInfoInterval,
 				CollectNodeStorageInfoInterval:   tt.fields.CollectNodeStorageInfoInterval,
+				CollectEphemeralStorageInterval:  tt.fields.CollectEphemeralStorageInterval,
 				CPICollectorInterval:             tt.fields.CPICollectorInterval,
 				PSICollectorInterval:             tt.fields.PSICollectorInterval,
 				CPICollectorTimeWindow:           tt.fields.CPICollectorTimeWindow,
 				ColdPageCollectorInterval:        tt.fields.ColdPageCollectorInterval,
 				ResctrlCollectorInterval:         tt.fields.ResctrlCollectorInterval,
+				CPUBenchmarkResultFile:           tt.fields.CPUBenchmarkResultFile,
 			}
 			c := NewDefaultConfig()
 			c.InitFlags(tt.args.fs)
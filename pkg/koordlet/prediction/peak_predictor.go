@@ -45,6 +45,7 @@ const (
 // PredictorFactory is an interface for creating predictors of different types.
 type PredictorFactory interface {
 	New(predictorType PredictorType, context PredictorContext) Predictor
+	NewPeakPredictor() PeakPredictor
 }
 
 type Predictor interface {
@@ -53,6 +54,16 @@ type Predictor interface {
 	GetResult() (v1.ResourceList, error)
 }
 
+// PeakPredictor predicts the peak usages of the pods and the system components.
+// The peak is the p95 of CPU and the p98 of memory with the safety margin.
+type PeakPredictor interface {
+	// GetPodPeak returns the predicted peak of a non-batch pod. It returns an error if the prediction is not
+	// available, e.g. the pod is in the cold start.
+	GetPodPeak(pod *v1.Pod) (v1.ResourceList, error)
+	// GetSystemPeak returns the predicted peak of the system components.
+	GetSystemPeak() (v1.ResourceList, error)
+}
+
 type predictorFactory struct {
 	predictServer       PredictServer
 	coldStartDuration   time.Duration
@@ -100,6 +111,16 @@ func (f *predictorFactory) New(t PredictorType, context PredictorContext) Predic
 	}
 }
 
+// NewPeakPredictor creates a new instance of the PeakPredictor.
+func (f *predictorFactory) NewPeakPredictor() PeakPredictor {
+	return &peakPredictor{
+		predictServer:       f.predictServer,
+		coldStartDuration:   f.coldStartDuration,
+		safetyMarginPercent: f.safetyMarginPercent,
+		podFilterFn:         isPodPeakPredictable,
+	}
+}
+
 var _ Predictor = (*emptyPredictor)(nil)
 
 type emptyPredictor struct {
@@ -130,6 +151,20 @@ func (f *emptyPredictorFactory) New(t PredictorType, context PredictorContext) P
 	return &emptyPredictor{}
 }
 
+func (f *emptyPredictorFactory) NewPeakPredictor() PeakPredictor {
+	return &emptyPredictor{}
+}
+
+// GetPodPeak returns an error indicating that the predictor is empty.
+func (p *emptyPredictor) GetPodPeak(pod *v1.Pod) (v1.ResourceList, error) {
+	return nil, fmt.Errorf("empty pridictor")
+}
+
+// GetSystemPeak returns an error indicating that the predictor is empty.
+func (p *emptyPredictor) GetSystemPeak() (v1.ResourceList, error) {
+	return nil, fmt.Errorf("empty pridictor")
+}
+
 var _ Predictor = (*podReclaimablePredictor)(nil)
 
 // podReclaimablePredictor predicts the peak according to historical metrics of the pods.
@@ -396,6 +431,58 @@ func (m *minPredictor) GetResult() (v1.ResourceList, error) {
 	return minimal, nil
 }
 
+var _ PeakPredictor = (*peakPredictor)(nil)
+
+// peakPredictor predicts the peak according to historical metrics of each pod and the system components.
+type peakPredictor struct {
+	predictServer       PredictServer
+	coldStartDuration   time.Duration
+	safetyMarginPercent int
+	podFilterFn         func(pod *v1.Pod) bool // return true if the pod peak should be predicted
+}
+
+func (p *peakPredictor) GetPodPeak(pod *v1.Pod) (v1.ResourceList, error) {
+	if !p.podFilterFn(pod) {
+		return nil, fmt.Errorf("pod %s is not predictable", util.GetPodKey(pod))
+	}
+	// the prediction of the pods in cold start is inaccurate
+	if time.Since(pod.CreationTimestamp.Time) <= p.coldStartDuration {
+		return nil, fmt.Errorf("pod %s is in cold start", util.GetPodKey(pod))
+	}
+	if pod.DeletionTimestamp != nil || util.IsPodTerminated(pod) {
+		return nil, fmt.Errorf("pod %s is terminating or terminated", util.GetPodKey(pod))
+	}
+
+	result, err := p.predictServer.GetPrediction(MetricDesc{UID: UIDType(pod.UID)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prediction for pod %s, err: %w", util.GetPodKey(pod), err)
+	}
+	return p.getPeakWithSafetyMargin(result), nil
+}
+
+func (p *peakPredictor) GetSystemPeak() (v1.ResourceList, error) {
+	result, err := p.predictServer.GetPrediction(MetricDesc{UID: getNodeItemUID(SystemItemID)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prediction of sys, err: %w", err)
+	}
+	return p.getPeakWithSafetyMargin(result), nil
+}
+
+func (p *peakPredictor) getPeakWithSafetyMargin(result Result) v1.ResourceList {
+	ratioAfterSafetyMargin := float64(100+p.safetyMarginPercent) / 100
+	p95Resources := result.Data["p95"]
+	p98Resources := result.Data["p98"]
+	return v1.ResourceList{
+		v1.ResourceCPU:    util.MultiplyMilliQuant(*p95Resources.Cpu(), ratioAfterSafetyMargin),
+		v1.ResourceMemory: util.MultiplyQuant(*p98Resources.Memory(), ratioAfterSafetyMargin),
+	}
+}
+
+func isPodPeakPredictable(pod *v1.Pod) bool {
+	priorityClass := extension.GetPodPriorityClassWithDefault(pod)
+	return priorityClass != extension.PriorityBatch && priorityClass != extension.PriorityFree
+}
+
 func isPodReclaimableForProd(pod *v1.Pod) bool {
 	priorityClass := extension.GetPodPriorityClassWithDefault(pod)
 	return isPriorityClassReclaimableForProd(priorityClass)
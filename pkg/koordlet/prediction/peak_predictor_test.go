@@ -17,6 +17,7 @@ limitations under the License.
 package prediction
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -599,3 +600,89 @@ func Test_priorityReclaimablePredictor(t *testing.T) {
 		})
 	}
 }
+
+func TestPeakPredictor(t *testing.T) {
+	prodPriority := extension.PriorityProdValueMin
+	batchPriority := extension.PriorityBatchValueMin
+	podPrediction := Result{
+		Data: map[string]v1.ResourceList{
+			"p95": {
+				v1.ResourceCPU:    *resource.NewMilliQuantity(500, resource.DecimalSI),
+				v1.ResourceMemory: *resource.NewQuantity(768*1024*1024, resource.BinarySI),
+			},
+			"p98": {
+				v1.ResourceCPU:    *resource.NewMilliQuantity(1000, resource.DecimalSI),
+				v1.ResourceMemory: *resource.NewQuantity(1024*1024*1024, resource.BinarySI),
+			},
+		},
+	}
+	sysPrediction := Result{
+		Data: map[string]v1.ResourceList{
+			"p95": {
+				v1.ResourceCPU:    *resource.NewMilliQuantity(300, resource.DecimalSI),
+				v1.ResourceMemory: *resource.NewQuantity(256*1024*1024, resource.BinarySI),
+			},
+			"p98": {
+				v1.ResourceCPU:    *resource.NewMilliQuantity(500, resource.DecimalSI),
+				v1.ResourceMemory: *resource.NewQuantity(512*1024*1024, resource.BinarySI),
+			},
+		},
+	}
+	podProd := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			UID:               "pod-prod",
+			Name:              "pod-prod",
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
+		},
+		Spec: v1.PodSpec{
+			Priority: &prodPriority,
+		},
+	}
+	podColdStart := podProd.DeepCopy()
+	podColdStart.UID = "pod-cold-start"
+	podColdStart.CreationTimestamp = metav1.Time{Time: time.Now().Add(-time.Minute)}
+	podBatch := podProd.DeepCopy()
+	podBatch.UID = "pod-batch"
+	podBatch.Spec.Priority = &batchPriority
+	podNoPrediction := podProd.DeepCopy()
+	podNoPrediction.UID = "pod-no-prediction"
+
+	predictServer := &mockPredictServer{
+		ResultMap: map[UIDType]Result{
+			getNodeItemUID(SystemItemID): sysPrediction,
+			UIDType(podProd.UID):         podPrediction,
+			UIDType(podColdStart.UID):    podPrediction,
+			UIDType(podBatch.UID):        podPrediction,
+		},
+		DefaultErr: fmt.Errorf("not found"),
+	}
+	predictor := NewPredictorFactory(predictServer, time.Hour, 10).NewPeakPredictor()
+	podPeakMemory := 1.1 * 1024 * 1024 * 1024
+	sysPeakMemory := 1.1 * 512 * 1024 * 1024
+
+	got, err := predictor.GetPodPeak(podProd)
+	assert.NoError(t, err)
+	assert.True(t, quotav1.Equals(v1.ResourceList{
+		v1.ResourceCPU:    *resource.NewMilliQuantity(550, resource.DecimalSI),
+		v1.ResourceMemory: *resource.NewQuantity(int64(podPeakMemory), resource.BinarySI),
+	}, got), got)
+	_, err = predictor.GetPodPeak(podColdStart)
+	assert.Error(t, err)
+	_, err = predictor.GetPodPeak(podBatch)
+	assert.Error(t, err)
+	_, err = predictor.GetPodPeak(podNoPrediction)
+	assert.Error(t, err)
+
+	got, err = predictor.GetSystemPeak()
+	assert.NoError(t, err)
+	assert.True(t, quotav1.Equals(v1.ResourceList{
+		v1.ResourceCPU:    *resource.NewMilliQuantity(330, resource.DecimalSI),
+		v1.ResourceMemory: *resource.NewQuantity(int64(sysPeakMemory), resource.BinarySI),
+	}, got), got)
+
+	emptyPredictor := NewEmptyPredictorFactory().NewPeakPredictor()
+	_, err = emptyPredictor.GetPodPeak(podProd)
+	assert.Error(t, err)
+	_, err = emptyPredictor.GetSystemPeak()
+	assert.Error(t, err)
+}
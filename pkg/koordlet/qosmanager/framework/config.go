@@ -21,26 +21,36 @@ import (
 )
 
 type Config struct {
-	ReconcileIntervalSeconds   int
-	CPUSuppressIntervalSeconds int
-	CPUEvictIntervalSeconds    int
-	MemoryEvictIntervalSeconds int
-	MemoryEvictCoolTimeSeconds int
-	CPUEvictCoolTimeSeconds    int
-	OnlyEvictByAPI             bool
-	QOSExtensionCfg            *QOSExtensionConfig
+	ReconcileIntervalSeconds             int
+	CPUSuppressIntervalSeconds           int
+	CPUEvictIntervalSeconds              int
+	MemoryEvictIntervalSeconds           int
+	MemoryEvictCoolTimeSeconds           int
+	MemoryReclaimIntervalSeconds         int
+	CPUEvictCoolTimeSeconds              int
+	EphemeralStorageEvictIntervalSeconds int
+	EphemeralStorageEvictCoolTimeSeconds int
+	GPUEvictIntervalSeconds              int
+	GPUEvictCoolTimeSeconds              int
+	OnlyEvictByAPI                       bool
+	QOSExtensionCfg                      *QOSExtensionConfig
 }
 
 func NewDefaultConfig() *Config {
 	return &Config{
-		ReconcileIntervalSeconds:   1,
-		CPUSuppressIntervalSeconds: 1,
-		CPUEvictIntervalSeconds:    1,
-		MemoryEvictIntervalSeconds: 1,
-		MemoryEvictCoolTimeSeconds: 4,
-		CPUEvictCoolTimeSeconds:    20,
-		OnlyEvictByAPI:             false,
-		QOSExtensionCfg:            &QOSExtensionConfig{FeatureGates: map[string]bool{}},
+		ReconcileIntervalSeconds:             1,
+		CPUSuppressIntervalSeconds:           1,
+		CPUEvictIntervalSeconds:              1,
+		MemoryEvictIntervalSeconds:           1,
+		MemoryEvictCoolTimeSeconds:           4,
+		MemoryReclaimIntervalSeconds:         10,
+		CPUEvictCoolTimeSeconds:              20,
+		EphemeralStorageEvictIntervalSeconds: 10,
+		EphemeralStorageEvictCoolTimeSeconds: 60,
+		GPUEvictIntervalSeconds:              1,
+		GPUEvictCoolTimeSeconds:              20,
+		OnlyEvictByAPI:                       false,
+		QOSExtensionCfg:                      &QOSExtensionConfig{FeatureGates: map[string]bool{}},
 	}
 }
 
@@ -50,7 +60,12 @@ func (c *Config) InitFlags(fs *flag.FlagSet) {
 	fs.IntVar(&c.CPUEvictIntervalSeconds, "cpu-evict-interval-seconds", c.CPUEvictIntervalSeconds, "evict be pod(cpu) interval by seconds")
 	fs.IntVar(&c.MemoryEvictIntervalSeconds, "memory-evict-interval-seconds", c.MemoryEvictIntervalSeconds, "evict be pod(memory) interval by seconds")
 	fs.IntVar(&c.MemoryEvictCoolTimeSeconds, "memory-evict-cool-time-seconds", c.MemoryEvictCoolTimeSeconds, "cooling time: memory next evict time should after lastEvictTime + MemoryEvictCoolTimeSeconds")
+	fs.IntVar(&c.MemoryReclaimIntervalSeconds, "memory-reclaim-interval-seconds", c.MemoryReclaimIntervalSeconds, "reclaim be pod cold memory interval by seconds")
 	fs.IntVar(&c.CPUEvictCoolTimeSeconds, "cpu-evict-cool-time-seconds", c.CPUEvictCoolTimeSeconds, "cooltime: CPU next evict time should after lastEvictTime + CPUEvictCoolTimeSeconds")
+	fs.IntVar(&c.EphemeralStorageEvictIntervalSeconds, "ephemeral-storage-evict-interval-seconds", c.EphemeralStorageEvictIntervalSeconds, "evict be pod(ephemeral storage) interval by seconds")
+	fs.IntVar(&c.EphemeralStorageEvictCoolTimeSeconds, "ephemeral-storage-evict-cool-time-seconds", c.EphemeralStorageEvictCoolTimeSeconds, "cooling time: ephemeral storage next evict time should after lastEvictTime + EphemeralStorageEvictCoolTimeSeconds, which should be longer than the collect interval of the ephemeral storage")
+	fs.IntVar(&c.GPUEvictIntervalSeconds, "gpu-evict-interval-seconds", c.GPUEvictIntervalSeconds, "evict be pod(gpu) interval by seconds")
+	fs.IntVar(&c.GPUEvictCoolTimeSeconds, "gpu-evict-cool-time-seconds", c.GPUEvictCoolTimeSeconds, "cooling time: gpu next evict time should after lastEvictTime + GPUEvictCoolTimeSeconds")
 	fs.BoolVar(&c.OnlyEvictByAPI, "only-evict-by-api", c.OnlyEvictByAPI, "only evict pod if call eviction api successed")
 	c.QOSExtensionCfg.InitFlags(fs)
 }
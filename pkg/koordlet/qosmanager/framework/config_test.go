@@ -25,14 +25,19 @@ import (
 
 func Test_NewDefaultConfig(t *testing.T) {
 	expectConfig := &Config{
-		ReconcileIntervalSeconds:   1,
-		CPUSuppressIntervalSeconds: 1,
-		CPUEvictIntervalSeconds:    1,
-		MemoryEvictIntervalSeconds: 1,
-		MemoryEvictCoolTimeSeconds: 4,
-		CPUEvictCoolTimeSeconds:    20,
-		OnlyEvictByAPI:             false,
-		QOSExtensionCfg:            &QOSExtensionConfig{FeatureGates: map[string]bool{}},
+		ReconcileIntervalSeconds:             1,
+		CPUSuppressIntervalSeconds:           1,
+		CPUEvictIntervalSeconds:              1,
+		MemoryEvictIntervalSeconds:           1,
+		MemoryEvictCoolTimeSeconds:           4,
+		MemoryReclaimIntervalSeconds:         10,
+		CPUEvictCoolTimeSeconds:              20,
+		EphemeralStorageEvictIntervalSeconds: 10,
+		EphemeralStorageEvictCoolTimeSeconds: 60,
+		GPUEvictIntervalSeconds:              1,
+		GPUEvictCoolTimeSeconds:              20,
+		OnlyEvictByAPI:                       false,
+		QOSExtensionCfg:                      &QOSExtensionConfig{FeatureGates: map[string]bool{}},
 	}
 	defaultConfig := NewDefaultConfig()
 	assert.Equal(t, expectConfig, defaultConfig)
@@ -46,21 +51,31 @@ func Test_InitFlags(t *testing.T) {
 		"--cpu-evict-interval-seconds=2",
 		"--memory-evict-interval-seconds=2",
 		"--memory-evict-cool-time-seconds=8",
+		"--memory-reclaim-interval-seconds=20",
 		"--cpu-evict-cool-time-seconds=40",
+		"--ephemeral-storage-evict-interval-seconds=20",
+		"--ephemeral-storage-evict-cool-time-seconds=120",
+		"--gpu-evict-interval-seconds=2",
+		"--gpu-evict-cool-time-seconds=40",
 		"--qos-extension-plugins=test-plugin=true",
 		"--only-evict-by-api=false",
 	}
 	fs := flag.NewFlagSet(cmdArgs[0], flag.ExitOnError)
 
 	type fields struct {
-		ReconcileIntervalSeconds   int
-		CPUSuppressIntervalSeconds int
-		CPUEvictIntervalSeconds    int
-		MemoryEvictIntervalSeconds int
-		MemoryEvictCoolTimeSeconds int
-		CPUEvictCoolTimeSeconds    int
-		OnlyEvictByAPI             bool
-		QOSExtensionCfg            *QOSExtensionConfig
+		ReconcileIntervalSeconds             int
+		CPUSuppressIntervalSeconds           int
+		CPUEvictIntervalSeconds              int
+		MemoryEvictIntervalSeconds           int
+		MemoryEvictCoolTimeSeconds           int
+		MemoryReclaimIntervalSeconds         int
+		CPUEvictCoolTimeSeconds              int
+		EphemeralStorageEvictIntervalSeconds int
+		EphemeralStorageEvictCoolTimeSeconds int
+		GPUEvictIntervalSeconds              int
+		GPUEvictCoolTimeSeconds              int
+		OnlyEvictByAPI                       bool
+		QOSExtensionCfg                      *QOSExtensionConfig
 	}
 	type args struct {
 		fs *flag.FlagSet
@@ -73,14 +88,19 @@ func Test_InitFlags(t *testing.T) {
 		{
 			name: "not default",
 			fields: fields{
-				ReconcileIntervalSeconds:   2,
-				CPUSuppressIntervalSeconds: 2,
-				CPUEvictIntervalSeconds:    2,
-				MemoryEvictIntervalSeconds: 2,
-				MemoryEvictCoolTimeSeconds: 8,
-				CPUEvictCoolTimeSeconds:    40,
-				OnlyEvictByAPI:             false,
-				QOSExtensionCfg:            &QOSExtensionConfig{FeatureGates: map[string]bool{"test-plugin": true}},
+				ReconcileIntervalSeconds:             2,
+				CPUSuppressIntervalSeconds:           2,
+				CPUEvictIntervalSeconds:              2,
+				MemoryEvictIntervalSeconds:           2,
+				MemoryEvictCoolTimeSeconds:           8,
+				MemoryReclaimIntervalSeconds:         20,
+				CPUEvictCoolTimeSeconds:              40,
+				EphemeralStorageEvictIntervalSeconds: 20,
+				EphemeralStorageEvictCoolTimeSeconds: 120,
+				GPUEvictIntervalSeconds:              2,
+				GPUEvictCoolTimeSeconds:              40,
+				OnlyEvictByAPI:                       false,
+				QOSExtensionCfg:                      &QOSExtensionConfig{FeatureGates: map[string]bool{"test-plugin": true}},
 			},
 			args: args{fs: fs},
 		},
@@ -88,14 +108,19 @@ func Test_InitFlags(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			raw := &Config{
-				ReconcileIntervalSeconds:   tt.fields.ReconcileIntervalSeconds,
-				CPUSuppressIntervalSeconds: tt.fields.CPUSuppressIntervalSeconds,
-				CPUEvictIntervalSeconds:    tt.fields.CPUEvictIntervalSeconds,
-				MemoryEvictIntervalSeconds: tt.fields.MemoryEvictIntervalSeconds,
-				MemoryEvictCoolTimeSeconds: tt.fields.MemoryEvictCoolTimeSeconds,
-				CPUEvictCoolTimeSeconds:    tt.fields.CPUEvictCoolTimeSeconds,
-				OnlyEvictByAPI:             tt.fields.OnlyEvictByAPI,
-				QOSExtensionCfg:            tt.fields.QOSExtensionCfg,
+				ReconcileIntervalSeconds:             tt.fields.ReconcileIntervalSeconds,
+				CPUSuppressIntervalSeconds:           tt.fields.CPUSuppressIntervalSeconds,
+				CPUEvictIntervalSeconds:              tt.fields.CPUEvictIntervalSeconds,
+				MemoryEvictIntervalSeconds:           tt.fields.MemoryEvictIntervalSeconds,
+				MemoryEvictCoolTimeSeconds:           tt.fields.MemoryEvictCoolTimeSeconds,
+				MemoryReclaimIntervalSeconds:         tt.fields.MemoryReclaimIntervalSeconds,
+				CPUEvictCoolTimeSeconds:              tt.fields.CPUEvictCoolTimeSeconds,
+				EphemeralStorageEvictIntervalSeconds: tt.fields.EphemeralStorageEvictIntervalSeconds,
+				EphemeralStorageEvictCoolTimeSeconds: tt.fields.EphemeralStorageEvictCoolTimeSeconds,
+				GPUEvictIntervalSeconds:              tt.fields.GPUEvictIntervalSeconds,
+				GPUEvictCoolTimeSeconds:              tt.fields.GPUEvictCoolTimeSeconds,
+				OnlyEvictByAPI:                       tt.fields.OnlyEvictByAPI,
+				QOSExtensionCfg:                      tt.fields.QOSExtensionCfg,
 			}
 			c := NewDefaultConfig()
 			c.InitFlags(tt.args.fs)
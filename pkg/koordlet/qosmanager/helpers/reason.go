@@ -27,6 +27,11 @@ const (
 	EvictPodByNodeMemoryUsage   = "EvictPodByNodeMemoryUsage"
 	EvictPodByBECPUSatisfaction = "EvictPodByBECPUSatisfaction"
 
+	EvictPodByNodeEphemeralStorageUsage = "EvictPodByNodeEphemeralStorageUsage"
+	EvictPodByEphemeralStorageLimit     = "EvictPodByEphemeralStorageLimit"
+
+	EvictPodByNodeGPUUsage = "EvictPodByNodeGPUUsage"
+
 	AdjustBEByNodeCPUUsage = "AdjustBEByNodeCPUUsage"
 
 	EvictPodSuccess = "evictPodSuccess"
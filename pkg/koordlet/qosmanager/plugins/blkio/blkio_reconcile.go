@@ -109,6 +109,7 @@ func (b *blkIOReconcile) reconcile() {
 	storageInfoRaw, exist := b.metricCache.Get(metriccache.NodeLocalStorageInfoKey)
 	if !exist {
 		klog.Errorf("%s: fail to get node local storage info not exist", BlkIOReconcileName)
+		statesinformer.RecordNodeSLOFailed(BlkIOReconcileName, "node local storage info not exist")
 		return
 	}
 	storageInfo, ok := storageInfoRaw.(*metriccache.NodeLocalStorageInfo)
@@ -120,8 +121,11 @@ func (b *blkIOReconcile) reconcile() {
 	nodeSLO := b.statesInformer.GetNodeSLO()
 	if nodeSLO == nil || nodeSLO.Spec.ResourceQOSStrategy == nil {
 		klog.Errorf("%s: nodeSLO or resourceQOSStrategy is nil, skip reconcile blkio!", BlkIOReconcileName)
+		statesinformer.RecordNodeSLOSkipped(BlkIOReconcileName, "nodeSLO or resourceQOSStrategy is nil")
 		return
 	}
+	// the targets failed to update, the strategy is recorded as applied only if all targets succeed
+	var failedTargets []string
 
 	// update node blk qos by strategy defined in nodeslo
 	strategy := nodeSLO.Spec.ResourceQOSStrategy
@@ -155,6 +159,7 @@ func (b *blkIOReconcile) reconcile() {
 		)
 		if err != nil {
 			klog.Errorf("%s: fail to update be class blkio config: %s", BlkIOReconcileName, err.Error())
+			failedTargets = append(failedTargets, "be class")
 		} else {
 			klog.V(4).Infof("%s: reconcile be class blkio config finished", BlkIOReconcileName)
 		}
@@ -181,6 +186,7 @@ func (b *blkIOReconcile) reconcile() {
 		)
 		if err != nil {
 			klog.Errorf("%s: fail to update root class blkio config: %s", BlkIOReconcileName, err.Error())
+			failedTargets = append(failedTargets, "root class")
 		} else {
 			klog.V(4).Infof("%s: reconcile root class blkio config finished", BlkIOReconcileName)
 		}
@@ -203,6 +209,7 @@ func (b *blkIOReconcile) reconcile() {
 			if podVolumeResult != "" {
 				if podBlkIOQoS, err = parseBlkIOResult(podVolumeResult); err != nil {
 					klog.Errorf("%s: unmarshal pod annotation %v failed, error %v", BlkIOReconcileName, slov1alpha1.AnnotationPodBlkioQoS, err)
+					failedTargets = append(failedTargets, fmt.Sprintf("pod %s/%s", podMeta.Pod.Namespace, podMeta.Pod.Name))
 					continue
 				}
 			}
@@ -223,10 +230,17 @@ func (b *blkIOReconcile) reconcile() {
 		)
 		if err != nil {
 			klog.Errorf("%s: fail to update pod %s/%s blkio config: %s", BlkIOReconcileName, podMeta.Pod.Namespace, podMeta.Pod.Name, err.Error())
+			failedTargets = append(failedTargets, fmt.Sprintf("pod %s/%s", podMeta.Pod.Namespace, podMeta.Pod.Name))
 		} else {
 			klog.V(4).Infof("%s: reconcile pod %s/%s blkio config finished", BlkIOReconcileName, podMeta.Pod.Namespace, podMeta.Pod.Name)
 		}
 	}
+
+	if len(failedTargets) > 0 {
+		statesinformer.RecordNodeSLOFailed(BlkIOReconcileName, fmt.Sprintf("failed to update blkio config of %s", strings.Join(failedTargets, ", ")))
+		return
+	}
+	statesinformer.RecordNodeSLOApplied(BlkIOReconcileName, nodeSLO)
 }
 
 type blkioUpdater struct {
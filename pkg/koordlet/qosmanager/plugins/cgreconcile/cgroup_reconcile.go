@@ -17,12 +17,15 @@ limitations under the License.
 package cgreconcile
 
 import (
+	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/pointer"
 
@@ -30,6 +33,7 @@ import (
 	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
 	"github.com/koordinator-sh/koordinator/pkg/features"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/audit"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/metrics"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/framework"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/helpers"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
@@ -42,6 +46,9 @@ import (
 
 const (
 	CgroupReconcileName = "CgroupReconcile"
+
+	// reasonMemoryQOSUnsupported is the reason of the node event reporting the unsupported memory qos fields.
+	reasonMemoryQOSUnsupported = "MemoryQOSUnsupported"
 )
 
 var _ framework.QOSStrategy = &cgroupResourcesReconcile{}
@@ -50,6 +57,9 @@ type cgroupResourcesReconcile struct {
 	reconcileInterval time.Duration
 	statesInformer    statesinformer.StatesInformer
 	executor          resourceexecutor.ResourceUpdateExecutor
+	eventRecorder     record.EventRecorder
+	// memoryQOSCapability is detected once the kubepods cgroup is ready, and the unsupported interfaces are skipped.
+	memoryQOSCapability *system.MemoryQOSCapability
 }
 
 // cgroupResourceSummary summarizes values of cgroup resources to update; nil value means not to update
@@ -64,6 +74,23 @@ type cgroupResourceSummary struct {
 	memoryUsePriorityOom   *int64
 	memoryPriority         *int64
 	memoryOomKillGroup     *int64
+	memorySwapMax          *int64
+	memorySwapHigh         *int64
+}
+
+// memoryQOSFields maps the memory qos cgroup interfaces to the fields of the MemoryQOS config.
+var memoryQOSFields = map[system.ResourceType]string{
+	system.MemoryMinName:              "minLimitPercent",
+	system.MemoryLowName:              "lowLimitPercent",
+	system.MemoryHighName:             "throttlingPercent",
+	system.MemoryOomGroupName:         "oomKillGroup",
+	system.MemoryWmarkRatioName:       "wmarkRatio",
+	system.MemoryWmarkScaleFactorName: "wmarkScalePermill",
+	system.MemoryWmarkMinAdjName:      "wmarkMinAdj",
+	system.MemoryPriorityName:         "priority",
+	system.MemoryUsePriorityOomName:   "priorityEnable",
+	system.MemorySwapMaxName:          "swapLimitPercent",
+	system.MemorySwapHighName:         "swapThrottlingPercent",
 }
 
 type cgroupResourceUpdaterMeta struct {
@@ -77,6 +104,7 @@ func New(opt *framework.Options) framework.QOSStrategy {
 		reconcileInterval: time.Duration(opt.Config.ReconcileIntervalSeconds) * time.Second,
 		statesInformer:    opt.StatesInformer,
 		executor:          resourceexecutor.NewResourceUpdateExecutor(),
+		eventRecorder:     opt.EventRecorder,
 	}
 }
 
@@ -101,16 +129,69 @@ func (m *cgroupResourcesReconcile) reconcile() {
 	if nodeSLO == nil || nodeSLO.Spec.ResourceQOSStrategy == nil {
 		// do nothing if nodeSLO == nil || nodeSLO.Spec.ResourceQOSStrategy == nil
 		klog.Warningf("nodeSLO or nodeSLO.Spec.ResourceQOSStrategy is nil %v", util.DumpJSON(nodeSLO))
+		statesinformer.RecordNodeSLOSkipped(CgroupReconcileName, "nodeSLO or resourceQOSStrategy is nil")
 		return
 	}
 
+	m.detectMemoryQOSCapability()
+
 	// apply CgroupReconcile: calculate resources to update, and then update them by a leveled order to avoid dynamic
 	// resource overcommitment/leak
-	m.calculateAndUpdateResources(nodeSLO)
+	if err := m.calculateAndUpdateResources(nodeSLO); err != nil {
+		statesinformer.RecordNodeSLOFailed(CgroupReconcileName, err.Error())
+		return
+	}
+	statesinformer.RecordNodeSLOApplied(CgroupReconcileName, nodeSLO)
 	klog.V(5).Infof("finish reconciling Cgroups!")
 }
 
-func (m *cgroupResourcesReconcile) calculateAndUpdateResources(nodeSLO *slov1alpha1.NodeSLO) {
+// detectMemoryQOSCapability detects and reports which memory qos fields are unsupported by the node kernel.
+// e.g. the upstream kernel with cgroups-v2 supports `memory.min`, `memory.low`, `memory.high` and `memory.oom.group`,
+// while the wmark and priority fields are only supported by the Anolis OS.
+func (m *cgroupResourcesReconcile) detectMemoryQOSCapability() {
+	if m.memoryQOSCapability != nil {
+		return
+	}
+	capability, err := system.DetectMemoryQOSCapability()
+	if err != nil {
+		klog.V(4).Infof("failed to detect memory qos capability, err: %v", err)
+		return
+	}
+	m.memoryQOSCapability = capability
+
+	var unsupportedFields []string
+	for _, t := range system.MemoryQOSResources {
+		supported := capability.IsSupported(t)
+		metrics.RecordMemoryQOSSupported(memoryQOSFields[t], string(t), supported)
+		if !supported {
+			unsupportedFields = append(unsupportedFields, fmt.Sprintf("%s(%s): %s", memoryQOSFields[t], t, capability.Unsupported[t]))
+		}
+	}
+	if len(unsupportedFields) > 0 {
+		klog.Infof("memory qos fields are unsupported on the node with cgroups-v%d and will be skipped, %s",
+			capability.CgroupVersion, strings.Join(unsupportedFields, "; "))
+		m.recordMemoryQOSUnsupported(capability, unsupportedFields)
+	} else {
+		klog.Infof("memory qos fields are all supported on the node with cgroups-v%d", capability.CgroupVersion)
+	}
+}
+
+// recordMemoryQOSUnsupported reports the unsupported memory qos fields with a node event, so that the users can know
+// which configured fields take no effect without checking the koordlet logs.
+func (m *cgroupResourcesReconcile) recordMemoryQOSUnsupported(capability *system.MemoryQOSCapability, unsupportedFields []string) {
+	if m.eventRecorder == nil || m.statesInformer == nil {
+		return
+	}
+	node := m.statesInformer.GetNode()
+	if node == nil {
+		return
+	}
+	m.eventRecorder.Eventf(node, corev1.EventTypeWarning, reasonMemoryQOSUnsupported,
+		"memory qos fields are unsupported on the node with cgroups-v%d and will be skipped, %s",
+		capability.CgroupVersion, strings.Join(unsupportedFields, "; "))
+}
+
+func (m *cgroupResourcesReconcile) calculateAndUpdateResources(nodeSLO *slov1alpha1.NodeSLO) error {
 	// 1. sort cgroup resources by the owner level (qos, pod, container).
 	//    e.g. for hierarchical resources of memoryMin, when qos-level memoryMin increases, they should be updated from
 	//         the top to bottom; while resources should be updated from the bottom to top when qos-level memoryMin
@@ -118,12 +199,12 @@ func (m *cgroupResourcesReconcile) calculateAndUpdateResources(nodeSLO *slov1alp
 	// 2. update resources in level order
 	if m.statesInformer == nil {
 		klog.Errorf("failed to calculate cgroup resources, err: statesInformer uninitialized")
-		return
+		return fmt.Errorf("statesInformer uninitialized")
 	}
 	node := m.statesInformer.GetNode()
 	if node == nil || node.Status.Allocatable == nil {
 		klog.Errorf("failed to calculate resources, err: node is invalid: %v", util.DumpJSON(node))
-		return
+		return fmt.Errorf("node is invalid")
 	}
 	podMetas := m.statesInformer.GetAllPods()
 
@@ -135,12 +216,12 @@ func (m *cgroupResourcesReconcile) calculateAndUpdateResources(nodeSLO *slov1alp
 	// e.g. /kubepods.slice/memory.min, /kubepods.slice-podxxx/memory.min, /kubepods.slice-podxxx/docker-yyy/memory.min
 	leveledResources := [][]resourceexecutor.ResourceUpdater{qosResources, podResources, containerResources}
 	m.executor.LeveledUpdateBatch(leveledResources)
+	return nil
 }
 
 // calculateResources calculates qos-level, pod-level and container-level resources with nodeCfg and podMetas
 func (m *cgroupResourcesReconcile) calculateResources(nodeCfg *slov1alpha1.ResourceQOSStrategy, node *corev1.Node,
 	podMetas []*statesinformer.PodMeta) (qosLevelResources, podLevelResources, containerLevelResources []resourceexecutor.ResourceUpdater) {
-	// TODO: check anolis os version
 	qosSummary := map[corev1.PodQOSClass]*cgroupResourceSummary{
 		corev1.PodQOSGuaranteed: {},
 		corev1.PodQOSBurstable:  {},
@@ -204,7 +285,7 @@ func (m *cgroupResourcesReconcile) calculateQoSResources(summary *cgroupResource
 		summary.memoryOomKillGroup = qosCfg.MemoryQOS.OomKillGroup
 	}
 
-	return makeCgroupResources(qosDir, summary)
+	return m.makeSupportedCgroupResources(qosDir, summary)
 }
 
 func (m *cgroupResourcesReconcile) calculatePodAndContainerResources(podMeta *statesinformer.PodMeta, node *corev1.Node,
@@ -268,6 +349,19 @@ func (m *cgroupResourcesReconcile) calculatePodResources(pod *corev1.Pod, parent
 		if podCfg.MemoryQOS.LowLimitPercent != nil {
 			summary.memoryLow = pointer.Int64(memRequest * (*podCfg.MemoryQOS.LowLimitPercent) / 100)
 		}
+		// memory.swap.max, memory.swap.high: only bound the pod-level swap when the pod memory limit is set, while the
+		// containers without limits are bounded at the container level
+		if podCfg.MemoryQOS.SwapLimitPercent != nil {
+			var memLimit int64
+			if apiext.GetPodQoSClassRaw(pod) != apiext.QoSBE {
+				memLimit = getPodMemoryByteLimit(pod)
+			} else {
+				memLimit = util.GetPodBEMemoryByteLimit(pod)
+			}
+			if memLimit > 0 {
+				summary.memorySwapMax, summary.memorySwapHigh = calculateSwapLimits(memLimit, podCfg.MemoryQOS)
+			}
+		}
 		// values improved: memory.low is no less than memory.min
 		if summary.memoryMin != nil && summary.memoryLow != nil && *summary.memoryLow > 0 &&
 			*summary.memoryLow < *summary.memoryMin {
@@ -277,7 +371,7 @@ func (m *cgroupResourcesReconcile) calculatePodResources(pod *corev1.Pod, parent
 		}
 	}
 
-	return makeCgroupResources(parentDir, summary)
+	return m.makeSupportedCgroupResources(parentDir, summary)
 }
 
 func (m *cgroupResourcesReconcile) calculateContainerResources(container *corev1.Container, pod *corev1.Pod,
@@ -331,6 +425,14 @@ func (m *cgroupResourcesReconcile) calculateContainerResources(container *corev1
 				summary.memoryHigh = pointer.Int64(((memRequest + (nodeLimit-memRequest)*(*podCfg.MemoryQOS.ThrottlingPercent)/100) / system.PageSize) * system.PageSize)
 			}
 		}
+		// memory.swap.max, memory.swap.high: if container's limit not set, calculate with node memory allocatable
+		if podCfg.MemoryQOS.SwapLimitPercent != nil {
+			if memLimit > 0 {
+				summary.memorySwapMax, summary.memorySwapHigh = calculateSwapLimits(memLimit, podCfg.MemoryQOS)
+			} else {
+				summary.memorySwapMax, summary.memorySwapHigh = calculateSwapLimits(node.Status.Allocatable.Memory().Value(), podCfg.MemoryQOS)
+			}
+		}
 		// values improved: memory.low is no less than memory.min
 		if summary.memoryMin != nil && summary.memoryLow != nil && *summary.memoryLow > 0 &&
 			*summary.memoryLow < *summary.memoryMin {
@@ -347,7 +449,7 @@ func (m *cgroupResourcesReconcile) calculateContainerResources(container *corev1
 		}
 	}
 
-	return makeCgroupResources(parentDir, summary)
+	return m.makeSupportedCgroupResources(parentDir, summary)
 }
 
 // getMergedPodResourceQoS returns a merged ResourceQOS for the pod (i.e. a pod-level qos config).
@@ -475,6 +577,61 @@ func completeCgroupSummaryForQoS(qosSummary map[corev1.PodQOSClass]*cgroupResour
 	}
 }
 
+// makeSupportedCgroupResources makes the resources without the memory qos interfaces unsupported by the node.
+func (m *cgroupResourcesReconcile) makeSupportedCgroupResources(parentDir string, summary *cgroupResourceSummary) []resourceexecutor.ResourceUpdater {
+	if m.memoryQOSCapability != nil {
+		for t, value := range map[system.ResourceType]**int64{
+			system.MemoryMinName:              &summary.memoryMin,
+			system.MemoryLowName:              &summary.memoryLow,
+			system.MemoryHighName:             &summary.memoryHigh,
+			system.MemoryOomGroupName:         &summary.memoryOomKillGroup,
+			system.MemoryWmarkRatioName:       &summary.memoryWmarkRatio,
+			system.MemoryWmarkScaleFactorName: &summary.memoryWmarkScaleFactor,
+			system.MemoryWmarkMinAdjName:      &summary.memoryWmarkMinAdj,
+			system.MemoryPriorityName:         &summary.memoryPriority,
+			system.MemoryUsePriorityOomName:   &summary.memoryUsePriorityOom,
+			system.MemorySwapMaxName:          &summary.memorySwapMax,
+			system.MemorySwapHighName:         &summary.memorySwapHigh,
+		} {
+			if *value != nil && !m.memoryQOSCapability.IsSupported(t) {
+				klog.V(6).Infof("skip unsupported memory qos resource %s [parentDir %s]", t, parentDir)
+				*value = nil
+			}
+		}
+	}
+	return makeCgroupResources(parentDir, summary)
+}
+
+// calculateSwapLimits calculates `memory.swap.max` and `memory.swap.high` with the memory limit.
+// `memory.swap.high` is left unset when the swap is disallowed, and reset to the system default if the throttling
+// factor is set as zero.
+func calculateSwapLimits(memLimit int64, cfg *slov1alpha1.MemoryQOSCfg) (swapMax, swapHigh *int64) {
+	swapMax = pointer.Int64((memLimit * (*cfg.SwapLimitPercent) / 100 / system.PageSize) * system.PageSize)
+	if *swapMax <= 0 || cfg.SwapThrottlingPercent == nil {
+		return swapMax, nil
+	}
+	if *cfg.SwapThrottlingPercent == 0 {
+		return swapMax, pointer.Int64(math.MaxInt64) // writing MaxInt64 is equal to write "max"
+	}
+	return swapMax, pointer.Int64((*swapMax * (*cfg.SwapThrottlingPercent) / 100 / system.PageSize) * system.PageSize)
+}
+
+// getPodMemoryByteLimit returns the sum of the containers' memory limits, or -1 if any container is unlimited.
+func getPodMemoryByteLimit(pod *corev1.Pod) int64 {
+	podMemoryByteLimit := int64(0)
+	for i := range pod.Spec.Containers {
+		containerMemByteLimit := util.GetContainerMemoryByteLimit(&pod.Spec.Containers[i])
+		if containerMemByteLimit <= 0 {
+			return -1
+		}
+		podMemoryByteLimit += containerMemByteLimit
+	}
+	if podMemoryByteLimit <= 0 {
+		return -1
+	}
+	return podMemoryByteLimit
+}
+
 func makeCgroupResources(parentDir string, summary *cgroupResourceSummary) []resourceexecutor.ResourceUpdater {
 	var resources []resourceexecutor.ResourceUpdater
 
@@ -521,6 +678,14 @@ func makeCgroupResources(parentDir string, summary *cgroupResourceSummary) []res
 			resourceType: system.MemoryOomGroupName,
 			value:        summary.memoryOomKillGroup,
 		},
+		{
+			resourceType: system.MemorySwapMaxName,
+			value:        summary.memorySwapMax,
+		},
+		{
+			resourceType: system.MemorySwapHighName,
+			value:        summary.memorySwapHigh,
+		},
 	} {
 		if t.value == nil {
 			continue
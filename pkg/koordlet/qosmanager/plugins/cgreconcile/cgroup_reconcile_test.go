@@ -1182,6 +1182,42 @@ func Test_makeCgroupResources(t *testing.T) {
 	}
 }
 
+func Test_makeSupportedCgroupResources(t *testing.T) {
+	helper := system.NewFileTestUtil(t)
+	defer helper.Cleanup()
+	parentDir := system.CgroupPathFormatter.ParentDir
+	helper.WriteCgroupFileContents(parentDir, system.MemoryMinV2, "0")
+	helper.WriteCgroupFileContents(parentDir, system.MemoryLowV2, "0")
+	helper.WriteCgroupFileContents(parentDir, system.MemoryHighV2, "max")
+	helper.WriteCgroupFileContents(parentDir, system.MemoryUsageV2, "1048576")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	si := mockstatesinformer.NewMockStatesInformer(ctrl)
+	si.EXPECT().GetNode().Return(testutil.MockTestNode("80", "120G")).AnyTimes()
+	recorder := &testutil.FakeRecorder{}
+	m := &cgroupResourcesReconcile{statesInformer: si, eventRecorder: recorder}
+	m.detectMemoryQOSCapability()
+	assert.NotNil(t, m.memoryQOSCapability)
+	assert.Equal(t, reasonMemoryQOSUnsupported, recorder.EventReason)
+	assert.False(t, m.memoryQOSCapability.IsSupported(system.MemoryWmarkRatioName))
+	assert.False(t, m.memoryQOSCapability.IsSupported(system.MemoryOomGroupName))
+
+	// the unsupported anolis-only fields are skipped
+	got := m.makeSupportedCgroupResources("pod1/container0", &cgroupResourceSummary{
+		memoryMin:              pointer.Int64(testingPodMemRequestLimitBytes),
+		memoryHigh:             pointer.Int64(testingPodMemRequestLimitBytes * 80 / 100),
+		memoryWmarkRatio:       pointer.Int64(95),
+		memoryWmarkScaleFactor: pointer.Int64(20),
+		memoryOomKillGroup:     pointer.Int64(1),
+	})
+	want := []resourceexecutor.ResourceUpdater{
+		createCgroupResourceUpdater(t, system.MemoryMinName, "pod1/container0", strconv.FormatInt(testingPodMemRequestLimitBytes, 10), true),
+		createCgroupResourceUpdater(t, system.MemoryHighName, "pod1/container0", strconv.FormatInt(testingPodMemRequestLimitBytes*80/100, 10), true),
+	}
+	assertCgroupResourceEqual(t, want, got)
+}
+
 func newTestCgroupResourcesReconcile(opt *framework.Options) *cgroupResourcesReconcile {
 	return &cgroupResourcesReconcile{
 		reconcileInterval: time.Duration(opt.Config.ReconcileIntervalSeconds) * time.Second,
@@ -1436,6 +1472,81 @@ func (p *memoryQOSGreyCtrlPlugin) name() string {
 	return "memory-qos-test-plugin"
 }
 
+func Test_calculateSwapLimits(t *testing.T) {
+	tests := []struct {
+		name         string
+		memLimit     int64
+		cfg          *slov1alpha1.MemoryQOSCfg
+		wantSwapMax  *int64
+		wantSwapHigh *int64
+	}{
+		{
+			name:     "disallow swap",
+			memLimit: 4 * 1024 * 1024 * 1024,
+			cfg: &slov1alpha1.MemoryQOSCfg{
+				MemoryQOS: slov1alpha1.MemoryQOS{
+					SwapLimitPercent:      pointer.Int64(0),
+					SwapThrottlingPercent: pointer.Int64(80),
+				},
+			},
+			wantSwapMax: pointer.Int64(0),
+		},
+		{
+			name:     "swap limit without throttling",
+			memLimit: 4 * 1024 * 1024 * 1024,
+			cfg: &slov1alpha1.MemoryQOSCfg{
+				MemoryQOS: slov1alpha1.MemoryQOS{
+					SwapLimitPercent: pointer.Int64(50),
+				},
+			},
+			wantSwapMax: pointer.Int64(2 * 1024 * 1024 * 1024),
+		},
+		{
+			name:     "swap limit with throttling",
+			memLimit: 4 * 1024 * 1024 * 1024,
+			cfg: &slov1alpha1.MemoryQOSCfg{
+				MemoryQOS: slov1alpha1.MemoryQOS{
+					SwapLimitPercent:      pointer.Int64(50),
+					SwapThrottlingPercent: pointer.Int64(50),
+				},
+			},
+			wantSwapMax:  pointer.Int64(2 * 1024 * 1024 * 1024),
+			wantSwapHigh: pointer.Int64(1024 * 1024 * 1024),
+		},
+		{
+			name:     "reset swap throttling",
+			memLimit: 4 * 1024 * 1024 * 1024,
+			cfg: &slov1alpha1.MemoryQOSCfg{
+				MemoryQOS: slov1alpha1.MemoryQOS{
+					SwapLimitPercent:      pointer.Int64(50),
+					SwapThrottlingPercent: pointer.Int64(0),
+				},
+			},
+			wantSwapMax:  pointer.Int64(2 * 1024 * 1024 * 1024),
+			wantSwapHigh: pointer.Int64(math.MaxInt64),
+		},
+		{
+			name:     "align to page size",
+			memLimit: 100 * 1024 * 1024,
+			cfg: &slov1alpha1.MemoryQOSCfg{
+				MemoryQOS: slov1alpha1.MemoryQOS{
+					SwapLimitPercent:      pointer.Int64(33),
+					SwapThrottlingPercent: pointer.Int64(33),
+				},
+			},
+			wantSwapMax:  pointer.Int64(100 * 1024 * 1024 * 33 / 100 / system.PageSize * system.PageSize),
+			wantSwapHigh: pointer.Int64(100 * 1024 * 1024 * 33 / 100 / system.PageSize * system.PageSize * 33 / 100 / system.PageSize * system.PageSize),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSwapMax, gotSwapHigh := calculateSwapLimits(tt.memLimit, tt.cfg)
+			assert.Equal(t, tt.wantSwapMax, gotSwapMax)
+			assert.Equal(t, tt.wantSwapHigh, gotSwapHigh)
+		})
+	}
+}
+
 func TestCgroupResourcesReconcile_mergePodResourceQoSForMemoryQoS(t *testing.T) {
 	type args struct {
 		pod *corev1.Pod
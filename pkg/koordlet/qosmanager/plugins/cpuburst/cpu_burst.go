@@ -212,6 +212,7 @@ func (b *cpuBurst) start() {
 	nodeSLO := b.statesInformer.GetNodeSLO()
 	if nodeSLO == nil || nodeSLO.Spec.CPUBurstStrategy == nil {
 		klog.Warningf("cpu burst strategy config is nil, %+v", nodeSLO)
+		statesinformer.RecordNodeSLOSkipped(CPUBurstName, "nodeSLO or cpuBurstStrategy is nil")
 		return
 	}
 	b.nodeCPUBurstStrategy = nodeSLO.Spec.CPUBurstStrategy
@@ -252,6 +253,7 @@ func (b *cpuBurst) start() {
 		b.applyCFSQuotaBurst(cpuBurstCfg, podMeta, nodeState)
 	}
 	b.Recycle()
+	statesinformer.RecordNodeSLOApplied(CPUBurstName, nodeSLO)
 }
 
 // getNodeStateForBurst checks whether node share pool cpu usage beyonds the threshold
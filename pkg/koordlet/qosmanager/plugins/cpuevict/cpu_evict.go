@@ -89,6 +89,7 @@ type podEvictCPUInfo struct {
 	milliRequest   int64
 	milliUsedCores int64
 	cpuUsage       float64 // cpuUsage = milliUsedCores / milliRequest
+	guaranteed     bool    // whether the pod declares the batch min share
 	pod            *corev1.Pod
 }
 
@@ -98,14 +99,17 @@ func (c *cpuEvictor) cpuEvict() {
 	nodeSLO := c.statesInformer.GetNodeSLO()
 	if disabled, err := features.IsFeatureDisabled(nodeSLO, features.BECPUEvict); err != nil {
 		klog.Warningf("cpuEvict failed, cannot check the feature gate, err: %s", err)
+		statesinformer.RecordNodeSLOFailed(CPUEvictName, fmt.Sprintf("cannot check the feature gate, err: %s", err))
 		return
 	} else if disabled {
 		klog.V(4).Infof("cpuEvict skipped, nodeSLO disable the feature gate")
+		statesinformer.RecordNodeSLOSkipped(CPUEvictName, "feature disabled")
 		return
 	}
 
 	if time.Since(c.lastEvictTime) < c.evictCoolingInterval {
 		klog.V(4).Infof("skip CPU evict process, still in evict cool time")
+		statesinformer.RecordNodeSLOSkipped(CPUEvictName, "in evict cooling time")
 		return
 	}
 
@@ -118,16 +122,19 @@ func (c *cpuEvictor) cpuEvict() {
 	node := c.statesInformer.GetNode()
 	if node == nil {
 		klog.Warningf("cpuEvict failed, got nil node")
+		statesinformer.RecordNodeSLOFailed(CPUEvictName, "node is nil")
 		return
 	}
 
 	cpuCapacity := node.Status.Capacity.Cpu().Value()
 	if cpuCapacity <= 0 {
 		klog.Warningf("cpuEvict failed, node cpuCapacity not valid,value: %d", cpuCapacity)
+		statesinformer.RecordNodeSLOFailed(CPUEvictName, fmt.Sprintf("node cpu capacity %d is invalid", cpuCapacity))
 		return
 	}
 
 	c.evictByResourceSatisfaction(node, thresholdConfig, windowSeconds)
+	statesinformer.RecordNodeSLOApplied(CPUEvictName, nodeSLO)
 	klog.V(5).Info("cpu evict process finished.")
 }
 
@@ -355,12 +362,20 @@ func (c *cpuEvictor) getPodEvictInfoAndSort() []*podEvictCPUInfo {
 			if bePodInfo.milliRequest > 0 {
 				bePodInfo.cpuUsage = float64(bePodInfo.milliUsedCores) / float64(bePodInfo.milliRequest)
 			}
+			if features.DefaultKoordletFeatureGate.Enabled(features.BEMinShare) {
+				minShare, err := apiext.GetBatchMinSharePercent(pod.Labels)
+				bePodInfo.guaranteed = err == nil && minShare > 0
+			}
 
 			bePodInfos = append(bePodInfos, bePodInfo)
 		}
 	}
 
 	sort.Slice(bePodInfos, func(i, j int) bool {
+		// evict the pods without the guaranteed min share first
+		if bePodInfos[i].guaranteed != bePodInfos[j].guaranteed {
+			return !bePodInfos[i].guaranteed
+		}
 		if bePodInfos[i].pod.Spec.Priority == nil || bePodInfos[j].pod.Spec.Priority == nil ||
 			*bePodInfos[i].pod.Spec.Priority == *bePodInfos[j].pod.Spec.Priority {
 			return bePodInfos[i].cpuUsage > bePodInfos[j].cpuUsage
@@ -36,6 +36,7 @@ import (
 
 	apiext "github.com/koordinator-sh/koordinator/apis/extension"
 	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/features"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/metriccache"
 	mock_metriccache "github.com/koordinator-sh/koordinator/pkg/koordlet/metriccache/mockmetriccache"
 	maframework "github.com/koordinator-sh/koordinator/pkg/koordlet/metricsadvisor/framework"
@@ -432,12 +433,15 @@ func Test_getPodEvictInfoAndSort(t *testing.T) {
 		CPURequest   resource.Quantity // sum(extendResources_Cpu:request) by all qos:BE pod
 	}
 
+	minSharePod := mockBEPodForCPUEvict("pod_be_3_priority10", 16*1000, 10)
+	minSharePod.Labels[apiext.LabelBatchMinSharePercent] = "30"
 	tests := []struct {
-		name       string
-		podMetrics []podMetricSample
-		pods       []*corev1.Pod
-		beMetric   BECPUResourceMetric
-		expect     []*podEvictCPUInfo
+		name           string
+		podMetrics     []podMetricSample
+		pods           []*corev1.Pod
+		beMetric       BECPUResourceMetric
+		enableMinShare bool
+		expect         []*podEvictCPUInfo
 	}{
 		{
 			name: "test_sort",
@@ -480,12 +484,57 @@ func Test_getPodEvictInfoAndSort(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test_sort_with_min_share",
+			podMetrics: []podMetricSample{
+				{UID: "pod_be_1_priority100", CPUUsed: 3},
+				{UID: "pod_be_2_priority100", CPUUsed: 4},
+				{UID: "pod_be_3_priority10", CPUUsed: 4},
+			},
+			pods: []*corev1.Pod{
+				mockBEPodForCPUEvict("pod_be_1_priority100", 16*1000, 100),
+				mockBEPodForCPUEvict("pod_be_2_priority100", 16*1000, 100),
+				minSharePod,
+			},
+			beMetric: BECPUResourceMetric{
+				CPUUsed:    *resource.NewMilliQuantity(11*1000, resource.DecimalSI),
+				CPURequest: *resource.NewMilliQuantity(48*1000, resource.DecimalSI),
+			},
+			enableMinShare: true,
+			expect: []*podEvictCPUInfo{
+				{
+					pod:            mockBEPodForCPUEvict("pod_be_2_priority100", 16*1000, 100),
+					milliRequest:   16 * 1000,
+					milliUsedCores: 4 * 1000,
+					cpuUsage:       float64(4*1000) / float64(16*1000),
+				},
+				{
+					pod:            mockBEPodForCPUEvict("pod_be_1_priority100", 16*1000, 100),
+					milliRequest:   16 * 1000,
+					milliUsedCores: 3 * 1000,
+					cpuUsage:       float64(3*1000) / float64(16*1000),
+				},
+				{
+					pod:            minSharePod,
+					milliRequest:   16 * 1000,
+					milliUsedCores: 4 * 1000,
+					cpuUsage:       float64(4*1000) / float64(16*1000),
+					guaranteed:     true,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctl := gomock.NewController(t)
 			defer ctl.Finish()
+			assert.NoError(t, features.DefaultMutableKoordletFeatureGate.SetFromMap(map[string]bool{
+				string(features.BEMinShare): tt.enableMinShare}))
+			defer func() {
+				assert.NoError(t, features.DefaultMutableKoordletFeatureGate.SetFromMap(map[string]bool{
+					string(features.BEMinShare): false}))
+			}()
 
 			mockStatesInformer := mock_statesinformer.NewMockStatesInformer(ctl)
 			mockStatesInformer.EXPECT().GetAllPods().Return(testutil.GetPodMetas(tt.pods)).AnyTimes()
@@ -517,6 +566,7 @@ func Test_getPodEvictInfoAndSort(t *testing.T) {
 				assert.Equal(t, fmt.Sprintf("%.2f", expectPodInfo.cpuUsage), fmt.Sprintf("%.2f", gotPodInfo.cpuUsage), "checkCpuUsage")
 				assert.Equal(t, expectPodInfo.milliRequest, gotPodInfo.milliRequest, "checkMilliRequest")
 				assert.Equal(t, expectPodInfo.milliUsedCores, gotPodInfo.milliUsedCores, "checkMilliUsedCores")
+				assert.Equal(t, expectPodInfo.guaranteed, gotPodInfo.guaranteed, "checkGuaranteed")
 			}
 		})
 	}
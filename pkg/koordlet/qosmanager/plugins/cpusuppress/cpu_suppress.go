@@ -42,6 +42,7 @@ import (
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer"
 	koordletutil "github.com/koordinator-sh/koordinator/pkg/koordlet/util"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/util/system"
+	"github.com/koordinator-sh/koordinator/pkg/util"
 	"github.com/koordinator-sh/koordinator/pkg/util/cpuset"
 )
 
@@ -54,6 +55,9 @@ const (
 	beMinCPUSetCores        = 2
 	beMinQuota              = 2000
 	beMaxIncreaseCPUPercent = 0.1 // scale up slow
+
+	// the total min share of the guaranteed BE pods is scaled down to it, so the others are not starved
+	beMaxTotalMinSharePercent = 90
 )
 
 type suppressPolicyStatus string
@@ -254,8 +258,13 @@ func (r *CPUSuppress) suppressBECPU() {
 
 	// Step 0.
 	nodeSLO := r.statesInformer.GetNodeSLO()
+	if features.DefaultKoordletFeatureGate.Enabled(features.BEMinShare) {
+		// the weights are kept no matter the suppression is enabled or not
+		r.adjustBEPodCPUSharesByMinShare()
+	}
 	if disabled, err := features.IsFeatureDisabled(nodeSLO, features.BECPUSuppress); err != nil {
 		klog.Warningf("suppressBECPU failed, cannot check the featuregate, err: %s", err)
+		statesinformer.RecordNodeSLOFailed(CPUSuppressName, fmt.Sprintf("cannot check the feature gate, err: %s", err))
 		return
 	} else if features.DefaultKoordletFeatureGate.Enabled(features.BECPUSuppress) &&
 		features.DefaultKoordletFeatureGate.Enabled(features.BECPUManager) {
@@ -263,11 +272,13 @@ func (r *CPUSuppress) suppressBECPU() {
 		r.recoverCPUSetForBECPUManager()
 		klog.V(5).Infof("suppressBECPU cannot work with BECPUManager together, suppress will be skipped, " +
 			"recover cpuset on all level if be pod does not specified numa node, and let be cpu set hook handle the others")
+		statesinformer.RecordNodeSLOSkipped(CPUSuppressName, "suppress is replaced by BECPUManager")
 		return
 	} else if disabled {
 		r.recoverCFSQuotaIfNeed()
 		r.recoverCPUSetIfNeed(koordletutil.ContainerCgroupPathRelativeDepth)
 		klog.V(5).Infof("suppressBECPU skipped, nodeSLO disable the featuregate")
+		statesinformer.RecordNodeSLOSkipped(CPUSuppressName, "feature disabled")
 		return
 	}
 
@@ -275,27 +286,32 @@ func (r *CPUSuppress) suppressBECPU() {
 	node := r.statesInformer.GetNode()
 	if node == nil {
 		klog.Warningf("suppressBECPU failed, got nil node")
+		statesinformer.RecordNodeSLOFailed(CPUSuppressName, "node is nil")
 		return
 	}
 	podMetas := r.statesInformer.GetAllPods()
 	if podMetas == nil || len(podMetas) <= 0 {
 		klog.Warningf("suppressBECPU failed, got empty pod metas %v", podMetas)
+		statesinformer.RecordNodeSLOFailed(CPUSuppressName, "pods are empty")
 		return
 	}
 
 	podMetrics := helpers.CollectAllPodMetricsLast(r.statesInformer, r.metricCache, metriccache.PodCPUUsageMetric, r.metricCollectInterval)
 	if podMetrics == nil {
 		klog.Warningf("suppressBECPU failed, got nil node metric or nil pod metrics, podMetrics %v", podMetrics)
+		statesinformer.RecordNodeSLOFailed(CPUSuppressName, "pod metrics are nil")
 		return
 	}
 	queryMeta, err := metriccache.NodeCPUUsageMetric.BuildQueryMeta(nil)
 	if err != nil {
 		klog.Warningf("build node query meta failed, error: %v", err)
+		statesinformer.RecordNodeSLOFailed(CPUSuppressName, fmt.Sprintf("failed to build node query meta, err: %s", err))
 		return
 	}
 	nodeCPUUsage, err := helpers.CollectorNodeMetricLast(r.metricCache, queryMeta, r.metricCollectInterval)
 	if err != nil {
 		klog.Warningf("query node cpu metrics failed, error: %v", err)
+		statesinformer.RecordNodeSLOFailed(CPUSuppressName, fmt.Sprintf("failed to query node cpu metrics, err: %s", err))
 		return
 	}
 	hostAppMetrics := helpers.CollectAllHostAppMetricsLast(nodeSLO.Spec.HostApplications, r.metricCache,
@@ -310,6 +326,7 @@ func (r *CPUSuppress) suppressBECPU() {
 	nodeCPUInfoRaw, exist := r.metricCache.Get(metriccache.NodeCPUInfoKey)
 	if !exist {
 		klog.Warning("suppressBECPU failed to get nodeCPUInfo from metriccache: not exist")
+		statesinformer.RecordNodeSLOFailed(CPUSuppressName, "node cpu info not exist")
 		return
 	}
 	nodeCPUInfo, ok := nodeCPUInfoRaw.(*metriccache.NodeCPUInfo)
@@ -317,22 +334,27 @@ func (r *CPUSuppress) suppressBECPU() {
 		klog.Fatalf("type error, expect %T， but got %T", metriccache.NodeCPUInfo{}, nodeCPUInfoRaw)
 	}
 	if nodeSLO.Spec.ResourceUsedThresholdWithBE.CPUSuppressPolicy == slov1alpha1.CPUCfsQuotaPolicy {
-		r.adjustByCfsQuota(suppressCPUQuantity, node)
+		err = r.adjustByCfsQuota(suppressCPUQuantity, node)
 		r.suppressPolicyStatuses[string(slov1alpha1.CPUCfsQuotaPolicy)] = policyUsing
 		r.recoverCPUSetIfNeed(koordletutil.ContainerCgroupPathRelativeDepth)
 	} else {
-		r.adjustByCPUSet(suppressCPUQuantity, nodeCPUInfo)
+		err = r.adjustByCPUSet(suppressCPUQuantity, nodeCPUInfo)
 		r.suppressPolicyStatuses[string(slov1alpha1.CPUSetPolicy)] = policyUsing
 		r.recoverCFSQuotaIfNeed()
 	}
+	if err != nil {
+		statesinformer.RecordNodeSLOFailed(CPUSuppressName, err.Error())
+		return
+	}
+	statesinformer.RecordNodeSLOApplied(CPUSuppressName, nodeSLO)
 }
 
-func (r *CPUSuppress) adjustByCPUSet(cpusetQuantity *resource.Quantity, nodeCPUInfo *metriccache.NodeCPUInfo) {
+func (r *CPUSuppress) adjustByCPUSet(cpusetQuantity *resource.Quantity, nodeCPUInfo *metriccache.NodeCPUInfo) error {
 	rootCgroupParentDir := koordletutil.GetPodQoSRelativePath(corev1.PodQOSBestEffort)
 	oldCPUS, err := r.cgroupReader.ReadCPUSet(rootCgroupParentDir)
 	if err != nil {
 		klog.Warningf("applyBESuppressPolicy failed to get current best-effort cgroup cpuset, err: %s", err)
-		return
+		return fmt.Errorf("failed to read best-effort cgroup cpuset, err: %w", err)
 	}
 	oldCPUSet := oldCPUS.ToInt32Slice()
 
@@ -360,7 +382,7 @@ func (r *CPUSuppress) adjustByCPUSet(cpusetQuantity *resource.Quantity, nodeCPUI
 	topo := r.statesInformer.GetNodeTopo()
 	if topo == nil {
 		klog.Errorf("node topo is nil")
-		return
+		return fmt.Errorf("node topo is nil")
 	}
 
 	var cpusetReserved cpuset.CPUSet
@@ -418,9 +440,10 @@ func (r *CPUSuppress) adjustByCPUSet(cpusetQuantity *resource.Quantity, nodeCPUI
 	err = r.applyBESuppressCPUSet(beCPUSet, oldCPUSet)
 	if err != nil {
 		klog.Warningf("suppressBECPU failed to apply be cpu suppress policy, err: %s", err)
-		return
+		return fmt.Errorf("failed to apply be cpuset, err: %w", err)
 	}
 	klog.Infof("suppressBECPU finished, suppress be cpu successfully: current cpuset %v", beCPUSet)
+	return nil
 }
 
 // recover cpuset path as be share pool for the following dirs:
@@ -593,7 +616,7 @@ func (r *CPUSuppress) calcBECPUSet() (*cpuset.CPUSet, error) {
 	return &beCPUSet, nil
 }
 
-func (r *CPUSuppress) adjustByCfsQuota(cpuQuantity *resource.Quantity, node *corev1.Node) {
+func (r *CPUSuppress) adjustByCfsQuota(cpuQuantity *resource.Quantity, node *corev1.Node) error {
 	newBeQuota := cpuQuantity.MilliValue() * system.DefaultCPUCFSPeriod / 1000
 	newBeQuota = int64(math.Max(float64(newBeQuota), float64(beMinQuota)))
 
@@ -602,7 +625,7 @@ func (r *CPUSuppress) adjustByCfsQuota(cpuQuantity *resource.Quantity, node *cor
 	currentBeQuota, err := r.cgroupReader.ReadCPUQuota(beCgroupPath)
 	if err != nil {
 		klog.Warningf("suppressBECPU fail:get currentBeQuota fail,error: %v", err)
-		return
+		return fmt.Errorf("failed to read best-effort cgroup cfs quota, err: %w", err)
 	}
 
 	minQuotaDelta := float64(node.Status.Capacity.Cpu().Value()) * float64(system.DefaultCPUCFSPeriod) * suppressBypassQuotaDeltaRatio
@@ -610,7 +633,7 @@ func (r *CPUSuppress) adjustByCfsQuota(cpuQuantity *resource.Quantity, node *cor
 	if math.Abs(float64(newBeQuota)-float64(currentBeQuota)) < minQuotaDelta && newBeQuota != beMinQuota {
 		klog.Infof("suppressBECPU: quota delta is too small, bypass suppress.reason: current quota: %d, target quota: %d, min quota delta: %f",
 			currentBeQuota, newBeQuota, minQuotaDelta)
-		return
+		return nil
 	}
 
 	beMaxIncreaseCPUQuota := float64(node.Status.Capacity.Cpu().Value()) * float64(system.DefaultCPUCFSPeriod) * beMaxIncreaseCPUPercent
@@ -622,16 +645,17 @@ func (r *CPUSuppress) adjustByCfsQuota(cpuQuantity *resource.Quantity, node *cor
 	updater, err := resourceexecutor.DefaultCgroupUpdaterFactory.New(system.CPUCFSQuotaName, beCgroupPath, strconv.FormatInt(newBeQuota, 10), eventHelper)
 	if err != nil {
 		klog.V(4).Infof("failed to get be cfs quota updater, err: %v", err)
-		return
+		return fmt.Errorf("failed to get be cfs quota updater, err: %w", err)
 	}
 	isUpdated, err := r.executor.Update(false, updater)
 	if err != nil {
 		klog.Errorf("suppressBECPU: failed to write cfs_quota_us for be pods, error: %v", err)
-		return
+		return fmt.Errorf("failed to write be cfs quota, err: %w", err)
 	}
 	metrics.RecordBESuppressCores(string(slov1alpha1.CPUCfsQuotaPolicy), float64(newBeQuota)/float64(system.DefaultCPUCFSPeriod))
 	_ = audit.V(1).Node().Reason(resourceexecutor.AdjustBEByNodeCPUUsage).Message("update BE group to cfs_quota: %v", newBeQuota).Do()
 	klog.Infof("suppressBECPU: succeeded to write cfs_quota_us for offline pods, isUpdated %v, new value: %d", isUpdated, newBeQuota)
+	return nil
 }
 
 func (r *CPUSuppress) recoverCFSQuotaIfNeed() {
@@ -656,6 +680,121 @@ func (r *CPUSuppress) recoverCFSQuotaIfNeed() {
 	r.suppressPolicyStatuses[string(slov1alpha1.CPUCfsQuotaPolicy)] = policyRecovered
 }
 
+// adjustBEPodCPUSharesByMinShare raises the cpu shares of the BE pods declaring the batch min share, so the suppressed
+// cpu of the BE pool is distributed by the weights instead of the batch cpu requests only.
+// The runtime hooks skip the pod cpu shares of these pods, so they are only updated here, and the shares of the
+// batch cpu requests are the lower bound.
+func (r *CPUSuppress) adjustBEPodCPUSharesByMinShare() {
+	podMetas := r.statesInformer.GetAllPods()
+	podShares := calculateBEPodCPUShares(podMetas)
+	if len(podShares) <= 0 {
+		return
+	}
+
+	var updaters []resourceexecutor.ResourceUpdater
+	for _, podMeta := range podMetas {
+		shares, ok := podShares[string(podMeta.Pod.UID)]
+		if !ok {
+			continue
+		}
+		eventHelper := audit.V(3).Pod(podMeta.Pod.Namespace, podMeta.Pod.Name).Reason(resourceexecutor.AdjustBEByNodeCPUUsage).
+			Message("update BE pod cpu shares by min share: %v", shares)
+		u, err := resourceexecutor.DefaultCgroupUpdaterFactory.New(system.CPUSharesName, podMeta.CgroupDir,
+			strconv.FormatInt(shares, 10), eventHelper)
+		if err != nil {
+			klog.V(4).Infof("failed to get cpu shares updater for pod %s, err: %s", podMeta.Key(), err)
+			continue
+		}
+		updaters = append(updaters, u)
+	}
+	r.executor.UpdateBatch(true, updaters...)
+	klog.V(5).Infof("suppressBECPU adjusted cpu shares for %d BE pods by min share", len(updaters))
+}
+
+// calculateBEPodCPUShares calculates the cpu shares of the BE pods declaring the batch min share. The other BE pods
+// keep the shares of their batch cpu requests. The shares are never lower than the shares of the batch cpu requests.
+// Shares[guaranteed] := max(Shares[request], MinShare * sum(Shares[request] of non-guaranteed) / (1 - sum(MinShare)))
+// If all BE pods are guaranteed, Shares[guaranteed] := max(Shares[request], MinShare * sum(Shares[request]) / sum(MinShare)).
+// It returns the shares indexed by the pod UID.
+func calculateBEPodCPUShares(podMetas []*statesinformer.PodMeta) map[string]int64 {
+	type podShareInfo struct {
+		uid           string
+		minShare      int64
+		requestShares int64
+	}
+	var guaranteedPods []podShareInfo
+	totalMinShare, nonGuaranteedShares, guaranteedShares := int64(0), int64(0), int64(0)
+	for _, podMeta := range podMetas {
+		if podMeta == nil || podMeta.Pod == nil || apiext.GetPodQoSClassRaw(podMeta.Pod) != apiext.QoSBE {
+			continue
+		}
+		milliRequest := int64(0)
+		for i := range podMeta.Pod.Spec.Containers {
+			if containerRequest := util.GetContainerBatchMilliCPURequest(&podMeta.Pod.Spec.Containers[i]); containerRequest > 0 {
+				milliRequest += containerRequest
+			}
+		}
+		requestShares := system.MilliCPUToShares(milliRequest)
+
+		minShare, err := apiext.GetBatchMinSharePercent(podMeta.Pod.Labels)
+		if err != nil {
+			klog.V(5).Infof("failed to get batch min share for pod %s, err: %s", podMeta.Key(), err)
+		}
+		if minShare <= 0 {
+			nonGuaranteedShares += requestShares
+			continue
+		}
+		guaranteedPods = append(guaranteedPods, podShareInfo{
+			uid:           string(podMeta.Pod.UID),
+			minShare:      minShare,
+			requestShares: requestShares,
+		})
+		totalMinShare += minShare
+		guaranteedShares += requestShares
+	}
+	if len(guaranteedPods) <= 0 {
+		return nil
+	}
+
+	podShares := make(map[string]int64, len(guaranteedPods))
+	if nonGuaranteedShares <= 0 {
+		for _, p := range guaranteedPods {
+			shares := guaranteedShares * p.minShare / totalMinShare
+			if shares < p.requestShares {
+				shares = p.requestShares
+			}
+			podShares[p.uid] = clampCPUShares(shares)
+		}
+		return podShares
+	}
+
+	// scale down the min shares to keep some cpu for the non-guaranteed pods
+	scaledTotalMinShare := totalMinShare
+	if scaledTotalMinShare > beMaxTotalMinSharePercent {
+		scaledTotalMinShare = beMaxTotalMinSharePercent
+	}
+	totalShares := float64(nonGuaranteedShares) * 100 / float64(100-scaledTotalMinShare)
+	for _, p := range guaranteedPods {
+		minShare := float64(p.minShare) * float64(scaledTotalMinShare) / float64(totalMinShare)
+		shares := int64(totalShares * minShare / 100)
+		if shares < p.requestShares {
+			shares = p.requestShares
+		}
+		podShares[p.uid] = clampCPUShares(shares)
+	}
+	return podShares
+}
+
+func clampCPUShares(shares int64) int64 {
+	if shares < system.CPUSharesMinValue {
+		return system.CPUSharesMinValue
+	}
+	if shares > system.CPUSharesMaxValue {
+		return system.CPUSharesMaxValue
+	}
+	return shares
+}
+
 // calculateBESuppressPolicy calculates the be cpu suppress policy with cpuset cpus number and node cpu info
 func calculateBESuppressCPUSetPolicy(cpus int32, processorInfos []koordletutil.ProcessorInfo) []int32 {
 	var CPUSets []int32
@@ -29,6 +29,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
 
 	apiext "github.com/koordinator-sh/koordinator/apis/extension"
@@ -39,6 +40,8 @@ import (
 	maframework "github.com/koordinator-sh/koordinator/pkg/koordlet/metricsadvisor/framework"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/framework"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/runtimehooks/hooks/batchresource"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/runtimehooks/protocol"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer"
 	mockstatesinformer "github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer/mockstatesinformer"
 	koordletutil "github.com/koordinator-sh/koordinator/pkg/koordlet/util"
@@ -2289,3 +2292,160 @@ func TestCPUSuppress_applyBESuppressCPUSet(t *testing.T) {
 		})
 	}
 }
+
+func mockBEPodMeta(name string, milliRequest int64, minShare string) *statesinformer.PodMeta {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-ns",
+			UID:       types.UID(name),
+			Labels: map[string]string{
+				apiext.LabelPodQoS: string(apiext.QoSBE),
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "main",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							apiext.BatchCPU: *resource.NewQuantity(milliRequest, resource.DecimalSI),
+						},
+					},
+				},
+			},
+		},
+	}
+	if minShare != "" {
+		pod.Labels[apiext.LabelBatchMinSharePercent] = minShare
+	}
+	return &statesinformer.PodMeta{
+		Pod:       pod,
+		CgroupDir: filepath.Join(koordletutil.GetPodQoSRelativePath(corev1.PodQOSBestEffort), name),
+	}
+}
+
+func Test_calculateBEPodCPUShares(t *testing.T) {
+	tests := []struct {
+		name     string
+		podMetas []*statesinformer.PodMeta
+		want     map[string]int64
+	}{
+		{
+			name: "no guaranteed pod",
+			podMetas: []*statesinformer.PodMeta{
+				mockBEPodMeta("be-0", 2000, ""),
+				mockBEPodMeta("be-1", 2000, "invalid"),
+				{Pod: mockLSRPod()},
+			},
+			want: nil,
+		},
+		{
+			name: "guaranteed pod gets the min share",
+			podMetas: []*statesinformer.PodMeta{
+				mockBEPodMeta("be-0", 2000, "30"),
+				mockBEPodMeta("be-1", 4000, ""),
+				mockBEPodMeta("be-2", 4000, ""),
+				{Pod: mockLSRPod()},
+			},
+			want: map[string]int64{
+				"be-0": 3510,
+			},
+		},
+		{
+			name: "guaranteed pod keeps the request shares",
+			podMetas: []*statesinformer.PodMeta{
+				mockBEPodMeta("be-0", 8000, "10"),
+				mockBEPodMeta("be-1", 4000, ""),
+			},
+			want: map[string]int64{
+				"be-0": 8192,
+			},
+		},
+		{
+			name: "scale down the total min share",
+			podMetas: []*statesinformer.PodMeta{
+				mockBEPodMeta("be-0", 1000, "60"),
+				mockBEPodMeta("be-1", 1000, "60"),
+				mockBEPodMeta("be-2", 1000, ""),
+			},
+			want: map[string]int64{
+				"be-0": 4608,
+				"be-1": 4608,
+			},
+		},
+		{
+			name: "all pods are guaranteed and the shares are only raised",
+			podMetas: []*statesinformer.PodMeta{
+				mockBEPodMeta("be-0", 1000, "20"),
+				mockBEPodMeta("be-1", 1000, "60"),
+			},
+			want: map[string]int64{
+				"be-0": 1024,
+				"be-1": 1536,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := calculateBEPodCPUShares(tt.podMetas)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_cpuSuppress_adjustBEPodCPUSharesByMinShare(t *testing.T) {
+	err := features.DefaultMutableKoordletFeatureGate.SetFromMap(map[string]bool{
+		string(features.BEMinShare):    true,
+		string(features.BECPUSuppress): true,
+	})
+	assert.NoError(t, err)
+	defer func() {
+		err = features.DefaultMutableKoordletFeatureGate.SetFromMap(map[string]bool{
+			string(features.BEMinShare):    false,
+			string(features.BECPUSuppress): true,
+		})
+		assert.NoError(t, err)
+	}()
+
+	helper := system.NewFileTestUtil(t)
+	podMetas := []*statesinformer.PodMeta{
+		mockBEPodMeta("be-0", 1000, "30"),
+		mockBEPodMeta("be-1", 4000, ""),
+	}
+	for _, podMeta := range podMetas {
+		helper.WriteCgroupFileContents(podMeta.CgroupDir, system.CPUShares, "2")
+	}
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	mockStatesInformer := mockstatesinformer.NewMockStatesInformer(ctl)
+	mockStatesInformer.EXPECT().GetAllPods().Return(podMetas).AnyTimes()
+	cpuSuppress := newTestCPUSuppress(&framework.Options{
+		StatesInformer:      mockStatesInformer,
+		Config:              framework.NewDefaultConfig(),
+		MetricAdvisorConfig: maframework.NewDefaultConfig(),
+	})
+	reconcilerExecutor := resourceexecutor.NewResourceUpdateExecutor()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	cpuSuppress.executor.Run(stopCh)
+	reconcilerExecutor.Run(stopCh)
+
+	// the batch resource reconciler and the suppress loop run in turns, and the cpu shares stay stable
+	batchResource := batchresource.Object()
+	reconcile := func() {
+		for _, podMeta := range podMetas {
+			podCtx := &protocol.PodContext{}
+			podCtx.FromReconciler(podMeta)
+			assert.NoError(t, batchResource.SetPodCPUShares(podCtx))
+			podCtx.ReconcilerDone(reconcilerExecutor)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		cpuSuppress.adjustBEPodCPUSharesByMinShare()
+		assert.Equal(t, "1755", helper.ReadCgroupFileContents(podMetas[0].CgroupDir, system.CPUShares))
+		reconcile()
+		assert.Equal(t, "1755", helper.ReadCgroupFileContents(podMetas[0].CgroupDir, system.CPUShares))
+		assert.Equal(t, "4096", helper.ReadCgroupFileContents(podMetas[1].CgroupDir, system.CPUShares))
+	}
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ephemeralstorageevict
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	"github.com/koordinator-sh/koordinator/pkg/features"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/metriccache"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/framework"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer"
+	"github.com/koordinator-sh/koordinator/pkg/util"
+)
+
+const (
+	EphemeralStorageEvictName = "ephemeralStorageEvict"
+
+	ephemeralStorageReleaseBufferPercent = 2
+)
+
+var _ framework.QOSStrategy = &ephemeralStorageEvictor{}
+
+// ephemeralStorageEvictor evicts the BE pods according to the ephemeral storage usages.
+// Since the batch-ephemeral-storage of the BE pods is not limited by the kubelet, it evicts:
+// 1. the BE pods whose ephemeral storage usages exceed their batch-ephemeral-storage limits.
+// 2. the BE pods in the order of the priorities and the usages when the node ephemeral storage is near full.
+// NOTE: The pods are always evicted via the eviction API, since the local volumes cannot be released by
+// killing the containers.
+type ephemeralStorageEvictor struct {
+	evictInterval        time.Duration
+	evictCoolingInterval time.Duration
+	statesInformer       statesinformer.StatesInformer
+	metricCache          metriccache.MetricCache
+	evictor              *framework.Evictor
+	lastEvictTime        time.Time
+}
+
+type podInfo struct {
+	pod   *corev1.Pod
+	used  int64
+	limit int64
+}
+
+func New(opt *framework.Options) framework.QOSStrategy {
+	return &ephemeralStorageEvictor{
+		evictInterval:        time.Duration(opt.Config.EphemeralStorageEvictIntervalSeconds) * time.Second,
+		evictCoolingInterval: time.Duration(opt.Config.EphemeralStorageEvictCoolTimeSeconds) * time.Second,
+		statesInformer:       opt.StatesInformer,
+		metricCache:          opt.MetricCache,
+	}
+}
+
+func (e *ephemeralStorageEvictor) Enabled() bool {
+	return features.DefaultKoordletFeatureGate.Enabled(features.BEEphemeralStorageEvict) &&
+		features.DefaultKoordletFeatureGate.Enabled(features.EphemeralStorageCollector) && e.evictInterval > 0
+}
+
+func (e *ephemeralStorageEvictor) Setup(ctx *framework.Context) {
+	e.evictor = ctx.Evictor
+}
+
+func (e *ephemeralStorageEvictor) Run(stopCh <-chan struct{}) {
+	go wait.Until(e.ephemeralStorageEvict, e.evictInterval, stopCh)
+}
+
+func (e *ephemeralStorageEvictor) ephemeralStorageEvict() {
+	klog.V(5).Infof("starting ephemeral storage evict process")
+	defer klog.V(5).Infof("ephemeral storage evict process completed")
+
+	if time.Now().Before(e.lastEvictTime.Add(e.evictCoolingInterval)) {
+		klog.V(5).Infof("skip ephemeral storage evict process, still in evict cooling time")
+		statesinformer.RecordNodeSLOSkipped(EphemeralStorageEvictName, "in evict cooling time")
+		return
+	}
+
+	nodeSLO := e.statesInformer.GetNodeSLO()
+	if disabled, err := features.IsFeatureDisabled(nodeSLO, features.BEEphemeralStorageEvict); err != nil {
+		klog.Errorf("failed to acquire ephemeral storage eviction feature-gate, error: %v", err)
+		statesinformer.RecordNodeSLOFailed(EphemeralStorageEvictName, fmt.Sprintf("cannot check the feature gate, err: %s", err))
+		return
+	} else if disabled {
+		klog.V(4).Infof("skip ephemeral storage evict, disabled in NodeSLO")
+		statesinformer.RecordNodeSLOSkipped(EphemeralStorageEvictName, "feature disabled")
+		return
+	}
+
+	node := e.statesInformer.GetNode()
+	if node == nil {
+		klog.Warningf("skip ephemeral storage evict, Node is nil")
+		statesinformer.RecordNodeSLOFailed(EphemeralStorageEvictName, "node is nil")
+		return
+	}
+	usage, err := e.getEphemeralStorageUsage()
+	if err != nil {
+		klog.Warningf("skip ephemeral storage evict, get ephemeral storage usage failed, error: %v", err)
+		statesinformer.RecordNodeSLOFailed(EphemeralStorageEvictName, fmt.Sprintf("failed to get ephemeral storage usage, err: %s", err))
+		return
+	}
+	bePodInfos := e.getBEPodInfos(usage)
+
+	evictedUIDs := e.evictPodsExceedingLimit(node, bePodInfos)
+
+	thresholdConfig := nodeSLO.Spec.ResourceUsedThresholdWithBE
+	needRelease := getNodeNeedRelease(usage, thresholdConfig.EphemeralStorageEvictThresholdPercent,
+		thresholdConfig.EphemeralStorageEvictLowerPercent)
+	if needRelease > 0 {
+		e.evictPodsByNodeUsage(node, bePodInfos, evictedUIDs, needRelease)
+	}
+
+	if len(evictedUIDs) > 0 {
+		e.lastEvictTime = time.Now()
+	}
+	statesinformer.RecordNodeSLOApplied(EphemeralStorageEvictName, nodeSLO)
+}
+
+func (e *ephemeralStorageEvictor) getEphemeralStorageUsage() (*metriccache.NodeEphemeralStorageUsage, error) {
+	value, exist := e.metricCache.Get(metriccache.NodeEphemeralStorageUsageKey)
+	if !exist {
+		return nil, fmt.Errorf("ephemeral storage usage not collected")
+	}
+	usage, ok := value.(*metriccache.NodeEphemeralStorageUsage)
+	if !ok || usage == nil {
+		return nil, fmt.Errorf("value type error, expect: %T, got %T", &metriccache.NodeEphemeralStorageUsage{}, value)
+	}
+	return usage, nil
+}
+
+// getNodeNeedRelease returns the bytes of ephemeral storage to release when the node usage exceeds the threshold
+// percent of the capacity, and the storage is released until the usage is under the lower percent.
+// It returns 0 if the threshold is not set or not exceeded.
+func getNodeNeedRelease(usage *metriccache.NodeEphemeralStorageUsage, thresholdPercent, lowerPercent *int64) int64 {
+	if thresholdPercent == nil {
+		klog.V(5).Infof("skip ephemeral storage evict by node usage, threshold percent is nil")
+		return 0
+	} else if *thresholdPercent <= 0 {
+		klog.Warningf("skip ephemeral storage evict by node usage, threshold percent(%v) should greater than 0", *thresholdPercent)
+		return 0
+	}
+	lower := *thresholdPercent - ephemeralStorageReleaseBufferPercent
+	if lowerPercent != nil {
+		lower = *lowerPercent
+	}
+	if lower >= *thresholdPercent {
+		klog.Warningf("skip ephemeral storage evict by node usage, lower percent(%v) should less than threshold percent(%v)",
+			lower, *thresholdPercent)
+		return 0
+	}
+	if usage.Capacity <= 0 {
+		klog.Warningf("skip ephemeral storage evict by node usage, capacity(%v) should greater than 0", usage.Capacity)
+		return 0
+	}
+
+	nodeUsage := usage.Used * 100 / usage.Capacity
+	if nodeUsage < *thresholdPercent {
+		klog.V(5).Infof("skip ephemeral storage evict by node usage, node usage(%v) is below threshold(%v)",
+			nodeUsage, *thresholdPercent)
+		return 0
+	}
+	klog.Infof("node EphemeralStorageUsage(%v): %.2f, evictThresholdUsage: %.2f, evictLowerUsage: %.2f",
+		usage.Used, float64(nodeUsage)/100, float64(*thresholdPercent)/100, float64(lower)/100)
+	return usage.Capacity * (nodeUsage - lower) / 100
+}
+
+// evictPodsExceedingLimit evicts the BE pods whose ephemeral storage usages exceed the limits.
+// It returns the UIDs of the evicted pods.
+func (e *ephemeralStorageEvictor) evictPodsExceedingLimit(node *corev1.Node, bePodInfos []*podInfo) map[string]struct{} {
+	evictedUIDs := map[string]struct{}{}
+	for _, bePod := range bePodInfos {
+		if bePod.limit <= 0 || bePod.used <= bePod.limit {
+			continue
+		}
+		message := fmt.Sprintf("pod ephemeral storage usage(%v) exceeds the limit(%v)", bePod.used, bePod.limit)
+		if e.evictor.EvictPodIfNotEvicted(bePod.pod, node, resourceexecutor.EvictPodByEphemeralStorageLimit, message) {
+			evictedUIDs[string(bePod.pod.UID)] = struct{}{}
+			klog.V(5).Infof("ephemeralStorageEvict pick pod %s to evict, %s", util.GetPodKey(bePod.pod), message)
+		}
+	}
+	return evictedUIDs
+}
+
+func (e *ephemeralStorageEvictor) evictPodsByNodeUsage(node *corev1.Node, bePodInfos []*podInfo,
+	evictedUIDs map[string]struct{}, needRelease int64) {
+	message := fmt.Sprintf("evictPodsByNodeUsage for node, need to release ephemeral storage: %v", needRelease)
+	released := int64(0)
+	for _, bePod := range bePodInfos { // the evicted pods are also counted in the released
+		if _, ok := evictedUIDs[string(bePod.pod.UID)]; ok {
+			released += bePod.used
+		}
+	}
+	for _, bePod := range sortBEPodInfos(bePodInfos) {
+		if released >= needRelease {
+			break
+		}
+		if _, ok := evictedUIDs[string(bePod.pod.UID)]; ok {
+			continue
+		}
+		if e.evictor.EvictPodIfNotEvicted(bePod.pod, node, resourceexecutor.EvictPodByNodeEphemeralStorageUsage, message) {
+			evictedUIDs[string(bePod.pod.UID)] = struct{}{}
+			released += bePod.used
+			klog.V(5).Infof("ephemeralStorageEvict pick pod %s to evict", util.GetPodKey(bePod.pod))
+		}
+	}
+	klog.Infof("evictPodsByNodeUsage completed, needRelease(%v) released(%v)", needRelease, released)
+}
+
+func (e *ephemeralStorageEvictor) getBEPodInfos(usage *metriccache.NodeEphemeralStorageUsage) []*podInfo {
+	var bePodInfos []*podInfo
+	for _, podMeta := range e.statesInformer.GetAllPods() {
+		pod := podMeta.Pod
+		if extension.GetPodQoSClassRaw(pod) != extension.QoSBE {
+			continue
+		}
+		bePodInfos = append(bePodInfos, &podInfo{
+			pod:   pod,
+			used:  usage.PodsUsed[string(pod.UID)],
+			limit: getPodBatchEphemeralStorageLimit(pod),
+		})
+	}
+	return bePodInfos
+}
+
+// getPodBatchEphemeralStorageLimit returns the sum of the containers' batch-ephemeral-storage limits.
+// It returns 0 if any of the containers is unlimited.
+func getPodBatchEphemeralStorageLimit(pod *corev1.Pod) int64 {
+	limit := int64(0)
+	for i := range pod.Spec.Containers {
+		q, ok := pod.Spec.Containers[i].Resources.Limits[extension.BatchEphemeralStorage]
+		if !ok || q.Value() <= 0 {
+			return 0
+		}
+		limit += q.Value()
+	}
+	return limit
+}
+
+// sortBEPodInfos sorts the pods in the order of priority asc > usage desc > name asc.
+// The pods without priority are regarded as the lowest.
+func sortBEPodInfos(bePodInfos []*podInfo) []*podInfo {
+	sorted := make([]*podInfo, len(bePodInfos))
+	copy(sorted, bePodInfos)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		iPriority, jPriority := getPodPriority(sorted[i].pod), getPodPriority(sorted[j].pod)
+		if iPriority != jPriority {
+			return iPriority < jPriority
+		}
+		if sorted[i].used != sorted[j].used {
+			return sorted[i].used > sorted[j].used
+		}
+		return sorted[i].pod.Name < sorted[j].pod.Name
+	})
+	return sorted
+}
+
+func getPodPriority(pod *corev1.Pod) int32 {
+	if pod.Spec.Priority == nil {
+		return math.MinInt32
+	}
+	return *pod.Spec.Priority
+}
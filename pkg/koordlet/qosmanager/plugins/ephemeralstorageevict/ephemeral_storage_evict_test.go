@@ -0,0 +1,285 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ephemeralstorageevict
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	apiext "github.com/koordinator-sh/koordinator/apis/extension"
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/metriccache"
+	mock_metriccache "github.com/koordinator-sh/koordinator/pkg/koordlet/metriccache/mockmetriccache"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/framework"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer"
+	mock_statesinformer "github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer/mockstatesinformer"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/util/testutil"
+)
+
+const gb = int64(1024 * 1024 * 1024)
+
+func Test_ephemeralStorageEvict(t *testing.T) {
+	tests := []struct {
+		name               string
+		pods               []*corev1.Pod
+		usage              *metriccache.NodeEphemeralStorageUsage
+		thresholdConfig    *slov1alpha1.ResourceThresholdStrategy
+		expectEvictPods    []*corev1.Pod
+		expectNotEvictPods []*corev1.Pod
+		expectState        slov1alpha1.NodeSLOStrategyState
+	}{
+		{
+			name: "skip when usage not collected",
+			pods: []*corev1.Pod{
+				createTestPod("test_be_pod", apiext.QoSBE, 100, "1Gi"),
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                                pointer.Bool(true),
+				EphemeralStorageEvictThresholdPercent: pointer.Int64(80),
+			},
+			expectNotEvictPods: []*corev1.Pod{
+				createTestPod("test_be_pod", apiext.QoSBE, 100, "1Gi"),
+			},
+			expectState: slov1alpha1.NodeSLOStrategyFailed,
+		},
+		{
+			name: "skip when disabled in NodeSLO",
+			pods: []*corev1.Pod{
+				createTestPod("test_be_pod", apiext.QoSBE, 100, "1Gi"),
+			},
+			usage: &metriccache.NodeEphemeralStorageUsage{
+				Capacity: 100 * gb,
+				Used:     90 * gb,
+				PodsUsed: map[string]int64{"test_be_pod": 10 * gb},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                                pointer.Bool(false),
+				EphemeralStorageEvictThresholdPercent: pointer.Int64(80),
+			},
+			expectNotEvictPods: []*corev1.Pod{
+				createTestPod("test_be_pod", apiext.QoSBE, 100, "1Gi"),
+			},
+			expectState: slov1alpha1.NodeSLOStrategySkipped,
+		},
+		{
+			name: "evict be pods exceeding the limits",
+			pods: []*corev1.Pod{
+				createTestPod("test_ls_pod", apiext.QoSLS, 500, "1Gi"),
+				createTestPod("test_be_pod_exceed", apiext.QoSBE, 100, "1Gi"),
+				createTestPod("test_be_pod_under", apiext.QoSBE, 100, "10Gi"),
+				createTestPod("test_be_pod_unlimited", apiext.QoSBE, 100, ""),
+			},
+			usage: &metriccache.NodeEphemeralStorageUsage{
+				Capacity: 100 * gb,
+				Used:     50 * gb,
+				PodsUsed: map[string]int64{
+					"test_ls_pod":           20 * gb,
+					"test_be_pod_exceed":    2 * gb,
+					"test_be_pod_under":     2 * gb,
+					"test_be_pod_unlimited": 20 * gb,
+				},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable: pointer.Bool(true),
+			},
+			expectEvictPods: []*corev1.Pod{
+				createTestPod("test_be_pod_exceed", apiext.QoSBE, 100, "1Gi"),
+			},
+			expectNotEvictPods: []*corev1.Pod{
+				createTestPod("test_ls_pod", apiext.QoSLS, 500, "1Gi"),
+				createTestPod("test_be_pod_under", apiext.QoSBE, 100, "10Gi"),
+				createTestPod("test_be_pod_unlimited", apiext.QoSBE, 100, ""),
+			},
+			expectState: slov1alpha1.NodeSLOStrategyApplied,
+		},
+		{
+			name: "evict be pods by node usage in the order of priority and usage",
+			pods: []*corev1.Pod{
+				createTestPod("test_ls_pod", apiext.QoSLS, 500, ""),
+				createTestPod("test_be_pod_priority100_1", apiext.QoSBE, 100, ""),
+				createTestPod("test_be_pod_priority100_2", apiext.QoSBE, 100, ""),
+				createTestPod("test_be_pod_priority120", apiext.QoSBE, 120, ""),
+			},
+			usage: &metriccache.NodeEphemeralStorageUsage{
+				Capacity: 100 * gb,
+				Used:     85 * gb,
+				PodsUsed: map[string]int64{
+					"test_ls_pod":               40 * gb,
+					"test_be_pod_priority100_1": 3 * gb,
+					"test_be_pod_priority100_2": 4 * gb,
+					"test_be_pod_priority120":   20 * gb,
+				},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                                pointer.Bool(true),
+				EphemeralStorageEvictThresholdPercent: pointer.Int64(80),
+				EphemeralStorageEvictLowerPercent:     pointer.Int64(78),
+			}, // need to release 7G
+			expectEvictPods: []*corev1.Pod{
+				createTestPod("test_be_pod_priority100_1", apiext.QoSBE, 100, ""),
+				createTestPod("test_be_pod_priority100_2", apiext.QoSBE, 100, ""),
+			},
+			expectNotEvictPods: []*corev1.Pod{
+				createTestPod("test_ls_pod", apiext.QoSLS, 500, ""),
+				createTestPod("test_be_pod_priority120", apiext.QoSBE, 120, ""),
+			},
+			expectState: slov1alpha1.NodeSLOStrategyApplied,
+		},
+		{
+			name: "skip evict by node usage below threshold",
+			pods: []*corev1.Pod{
+				createTestPod("test_be_pod", apiext.QoSBE, 100, ""),
+			},
+			usage: &metriccache.NodeEphemeralStorageUsage{
+				Capacity: 100 * gb,
+				Used:     70 * gb,
+				PodsUsed: map[string]int64{"test_be_pod": 10 * gb},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                                pointer.Bool(true),
+				EphemeralStorageEvictThresholdPercent: pointer.Int64(80),
+			},
+			expectNotEvictPods: []*corev1.Pod{
+				createTestPod("test_be_pod", apiext.QoSBE, 100, ""),
+			},
+			expectState: slov1alpha1.NodeSLOStrategyApplied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			mockStatesInformer := mock_statesinformer.NewMockStatesInformer(ctl)
+			mockStatesInformer.EXPECT().GetAllPods().Return(testutil.GetPodMetas(tt.pods)).AnyTimes()
+			mockStatesInformer.EXPECT().GetNode().Return(testutil.MockTestNode("80", "120G")).AnyTimes()
+			mockStatesInformer.EXPECT().GetNodeSLO().Return(testutil.GetNodeSLOByThreshold(tt.thresholdConfig)).AnyTimes()
+			mockMetricCache := mock_metriccache.NewMockMetricCache(ctl)
+			if tt.usage != nil {
+				mockMetricCache.EXPECT().Get(metriccache.NodeEphemeralStorageUsageKey).Return(tt.usage, true).AnyTimes()
+			} else {
+				mockMetricCache.EXPECT().Get(metriccache.NodeEphemeralStorageUsageKey).Return(nil, false).AnyTimes()
+			}
+
+			client := clientsetfake.NewSimpleClientset()
+			for _, pod := range tt.pods {
+				_, err := client.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+				assert.NoError(t, err)
+			}
+			stop := make(chan struct{})
+			evictor := framework.NewEvictor(client, &testutil.FakeRecorder{}, policyv1beta1.SchemeGroupVersion.Version)
+			assert.NoError(t, evictor.Start(stop))
+			defer close(stop)
+
+			s := New(&framework.Options{
+				StatesInformer: mockStatesInformer,
+				MetricCache:    mockMetricCache,
+				Config:         framework.NewDefaultConfig(),
+			})
+			e := s.(*ephemeralStorageEvictor)
+			e.Setup(&framework.Context{Evictor: evictor})
+			statesinformer.ResetNodeSLOAppliedStrategies()
+			defer statesinformer.ResetNodeSLOAppliedStrategies()
+			e.ephemeralStorageEvict()
+			strategies := statesinformer.GetNodeSLOAppliedStrategies()
+			assert.Equal(t, 1, len(strategies))
+			assert.Equal(t, tt.expectState, strategies[0].State)
+
+			for _, pod := range tt.expectEvictPods {
+				assert.True(t, e.evictor.IsPodEvicted(pod), pod.Name)
+			}
+			for _, pod := range tt.expectNotEvictPods {
+				assert.False(t, e.evictor.IsPodEvicted(pod), pod.Name)
+			}
+			if len(tt.expectEvictPods) > 0 {
+				assert.False(t, e.lastEvictTime.IsZero())
+			}
+		})
+	}
+}
+
+func Test_sortBEPodInfos(t *testing.T) {
+	podNoPriority := createTestPod("test_be_pod_no_priority", apiext.QoSBE, 0, "")
+	podNoPriority.Spec.Priority = nil
+	podNoPriorityLarge := createTestPod("test_be_pod_no_priority_large", apiext.QoSBE, 0, "")
+	podNoPriorityLarge.Spec.Priority = nil
+	bePodInfos := []*podInfo{
+		{pod: createTestPod("test_be_pod_high", apiext.QoSBE, 200, ""), used: 10 * gb},
+		{pod: podNoPriority, used: gb},
+		{pod: createTestPod("test_be_pod_b", apiext.QoSBE, 100, ""), used: gb},
+		{pod: createTestPod("test_be_pod_low", apiext.QoSBE, -100, ""), used: gb},
+		{pod: podNoPriorityLarge, used: 2 * gb},
+		{pod: createTestPod("test_be_pod_a", apiext.QoSBE, 100, ""), used: gb},
+		{pod: createTestPod("test_be_pod_c", apiext.QoSBE, 100, ""), used: 2 * gb},
+	}
+
+	got := sortBEPodInfos(bePodInfos)
+	var gotNames []string
+	for _, info := range got {
+		gotNames = append(gotNames, info.pod.Name)
+	}
+	// the pods without priority are the lowest, and the pods with the same priority and usage are sorted by names
+	assert.Equal(t, []string{
+		"test_be_pod_no_priority_large",
+		"test_be_pod_no_priority",
+		"test_be_pod_low",
+		"test_be_pod_c",
+		"test_be_pod_a",
+		"test_be_pod_b",
+		"test_be_pod_high",
+	}, gotNames)
+}
+
+func createTestPod(name string, qosClass apiext.QoSClass, priority int32, limit string) *corev1.Pod {
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			UID:  types.UID(name),
+			Labels: map[string]string{
+				apiext.LabelPodQoS: string(qosClass),
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: name + "_main",
+				},
+			},
+			Priority: &priority,
+		},
+		Status: corev1.PodStatus{
+			StartTime: &metav1.Time{Time: time.Now()},
+		},
+	}
+	if limit != "" {
+		pod.Spec.Containers[0].Resources.Limits = corev1.ResourceList{
+			apiext.BatchEphemeralStorage: resource.MustParse(limit),
+		}
+	}
+	return pod
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gpuevict
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	schedulingv1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/features"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/metriccache"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/framework"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/helpers"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer"
+	koordletutil "github.com/koordinator-sh/koordinator/pkg/koordlet/util"
+	"github.com/koordinator-sh/koordinator/pkg/util"
+)
+
+const (
+	GPUEvictName = "gpuEvict"
+
+	gpuReleaseBufferPercent = 2
+)
+
+var _ framework.QOSStrategy = &gpuEvictor{}
+
+// gpuEvictor evicts the BE pods allocated on a GPU device when the GPU core or memory usage of the device exceeds
+// the threshold, e.g. the usage of the LS pods rises and the GPU resources reclaimed for the batch pods are no longer
+// available. The GPU usages are released until they are under the lower percent.
+// NOTE: The pods are evicted via the eviction API, the GPU memory is released after the containers exit.
+type gpuEvictor struct {
+	evictInterval         time.Duration
+	evictCoolingInterval  time.Duration
+	metricCollectInterval time.Duration
+	statesInformer        statesinformer.StatesInformer
+	metricCache           metriccache.MetricCache
+	evictor               *framework.Evictor
+	lastEvictTime         time.Time
+}
+
+// podInfo is the usage of a BE pod on a GPU device, in the percentage of the device total.
+type podInfo struct {
+	pod         *corev1.Pod
+	coreUsage   float64
+	memoryUsage float64
+}
+
+func New(opt *framework.Options) framework.QOSStrategy {
+	return &gpuEvictor{
+		evictInterval:         time.Duration(opt.Config.GPUEvictIntervalSeconds) * time.Second,
+		evictCoolingInterval:  time.Duration(opt.Config.GPUEvictCoolTimeSeconds) * time.Second,
+		metricCollectInterval: opt.MetricAdvisorConfig.CollectResUsedInterval,
+		statesInformer:        opt.StatesInformer,
+		metricCache:           opt.MetricCache,
+	}
+}
+
+func (g *gpuEvictor) Enabled() bool {
+	return features.DefaultKoordletFeatureGate.Enabled(features.BEGPUEvict) &&
+		features.DefaultKoordletFeatureGate.Enabled(features.Accelerators) && g.evictInterval > 0
+}
+
+func (g *gpuEvictor) Setup(ctx *framework.Context) {
+	g.evictor = ctx.Evictor
+}
+
+func (g *gpuEvictor) Run(stopCh <-chan struct{}) {
+	go wait.Until(g.gpuEvict, g.evictInterval, stopCh)
+}
+
+func (g *gpuEvictor) gpuEvict() {
+	klog.V(5).Infof("starting gpu evict process")
+	defer klog.V(5).Infof("gpu evict process completed")
+
+	if time.Now().Before(g.lastEvictTime.Add(g.evictCoolingInterval)) {
+		klog.V(5).Infof("skip gpu evict process, still in evict cooling time")
+		statesinformer.RecordNodeSLOSkipped(GPUEvictName, "in evict cooling time")
+		return
+	}
+
+	nodeSLO := g.statesInformer.GetNodeSLO()
+	if disabled, err := features.IsFeatureDisabled(nodeSLO, features.BEGPUEvict); err != nil {
+		klog.Errorf("failed to acquire gpu eviction feature-gate, error: %v", err)
+		statesinformer.RecordNodeSLOFailed(GPUEvictName, fmt.Sprintf("cannot check the feature gate, err: %s", err))
+		return
+	} else if disabled {
+		klog.V(4).Infof("skip gpu evict, disabled in NodeSLO")
+		statesinformer.RecordNodeSLOSkipped(GPUEvictName, "feature disabled")
+		return
+	}
+
+	thresholdConfig := nodeSLO.Spec.ResourceUsedThresholdWithBE
+	thresholdPercent := thresholdConfig.GPUEvictThresholdPercent
+	if thresholdPercent == nil {
+		klog.V(5).Infof("skip gpu evict, threshold percent is nil")
+		statesinformer.RecordNodeSLOSkipped(GPUEvictName, "threshold percent is nil")
+		return
+	} else if *thresholdPercent <= 0 {
+		klog.Warningf("skip gpu evict, threshold percent(%v) should greater than 0", *thresholdPercent)
+		statesinformer.RecordNodeSLOFailed(GPUEvictName, fmt.Sprintf("threshold percent %v is invalid", *thresholdPercent))
+		return
+	}
+	lowerPercent := *thresholdPercent - gpuReleaseBufferPercent
+	if thresholdConfig.GPUEvictLowerPercent != nil {
+		lowerPercent = *thresholdConfig.GPUEvictLowerPercent
+	}
+	if lowerPercent >= *thresholdPercent {
+		klog.Warningf("skip gpu evict, lower percent(%v) should less than threshold percent(%v)", lowerPercent, *thresholdPercent)
+		statesinformer.RecordNodeSLOFailed(GPUEvictName, fmt.Sprintf("lower percent %v is not less than threshold percent %v", lowerPercent, *thresholdPercent))
+		return
+	}
+
+	node := g.statesInformer.GetNode()
+	if node == nil {
+		klog.Warningf("skip gpu evict, Node is nil")
+		statesinformer.RecordNodeSLOFailed(GPUEvictName, "node is nil")
+		return
+	}
+	gpus, err := g.getGPUDevices()
+	if err != nil {
+		klog.Warningf("skip gpu evict, get gpu devices failed, error: %v", err)
+		statesinformer.RecordNodeSLOFailed(GPUEvictName, fmt.Sprintf("failed to get gpu devices, err: %s", err))
+		return
+	}
+
+	bePods := g.getBEPodsByMinor()
+	evictedUIDs := map[string]struct{}{}
+	var failedMinors []int32
+	for i := range gpus {
+		gpu := &gpus[i]
+		if len(bePods[gpu.Minor]) <= 0 {
+			continue
+		}
+		coreUsage, memoryUsage, err := g.getNodeGPUUsage(gpu)
+		if err != nil {
+			klog.V(4).Infof("skip gpu evict for device %v, get gpu usage failed, error: %v", gpu.Minor, err)
+			failedMinors = append(failedMinors, gpu.Minor)
+			continue
+		}
+		coreNeedRelease := getNeedRelease(coreUsage, *thresholdPercent, lowerPercent)
+		memoryNeedRelease := getNeedRelease(memoryUsage, *thresholdPercent, lowerPercent)
+		if coreNeedRelease <= 0 && memoryNeedRelease <= 0 {
+			klog.V(5).Infof("skip gpu evict for device %v, core usage(%.2f) and memory usage(%.2f) are below threshold(%v)",
+				gpu.Minor, coreUsage, memoryUsage, *thresholdPercent)
+			continue
+		}
+		klog.Infof("node gpu %v CoreUsage: %.2f, MemoryUsage: %.2f, evictThresholdUsage: %.2f, evictLowerUsage: %.2f",
+			gpu.Minor, coreUsage/100, memoryUsage/100, float64(*thresholdPercent)/100, float64(lowerPercent)/100)
+
+		podInfos := g.getSortedBEPodInfos(gpu, bePods[gpu.Minor])
+		g.evictPodsByDeviceUsage(node, gpu.Minor, podInfos, evictedUIDs, coreNeedRelease, memoryNeedRelease)
+	}
+
+	if len(evictedUIDs) > 0 {
+		g.lastEvictTime = time.Now()
+	}
+	if len(failedMinors) > 0 {
+		statesinformer.RecordNodeSLOFailed(GPUEvictName, fmt.Sprintf("failed to get gpu usage of devices %v", failedMinors))
+		return
+	}
+	statesinformer.RecordNodeSLOApplied(GPUEvictName, nodeSLO)
+}
+
+// getNeedRelease returns the percentage of the device to release when the usage exceeds the threshold percent, and
+// the usage is released until it is under the lower percent.
+func getNeedRelease(usage float64, thresholdPercent, lowerPercent int64) float64 {
+	if usage < float64(thresholdPercent) {
+		return 0
+	}
+	return usage - float64(lowerPercent)
+}
+
+func (g *gpuEvictor) evictPodsByDeviceUsage(node *corev1.Node, minor int32, podInfos []*podInfo,
+	evictedUIDs map[string]struct{}, coreNeedRelease, memoryNeedRelease float64) {
+	message := fmt.Sprintf("evictPodsByDeviceUsage for gpu %v, need to release core: %.2f%%, memory: %.2f%%",
+		minor, coreNeedRelease, memoryNeedRelease)
+	coreReleased, memoryReleased := float64(0), float64(0)
+	for _, bePod := range podInfos {
+		if coreReleased >= coreNeedRelease && memoryReleased >= memoryNeedRelease {
+			break
+		}
+		// the pod evicted for the other device also releases the usage on this device
+		if _, ok := evictedUIDs[string(bePod.pod.UID)]; !ok {
+			if !g.evictor.EvictPodIfNotEvicted(bePod.pod, node, resourceexecutor.EvictPodByNodeGPUUsage, message) {
+				continue
+			}
+			evictedUIDs[string(bePod.pod.UID)] = struct{}{}
+			klog.V(5).Infof("gpuEvict pick pod %s to evict", util.GetPodKey(bePod.pod))
+		}
+		coreReleased += bePod.coreUsage
+		memoryReleased += bePod.memoryUsage
+	}
+	klog.Infof("evictPodsByDeviceUsage completed for gpu %v, coreNeedRelease(%.2f) coreReleased(%.2f) "+
+		"memoryNeedRelease(%.2f) memoryReleased(%.2f)", minor, coreNeedRelease, coreReleased, memoryNeedRelease, memoryReleased)
+}
+
+func (g *gpuEvictor) getGPUDevices() (koordletutil.GPUDevices, error) {
+	value, exist := g.metricCache.Get(koordletutil.GPUDeviceType)
+	if !exist {
+		return nil, fmt.Errorf("gpu devices not collected")
+	}
+	gpus, ok := value.(koordletutil.GPUDevices)
+	if !ok {
+		return nil, fmt.Errorf("value type error, expect: %T, got %T", koordletutil.GPUDevices{}, value)
+	}
+	return gpus, nil
+}
+
+// getNodeGPUUsage returns the core usage and the memory usage of the device in percentage.
+func (g *gpuEvictor) getNodeGPUUsage(gpu *koordletutil.GPUDeviceInfo) (float64, float64, error) {
+	if gpu.MemoryTotal <= 0 {
+		return 0, 0, fmt.Errorf("gpu memory total(%v) should greater than 0", gpu.MemoryTotal)
+	}
+	properties := metriccache.MetricPropertiesFunc.GPU(fmt.Sprintf("%d", gpu.Minor), gpu.UUID)
+	coreQueryMeta, err := metriccache.NodeGPUCoreUsageMetric.BuildQueryMeta(properties)
+	if err != nil {
+		return 0, 0, err
+	}
+	coreUsage, err := helpers.CollectorNodeMetricLast(g.metricCache, coreQueryMeta, g.metricCollectInterval)
+	if err != nil {
+		return 0, 0, err
+	}
+	memoryQueryMeta, err := metriccache.NodeGPUMemUsageMetric.BuildQueryMeta(properties)
+	if err != nil {
+		return 0, 0, err
+	}
+	memoryUsed, err := helpers.CollectorNodeMetricLast(g.metricCache, memoryQueryMeta, g.metricCollectInterval)
+	if err != nil {
+		return 0, 0, err
+	}
+	return coreUsage, memoryUsed * 100 / float64(gpu.MemoryTotal), nil
+}
+
+// getBEPodsByMinor returns the BE pods allocated on each GPU device.
+func (g *gpuEvictor) getBEPodsByMinor() map[int32][]*corev1.Pod {
+	bePods := map[int32][]*corev1.Pod{}
+	for _, podMeta := range g.statesInformer.GetAllPods() {
+		pod := podMeta.Pod
+		if extension.GetPodQoSClassRaw(pod) != extension.QoSBE {
+			continue
+		}
+		allocations, err := extension.GetDeviceAllocations(pod.Annotations)
+		if err != nil {
+			klog.V(5).Infof("failed to get device allocations of pod %s, error: %v", util.GetPodKey(pod), err)
+			continue
+		}
+		for _, allocation := range allocations[schedulingv1alpha1.GPU] {
+			bePods[allocation.Minor] = append(bePods[allocation.Minor], pod)
+		}
+	}
+	return bePods
+}
+
+// getSortedBEPodInfos returns the usages of the BE pods on the device, sorted in the order of
+// priority asc > core usage desc > memory usage desc > name asc. The pod without priority is regarded as the lowest.
+func (g *gpuEvictor) getSortedBEPodInfos(gpu *koordletutil.GPUDeviceInfo, pods []*corev1.Pod) []*podInfo {
+	podInfos := make([]*podInfo, 0, len(pods))
+	for _, pod := range pods {
+		info := &podInfo{pod: pod}
+		properties := metriccache.MetricPropertiesFunc.PodGPU(string(pod.UID), fmt.Sprintf("%d", gpu.Minor), gpu.UUID)
+		if queryMeta, err := metriccache.PodGPUCoreUsageMetric.BuildQueryMeta(properties); err == nil {
+			if coreUsage, err := helpers.CollectPodMetricLast(g.metricCache, queryMeta, g.metricCollectInterval); err == nil {
+				info.coreUsage = coreUsage
+			}
+		}
+		if queryMeta, err := metriccache.PodGPUMemUsageMetric.BuildQueryMeta(properties); err == nil && gpu.MemoryTotal > 0 {
+			if memoryUsed, err := helpers.CollectPodMetricLast(g.metricCache, queryMeta, g.metricCollectInterval); err == nil {
+				info.memoryUsage = memoryUsed * 100 / float64(gpu.MemoryTotal)
+			}
+		}
+		podInfos = append(podInfos, info)
+	}
+
+	sort.SliceStable(podInfos, func(i, j int) bool {
+		iPriority, jPriority := getPodPriority(podInfos[i].pod), getPodPriority(podInfos[j].pod)
+		if iPriority != jPriority {
+			return iPriority < jPriority
+		}
+		if podInfos[i].coreUsage != podInfos[j].coreUsage {
+			return podInfos[i].coreUsage > podInfos[j].coreUsage
+		}
+		if podInfos[i].memoryUsage != podInfos[j].memoryUsage {
+			return podInfos[i].memoryUsage > podInfos[j].memoryUsage
+		}
+		return podInfos[i].pod.Name < podInfos[j].pod.Name
+	})
+	return podInfos
+}
+
+func getPodPriority(pod *corev1.Pod) int32 {
+	if pod.Spec.Priority == nil {
+		return math.MinInt32
+	}
+	return *pod.Spec.Priority
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gpuevict
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	apiext "github.com/koordinator-sh/koordinator/apis/extension"
+	schedulingv1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/metriccache"
+	mock_metriccache "github.com/koordinator-sh/koordinator/pkg/koordlet/metriccache/mockmetriccache"
+	maframework "github.com/koordinator-sh/koordinator/pkg/koordlet/metricsadvisor/framework"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/framework"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer"
+	mock_statesinformer "github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer/mockstatesinformer"
+	koordletutil "github.com/koordinator-sh/koordinator/pkg/koordlet/util"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/util/testutil"
+)
+
+const gb = uint64(1024 * 1024 * 1024)
+
+type gpuUsage struct {
+	core   float64
+	memory float64
+}
+
+func Test_gpuEvict(t *testing.T) {
+	gpus := koordletutil.GPUDevices{
+		{UUID: "gpu-0", Minor: 0, MemoryTotal: 16 * gb},
+		{UUID: "gpu-1", Minor: 1, MemoryTotal: 16 * gb},
+	}
+	tests := []struct {
+		name               string
+		pods               []*corev1.Pod
+		nodeUsages         map[int32]gpuUsage
+		podUsages          map[string]map[int32]gpuUsage
+		thresholdConfig    *slov1alpha1.ResourceThresholdStrategy
+		expectEvictPods    []*corev1.Pod
+		expectNotEvictPods []*corev1.Pod
+		expectState        slov1alpha1.NodeSLOStrategyState
+	}{
+		{
+			name: "skip when disabled in NodeSLO",
+			pods: []*corev1.Pod{
+				createTestPod("test_be_pod", apiext.QoSBE, 100, 0),
+			},
+			nodeUsages: map[int32]gpuUsage{0: {core: 95, memory: float64(8 * gb)}},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                   pointer.Bool(false),
+				GPUEvictThresholdPercent: pointer.Int64(80),
+			},
+			expectNotEvictPods: []*corev1.Pod{
+				createTestPod("test_be_pod", apiext.QoSBE, 100, 0),
+			},
+			expectState: slov1alpha1.NodeSLOStrategySkipped,
+		},
+		{
+			name: "skip when threshold not set",
+			pods: []*corev1.Pod{
+				createTestPod("test_be_pod", apiext.QoSBE, 100, 0),
+			},
+			nodeUsages: map[int32]gpuUsage{0: {core: 95, memory: float64(8 * gb)}},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable: pointer.Bool(true),
+			},
+			expectNotEvictPods: []*corev1.Pod{
+				createTestPod("test_be_pod", apiext.QoSBE, 100, 0),
+			},
+			expectState: slov1alpha1.NodeSLOStrategySkipped,
+		},
+		{
+			name: "skip when device usage below threshold",
+			pods: []*corev1.Pod{
+				createTestPod("test_be_pod", apiext.QoSBE, 100, 0),
+			},
+			nodeUsages: map[int32]gpuUsage{0: {core: 60, memory: float64(8 * gb)}},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                   pointer.Bool(true),
+				GPUEvictThresholdPercent: pointer.Int64(80),
+			},
+			expectNotEvictPods: []*corev1.Pod{
+				createTestPod("test_be_pod", apiext.QoSBE, 100, 0),
+			},
+			expectState: slov1alpha1.NodeSLOStrategyApplied,
+		},
+		{
+			name: "evict be pods on the device whose core usage exceeds threshold",
+			pods: []*corev1.Pod{
+				createTestPod("test_ls_pod", apiext.QoSLS, 9000, 0),
+				createTestPod("test_be_pod_1", apiext.QoSBE, 100, 0),
+				createTestPod("test_be_pod_2", apiext.QoSBE, 200, 0),
+				createTestPod("test_be_pod_3", apiext.QoSBE, 100, 1),
+			},
+			nodeUsages: map[int32]gpuUsage{
+				0: {core: 90, memory: float64(8 * gb)},
+				1: {core: 95, memory: float64(8 * gb)},
+			},
+			podUsages: map[string]map[int32]gpuUsage{
+				"test_be_pod_1": {0: {core: 20, memory: float64(2 * gb)}},
+				"test_be_pod_2": {0: {core: 20, memory: float64(2 * gb)}},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                   pointer.Bool(true),
+				GPUEvictThresholdPercent: pointer.Int64(80),
+			},
+			expectEvictPods: []*corev1.Pod{
+				createTestPod("test_be_pod_1", apiext.QoSBE, 100, 0),
+				createTestPod("test_be_pod_3", apiext.QoSBE, 100, 1),
+			},
+			expectNotEvictPods: []*corev1.Pod{
+				createTestPod("test_ls_pod", apiext.QoSLS, 9000, 0),
+				createTestPod("test_be_pod_2", apiext.QoSBE, 200, 0),
+			},
+			expectState: slov1alpha1.NodeSLOStrategyApplied,
+		},
+		{
+			name: "evict be pods until memory usage under lower percent",
+			pods: []*corev1.Pod{
+				createTestPod("test_be_pod_1", apiext.QoSBE, 100, 0),
+				createTestPod("test_be_pod_2", apiext.QoSBE, 100, 0),
+				createTestPod("test_be_pod_3", apiext.QoSBE, 100, 0),
+			},
+			nodeUsages: map[int32]gpuUsage{0: {core: 30, memory: float64(15 * gb)}},
+			podUsages: map[string]map[int32]gpuUsage{
+				"test_be_pod_1": {0: {core: 10, memory: float64(1 * gb)}},
+				"test_be_pod_2": {0: {core: 10, memory: float64(2 * gb)}},
+				"test_be_pod_3": {0: {core: 10, memory: float64(3 * gb)}},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                   pointer.Bool(true),
+				GPUEvictThresholdPercent: pointer.Int64(90),
+				GPUEvictLowerPercent:     pointer.Int64(70),
+			},
+			expectEvictPods: []*corev1.Pod{
+				createTestPod("test_be_pod_2", apiext.QoSBE, 100, 0),
+				createTestPod("test_be_pod_3", apiext.QoSBE, 100, 0),
+			},
+			expectNotEvictPods: []*corev1.Pod{
+				createTestPod("test_be_pod_1", apiext.QoSBE, 100, 0),
+			},
+			expectState: slov1alpha1.NodeSLOStrategyApplied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			mockStatesInformer := mock_statesinformer.NewMockStatesInformer(ctl)
+			mockStatesInformer.EXPECT().GetAllPods().Return(testutil.GetPodMetas(tt.pods)).AnyTimes()
+			mockStatesInformer.EXPECT().GetNode().Return(testutil.MockTestNode("80", "120G")).AnyTimes()
+			mockStatesInformer.EXPECT().GetNodeSLO().Return(testutil.GetNodeSLOByThreshold(tt.thresholdConfig)).AnyTimes()
+			mockMetricCache := mock_metriccache.NewMockMetricCache(ctl)
+			mockMetricCache.EXPECT().Get(koordletutil.GPUDeviceType).Return(gpus, true).AnyTimes()
+			mockResultFactory := mock_metriccache.NewMockAggregateResultFactory(ctl)
+			metriccache.DefaultAggregateResultFactory = mockResultFactory
+			mockQuerier := mock_metriccache.NewMockQuerier(ctl)
+			mockMetricCache.EXPECT().Querier(gomock.Any(), gomock.Any()).Return(mockQuerier, nil).AnyTimes()
+			for _, gpu := range gpus {
+				usage := tt.nodeUsages[gpu.Minor]
+				properties := metriccache.MetricPropertiesFunc.GPU(fmt.Sprintf("%d", gpu.Minor), gpu.UUID)
+				buildMockQueryResult(t, ctl, mockQuerier, mockResultFactory, metriccache.NodeGPUCoreUsageMetric, properties, usage.core)
+				buildMockQueryResult(t, ctl, mockQuerier, mockResultFactory, metriccache.NodeGPUMemUsageMetric, properties, usage.memory)
+				for _, pod := range tt.pods {
+					podUsage := tt.podUsages[pod.Name][gpu.Minor]
+					properties := metriccache.MetricPropertiesFunc.PodGPU(string(pod.UID), fmt.Sprintf("%d", gpu.Minor), gpu.UUID)
+					buildMockQueryResult(t, ctl, mockQuerier, mockResultFactory, metriccache.PodGPUCoreUsageMetric, properties, podUsage.core)
+					buildMockQueryResult(t, ctl, mockQuerier, mockResultFactory, metriccache.PodGPUMemUsageMetric, properties, podUsage.memory)
+				}
+			}
+
+			client := clientsetfake.NewSimpleClientset()
+			for _, pod := range tt.pods {
+				_, err := client.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+				assert.NoError(t, err)
+			}
+			stop := make(chan struct{})
+			evictor := framework.NewEvictor(client, &testutil.FakeRecorder{}, policyv1beta1.SchemeGroupVersion.Version)
+			assert.NoError(t, evictor.Start(stop))
+			defer close(stop)
+
+			s := New(&framework.Options{
+				StatesInformer:      mockStatesInformer,
+				MetricCache:         mockMetricCache,
+				Config:              framework.NewDefaultConfig(),
+				MetricAdvisorConfig: maframework.NewDefaultConfig(),
+			})
+			g := s.(*gpuEvictor)
+			g.Setup(&framework.Context{Evictor: evictor})
+			statesinformer.ResetNodeSLOAppliedStrategies()
+			defer statesinformer.ResetNodeSLOAppliedStrategies()
+			g.gpuEvict()
+			strategies := statesinformer.GetNodeSLOAppliedStrategies()
+			assert.Equal(t, 1, len(strategies))
+			assert.Equal(t, tt.expectState, strategies[0].State)
+
+			for _, pod := range tt.expectEvictPods {
+				assert.True(t, g.evictor.IsPodEvicted(pod), pod.Name)
+			}
+			for _, pod := range tt.expectNotEvictPods {
+				assert.False(t, g.evictor.IsPodEvicted(pod), pod.Name)
+			}
+			if len(tt.expectEvictPods) > 0 {
+				assert.False(t, g.lastEvictTime.IsZero())
+			}
+		})
+	}
+}
+
+func Test_getSortedBEPodInfos(t *testing.T) {
+	gpu := &koordletutil.GPUDeviceInfo{UUID: "gpu-0", Minor: 0, MemoryTotal: 16 * gb}
+	podNoPriority := createTestPod("test_be_pod_no_priority", apiext.QoSBE, 0, 0)
+	podNoPriority.Spec.Priority = nil
+	pods := []*corev1.Pod{
+		createTestPod("test_be_pod_b", apiext.QoSBE, 100, 0),
+		createTestPod("test_be_pod_a", apiext.QoSBE, 100, 0),
+		createTestPod("test_be_pod_high", apiext.QoSBE, 200, 0),
+		podNoPriority,
+	}
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	mockMetricCache := mock_metriccache.NewMockMetricCache(ctl)
+	mockResultFactory := mock_metriccache.NewMockAggregateResultFactory(ctl)
+	metriccache.DefaultAggregateResultFactory = mockResultFactory
+	mockQuerier := mock_metriccache.NewMockQuerier(ctl)
+	mockMetricCache.EXPECT().Querier(gomock.Any(), gomock.Any()).Return(mockQuerier, nil).AnyTimes()
+	for _, pod := range pods {
+		properties := metriccache.MetricPropertiesFunc.PodGPU(string(pod.UID), fmt.Sprintf("%d", gpu.Minor), gpu.UUID)
+		buildMockQueryResult(t, ctl, mockQuerier, mockResultFactory, metriccache.PodGPUCoreUsageMetric, properties, 10)
+		buildMockQueryResult(t, ctl, mockQuerier, mockResultFactory, metriccache.PodGPUMemUsageMetric, properties, float64(gb))
+	}
+	g := &gpuEvictor{
+		metricCache:           mockMetricCache,
+		metricCollectInterval: time.Minute,
+	}
+
+	got := g.getSortedBEPodInfos(gpu, pods)
+	var gotNames []string
+	for _, info := range got {
+		gotNames = append(gotNames, info.pod.Name)
+	}
+	// the pod without priority is the lowest, and the pods with the same priority and usages are sorted by names
+	assert.Equal(t, []string{"test_be_pod_no_priority", "test_be_pod_a", "test_be_pod_b", "test_be_pod_high"}, gotNames)
+}
+
+func buildMockQueryResult(t *testing.T, ctl *gomock.Controller, querier *mock_metriccache.MockQuerier,
+	factory *mock_metriccache.MockAggregateResultFactory, metric metriccache.MetricResource,
+	properties map[metriccache.MetricProperty]string, value float64) {
+	queryMeta, err := metric.BuildQueryMeta(properties)
+	assert.NoError(t, err)
+	result := mock_metriccache.NewMockAggregateResult(ctl)
+	result.EXPECT().Value(gomock.Any()).Return(value, nil).AnyTimes()
+	result.EXPECT().Count().Return(1).AnyTimes()
+	factory.EXPECT().New(queryMeta).Return(result).AnyTimes()
+	querier.EXPECT().QueryAndClose(queryMeta, gomock.Any(), gomock.Any()).SetArg(2, *result).Return(nil).AnyTimes()
+}
+
+func createTestPod(name string, qosClass apiext.QoSClass, priority int32, minor int32) *corev1.Pod {
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			UID:  types.UID(name),
+			Labels: map[string]string{
+				apiext.LabelPodQoS: string(qosClass),
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: name + "_main",
+				},
+			},
+			Priority: &priority,
+		},
+		Status: corev1.PodStatus{
+			StartTime: &metav1.Time{Time: time.Now()},
+		},
+	}
+	_ = apiext.SetDeviceAllocations(pod, apiext.DeviceAllocations{
+		schedulingv1alpha1.GPU: {
+			{Minor: minor},
+		},
+	})
+	return pod
+}
@@ -88,15 +88,18 @@ func (m *memoryEvictor) memoryEvict() {
 
 	if time.Now().Before(m.lastEvictTime.Add(m.evictCoolingInterval)) {
 		klog.V(5).Infof("skip memory evict process, still in evict cooling time")
+		statesinformer.RecordNodeSLOSkipped(MemoryEvictName, "in evict cooling time")
 		return
 	}
 
 	nodeSLO := m.statesInformer.GetNodeSLO()
 	if disabled, err := features.IsFeatureDisabled(nodeSLO, features.BEMemoryEvict); err != nil {
 		klog.Errorf("failed to acquire memory eviction feature-gate, error: %v", err)
+		statesinformer.RecordNodeSLOFailed(MemoryEvictName, fmt.Sprintf("cannot check the feature gate, err: %s", err))
 		return
 	} else if disabled {
 		klog.V(4).Infof("skip memory evict, disabled in NodeSLO")
+		statesinformer.RecordNodeSLOSkipped(MemoryEvictName, "feature disabled")
 		return
 	}
 
@@ -104,9 +107,11 @@ func (m *memoryEvictor) memoryEvict() {
 	thresholdPercent := thresholdConfig.MemoryEvictThresholdPercent
 	if thresholdPercent == nil {
 		klog.Warningf("skip memory evict, threshold percent is nil")
+		statesinformer.RecordNodeSLOSkipped(MemoryEvictName, "threshold percent is nil")
 		return
 	} else if *thresholdPercent < 0 {
 		klog.Warningf("skip memory evict, threshold percent(%v) should greater than 0", *thresholdPercent)
+		statesinformer.RecordNodeSLOFailed(MemoryEvictName, fmt.Sprintf("threshold percent %v is invalid", *thresholdPercent))
 		return
 	}
 
@@ -119,6 +124,7 @@ func (m *memoryEvictor) memoryEvict() {
 
 	if lowerPercent >= *thresholdPercent {
 		klog.Warningf("skip memory evict, lower percent(%v) should less than threshold percent(%v)", lowerPercent, *thresholdPercent)
+		statesinformer.RecordNodeSLOFailed(MemoryEvictName, fmt.Sprintf("lower percent %v is not less than threshold percent %v", lowerPercent, *thresholdPercent))
 		return
 	}
 
@@ -126,41 +132,99 @@ func (m *memoryEvictor) memoryEvict() {
 	node := m.statesInformer.GetNode()
 	if node == nil {
 		klog.Warningf("skip memory evict, Node is nil")
+		statesinformer.RecordNodeSLOFailed(MemoryEvictName, "node is nil")
 		return
 	}
 
 	memoryCapacity := node.Status.Capacity.Memory().Value()
 	if memoryCapacity <= 0 {
 		klog.Warningf("skip memory evict, memory capacity(%v) should greater than 0", memoryCapacity)
+		statesinformer.RecordNodeSLOFailed(MemoryEvictName, fmt.Sprintf("node memory capacity %d is invalid", memoryCapacity))
 		return
 	}
 
 	queryMeta, err := metriccache.NodeMemoryUsageMetric.BuildQueryMeta(nil)
 	if err != nil {
 		klog.Warningf("skip memory evict, get node query failed, error: %v", err)
+		statesinformer.RecordNodeSLOFailed(MemoryEvictName, fmt.Sprintf("failed to build node query meta, err: %s", err))
 		return
 	}
 
 	nodeMemoryUsed, err := helpers.CollectorNodeMetricLast(m.metricCache, queryMeta, m.metricCollectInterval)
 	if err != nil {
 		klog.Warningf("skip memory evict, get node metrics error: %v", err)
+		statesinformer.RecordNodeSLOFailed(MemoryEvictName, fmt.Sprintf("failed to query node memory metrics, err: %s", err))
 		return
 	}
 	nodeMemoryUsage := int64(nodeMemoryUsed) * 100 / memoryCapacity
-	if nodeMemoryUsage < *thresholdPercent {
+	swapNeedRelease := m.getSwapNeedRelease(thresholdConfig.MemoryEvictSwapThresholdPercent)
+	if nodeMemoryUsage < *thresholdPercent && swapNeedRelease <= 0 {
 		klog.V(5).Infof("skip memory evict, node memory usage(%v) is below threshold(%v)", nodeMemoryUsage, *thresholdPercent)
+		statesinformer.RecordNodeSLOApplied(MemoryEvictName, nodeSLO)
 		return
 	}
 
-	klog.Infof("node MemoryUsage(%v): %.2f, evictThresholdUsage: %.2f, evictLowerUsage: %.2f",
+	klog.Infof("node MemoryUsage(%v): %.2f, evictThresholdUsage: %.2f, evictLowerUsage: %.2f, swapNeedRelease: %v",
 		nodeMemoryUsed,
 		float64(nodeMemoryUsage)/100,
 		float64(*thresholdPercent)/100,
 		float64(lowerPercent)/100,
+		swapNeedRelease,
 	)
 
-	memoryNeedRelease := memoryCapacity * (nodeMemoryUsage - lowerPercent) / 100
+	memoryNeedRelease := int64(0)
+	if nodeMemoryUsage >= *thresholdPercent {
+		memoryNeedRelease = memoryCapacity * (nodeMemoryUsage - lowerPercent) / 100
+	}
+	// the swapped-out memory of BE pods is released along with the pods, so the swap overage is counted in
+	memoryNeedRelease += swapNeedRelease
 	m.killAndEvictBEPods(node, podMetrics, memoryNeedRelease)
+	statesinformer.RecordNodeSLOApplied(MemoryEvictName, nodeSLO)
+}
+
+// getSwapNeedRelease returns the bytes of swap to release when the node swap usage exceeds the threshold percent of
+// the swap total, and the swap is released until the usage is under the threshold minus the release buffer.
+// It returns 0 if the threshold is not set or the node has no swap.
+func (m *memoryEvictor) getSwapNeedRelease(swapThresholdPercent *int64) int64 {
+	if swapThresholdPercent == nil || *swapThresholdPercent <= 0 {
+		return 0
+	}
+	swapTotal, err := m.getNodeMetricLast(metriccache.NodeMemorySwapTotalMetric)
+	if err != nil {
+		klog.V(4).Infof("skip swap evict, get node swap total failed, error: %v", err)
+		return 0
+	}
+	if swapTotal <= 0 {
+		klog.V(6).Infof("skip swap evict, node swap is off")
+		return 0
+	}
+	swapUsed, err := m.getNodeMetricLast(metriccache.NodeMemorySwapUsageMetric)
+	if err != nil {
+		klog.V(4).Infof("skip swap evict, get node swap usage failed, error: %v", err)
+		return 0
+	}
+	swapUsage := swapUsed * 100 / swapTotal
+	if swapUsage < *swapThresholdPercent {
+		klog.V(5).Infof("skip swap evict, node swap usage(%v) is below threshold(%v)", swapUsage, *swapThresholdPercent)
+		return 0
+	}
+	lowerPercent := *swapThresholdPercent - memoryReleaseBufferPercent
+	if lowerPercent < 0 {
+		lowerPercent = 0
+	}
+	return swapTotal * (swapUsage - lowerPercent) / 100
+}
+
+func (m *memoryEvictor) getNodeMetricLast(metric metriccache.MetricResource) (int64, error) {
+	queryMeta, err := metric.BuildQueryMeta(nil)
+	if err != nil {
+		return 0, err
+	}
+	value, err := helpers.CollectorNodeMetricLast(m.metricCache, queryMeta, m.metricCollectInterval)
+	if err != nil {
+		return 0, err
+	}
+	return int64(value), nil
 }
 
 func (m *memoryEvictor) killAndEvictBEPods(node *corev1.Node, podMetrics map[string]float64, memoryNeedRelease int64) {
@@ -57,6 +57,8 @@ func Test_memoryEvict(t *testing.T) {
 		name               string
 		node               *corev1.Node
 		nodeMemUsed        resource.Quantity
+		nodeSwapUsed       resource.Quantity
+		nodeSwapTotal      resource.Quantity
 		podMetrics         []podMemSample
 		pods               []*corev1.Pod
 		thresholdConfig    *slov1alpha1.ResourceThresholdStrategy
@@ -335,6 +337,63 @@ func Test_memoryEvict(t *testing.T) {
 				createMemoryEvictTestPod("test_noqos_pod", apiext.QoSNone, 100),
 			},
 		},
+		{
+			name: "test_memoryevict_MemoryEvictSwapThresholdPercent_80",
+			node: testutil.MockTestNode("80", "120G"),
+			pods: []*corev1.Pod{
+				createMemoryEvictTestPod("test_lsr_pod", apiext.QoSLSR, 1000),
+				createMemoryEvictTestPod("test_ls_pod", apiext.QoSLS, 500),
+				createMemoryEvictTestPod("test_be_pod_priority100_1", apiext.QoSBE, 100),
+				createMemoryEvictTestPod("test_be_pod_priority100_2", apiext.QoSBE, 100),
+				createMemoryEvictTestPod("test_be_pod_priority120", apiext.QoSBE, 120),
+			},
+			nodeMemUsed:   resource.MustParse("80G"),
+			nodeSwapUsed:  resource.MustParse("9G"),
+			nodeSwapTotal: resource.MustParse("10G"),
+			podMetrics: []podMemSample{
+				{UID: "test_lsr_pod", MemUsed: resource.MustParse("40G")},
+				{UID: "test_ls_pod", MemUsed: resource.MustParse("30G")},
+				{UID: "test_be_pod_priority100_1", MemUsed: resource.MustParse("5G")},
+				{UID: "test_be_pod_priority100_2", MemUsed: resource.MustParse("2G")},
+				{UID: "test_be_pod_priority120", MemUsed: resource.MustParse("3G")},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                          pointer.Bool(true),
+				MemoryEvictThresholdPercent:     pointer.Int64(82),
+				MemoryEvictSwapThresholdPercent: pointer.Int64(80),
+			}, // memory is below threshold, swap >1.2G
+			expectEvictPods: []*corev1.Pod{
+				createMemoryEvictTestPod("test_be_pod_priority100_1", apiext.QoSBE, 100),
+			},
+			expectNotEvictPods: []*corev1.Pod{
+				createMemoryEvictTestPod("test_lsr_pod", apiext.QoSLSR, 1000),
+				createMemoryEvictTestPod("test_ls_pod", apiext.QoSLS, 500),
+				createMemoryEvictTestPod("test_be_pod_priority100_2", apiext.QoSBE, 100),
+				createMemoryEvictTestPod("test_be_pod_priority120", apiext.QoSBE, 120),
+			},
+		},
+		{
+			name: "test_memoryevict_MemoryEvictSwapThresholdPercent_swap_off",
+			node: testutil.MockTestNode("80", "120G"),
+			pods: []*corev1.Pod{
+				createMemoryEvictTestPod("test_ls_pod", apiext.QoSLS, 500),
+				createMemoryEvictTestPod("test_be_pod_priority100_1", apiext.QoSBE, 100),
+			},
+			nodeMemUsed: resource.MustParse("80G"),
+			podMetrics: []podMemSample{
+				{UID: "test_ls_pod", MemUsed: resource.MustParse("70G")},
+				{UID: "test_be_pod_priority100_1", MemUsed: resource.MustParse("5G")},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                          pointer.Bool(true),
+				MemoryEvictThresholdPercent:     pointer.Int64(82),
+				MemoryEvictSwapThresholdPercent: pointer.Int64(80),
+			},
+			expectNotEvictPods: []*corev1.Pod{
+				createMemoryEvictTestPod("test_ls_pod", apiext.QoSLS, 500),
+				createMemoryEvictTestPod("test_be_pod_priority100_1", apiext.QoSBE, 100),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -361,6 +420,18 @@ func Test_memoryEvict(t *testing.T) {
 			mockQuerier := mock_metriccache.NewMockQuerier(ctl)
 			mockQuerier.EXPECT().QueryAndClose(nodeMemQueryMeta, gomock.Any(), gomock.Any()).SetArg(2, *result).Return(nil).AnyTimes()
 			mockMetricCache.EXPECT().Querier(gomock.Any(), gomock.Any()).Return(mockQuerier, nil).AnyTimes()
+			for metric, value := range map[metriccache.MetricResource]resource.Quantity{
+				metriccache.NodeMemorySwapUsageMetric: tt.nodeSwapUsed,
+				metriccache.NodeMemorySwapTotalMetric: tt.nodeSwapTotal,
+			} {
+				result := mock_metriccache.NewMockAggregateResult(ctl)
+				result.EXPECT().Value(gomock.Any()).Return(float64(value.Value()), nil).AnyTimes()
+				result.EXPECT().Count().Return(1).AnyTimes()
+				queryMeta, err := metric.BuildQueryMeta(nil)
+				assert.NoError(t, err)
+				mockResultFactory.EXPECT().New(queryMeta).Return(result).AnyTimes()
+				mockQuerier.EXPECT().QueryAndClose(queryMeta, gomock.Any(), gomock.Any()).SetArg(2, *result).Return(nil).AnyTimes()
+			}
 
 			for _, podMetric := range tt.podMetrics {
 				result := mock_metriccache.NewMockAggregateResult(ctl)
@@ -0,0 +1,316 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memoryreclaim
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	apiext "github.com/koordinator-sh/koordinator/apis/extension"
+	"github.com/koordinator-sh/koordinator/pkg/features"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/audit"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/metriccache"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/metrics"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/framework"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/helpers"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer"
+	koordletutil "github.com/koordinator-sh/koordinator/pkg/koordlet/util"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/util/system"
+	"github.com/koordinator-sh/koordinator/pkg/util"
+)
+
+const (
+	MemoryReclaimName = "memoryReclaim"
+
+	// memoryReclaimBufferPercent is the percentage under the threshold that the reclamation aims at, which
+	// avoids the reclamation being triggered too frequently.
+	memoryReclaimBufferPercent = 2
+)
+
+var _ framework.QOSStrategy = &memoryReclaimer{}
+
+// memoryReclaimer proactively reclaims the cold memory of the containers when the node memory usage exceeds the
+// reclaim threshold, which is cheaper than evicting the pods when the usage further exceeds the evict threshold.
+// The cold page sizes are collected by the ColdPageCollector.
+type memoryReclaimer struct {
+	reclaimInterval       time.Duration
+	metricCollectInterval time.Duration
+	statesInformer        statesinformer.StatesInformer
+	metricCache           metriccache.MetricCache
+	executor              resourceexecutor.ResourceUpdateExecutor
+	cgroupReader          resourceexecutor.CgroupReader
+}
+
+type containerInfo struct {
+	pod          *corev1.Pod
+	qosClass     apiext.QoSClass
+	name         string
+	containerDir string
+	coldPageSize int64
+}
+
+func New(opt *framework.Options) framework.QOSStrategy {
+	return &memoryReclaimer{
+		reclaimInterval:       time.Duration(opt.Config.MemoryReclaimIntervalSeconds) * time.Second,
+		metricCollectInterval: opt.MetricAdvisorConfig.CollectResUsedInterval,
+		statesInformer:        opt.StatesInformer,
+		metricCache:           opt.MetricCache,
+		executor:              resourceexecutor.NewResourceUpdateExecutor(),
+		cgroupReader:          opt.CgroupReader,
+	}
+}
+
+func (m *memoryReclaimer) Enabled() bool {
+	return features.DefaultKoordletFeatureGate.Enabled(features.BEMemoryReclaim) && m.reclaimInterval > 0
+}
+
+func (m *memoryReclaimer) Setup(ctx *framework.Context) {
+}
+
+func (m *memoryReclaimer) Run(stopCh <-chan struct{}) {
+	m.executor.Run(stopCh)
+	go wait.Until(m.memoryReclaim, m.reclaimInterval, stopCh)
+}
+
+func (m *memoryReclaimer) memoryReclaim() {
+	klog.V(5).Infof("starting memory reclaim process")
+	defer klog.V(5).Infof("memory reclaim process completed")
+
+	nodeSLO := m.statesInformer.GetNodeSLO()
+	if disabled, err := features.IsFeatureDisabled(nodeSLO, features.BEMemoryReclaim); err != nil {
+		klog.Errorf("failed to acquire memory reclaim feature-gate, error: %v", err)
+		statesinformer.RecordNodeSLOFailed(MemoryReclaimName, fmt.Sprintf("cannot check the feature gate, err: %s", err))
+		return
+	} else if disabled {
+		klog.V(4).Infof("skip memory reclaim, disabled in NodeSLO")
+		statesinformer.RecordNodeSLOSkipped(MemoryReclaimName, "feature disabled")
+		return
+	}
+
+	thresholdConfig := nodeSLO.Spec.ResourceUsedThresholdWithBE
+	thresholdPercent := thresholdConfig.MemoryReclaimThresholdPercent
+	if thresholdPercent == nil {
+		klog.V(5).Infof("skip memory reclaim, threshold percent is nil")
+		statesinformer.RecordNodeSLOSkipped(MemoryReclaimName, "threshold percent is nil")
+		return
+	} else if *thresholdPercent <= 0 {
+		klog.Warningf("skip memory reclaim, threshold percent(%v) should greater than 0", *thresholdPercent)
+		statesinformer.RecordNodeSLOFailed(MemoryReclaimName, fmt.Sprintf("threshold percent %v is invalid", *thresholdPercent))
+		return
+	}
+	if evictPercent := thresholdConfig.MemoryEvictThresholdPercent; evictPercent != nil && *thresholdPercent >= *evictPercent {
+		klog.Warningf("skip memory reclaim, threshold percent(%v) should less than evict threshold percent(%v)",
+			*thresholdPercent, *evictPercent)
+		statesinformer.RecordNodeSLOFailed(MemoryReclaimName, fmt.Sprintf("threshold percent %v is not less than evict threshold percent %v",
+			*thresholdPercent, *evictPercent))
+		return
+	}
+
+	node := m.statesInformer.GetNode()
+	if node == nil {
+		klog.Warningf("skip memory reclaim, Node is nil")
+		statesinformer.RecordNodeSLOFailed(MemoryReclaimName, "node is nil")
+		return
+	}
+	memoryCapacity := node.Status.Capacity.Memory().Value()
+	if memoryCapacity <= 0 {
+		klog.Warningf("skip memory reclaim, memory capacity(%v) should greater than 0", memoryCapacity)
+		statesinformer.RecordNodeSLOFailed(MemoryReclaimName, fmt.Sprintf("node memory capacity %d is invalid", memoryCapacity))
+		return
+	}
+
+	queryMeta, err := metriccache.NodeMemoryUsageMetric.BuildQueryMeta(nil)
+	if err != nil {
+		klog.Warningf("skip memory reclaim, get node query failed, error: %v", err)
+		statesinformer.RecordNodeSLOFailed(MemoryReclaimName, fmt.Sprintf("failed to build node query meta, err: %s", err))
+		return
+	}
+	nodeMemoryUsed, err := helpers.CollectorNodeMetricLast(m.metricCache, queryMeta, m.metricCollectInterval)
+	if err != nil {
+		klog.Warningf("skip memory reclaim, get node metrics error: %v", err)
+		statesinformer.RecordNodeSLOFailed(MemoryReclaimName, fmt.Sprintf("failed to query node memory metrics, err: %s", err))
+		return
+	}
+	nodeMemoryUsage := int64(nodeMemoryUsed) * 100 / memoryCapacity
+	if nodeMemoryUsage < *thresholdPercent {
+		klog.V(5).Infof("skip memory reclaim, node memory usage(%v) is below threshold(%v)", nodeMemoryUsage, *thresholdPercent)
+		statesinformer.RecordNodeSLOApplied(MemoryReclaimName, nodeSLO)
+		return
+	}
+
+	lowerPercent := *thresholdPercent - memoryReclaimBufferPercent
+	if lowerPercent < 0 {
+		lowerPercent = 0
+	}
+	memoryNeedReclaim := memoryCapacity * (nodeMemoryUsage - lowerPercent) / 100
+	klog.Infof("node MemoryUsage(%v): %.2f, reclaimThresholdUsage: %.2f, need to reclaim cold memory: %v",
+		nodeMemoryUsed, float64(nodeMemoryUsage)/100, float64(*thresholdPercent)/100, memoryNeedReclaim)
+
+	includeLS := thresholdConfig.MemoryReclaimIncludeLS != nil && *thresholdConfig.MemoryReclaimIncludeLS
+	m.reclaimColdMemory(m.getSortedContainerInfos(includeLS), memoryNeedReclaim)
+	statesinformer.RecordNodeSLOApplied(MemoryReclaimName, nodeSLO)
+}
+
+func (m *memoryReclaimer) reclaimColdMemory(containerInfos []*containerInfo, memoryNeedReclaim int64) {
+	memoryReclaimed := int64(0)
+	for _, c := range containerInfos {
+		if memoryReclaimed >= memoryNeedReclaim {
+			break
+		}
+		reclaimSize := c.coldPageSize
+		if reclaimSize > memoryNeedReclaim-memoryReclaimed {
+			reclaimSize = memoryNeedReclaim - memoryReclaimed
+		}
+		if err := m.reclaimContainer(c.containerDir, reclaimSize); err != nil {
+			klog.V(4).Infof("failed to reclaim cold memory for container %s/%s, size %v, err: %v",
+				util.GetPodKey(c.pod), c.name, reclaimSize, err)
+			continue
+		}
+		memoryReclaimed += reclaimSize
+		metrics.RecordMemoryColdReclaimedBytes(string(c.qosClass), reclaimSize)
+		klog.V(5).Infof("memoryReclaim reclaim cold memory for container %s/%s, size %v",
+			util.GetPodKey(c.pod), c.name, reclaimSize)
+	}
+
+	klog.Infof("reclaimColdMemory completed, memoryNeedReclaim(%v) memoryReclaimed(%v)", memoryNeedReclaim, memoryReclaimed)
+}
+
+// reclaimContainer reclaims the memory of the container cgroup with `memory.reclaim` if supported, otherwise
+// it nudges `memory.high` below the usage to trigger the direct reclamation and then restores the origin value.
+func (m *memoryReclaimer) reclaimContainer(containerDir string, size int64) error {
+	if r, err := system.GetCgroupResource(system.MemoryReclaimName); err == nil {
+		if supported, _ := r.IsSupported(containerDir); supported {
+			eventHelper := audit.V(3).Reason("memory reclaim").Message("reclaim cold memory: %v", size)
+			updater, err := resourceexecutor.NewCommonCgroupUpdater(system.MemoryReclaimName, containerDir, strconv.FormatInt(size, 10), eventHelper)
+			if err != nil {
+				return err
+			}
+			_, err = m.executor.Update(false, updater)
+			return err
+		}
+	}
+	return m.nudgeMemoryHigh(containerDir, size)
+}
+
+func (m *memoryReclaimer) nudgeMemoryHigh(containerDir string, size int64) error {
+	r, err := system.GetCgroupResource(system.MemoryHighName)
+	if err != nil {
+		return err
+	}
+	if supported, msg := r.IsSupported(containerDir); !supported {
+		return fmt.Errorf("neither memory.reclaim nor memory.high is supported, %s", msg)
+	}
+	content, err := system.CommonFileRead(r.Path(containerDir))
+	if err != nil {
+		return fmt.Errorf("failed to read memory.high, err: %w", err)
+	}
+	originValue := strings.TrimSpace(content)
+	if originValue == "max" {
+		originValue = strconv.FormatInt(math.MaxInt64, 10) // writing MaxInt64 is equal to write "max"
+	}
+	memUsage, err := m.cgroupReader.ReadMemoryUsage(containerDir)
+	if err != nil {
+		return fmt.Errorf("failed to read memory usage, err: %w", err)
+	}
+	target := (int64(memUsage) - size) / system.PageSize * system.PageSize
+	if target <= 0 {
+		return fmt.Errorf("memory usage %v is too small to reclaim %v", memUsage, size)
+	}
+
+	eventHelper := audit.V(3).Reason("memory reclaim").Message("nudge memory.high to reclaim cold memory: %v", size)
+	updater, err := resourceexecutor.NewCommonCgroupUpdater(system.MemoryHighName, containerDir, strconv.FormatInt(target, 10), eventHelper)
+	if err != nil {
+		return err
+	}
+	_, err = m.executor.Update(false, updater)
+
+	// always restore memory.high whether the nudging succeeds or not
+	eventHelper = audit.V(3).Reason("memory reclaim").Message("restore memory.high: %v", originValue)
+	restoreUpdater, restoreErr := resourceexecutor.NewCommonCgroupUpdater(system.MemoryHighName, containerDir, originValue, eventHelper)
+	if restoreErr == nil {
+		_, restoreErr = m.executor.Update(false, restoreUpdater)
+	}
+	if restoreErr != nil {
+		klog.Warningf("failed to restore memory.high for container dir %s, value %s, err: %v", containerDir, originValue, restoreErr)
+	}
+	return err
+}
+
+// getSortedContainerInfos returns the containers to reclaim, where the BE containers are in front of the LS ones, and
+// the containers with more cold memory are in front of the others.
+func (m *memoryReclaimer) getSortedContainerInfos(includeLS bool) []*containerInfo {
+	var containerInfos []*containerInfo
+	for _, podMeta := range m.statesInformer.GetAllPods() {
+		pod := podMeta.Pod
+		qosClass := apiext.GetPodQoSClassRaw(pod)
+		if qosClass != apiext.QoSBE && !(includeLS && qosClass == apiext.QoSLS) {
+			continue
+		}
+		for i := range pod.Status.ContainerStatuses {
+			containerStat := &pod.Status.ContainerStatuses[i]
+			if containerStat.ContainerID == "" || containerStat.State.Running == nil {
+				continue
+			}
+			queryMeta, err := metriccache.ContainerMemoryColdPageSizeMetric.BuildQueryMeta(
+				metriccache.MetricPropertiesFunc.Container(containerStat.ContainerID))
+			if err != nil {
+				klog.V(4).Infof("failed to build cold page query for container %s/%s, err: %v",
+					util.GetPodKey(pod), containerStat.Name, err)
+				continue
+			}
+			coldPageSize, err := helpers.CollectContainerResMetricLast(m.metricCache, queryMeta, m.metricCollectInterval)
+			if err != nil || coldPageSize <= 0 {
+				klog.V(6).Infof("skip container %s/%s without cold memory, size %v, err: %v",
+					util.GetPodKey(pod), containerStat.Name, coldPageSize, err)
+				continue
+			}
+			containerDir, err := koordletutil.GetContainerCgroupParentDir(podMeta.CgroupDir, containerStat)
+			if err != nil {
+				klog.V(4).Infof("failed to get cgroup dir for container %s/%s, err: %v",
+					util.GetPodKey(pod), containerStat.Name, err)
+				continue
+			}
+			containerInfos = append(containerInfos, &containerInfo{
+				pod:          pod,
+				qosClass:     qosClass,
+				name:         containerStat.Name,
+				containerDir: containerDir,
+				coldPageSize: int64(coldPageSize),
+			})
+		}
+	}
+
+	sort.Slice(containerInfos, func(i, j int) bool {
+		if containerInfos[i].qosClass != containerInfos[j].qosClass {
+			return containerInfos[i].qosClass == apiext.QoSBE
+		}
+		if containerInfos[i].coldPageSize != containerInfos[j].coldPageSize {
+			return containerInfos[i].coldPageSize > containerInfos[j].coldPageSize
+		}
+		return containerInfos[i].containerDir < containerInfos[j].containerDir
+	})
+	return containerInfos
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memoryreclaim
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+
+	apiext "github.com/koordinator-sh/koordinator/apis/extension"
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/metriccache"
+	mock_metriccache "github.com/koordinator-sh/koordinator/pkg/koordlet/metriccache/mockmetriccache"
+	maframework "github.com/koordinator-sh/koordinator/pkg/koordlet/metricsadvisor/framework"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/framework"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+	mock_statesinformer "github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer/mockstatesinformer"
+	koordletutil "github.com/koordinator-sh/koordinator/pkg/koordlet/util"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/util/system"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/util/testutil"
+)
+
+func Test_memoryReclaim(t *testing.T) {
+	type containerSample struct {
+		podName      string
+		qosClass     apiext.QoSClass
+		coldPageSize int64
+		memUsage     int64
+		memHigh      string
+	}
+	tests := []struct {
+		name            string
+		useCgroupsV2    bool
+		nodeMemUsed     resource.Quantity
+		containers      []containerSample
+		thresholdConfig *slov1alpha1.ResourceThresholdStrategy
+		// pod name -> expected memory.reclaim for cgroups-v2, or memory.high for cgroups-v1
+		want map[string]string
+	}{
+		{
+			name:         "reclaim disabled",
+			useCgroupsV2: true,
+			nodeMemUsed:  resource.MustParse("75Gi"),
+			containers: []containerSample{
+				{podName: "be-pod-1", qosClass: apiext.QoSBE, coldPageSize: 5 << 30},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                      pointer.Bool(true),
+				MemoryEvictThresholdPercent: pointer.Int64(80),
+			},
+			want: map[string]string{
+				"be-pod-1": "",
+			},
+		},
+		{
+			name:         "node memory usage below threshold",
+			useCgroupsV2: true,
+			nodeMemUsed:  resource.MustParse("60Gi"),
+			containers: []containerSample{
+				{podName: "be-pod-1", qosClass: apiext.QoSBE, coldPageSize: 5 << 30},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                        pointer.Bool(true),
+				MemoryEvictThresholdPercent:   pointer.Int64(80),
+				MemoryReclaimThresholdPercent: pointer.Int64(70),
+			},
+			want: map[string]string{
+				"be-pod-1": "",
+			},
+		},
+		{
+			name:         "reclaim threshold is not less than evict threshold",
+			useCgroupsV2: true,
+			nodeMemUsed:  resource.MustParse("85Gi"),
+			containers: []containerSample{
+				{podName: "be-pod-1", qosClass: apiext.QoSBE, coldPageSize: 5 << 30},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                        pointer.Bool(true),
+				MemoryEvictThresholdPercent:   pointer.Int64(80),
+				MemoryReclaimThresholdPercent: pointer.Int64(80),
+			},
+			want: map[string]string{
+				"be-pod-1": "",
+			},
+		},
+		{
+			name:         "reclaim be containers with memory.reclaim",
+			useCgroupsV2: true,
+			nodeMemUsed:  resource.MustParse("75Gi"),
+			containers: []containerSample{
+				{podName: "be-pod-1", qosClass: apiext.QoSBE, coldPageSize: 4 << 30},
+				{podName: "be-pod-2", qosClass: apiext.QoSBE, coldPageSize: 5 << 30},
+				{podName: "be-pod-3", qosClass: apiext.QoSBE, coldPageSize: 1 << 30},
+				{podName: "ls-pod", qosClass: apiext.QoSLS, coldPageSize: 10 << 30},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                        pointer.Bool(true),
+				MemoryEvictThresholdPercent:   pointer.Int64(80),
+				MemoryReclaimThresholdPercent: pointer.Int64(70),
+			}, // need to reclaim 7Gi
+			want: map[string]string{
+				"be-pod-1": strconv.FormatInt(2<<30, 10),
+				"be-pod-2": strconv.FormatInt(5<<30, 10),
+				"be-pod-3": "",
+				"ls-pod":   "",
+			},
+		},
+		{
+			name:         "reclaim ls containers after be containers",
+			useCgroupsV2: true,
+			nodeMemUsed:  resource.MustParse("75Gi"),
+			containers: []containerSample{
+				{podName: "be-pod-1", qosClass: apiext.QoSBE, coldPageSize: 4 << 30},
+				{podName: "ls-pod", qosClass: apiext.QoSLS, coldPageSize: 10 << 30},
+				{podName: "lsr-pod", qosClass: apiext.QoSLSR, coldPageSize: 10 << 30},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                        pointer.Bool(true),
+				MemoryEvictThresholdPercent:   pointer.Int64(80),
+				MemoryReclaimThresholdPercent: pointer.Int64(70),
+				MemoryReclaimIncludeLS:        pointer.Bool(true),
+			},
+			want: map[string]string{
+				"be-pod-1": strconv.FormatInt(4<<30, 10),
+				"ls-pod":   strconv.FormatInt(3<<30, 10),
+				"lsr-pod":  "",
+			},
+		},
+		{
+			name:         "nudge memory.high and restore it on cgroups-v1",
+			useCgroupsV2: false,
+			nodeMemUsed:  resource.MustParse("75Gi"),
+			containers: []containerSample{
+				{podName: "be-pod-1", qosClass: apiext.QoSBE, coldPageSize: 4 << 30, memUsage: 8 << 30, memHigh: "max"},
+				{podName: "be-pod-2", qosClass: apiext.QoSBE, coldPageSize: 2 << 30, memUsage: 6 << 30, memHigh: strconv.FormatInt(7<<30, 10)},
+			},
+			thresholdConfig: &slov1alpha1.ResourceThresholdStrategy{
+				Enable:                        pointer.Bool(true),
+				MemoryEvictThresholdPercent:   pointer.Int64(80),
+				MemoryReclaimThresholdPercent: pointer.Int64(70),
+			},
+			want: map[string]string{
+				"be-pod-1": strconv.FormatInt(math.MaxInt64, 10),
+				"be-pod-2": strconv.FormatInt(7<<30, 10),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			helper := system.NewFileTestUtil(t)
+			defer helper.Cleanup()
+			helper.SetCgroupsV2(tt.useCgroupsV2)
+			if tt.useCgroupsV2 {
+				helper.SetResourcesSupported(true, system.MemoryReclaimV2)
+			} else {
+				helper.SetResourcesSupported(true, system.MemoryHigh)
+			}
+
+			var pods []*corev1.Pod
+			for _, c := range tt.containers {
+				pod := testutil.MockTestPod(c.qosClass, c.podName)
+				pod.Namespace = "default"
+				pod.Spec.Containers = []corev1.Container{{Name: "main"}}
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name:        "main",
+						ContainerID: "containerd://" + c.podName,
+						State:       corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+					},
+				}
+				pods = append(pods, pod)
+			}
+			podMetas := testutil.GetPodMetas(pods)
+			containerDirs := map[string]string{}
+			for i, podMeta := range podMetas {
+				containerDir, err := koordletutil.GetContainerCgroupParentDir(podMeta.CgroupDir, &podMeta.Pod.Status.ContainerStatuses[0])
+				assert.NoError(t, err)
+				containerDirs[podMeta.Pod.Name] = containerDir
+				c := tt.containers[i]
+				if tt.useCgroupsV2 {
+					helper.CreateCgroupFile(containerDir, system.MemoryReclaimV2)
+				} else {
+					helper.WriteCgroupFileContents(containerDir, system.MemoryHigh, c.memHigh)
+					helper.WriteCgroupFileContents(containerDir, system.MemoryUsage, strconv.FormatInt(c.memUsage, 10))
+				}
+			}
+
+			mockStatesInformer := mock_statesinformer.NewMockStatesInformer(ctl)
+			mockStatesInformer.EXPECT().GetAllPods().Return(podMetas).AnyTimes()
+			mockStatesInformer.EXPECT().GetNode().Return(testutil.MockTestNode("80", "100Gi")).AnyTimes()
+			mockStatesInformer.EXPECT().GetNodeSLO().Return(testutil.GetNodeSLOByThreshold(tt.thresholdConfig)).AnyTimes()
+
+			mockMetricCache := mock_metriccache.NewMockMetricCache(ctl)
+			mockResultFactory := mock_metriccache.NewMockAggregateResultFactory(ctl)
+			metriccache.DefaultAggregateResultFactory = mockResultFactory
+			mockQuerier := mock_metriccache.NewMockQuerier(ctl)
+			mockMetricCache.EXPECT().Querier(gomock.Any(), gomock.Any()).Return(mockQuerier, nil).AnyTimes()
+			nodeQueryMeta, err := metriccache.NodeMemoryUsageMetric.BuildQueryMeta(nil)
+			assert.NoError(t, err)
+			testutil.BuildMockQueryResult(ctl, mockQuerier, mockResultFactory, nodeQueryMeta, float64(tt.nodeMemUsed.Value()))
+			for _, c := range tt.containers {
+				queryMeta, err := metriccache.ContainerMemoryColdPageSizeMetric.BuildQueryMeta(
+					metriccache.MetricPropertiesFunc.Container("containerd://" + c.podName))
+				assert.NoError(t, err)
+				testutil.BuildMockQueryResult(ctl, mockQuerier, mockResultFactory, queryMeta, float64(c.coldPageSize))
+			}
+
+			opt := &framework.Options{
+				StatesInformer:      mockStatesInformer,
+				MetricCache:         mockMetricCache,
+				CgroupReader:        resourceexecutor.NewCgroupReader(),
+				Config:              framework.NewDefaultConfig(),
+				MetricAdvisorConfig: maframework.NewDefaultConfig(),
+			}
+			m := New(opt).(*memoryReclaimer)
+			stop := make(chan struct{})
+			defer close(stop)
+			m.executor.Run(stop)
+			m.memoryReclaim()
+
+			for podName, want := range tt.want {
+				if tt.useCgroupsV2 {
+					assert.Equal(t, want, helper.ReadCgroupFileContents(containerDirs[podName], system.MemoryReclaimV2), podName)
+				} else {
+					assert.Equal(t, want, helper.ReadCgroupFileContents(containerDirs[podName], system.MemoryHigh), podName)
+				}
+			}
+		})
+	}
+}
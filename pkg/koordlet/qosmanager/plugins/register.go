@@ -23,20 +23,26 @@ import (
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/plugins/cpuburst"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/plugins/cpuevict"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/plugins/cpusuppress"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/plugins/ephemeralstorageevict"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/plugins/gpuevict"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/plugins/memoryevict"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/plugins/memoryreclaim"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/plugins/resctrl"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/qosmanager/plugins/sysreconcile"
 )
 
 var (
 	StrategyPlugins = map[string]framework.QOSStrategyFactory{
-		blkio.BlkIOReconcileName:               blkio.New,
-		cgreconcile.CgroupReconcileName:        cgreconcile.New,
-		cpuburst.CPUBurstName:                  cpuburst.New,
-		cpuevict.CPUEvictName:                  cpuevict.New,
-		cpusuppress.CPUSuppressName:            cpusuppress.New,
-		memoryevict.MemoryEvictName:            memoryevict.New,
-		resctrl.ResctrlReconcileName:           resctrl.New,
-		sysreconcile.SystemConfigReconcileName: sysreconcile.New,
+		blkio.BlkIOReconcileName:                        blkio.New,
+		cgreconcile.CgroupReconcileName:                 cgreconcile.New,
+		cpuburst.CPUBurstName:                           cpuburst.New,
+		cpuevict.CPUEvictName:                           cpuevict.New,
+		cpusuppress.CPUSuppressName:                     cpusuppress.New,
+		ephemeralstorageevict.EphemeralStorageEvictName: ephemeralstorageevict.New,
+		gpuevict.GPUEvictName:                           gpuevict.New,
+		memoryevict.MemoryEvictName:                     memoryevict.New,
+		memoryreclaim.MemoryReclaimName:                 memoryreclaim.New,
+		resctrl.ResctrlReconcileName:                    resctrl.New,
+		sysreconcile.SystemConfigReconcileName:          sysreconcile.New,
 	}
 )
@@ -507,22 +507,27 @@ func (r *resctrlReconcile) reconcile() {
 	if nodeSLO == nil || nodeSLO.Spec.ResourceQOSStrategy == nil {
 		// do nothing if nodeSLO == nil || nodeSLO.spec.ResourceStrategy == nil
 		klog.Warningf("nodeSLO is nil %v, or nodeSLO.Spec.ResourceQOSStrategy is nil", nodeSLO == nil)
+		statesinformer.RecordNodeSLOSkipped(ResctrlReconcileName, "nodeSLO or resourceQOSStrategy is nil")
 		return
 	}
 
 	// skip if host not support resctrl
 	if support, err := system.IsSupportResctrl(); err != nil {
 		klog.Warningf("check support resctrl failed, err: %s", err)
+		statesinformer.RecordNodeSLOFailed(ResctrlReconcileName, fmt.Sprintf("failed to check resctrl support, err: %s", err))
 		return
 	} else if !support {
 		klog.V(5).Infof("resctrlReconcile skipped, cpu not support CAT/MBA")
+		statesinformer.RecordNodeSLOSkipped(ResctrlReconcileName, "resctrl is not supported")
 		return
 	}
 
 	if err := initCatResctrl(); err != nil {
 		klog.V(4).Infof("resctrlReconcile failed, cannot initialize cat resctrl group, err: %s", err)
+		statesinformer.RecordNodeSLOFailed(ResctrlReconcileName, fmt.Sprintf("failed to initialize resctrl groups, err: %s", err))
 		return
 	}
 	r.reconcileRDTResctrlPolicy(nodeSLO.Spec.ResourceQOSStrategy)
 	r.reconcileResctrlGroups(nodeSLO.Spec.ResourceQOSStrategy)
+	statesinformer.RecordNodeSLOApplied(ResctrlReconcileName, nodeSLO)
 }
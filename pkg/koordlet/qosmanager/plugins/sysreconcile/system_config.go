@@ -17,6 +17,7 @@ limitations under the License.
 package sysreconcile
 
 import (
+	"fmt"
 	"strconv"
 	"time"
 
@@ -70,20 +71,22 @@ func (s *systemConfig) init(stopCh <-chan struct{}) {
 
 func (s *systemConfig) reconcile() {
 	nodeSLO := s.statesInformer.GetNodeSLO()
-
 	if nodeSLO == nil || nodeSLO.Spec.SystemStrategy == nil {
 		klog.Warningf("nodeSLO or systemStrategy is nil, skip reconcile systemConfig!")
+		statesinformer.RecordNodeSLOSkipped(SystemConfigReconcileName, "nodeSLO or systemStrategy is nil")
 		return
 	}
 
 	node := s.statesInformer.GetNode()
 	if node == nil {
 		klog.Warningf("systemStrategy config failed, got nil node")
+		statesinformer.RecordNodeSLOFailed(SystemConfigReconcileName, "node is nil")
 		return
 	}
 	memoryCapacity := node.Status.Capacity.Memory().Value()
 	if memoryCapacity <= 0 {
 		klog.Warningf("systemStrategy config failed, node memoryCapacity not valid,value: %d", memoryCapacity)
+		statesinformer.RecordNodeSLOFailed(SystemConfigReconcileName, fmt.Sprintf("node memory capacity %d is invalid", memoryCapacity))
 		return
 	}
 
@@ -91,6 +94,7 @@ func (s *systemConfig) reconcile() {
 	resources = append(resources, caculateMemoryConfig(nodeSLO.Spec.SystemStrategy, memoryCapacity)...)
 
 	s.executor.UpdateBatch(true, resources...)
+	statesinformer.RecordNodeSLOApplied(SystemConfigReconcileName, nodeSLO)
 	klog.V(5).Infof("finish to reconcile system config!")
 }
 
@@ -0,0 +1,153 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceexecutor
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const defaultDryRunRecordExpiration = 10 * time.Minute
+
+// DryRunRecord is a resource update which is recorded by the dry-run executor instead of being applied.
+type DryRunRecord struct {
+	// Owner is the name of the hook or the plugin which generates the update.
+	Owner string `json:"owner,omitempty"`
+	// Target is the object the update applies to, e.g. a pod or a container. It is the parent dir of the resource
+	// if the owner does not specify.
+	Target        string    `json:"target"`
+	Resource      string    `json:"resource"`
+	Path          string    `json:"path"`
+	CurrentValue  string    `json:"currentValue"`
+	ExpectedValue string    `json:"expectedValue"`
+	Changed       bool      `json:"changed"`
+	ReadError     string    `json:"readError,omitempty"`
+	UpdateTime    time.Time `json:"updateTime"`
+}
+
+// DryRunRecorder keeps the latest record of each owner and resource. The records not refreshed for the expiration
+// are dropped, e.g. the pod is deleted.
+type DryRunRecorder struct {
+	lock       sync.RWMutex
+	records    map[string]*DryRunRecord
+	expiration time.Duration
+}
+
+func NewDryRunRecorder() *DryRunRecorder {
+	return &DryRunRecorder{
+		records:    map[string]*DryRunRecord{},
+		expiration: defaultDryRunRecordExpiration,
+	}
+}
+
+// NewExecutor returns a ResourceUpdateExecutor which records the updates with the given owner and target rather
+// than writing to the resources.
+func (r *DryRunRecorder) NewExecutor(owner, target string) ResourceUpdateExecutor {
+	return &dryRunExecutor{
+		recorder: r,
+		owner:    owner,
+		target:   target,
+	}
+}
+
+// GetRecords returns the unexpired records ordered by the target, the path and the owner.
+func (r *DryRunRecorder) GetRecords() []DryRunRecord {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := time.Now()
+	records := make([]DryRunRecord, 0, len(r.records))
+	for key, record := range r.records {
+		if now.Sub(record.UpdateTime) > r.expiration {
+			delete(r.records, key)
+			continue
+		}
+		records = append(records, *record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Target != records[j].Target {
+			return records[i].Target < records[j].Target
+		}
+		if records[i].Path != records[j].Path {
+			return records[i].Path < records[j].Path
+		}
+		return records[i].Owner < records[j].Owner
+	})
+	return records
+}
+
+func (r *DryRunRecorder) record(owner, target string, updater ResourceUpdater) {
+	record := &DryRunRecord{
+		Owner:         owner,
+		Target:        target,
+		Resource:      string(updater.ResourceType()),
+		Path:          updater.Path(),
+		ExpectedValue: updater.Value(),
+		UpdateTime:    time.Now(),
+	}
+	if record.Target == "" {
+		record.Target = filepath.Dir(record.Path)
+	}
+	content, err := os.ReadFile(record.Path)
+	if err != nil {
+		record.ReadError = err.Error()
+		record.Changed = true
+	} else {
+		record.CurrentValue = strings.TrimSpace(string(content))
+		record.Changed = record.CurrentValue != record.ExpectedValue
+	}
+	klog.V(5).Infof("dry-run update resource %s from %q to %q, owner %s", record.Path, record.CurrentValue,
+		record.ExpectedValue, owner)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.records[owner+"/"+updater.Key()] = record
+}
+
+var _ ResourceUpdateExecutor = &dryRunExecutor{}
+
+type dryRunExecutor struct {
+	recorder *DryRunRecorder
+	owner    string
+	target   string
+}
+
+func (e *dryRunExecutor) Update(cacheable bool, updater ResourceUpdater) (bool, error) {
+	e.recorder.record(e.owner, e.target, updater)
+	return false, nil
+}
+
+func (e *dryRunExecutor) UpdateBatch(cacheable bool, updaters ...ResourceUpdater) {
+	for _, updater := range updaters {
+		e.recorder.record(e.owner, e.target, updater)
+	}
+}
+
+func (e *dryRunExecutor) LeveledUpdateBatch(updaters [][]ResourceUpdater) {
+	for _, levelUpdaters := range updaters {
+		for _, updater := range levelUpdaters {
+			e.recorder.record(e.owner, e.target, updater)
+		}
+	}
+}
+
+func (e *dryRunExecutor) Run(stopCh <-chan struct{}) {}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceexecutor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/audit"
+	sysutil "github.com/koordinator-sh/koordinator/pkg/koordlet/util/system"
+)
+
+func TestDryRunRecorder(t *testing.T) {
+	helper := sysutil.NewFileTestUtil(t)
+	defer helper.Cleanup()
+	parentDir := "kubepods.slice/kubepods-pod1.slice"
+	helper.WriteCgroupFileContents(parentDir, sysutil.CPUCFSQuota, "-1")
+	helper.WriteCgroupFileContents(parentDir, sysutil.CPUShares, "1024")
+
+	quotaUpdater, err := DefaultCgroupUpdaterFactory.New(sysutil.CPUCFSQuotaName, parentDir, "200000", &audit.EventHelper{})
+	assert.NoError(t, err)
+	sharesUpdater, err := DefaultCgroupUpdaterFactory.New(sysutil.CPUSharesName, parentDir, "1024", &audit.EventHelper{})
+	assert.NoError(t, err)
+	memoryUpdater, err := DefaultCgroupUpdaterFactory.New(sysutil.MemoryLimitName, parentDir, "1048576", &audit.EventHelper{})
+	assert.NoError(t, err)
+
+	r := NewDryRunRecorder()
+	e := r.NewExecutor("test-hook", "default/pod1")
+	e.UpdateBatch(true, quotaUpdater, sharesUpdater)
+	updated, err := r.NewExecutor("test-plugin", "").Update(true, memoryUpdater)
+	assert.False(t, updated)
+	assert.NoError(t, err)
+
+	// nothing is written
+	assert.Equal(t, "-1", helper.ReadCgroupFileContents(parentDir, sysutil.CPUCFSQuota))
+
+	records := r.GetRecords()
+	assert.Len(t, records, 3)
+	// the target defaults to the cgroup dir
+	assert.Equal(t, filepath.Dir(memoryUpdater.Path()), records[0].Target)
+	assert.Equal(t, "test-plugin", records[0].Owner)
+	assert.NotEmpty(t, records[0].ReadError)
+	assert.True(t, records[0].Changed)
+	assert.Equal(t, "default/pod1", records[1].Target)
+	assert.Equal(t, quotaUpdater.Path(), records[1].Path)
+	assert.Equal(t, "-1", records[1].CurrentValue)
+	assert.Equal(t, "200000", records[1].ExpectedValue)
+	assert.True(t, records[1].Changed)
+	assert.Equal(t, "test-hook", records[2].Owner)
+	assert.Equal(t, "1024", records[2].CurrentValue)
+	assert.False(t, records[2].Changed)
+
+	// the latest record of the same owner and resource is kept
+	quotaUpdater1, err := DefaultCgroupUpdaterFactory.New(sysutil.CPUCFSQuotaName, parentDir, "-1", &audit.EventHelper{})
+	assert.NoError(t, err)
+	e.LeveledUpdateBatch([][]ResourceUpdater{{quotaUpdater1}})
+	records = r.GetRecords()
+	assert.Len(t, records, 3)
+	assert.Equal(t, "-1", records[1].ExpectedValue)
+	assert.False(t, records[1].Changed)
+
+	// expired records are dropped
+	r.expiration = time.Nanosecond
+	time.Sleep(time.Millisecond)
+	assert.Len(t, r.GetRecords(), 0)
+}
@@ -134,6 +134,7 @@ func (e *ResourceUpdateExecutorImpl) LeveledUpdateBatch(updaters [][]ResourceUpd
 				continue
 			}
 			if err != nil {
+				recordUpdateError(updater, err)
 				klog.V(4).Infof("failed to merge update resource %s to %v, err: %v",
 					updater.Key(), updater.Value(), err)
 				continue
@@ -172,6 +173,7 @@ func (e *ResourceUpdateExecutorImpl) LeveledUpdateBatch(updaters [][]ResourceUpd
 				continue
 			}
 			if err != nil {
+				recordUpdateError(updater, err)
 				klog.V(4).Infof("failed update resource %s, err: %v", updater.Key(), err)
 				continue
 			}
@@ -225,6 +227,7 @@ func (e *ResourceUpdateExecutorImpl) update(updater ResourceUpdater) error {
 	err := updater.update()
 	if err != nil && !e.isUpdateErrIgnored(err) {
 		metrics.RecordResourceUpdateDuration(updater.Name(), metrics.ResourceUpdateStatusFailed, metrics.SinceInSeconds(start))
+		recordUpdateError(updater, err)
 		klog.V(5).Infof("failed to update resource %s to %v, err: %v", updater.Key(), updater.Value(), err)
 		return err
 	} else if err != nil {
@@ -247,6 +250,7 @@ func (e *ResourceUpdateExecutorImpl) updateByCache(updater ResourceUpdater) (boo
 		}
 		if err != nil {
 			metrics.RecordResourceUpdateDuration(updater.Name(), metrics.ResourceUpdateStatusFailed, metrics.SinceInSeconds(start))
+			recordUpdateError(updater, err)
 			klog.V(5).Infof("failed to cacheable update resource %s to %v, err: %v", updater.Key(), updater.Value(), err)
 			return false, err
 		}
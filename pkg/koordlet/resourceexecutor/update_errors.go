@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceexecutor
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// UpdateErrorExpireDuration is the duration after which an update error is no longer regarded as recent.
+	UpdateErrorExpireDuration = 10 * time.Minute
+	// maxUpdateErrorRecords limits the number of resources whose update errors are kept.
+	maxUpdateErrorRecords = 32
+)
+
+// UpdateErrorRecord is the aggregated recent update errors of a resource type, e.g. `cpu.cfs_quota_us`.
+type UpdateErrorRecord struct {
+	Resource string
+	Message  string
+	Count    int64
+	LastTime time.Time
+}
+
+var updateErrors = &updateErrorRecorder{records: map[string]*UpdateErrorRecord{}}
+
+type updateErrorRecorder struct {
+	lock    sync.Mutex
+	records map[string]*UpdateErrorRecord
+}
+
+func (r *updateErrorRecorder) record(resource string, err error, now time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.expire(now)
+	record, ok := r.records[resource]
+	if !ok {
+		if len(r.records) >= maxUpdateErrorRecords {
+			r.evictOldest()
+		}
+		record = &UpdateErrorRecord{Resource: resource}
+		r.records[resource] = record
+	}
+	record.Message = err.Error()
+	record.Count++
+	record.LastTime = now
+}
+
+func (r *updateErrorRecorder) list(now time.Time) []UpdateErrorRecord {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.expire(now)
+	if len(r.records) == 0 {
+		return nil
+	}
+	records := make([]UpdateErrorRecord, 0, len(r.records))
+	for _, record := range r.records {
+		records = append(records, *record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Resource < records[j].Resource
+	})
+	return records
+}
+
+func (r *updateErrorRecorder) expire(now time.Time) {
+	for resource, record := range r.records {
+		if now.Sub(record.LastTime) > UpdateErrorExpireDuration {
+			delete(r.records, resource)
+		}
+	}
+}
+
+func (r *updateErrorRecorder) evictOldest() {
+	oldest := ""
+	for resource, record := range r.records {
+		if oldest == "" || record.LastTime.Before(r.records[oldest].LastTime) {
+			oldest = resource
+		}
+	}
+	delete(r.records, oldest)
+}
+
+// recordUpdateError records a failure of updating the resource which is not ignorable.
+func recordUpdateError(updater ResourceUpdater, err error) {
+	updateErrors.record(string(updater.ResourceType()), err, time.Now())
+}
+
+// GetRecentUpdateErrors returns the update errors during the recent UpdateErrorExpireDuration, aggregated by the
+// resource type in the order of the resource names.
+func GetRecentUpdateErrors() []UpdateErrorRecord {
+	return updateErrors.list(time.Now())
+}
+
+// ResetUpdateErrors cleans up the recorded update errors.
+// NOTE: Please DO NOT use it except unittests.
+func ResetUpdateErrors() {
+	updateErrors.lock.Lock()
+	defer updateErrors.lock.Unlock()
+	updateErrors.records = map[string]*UpdateErrorRecord{}
+}
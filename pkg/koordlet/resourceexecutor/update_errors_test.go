@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceexecutor
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sysutil "github.com/koordinator-sh/koordinator/pkg/koordlet/util/system"
+)
+
+func Test_updateErrorRecorder(t *testing.T) {
+	now := time.Now()
+	r := &updateErrorRecorder{records: map[string]*UpdateErrorRecord{}}
+	assert.Nil(t, r.list(now))
+
+	r.record("cpu.cfs_quota_us", fmt.Errorf("err 1"), now.Add(-2*time.Minute))
+	r.record("cpu.cfs_quota_us", fmt.Errorf("err 2"), now.Add(-time.Minute))
+	r.record("memory.min", fmt.Errorf("err 3"), now.Add(-UpdateErrorExpireDuration-time.Minute))
+	assert.Equal(t, []UpdateErrorRecord{
+		{Resource: "cpu.cfs_quota_us", Message: "err 2", Count: 2, LastTime: now.Add(-time.Minute)},
+	}, r.list(now), "expired errors are removed")
+
+	for i := 0; i < maxUpdateErrorRecords; i++ {
+		r.record(fmt.Sprintf("resource-%d", i), fmt.Errorf("err"), now)
+	}
+	got := r.list(now)
+	assert.Equal(t, maxUpdateErrorRecords, len(got))
+	for _, record := range got {
+		assert.NotEqual(t, "cpu.cfs_quota_us", record.Resource, "the oldest is evicted")
+	}
+}
+
+func TestResourceUpdateExecutor_RecordUpdateErrors(t *testing.T) {
+	helper := sysutil.NewFileTestUtil(t)
+	defer helper.Cleanup()
+	ResetUpdateErrors()
+	defer ResetUpdateErrors()
+
+	e := NewTestResourceExecutor()
+	// the cgroup file exists, while the value is invalid
+	helper.WriteCgroupFileContents("test", sysutil.CPUShares, "1024")
+	u, err := NewCommonCgroupUpdater(sysutil.CPUSharesName, "test", "-1", nil)
+	assert.NoError(t, err)
+	_, err = e.Update(false, u)
+	assert.Error(t, err)
+
+	got := GetRecentUpdateErrors()
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, string(sysutil.CPUSharesName), got[0].Resource)
+	assert.Equal(t, int64(1), got[0].Count)
+}
@@ -149,6 +149,8 @@ type Config struct {
 	RuntimeHooksNRIPluginName       string
 	RuntimeHooksNRIPluginIndex      string
 	RuntimeHookReconcileInterval    time.Duration
+	RuntimeHooksDryRun              bool
+	RuntimeHooksDryRunPlugins       []string
 }
 
 func NewDefaultConfig() *Config {
@@ -170,6 +172,8 @@ func NewDefaultConfig() *Config {
 		RuntimeHooksNRIPluginName:       "koordlet_nri",
 		RuntimeHooksNRIPluginIndex:      "00",
 		RuntimeHookReconcileInterval:    10 * time.Second,
+		RuntimeHooksDryRun:              false,
+		RuntimeHooksDryRunPlugins:       []string{},
 	}
 }
 
@@ -191,6 +195,8 @@ func (c *Config) InitFlags(fs *flag.FlagSet) {
 	fs.Var(cliflag.NewStringSlice(&c.RuntimeHookDisableStages), "runtime-hooks-disable-stages", "disable stages for runtime hooks")
 	fs.BoolVar(&c.RuntimeHooksNRI, "enable-nri-runtime-hook", c.RuntimeHooksNRI, "enable/disable runtime hooks nri mode")
 	fs.DurationVar(&c.RuntimeHookReconcileInterval, "runtime-hooks-reconcile-interval", c.RuntimeHookReconcileInterval, "reconcile interval for each plugins")
+	fs.BoolVar(&c.RuntimeHooksDryRun, "runtime-hooks-dry-run", c.RuntimeHooksDryRun, "record the resource updates of all runtime hook plugins without applying them")
+	fs.Var(cliflag.NewStringSlice(&c.RuntimeHooksDryRunPlugins), "runtime-hooks-dry-run-plugins", "runtime hook plugins which record the resource updates without applying them, e.g. BatchResource,CPUNormalization")
 }
 
 func init() {
@@ -46,6 +46,8 @@ func Test_NewDefaultConfig(t *testing.T) {
 		RuntimeHooksNRIPluginName:       "koordlet_nri",
 		RuntimeHooksNRIPluginIndex:      "00",
 		RuntimeHookReconcileInterval:    10 * time.Second,
+		RuntimeHooksDryRun:              false,
+		RuntimeHooksDryRunPlugins:       []string{},
 	}
 	defaultConfig := NewDefaultConfig()
 	assert.Equal(t, expectConfig, defaultConfig)
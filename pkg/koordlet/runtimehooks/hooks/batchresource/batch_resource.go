@@ -25,6 +25,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	apiext "github.com/koordinator-sh/koordinator/apis/extension"
+	"github.com/koordinator-sh/koordinator/pkg/features"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/runtimehooks/hooks"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/runtimehooks/protocol"
@@ -59,9 +60,12 @@ func (p *plugin) Register(op hooks.Options) {
 	rule.Register(ruleNameForNodeMeta, description,
 		rule.WithParseFunc(statesinformer.RegisterTypeNodeMetadata, p.parseRuleForNodeMeta),
 		rule.WithUpdateCallback(p.ruleUpdateCbForNodeMeta))
-	hooks.Register(rmconfig.PreRunPodSandbox, name, description+" (pod)", p.SetPodResources)
-	hooks.Register(rmconfig.PreCreateContainer, name, description+" (container)", p.SetContainerResources)
-	hooks.Register(rmconfig.PreUpdateContainerResources, name, description+" (container)", p.SetContainerResources)
+	hooks.Register(rmconfig.PreRunPodSandbox, name, description+" (pod)", p.SetPodResources,
+		hooks.WithPriority(hooks.PriorityBatchResource))
+	hooks.Register(rmconfig.PreCreateContainer, name, description+" (container)", p.SetContainerResources,
+		hooks.WithPriority(hooks.PriorityBatchResource))
+	hooks.Register(rmconfig.PreUpdateContainerResources, name, description+" (container)", p.SetContainerResources,
+		hooks.WithPriority(hooks.PriorityBatchResource))
 	reconciler.RegisterCgroupReconciler(reconciler.PodLevel, sysutil.CPUShares, description+" (pod cpu shares)",
 		p.SetPodCPUShares, reconciler.PodQOSFilter(), podQOSConditions...)
 	reconciler.RegisterCgroupReconciler(reconciler.PodLevel, sysutil.CPUCFSQuota, description+" (pod cfs quota)",
@@ -129,6 +133,11 @@ func (p *plugin) SetPodCPUShares(proto protocol.HooksProtocol) error {
 		return nil
 	}
 
+	// the cpu shares of the pod with a min batch share is maintained by the cpu suppress
+	if isPodCPUSharesByMinShare(podCtx.Request.Labels) {
+		return nil
+	}
+
 	extendedResourceSpec := podCtx.Request.ExtendedResources
 	// if the extendedResourceSpec is empty, do nothing and keep the original cgroup configs
 	if extendedResourceSpec == nil {
@@ -379,6 +388,15 @@ func (p *plugin) SetContainerMemoryLimit(proto protocol.HooksProtocol) error {
 	return nil
 }
 
+func isPodCPUSharesByMinShare(labels map[string]string) bool {
+	if !features.DefaultKoordletFeatureGate.Enabled(features.BEMinShare) ||
+		!features.DefaultKoordletFeatureGate.Enabled(features.BECPUSuppress) {
+		return false
+	}
+	minShare, err := apiext.GetBatchMinSharePercent(labels)
+	return err == nil && minShare > 0
+}
+
 func isPodQoSBEByAttr(labels map[string]string, annotations map[string]string) bool {
 	return apiext.GetQoSClassByAttrs(labels, annotations) == apiext.QoSBE
 }
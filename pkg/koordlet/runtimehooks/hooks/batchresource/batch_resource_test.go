@@ -27,6 +27,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	apiext "github.com/koordinator-sh/koordinator/apis/extension"
+	"github.com/koordinator-sh/koordinator/pkg/features"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/runtimehooks/hooks"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/runtimehooks/protocol"
 )
@@ -225,6 +226,48 @@ func Test_plugin_SetPodResources(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "a Batch pod with a min share keeps the request cpu shares if the min share is disabled",
+			fields: fields{
+				rule: &Rule{
+					enableCFSQuota:        pointer.Bool(false),
+					cpuNormalizationRatio: pointer.Float64(-1),
+				},
+			},
+			args: args{
+				proto: &protocol.PodContext{
+					Request: protocol.PodRequest{
+						Labels: map[string]string{
+							apiext.LabelPodQoS:               string(apiext.QoSBE),
+							apiext.LabelBatchMinSharePercent: "30",
+						},
+						Annotations: map[string]string{
+							apiext.AnnotationExtendedResourceSpec: string(testSpecBytes),
+						},
+						ExtendedResources: testSpec,
+					},
+				},
+			},
+			want: &protocol.PodContext{
+				Request: protocol.PodRequest{
+					Labels: map[string]string{
+						apiext.LabelPodQoS:               string(apiext.QoSBE),
+						apiext.LabelBatchMinSharePercent: "30",
+					},
+					Annotations: map[string]string{
+						apiext.AnnotationExtendedResourceSpec: string(testSpecBytes),
+					},
+					ExtendedResources: testSpec,
+				},
+				Response: protocol.PodResponse{
+					Resources: protocol.Resources{
+						CPUShares:   pointer.Int64(1024 * 500 / 1000),
+						CFSQuota:    pointer.Int64(-1),
+						MemoryLimit: pointer.Int64(2 * 1024 * 1024 * 1024),
+					},
+				},
+			},
+		},
 		{
 			name: "a Batch pod with cpu memory requests",
 			fields: fields{
@@ -841,3 +884,60 @@ func Test_isPodQoSBEByAttr(t *testing.T) {
 		})
 	}
 }
+
+func Test_isPodCPUSharesByMinShare(t *testing.T) {
+	tests := []struct {
+		name        string
+		enableGates bool
+		labels      map[string]string
+		want        bool
+	}{
+		{
+			name:        "feature gates disabled",
+			enableGates: false,
+			labels: map[string]string{
+				apiext.LabelBatchMinSharePercent: "30",
+			},
+			want: false,
+		},
+		{
+			name:        "pod has a min share",
+			enableGates: true,
+			labels: map[string]string{
+				apiext.LabelBatchMinSharePercent: "30",
+			},
+			want: true,
+		},
+		{
+			name:        "pod has an invalid min share",
+			enableGates: true,
+			labels: map[string]string{
+				apiext.LabelBatchMinSharePercent: "200",
+			},
+			want: false,
+		},
+		{
+			name:        "pod has no min share",
+			enableGates: true,
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := features.DefaultMutableKoordletFeatureGate.SetFromMap(map[string]bool{
+				string(features.BEMinShare):    tt.enableGates,
+				string(features.BECPUSuppress): tt.enableGates,
+			})
+			assert.NoError(t, err)
+			defer func() {
+				err = features.DefaultMutableKoordletFeatureGate.SetFromMap(map[string]bool{
+					string(features.BEMinShare):    false,
+					string(features.BECPUSuppress): true,
+				})
+				assert.NoError(t, err)
+			}()
+			got := isPodCPUSharesByMinShare(tt.labels)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -66,9 +66,12 @@ func (p *Plugin) Register(op hooks.Options) {
 	rule.Register(name, description,
 		rule.WithParseFunc(statesinformer.RegisterTypeNodeMetadata, p.parseRule),
 		rule.WithUpdateCallback(p.ruleUpdateCb))
-	hooks.Register(rmconfig.PreRunPodSandbox, name, description+" (pod)", p.AdjustPodCFSQuota)
-	hooks.Register(rmconfig.PreCreateContainer, name, description+" (container)", p.AdjustContainerCFSQuota)
-	hooks.Register(rmconfig.PreUpdateContainerResources, name, description+" (container)", p.AdjustContainerCFSQuota)
+	hooks.Register(rmconfig.PreRunPodSandbox, name, description+" (pod)", p.AdjustPodCFSQuota,
+		hooks.WithPriority(hooks.PriorityCPUNormalization))
+	hooks.Register(rmconfig.PreCreateContainer, name, description+" (container)", p.AdjustContainerCFSQuota,
+		hooks.WithPriority(hooks.PriorityCPUNormalization))
+	hooks.Register(rmconfig.PreUpdateContainerResources, name, description+" (container)", p.AdjustContainerCFSQuota,
+		hooks.WithPriority(hooks.PriorityCPUNormalization))
 	reconciler.RegisterCgroupReconciler(reconciler.PodLevel, sysutil.CPUCFSQuota, description+" (pod cfs quota)",
 		p.AdjustPodCFSQuota, reconciler.PodQOSFilter(), podQOSConditions...)
 	reconciler.RegisterCgroupReconciler(reconciler.ContainerLevel, sysutil.CPUCFSQuota, description+" (container cfs quota)",
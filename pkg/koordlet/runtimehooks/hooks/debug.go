@@ -0,0 +1,129 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"k8s.io/klog/v2"
+
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+	rmconfig "github.com/koordinator-sh/koordinator/pkg/runtimeproxy/config"
+)
+
+const (
+	HTTPPath       = "/runtime-hooks"
+	DryRunHTTPPath = "/runtime-hooks/dry-run"
+)
+
+// HookInfo is the debug information of a registered hook.
+type HookInfo struct {
+	Name          string                     `json:"name"`
+	Description   string                     `json:"description,omitempty"`
+	Priority      int                        `json:"priority"`
+	Dependencies  []string                   `json:"dependencies,omitempty"`
+	FailurePolicy rmconfig.FailurePolicyType `json:"failurePolicy,omitempty"`
+	Timeout       string                     `json:"timeout,omitempty"`
+	DryRun        bool                       `json:"dryRun,omitempty"`
+	LastResult    *HookResult                `json:"lastResult,omitempty"`
+}
+
+// GetHookInfos returns the hooks of each stage in the resolved order.
+func GetHookInfos() map[rmconfig.RuntimeHookType][]HookInfo {
+	globalStageHooksLock.RLock()
+	defer globalStageHooksLock.RUnlock()
+	infos := make(map[rmconfig.RuntimeHookType][]HookInfo, len(globalStageHooks))
+	for stage, stageHooks := range globalStageHooks {
+		if len(stageHooks) <= 0 {
+			continue
+		}
+		stageInfos := make([]HookInfo, 0, len(stageHooks))
+		for _, hook := range stageHooks {
+			info := HookInfo{
+				Name:          hook.name,
+				Description:   hook.description,
+				Priority:      hook.priority,
+				Dependencies:  hook.dependencies,
+				FailurePolicy: hook.failurePolicy,
+				DryRun:        hook.dryRun,
+				LastResult:    hook.getLastResult(),
+			}
+			if hook.timeout > 0 {
+				info.Timeout = hook.timeout.String()
+			}
+			stageInfos = append(stageInfos, info)
+		}
+		infos[stage] = stageInfos
+	}
+	return infos
+}
+
+// HTTPHandler lists the resolved order and the last execution result of the hooks.
+func HTTPHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		data, err := json.Marshal(GetHookInfos())
+		if err != nil {
+			klog.Errorf("failed to marshal hook infos, err: %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}
+}
+
+// GetDryRunRecords returns the updates recorded by the dry-run hooks grouped by the target, e.g. the pod or the
+// container. Only the updates which differ from the current values are returned if onlyChanged is true.
+func GetDryRunRecords(onlyChanged bool) map[string][]resourceexecutor.DryRunRecord {
+	globalStageHooksLock.RLock()
+	recorder := globalDryRunRecorder
+	globalStageHooksLock.RUnlock()
+	if recorder == nil {
+		return nil
+	}
+	records := map[string][]resourceexecutor.DryRunRecord{}
+	for _, record := range recorder.GetRecords() {
+		if onlyChanged && !record.Changed {
+			continue
+		}
+		records[record.Target] = append(records[record.Target], record)
+	}
+	return records
+}
+
+// DryRunHTTPHandler shows the current value of each resource versus what the dry-run hooks would set.
+// Use the query "changed=true" to show only the differences.
+func DryRunHTTPHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		onlyChanged, _ := strconv.ParseBool(r.URL.Query().Get("changed"))
+		records := GetDryRunRecords(onlyChanged)
+		if records == nil {
+			http.Error(w, "runtime hooks dry-run is not enabled", http.StatusNotFound)
+			return
+		}
+		data, err := json.Marshal(records)
+		if err != nil {
+			klog.Errorf("failed to marshal dry-run records, err: %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}
+}
@@ -17,9 +17,13 @@ limitations under the License.
 package hooks
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 
@@ -35,6 +39,65 @@ type Hook struct {
 	stage       rmconfig.RuntimeHookType
 	description string
 	fn          HookFn
+	// priority decides the order of the hooks in the same stage, the hooks with higher priority run earlier.
+	priority int
+	// dependencies are the names of hooks in the same stage which must run before this hook.
+	dependencies []string
+	// failurePolicy overrides the failure policy of RunHooks if it is not PolicyNone.
+	failurePolicy rmconfig.FailurePolicyType
+	// timeout is the max duration to wait for the hook, and the hook running longer is regarded as failed.
+	timeout time.Duration
+	// dryRun makes the hook run on a copy of the protocol and record its results rather than applying them.
+	dryRun bool
+
+	resultLock sync.RWMutex
+	lastResult *HookResult
+}
+
+// HookResult is the result of the last execution of a hook.
+type HookResult struct {
+	StartTime time.Time     `json:"startTime"`
+	Duration  time.Duration `json:"duration"`
+	Error     string        `json:"error,omitempty"`
+	TimedOut  bool          `json:"timedOut,omitempty"`
+}
+
+var errHookTimeout = errors.New("hook timed out")
+
+// The priorities of the built-in hooks which update the same cgroups, the hooks with higher priority run earlier.
+const (
+	PriorityCPUSet           = 300
+	PriorityCPUNormalization = 200
+	PriorityBatchResource    = 100
+	PriorityDefault          = 0
+)
+
+type HookOption func(h *Hook)
+
+func WithPriority(priority int) HookOption {
+	return func(h *Hook) {
+		h.priority = priority
+	}
+}
+
+func WithDependencies(names ...string) HookOption {
+	return func(h *Hook) {
+		h.dependencies = append(h.dependencies, names...)
+	}
+}
+
+func WithFailurePolicy(policy rmconfig.FailurePolicyType) HookOption {
+	return func(h *Hook) {
+		h.failurePolicy = policy
+	}
+}
+
+// WithTimeout stops waiting for the hook when the timeout is exceeded, and the failure policy of the hook applies.
+// The hook cannot be canceled, so it keeps running in the background until it returns.
+func WithTimeout(timeout time.Duration) HookOption {
+	return func(h *Hook) {
+		h.timeout = timeout
+	}
 }
 
 type Options struct {
@@ -46,20 +109,32 @@ type Options struct {
 
 type HookFn func(protocol.HooksProtocol) error
 
-var globalStageHooks map[rmconfig.RuntimeHookType][]*Hook
+var (
+	globalStageHooksLock sync.RWMutex
+	// globalStageHooks keeps the hooks of each stage in the resolved order
+	globalStageHooks map[rmconfig.RuntimeHookType][]*Hook
+	// globalDryRunRecorder keeps the results of the dry-run hooks, and it is nil if no hook runs in dry-run mode.
+	globalDryRunRecorder *resourceexecutor.DryRunRecorder
+)
 
-func Register(stage rmconfig.RuntimeHookType, name, description string, hookFn HookFn) *Hook {
-	h, err := generateNewHook(stage, name)
+func Register(stage rmconfig.RuntimeHookType, name, description string, hookFn HookFn, opts ...HookOption) *Hook {
+	h, err := generateNewHook(stage, name, func(h *Hook) {
+		h.description = description
+		h.fn = hookFn
+		for _, opt := range opts {
+			opt(h)
+		}
+	})
 	if err != nil {
 		klog.Fatalf("hook %s register failed, reason: %v", name, err)
 	}
 	klog.V(1).Infof("hook %s with description %v is registered", name, description)
-	h.description = description
-	h.fn = hookFn
 	return h
 }
 
-func generateNewHook(stage rmconfig.RuntimeHookType, name string) (*Hook, error) {
+func generateNewHook(stage rmconfig.RuntimeHookType, name string, initFn func(h *Hook)) (*Hook, error) {
+	globalStageHooksLock.Lock()
+	defer globalStageHooksLock.Unlock()
 	stageHooks, stageExist := globalStageHooks[stage]
 	if !stageExist {
 		return nil, fmt.Errorf("stage %s is invalid", stage)
@@ -71,11 +146,78 @@ func generateNewHook(stage rmconfig.RuntimeHookType, name string) (*Hook, error)
 		}
 	}
 	newHook := &Hook{name: name, stage: stage}
-	globalStageHooks[stage] = append(globalStageHooks[stage], newHook)
+	if initFn != nil {
+		initFn(newHook)
+	}
+	globalStageHooks[stage] = sortHooks(append(stageHooks, newHook))
 	return newHook, nil
 }
 
+// sortHooks sorts the hooks of a stage topologically by the dependencies. The hooks without unresolved
+// dependencies run in the descending order of the priority, and then in the registration order.
+// The dependencies which are not registered are ignored, and the hooks in a dependency cycle run in the end.
+func sortHooks(hooks []*Hook) []*Hook {
+	index := make(map[string]int, len(hooks))
+	for i, hook := range hooks {
+		index[hook.name] = i
+	}
+	inDegree := make([]int, len(hooks))
+	dependents := make([][]int, len(hooks))
+	for i, hook := range hooks {
+		for _, dep := range hook.dependencies {
+			j, ok := index[dep]
+			if !ok || j == i {
+				continue
+			}
+			inDegree[i]++
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+	before := func(i, j int) bool {
+		if hooks[i].priority != hooks[j].priority {
+			return hooks[i].priority > hooks[j].priority
+		}
+		return i < j
+	}
+
+	sorted := make([]*Hook, 0, len(hooks))
+	visited := make([]bool, len(hooks))
+	for len(sorted) < len(hooks) {
+		next := -1
+		for i := range hooks {
+			if !visited[i] && inDegree[i] == 0 && (next < 0 || before(i, next)) {
+				next = i
+			}
+		}
+		if next < 0 {
+			// dependency cycle
+			var cycle []int
+			for i := range hooks {
+				if !visited[i] {
+					cycle = append(cycle, i)
+				}
+			}
+			sort.SliceStable(cycle, func(a, b int) bool {
+				return before(cycle[a], cycle[b])
+			})
+			for _, i := range cycle {
+				klog.Errorf("hook %s in stage %s has circular dependencies %v", hooks[i].name, hooks[i].stage, hooks[i].dependencies)
+				sorted = append(sorted, hooks[i])
+			}
+			break
+		}
+		visited[next] = true
+		sorted = append(sorted, hooks[next])
+		for _, i := range dependents[next] {
+			inDegree[i]--
+		}
+	}
+	return sorted
+}
+
 func getHooksByStage(stage rmconfig.RuntimeHookType) []*Hook {
+	globalStageHooksLock.RLock()
+	defer globalStageHooksLock.RUnlock()
 	if hooks, exist := globalStageHooks[stage]; exist {
 		return hooks
 	} else {
@@ -89,11 +231,15 @@ func RunHooks(failPolicy rmconfig.FailurePolicyType, stage rmconfig.RuntimeHookT
 	for _, hook := range hooks {
 		start := time.Now()
 		klog.V(5).Infof("call hook %v with description %v", hook.name, hook.description)
-		err := hook.fn(protocol)
-		metrics.RecordRuntimeHookInvokedDurationMilliSeconds(hook.name, string(stage), err, metrics.SinceInSeconds(start))
-		if err != nil {
+		err := hook.runWithTimeout(protocol)
+		duration := time.Since(start)
+		hook.setLastResult(start, duration, err)
+		metrics.RecordRuntimeHookInvokedDurationMilliSeconds(hook.name, string(stage), err, duration.Seconds())
+		if err != nil && hook.dryRun {
+			klog.V(4).Infof("failed to dry-run hook %s in stage %s, reason: %v", hook.name, stage, err)
+		} else if err != nil {
 			klog.Errorf("failed to run hook %s in stage %s, reason: %v", hook.name, stage, err)
-			if failPolicy == rmconfig.PolicyFail {
+			if hook.getFailurePolicy(failPolicy) == rmconfig.PolicyFail {
 				return err
 			}
 		}
@@ -101,6 +247,72 @@ func RunHooks(failPolicy rmconfig.FailurePolicyType, stage rmconfig.RuntimeHookT
 	return nil
 }
 
+// runWithTimeout runs the hook and returns an errHookTimeout if the hook does not return in time.
+func (h *Hook) runWithTimeout(p protocol.HooksProtocol) error {
+	run := h.fn
+	if h.dryRun {
+		run = h.runDryRun
+	}
+	if h.timeout <= 0 {
+		return run(p)
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- run(p)
+	}()
+	timer := time.NewTimer(h.timeout)
+	defer timer.Stop()
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+		return fmt.Errorf("%w after %v", errHookTimeout, h.timeout)
+	}
+}
+
+// runDryRun runs the hook on a copy of the protocol and records the updates it would apply.
+// The hook is skipped if the protocol does not support the dry-run.
+func (h *Hook) runDryRun(p protocol.HooksProtocol) error {
+	dryRunProtocol, ok := p.(protocol.DryRunProtocol)
+	if !ok {
+		klog.V(5).Infof("skip dry-run hook %s in stage %s since protocol %T is not supported", h.name, h.stage, p)
+		return nil
+	}
+	protocolCopy := dryRunProtocol.DryRunCopy()
+	if err := h.fn(protocolCopy); err != nil {
+		return err
+	}
+	protocolCopy.ReconcilerDone(globalDryRunRecorder.NewExecutor(h.name, dryRunProtocol.DryRunTarget()))
+	return nil
+}
+
+func (h *Hook) getFailurePolicy(defaultPolicy rmconfig.FailurePolicyType) rmconfig.FailurePolicyType {
+	if h.failurePolicy != rmconfig.PolicyNone {
+		return h.failurePolicy
+	}
+	return defaultPolicy
+}
+
+func (h *Hook) setLastResult(start time.Time, duration time.Duration, err error) {
+	result := &HookResult{
+		StartTime: start,
+		Duration:  duration,
+	}
+	if err != nil {
+		result.Error = err.Error()
+		result.TimedOut = errors.Is(err, errHookTimeout)
+	}
+	h.resultLock.Lock()
+	defer h.resultLock.Unlock()
+	h.lastResult = result
+}
+
+func (h *Hook) getLastResult() *HookResult {
+	h.resultLock.RLock()
+	defer h.resultLock.RUnlock()
+	return h.lastResult
+}
+
 func init() {
 	globalStageHooks = map[rmconfig.RuntimeHookType][]*Hook{
 		rmconfig.PreRunPodSandbox:            make([]*Hook, 0),
@@ -111,10 +323,46 @@ func init() {
 		rmconfig.PostStopPodSandbox:          make([]*Hook, 0),
 		rmconfig.PreUpdateContainerResources: make([]*Hook, 0),
 		rmconfig.PreRemoveRunPodSandbox:      make([]*Hook, 0),
+		rmconfig.PrePullImage:                make([]*Hook, 0),
+		rmconfig.PostContainerStats:          make([]*Hook, 0),
+		rmconfig.PreCheckpointContainer:      make([]*Hook, 0),
+		rmconfig.PostCheckpointContainer:     make([]*Hook, 0),
+		rmconfig.PreRestoreContainer:         make([]*Hook, 0),
+	}
+}
+
+// GetHookNames returns the names of all registered hooks.
+func GetHookNames() sets.String {
+	globalStageHooksLock.RLock()
+	defer globalStageHooksLock.RUnlock()
+	names := sets.NewString()
+	for _, hooks := range globalStageHooks {
+		for _, hook := range hooks {
+			names.Insert(hook.name)
+		}
+	}
+	return names
+}
+
+// EnableDryRun makes the hooks of the given names record their results into the recorder instead of applying them.
+func EnableDryRun(recorder *resourceexecutor.DryRunRecorder, names ...string) {
+	globalStageHooksLock.Lock()
+	defer globalStageHooksLock.Unlock()
+	globalDryRunRecorder = recorder
+	nameSet := sets.NewString(names...)
+	for _, hooks := range globalStageHooks {
+		for _, hook := range hooks {
+			if nameSet.Has(hook.name) {
+				hook.dryRun = true
+				klog.V(4).Infof("hook %s in stage %s runs in dry-run mode", hook.name, hook.stage)
+			}
+		}
 	}
 }
 
 func GetStages(disable map[string]struct{}) []rmconfig.RuntimeHookType {
+	globalStageHooksLock.RLock()
+	defer globalStageHooksLock.RUnlock()
 	var stages []rmconfig.RuntimeHookType
 	for stage, hooks := range globalStageHooks {
 		if _, ok := disable[string(stage)]; ok {
@@ -0,0 +1,219 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/runtimehooks/protocol"
+	rmconfig "github.com/koordinator-sh/koordinator/pkg/runtimeproxy/config"
+)
+
+func resetStageHooks(t *testing.T) {
+	old := globalStageHooks
+	globalStageHooks = map[rmconfig.RuntimeHookType][]*Hook{
+		rmconfig.PreRunPodSandbox:   make([]*Hook, 0),
+		rmconfig.PreCreateContainer: make([]*Hook, 0),
+	}
+	t.Cleanup(func() {
+		globalStageHooks = old
+	})
+}
+
+func getHookNames(stage rmconfig.RuntimeHookType) []string {
+	var names []string
+	for _, h := range getHooksByStage(stage) {
+		names = append(names, h.name)
+	}
+	return names
+}
+
+func TestRegisterOrder(t *testing.T) {
+	resetStageHooks(t)
+	var executed []string
+	newFn := func(name string) HookFn {
+		return func(protocol.HooksProtocol) error {
+			executed = append(executed, name)
+			return nil
+		}
+	}
+	stage := rmconfig.PreCreateContainer
+	Register(stage, "batchresource", "", newFn("batchresource"), WithPriority(PriorityBatchResource))
+	Register(stage, "gpu", "", newFn("gpu"))
+	Register(stage, "cpunormalization", "", newFn("cpunormalization"), WithPriority(PriorityCPUNormalization))
+	Register(stage, "cpuset", "", newFn("cpuset"), WithPriority(PriorityCPUSet))
+	// the dependency runs before even if it has a lower priority
+	Register(stage, "custom", "", newFn("custom"), WithPriority(1000), WithDependencies("gpu", "not-registered"))
+	assert.Equal(t, []string{"cpuset", "cpunormalization", "batchresource", "gpu", "custom"}, getHookNames(stage))
+
+	err := RunHooks(rmconfig.PolicyFail, stage, &protocol.ContainerContext{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cpuset", "cpunormalization", "batchresource", "gpu", "custom"}, executed)
+	// other stages are not affected
+	assert.Empty(t, getHookNames(rmconfig.PreRunPodSandbox))
+}
+
+func TestRegisterCircularDependencies(t *testing.T) {
+	resetStageHooks(t)
+	fn := func(protocol.HooksProtocol) error { return nil }
+	stage := rmconfig.PreRunPodSandbox
+	Register(stage, "a", "", fn, WithDependencies("b"))
+	Register(stage, "b", "", fn, WithDependencies("a"), WithPriority(1))
+	Register(stage, "c", "", fn)
+	assert.Equal(t, []string{"c", "b", "a"}, getHookNames(stage))
+}
+
+func TestRunHooksFailurePolicy(t *testing.T) {
+	resetStageHooks(t)
+	var executed []string
+	stage := rmconfig.PreRunPodSandbox
+	Register(stage, "ignored", "", func(protocol.HooksProtocol) error {
+		executed = append(executed, "ignored")
+		return fmt.Errorf("expected error")
+	}, WithPriority(3), WithFailurePolicy(rmconfig.PolicyIgnore))
+	Register(stage, "failed", "", func(protocol.HooksProtocol) error {
+		executed = append(executed, "failed")
+		return fmt.Errorf("expected error")
+	}, WithPriority(2))
+	Register(stage, "last", "", func(protocol.HooksProtocol) error {
+		executed = append(executed, "last")
+		return nil
+	}, WithPriority(1))
+
+	// the failed hook fails by the global policy
+	err := RunHooks(rmconfig.PolicyFail, stage, &protocol.PodContext{})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"ignored", "failed"}, executed)
+
+	executed = nil
+	err = RunHooks(rmconfig.PolicyIgnore, stage, &protocol.PodContext{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ignored", "failed", "last"}, executed)
+
+	infos := GetHookInfos()
+	assert.Len(t, infos, 1)
+	stageInfos := infos[stage]
+	assert.Len(t, stageInfos, 3)
+	assert.Equal(t, "ignored", stageInfos[0].Name)
+	assert.Equal(t, rmconfig.PolicyIgnore, stageInfos[0].FailurePolicy)
+	assert.Equal(t, "expected error", stageInfos[0].LastResult.Error)
+	assert.Equal(t, "failed", stageInfos[1].Name)
+	assert.Equal(t, "expected error", stageInfos[1].LastResult.Error)
+	assert.Empty(t, stageInfos[2].LastResult.Error)
+
+	w := httptest.NewRecorder()
+	HTTPHandler()(w, httptest.NewRequest("GET", HTTPPath, nil))
+	assert.Equal(t, 200, w.Code)
+	got := map[rmconfig.RuntimeHookType][]HookInfo{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	assert.Len(t, got[stage], 3)
+}
+
+func TestRunHooksTimeout(t *testing.T) {
+	resetStageHooks(t)
+	var executed []string
+	blocked := make(chan struct{})
+	defer close(blocked)
+	stage := rmconfig.PreRunPodSandbox
+	Register(stage, "blocked", "", func(protocol.HooksProtocol) error {
+		<-blocked
+		return nil
+	}, WithPriority(3), WithTimeout(10*time.Millisecond))
+	Register(stage, "blocked-ignored", "", func(protocol.HooksProtocol) error {
+		<-blocked
+		return nil
+	}, WithPriority(2), WithTimeout(10*time.Millisecond), WithFailurePolicy(rmconfig.PolicyIgnore))
+	Register(stage, "last", "", func(protocol.HooksProtocol) error {
+		executed = append(executed, "last")
+		return nil
+	}, WithPriority(1), WithTimeout(time.Minute))
+
+	// the blocked hook fails by the global policy
+	err := RunHooks(rmconfig.PolicyFail, stage, &protocol.PodContext{})
+	assert.True(t, errors.Is(err, errHookTimeout))
+	assert.Empty(t, executed)
+
+	err = RunHooks(rmconfig.PolicyIgnore, stage, &protocol.PodContext{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"last"}, executed)
+
+	stageInfos := GetHookInfos()[stage]
+	assert.Len(t, stageInfos, 3)
+	assert.Equal(t, "10ms", stageInfos[0].Timeout)
+	assert.True(t, stageInfos[0].LastResult.TimedOut)
+	assert.Contains(t, stageInfos[0].LastResult.Error, "hook timed out")
+	assert.Equal(t, "blocked-ignored", stageInfos[1].Name)
+	assert.True(t, stageInfos[1].LastResult.TimedOut)
+	assert.Equal(t, "1m0s", stageInfos[2].Timeout)
+	assert.False(t, stageInfos[2].LastResult.TimedOut)
+	assert.Empty(t, stageInfos[2].LastResult.Error)
+}
+
+func TestRunHooksDryRun(t *testing.T) {
+	resetStageHooks(t)
+	oldRecorder := globalDryRunRecorder
+	t.Cleanup(func() {
+		globalDryRunRecorder = oldRecorder
+	})
+	stage := rmconfig.PreRunPodSandbox
+	Register(stage, "bvt", "", func(p protocol.HooksProtocol) error {
+		podCtx := p.(*protocol.PodContext)
+		bvt := int64(2)
+		podCtx.Response.Resources.CPUBvt = &bvt
+		return nil
+	})
+	Register(stage, "failed", "", func(p protocol.HooksProtocol) error {
+		return fmt.Errorf("expected error")
+	})
+	assert.Nil(t, GetDryRunRecords(false))
+	assert.Equal(t, []string{"bvt", "failed"}, GetHookNames().List())
+
+	recorder := resourceexecutor.NewDryRunRecorder()
+	EnableDryRun(recorder, "bvt", "failed")
+	podCtx := &protocol.PodContext{}
+	podCtx.Request.PodMeta = protocol.PodMeta{Namespace: "default", Name: "test-pod"}
+	podCtx.Request.CgroupParent = "kubepods.slice/kubepods-test.slice"
+	// the errors of the dry-run hooks are ignored
+	err := RunHooks(rmconfig.PolicyFail, stage, podCtx)
+	assert.NoError(t, err)
+	assert.Nil(t, podCtx.Response.Resources.CPUBvt)
+	// the hooks are skipped if the protocol does not support the dry-run
+	err = RunHooks(rmconfig.PolicyFail, stage, &protocol.ImageContext{})
+	assert.NoError(t, err)
+
+	records := GetDryRunRecords(false)
+	assert.Len(t, records, 1)
+	assert.Len(t, records["default/test-pod"], 1)
+	assert.Equal(t, "bvt", records["default/test-pod"][0].Owner)
+	assert.Equal(t, "2", records["default/test-pod"][0].ExpectedValue)
+	assert.True(t, GetHookInfos()[stage][0].DryRun)
+
+	w := httptest.NewRecorder()
+	DryRunHTTPHandler()(w, httptest.NewRequest("GET", DryRunHTTPPath+"?changed=true", nil))
+	assert.Equal(t, 200, w.Code)
+	got := map[string][]resourceexecutor.DryRunRecord{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	assert.Len(t, got["default/test-pod"], 1)
+}
@@ -175,9 +175,65 @@ func (p *NriServer) Configure(_ context.Context, config, runtime, version string
 	return p.mask, nil
 }
 
+// Synchronize runs the hooks for the pods and containers which already exist when the plugin connects to the runtime,
+// e.g. the ones started before koordlet is ready or during koordlet restarts.
+// The pod-level resources are updated by the executor, and the container-level resources are returned as updates.
 func (p *NriServer) Synchronize(_ context.Context, pods []*api.PodSandbox, containers []*api.Container) ([]*api.ContainerUpdate, error) {
-	// todo: update existed containers configure
-	return nil, nil
+	podMap := make(map[string]*api.PodSandbox, len(pods))
+	for _, pod := range pods {
+		podMap[pod.GetId()] = pod
+
+		podCtx := &protocol.PodContext{}
+		podCtx.FromNri(pod)
+		err := hooks.RunHooks(p.options.PluginFailurePolicy, rmconfig.PreRunPodSandbox, podCtx)
+		if err != nil {
+			klog.Errorf("nri hooks run error when synchronizing pod %s/%s: %v", pod.GetNamespace(), pod.GetName(), err)
+			if p.options.PluginFailurePolicy == rmconfig.PolicyFail {
+				return nil, err
+			}
+		}
+		podCtx.NriDone(p.options.Executor)
+	}
+
+	var updates []*api.ContainerUpdate
+	for _, container := range containers {
+		pod, ok := podMap[container.GetPodSandboxId()]
+		if !ok {
+			klog.V(4).Infof("pod sandbox %s not found when synchronizing container %s, skip",
+				container.GetPodSandboxId(), container.GetName())
+			continue
+		}
+
+		containerCtx := &protocol.ContainerContext{}
+		containerCtx.FromNri(pod, container)
+		err := hooks.RunHooks(p.options.PluginFailurePolicy, rmconfig.PreUpdateContainerResources, containerCtx)
+		if err != nil {
+			klog.Errorf("nri run hooks error when synchronizing container %s/%s/%s: %v",
+				pod.GetNamespace(), pod.GetName(), container.GetName(), err)
+			if p.options.PluginFailurePolicy == rmconfig.PolicyFail {
+				return nil, err
+			}
+		}
+
+		_, update, err := containerCtx.NriDone(p.options.Executor)
+		if err != nil {
+			klog.Errorf("containerCtx nri done failed when synchronizing container %s/%s/%s: %v",
+				pod.GetNamespace(), pod.GetName(), container.GetName(), err)
+			continue
+		}
+		if update == nil || update.GetLinux() == nil {
+			continue
+		}
+		update.SetContainerId(container.GetId())
+		if p.options.PluginFailurePolicy != rmconfig.PolicyFail {
+			update.SetIgnoreFailure()
+		}
+		updates = append(updates, update)
+	}
+
+	klog.V(6).Infof("handle NRI Synchronize successfully, pods %d, containers %d, updates %d",
+		len(pods), len(containers), len(updates))
+	return updates, nil
 }
 
 func (p *NriServer) RunPodSandbox(_ context.Context, pod *api.PodSandbox) error {
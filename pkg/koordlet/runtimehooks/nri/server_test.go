@@ -43,6 +43,22 @@ func (p *mockPlugin) Remove(proto protocol.HooksProtocol) error {
 	return fmt.Errorf("mock error")
 }
 
+type mockSyncPlugin struct{}
+
+func (p *mockSyncPlugin) Register(op hooks.Options) {
+	hooks.Register(config.PreUpdateContainerResources, "mockSyncPlugin", "mockSyncPlugin set cpuset", p.SetCPUSet)
+}
+
+func (p *mockSyncPlugin) SetCPUSet(proto protocol.HooksProtocol) error {
+	containerCtx, ok := proto.(*protocol.ContainerContext)
+	if !ok || containerCtx.Request.ContainerMeta.Name != "sync-container" {
+		return nil
+	}
+	cpuset := "0-3"
+	containerCtx.Response.Resources.CPUSet = &cpuset
+	return nil
+}
+
 func getDisableStagesMap(stagesSlice []string) map[string]struct{} {
 	stagesMap := map[string]struct{}{}
 	for _, item := range stagesSlice {
@@ -331,7 +347,61 @@ func TestNriServer_Synchronize(t *testing.T) {
 			want:    nil,
 			wantErr: false,
 		},
+		{
+			name: "synchronize existing containers",
+			fields: fields{
+				options: Options{
+					PluginFailurePolicy: config.PolicyIgnore,
+					Executor:            resourceexecutor.NewTestResourceExecutor(),
+				},
+			},
+			args: args{
+				pods: []*api.PodSandbox{
+					{
+						Id:        "test-pod",
+						Name:      "test-pod",
+						Uid:       "test-pod-uid",
+						Namespace: "test",
+						Linux: &api.LinuxPodSandbox{
+							CgroupParent: "kubepods/besteffort/podtest-pod-uid",
+						},
+					},
+				},
+				containers: []*api.Container{
+					{
+						Id:           "sync-container-id",
+						PodSandboxId: "test-pod",
+						Name:         "sync-container",
+					},
+					{
+						Id:           "other-container-id",
+						PodSandboxId: "test-pod",
+						Name:         "other-container",
+					},
+					{
+						Id:           "orphan-container-id",
+						PodSandboxId: "not-exist",
+						Name:         "sync-container",
+					},
+				},
+			},
+			want: []*api.ContainerUpdate{
+				{
+					ContainerId: "sync-container-id",
+					Linux: &api.LinuxContainerUpdate{
+						Resources: &api.LinuxResources{
+							Cpu: &api.LinuxCPU{
+								Cpus: "0-3",
+							},
+						},
+					},
+					IgnoreFailure: true,
+				},
+			},
+			wantErr: false,
+		},
 	}
+	(&mockSyncPlugin{}).Register(hooks.Options{})
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := &NriServer{
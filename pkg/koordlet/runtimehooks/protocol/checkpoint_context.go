@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protocol
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	runtimeapi "github.com/koordinator-sh/koordinator/apis/runtime/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+)
+
+type CheckpointRequest struct {
+	PodMeta              PodMeta
+	ContainerMeta        ContainerMeta
+	PodLabels            map[string]string
+	PodAnnotations       map[string]string
+	ContainerAnnotations map[string]string
+	// Location is the path of the checkpoint archive.
+	Location       string
+	TimeoutSeconds int64
+}
+
+func (r *CheckpointRequest) FromProxy(req *runtimeapi.ContainerCheckpointHookRequest) {
+	r.PodMeta.FromProxy(req.GetPodMeta())
+	r.ContainerMeta.FromProxy(req.GetContainerMeta(), req.GetPodAnnotations())
+	r.PodLabels = req.GetPodLabels()
+	r.PodAnnotations = req.GetPodAnnotations()
+	r.ContainerAnnotations = req.GetContainerAnnotations()
+	r.Location = req.GetLocation()
+	r.TimeoutSeconds = req.GetTimeout()
+}
+
+type CheckpointResponse struct {
+	// Location modifies the path of the checkpoint archive if it is not empty.
+	Location string
+}
+
+func (r *CheckpointResponse) ProxyDone(resp *runtimeapi.ContainerCheckpointHookResponse) {
+	if r.Location != "" {
+		resp.Location = r.Location
+	}
+}
+
+// CheckpointContext is the context of the container checkpoint and restore.
+type CheckpointContext struct {
+	Request  CheckpointRequest
+	Response CheckpointResponse
+}
+
+func (c *CheckpointContext) FromProxy(req *runtimeapi.ContainerCheckpointHookRequest) {
+	c.Request.FromProxy(req)
+}
+
+func (c *CheckpointContext) ProxyDone(resp *runtimeapi.ContainerCheckpointHookResponse) {
+	c.Response.ProxyDone(resp)
+}
+
+func (c *CheckpointContext) ReconcilerDone(executor resourceexecutor.ResourceUpdateExecutor) {}
+
+func (c *CheckpointContext) Update() {}
+
+func (c *CheckpointContext) GetUpdaters() []resourceexecutor.ResourceUpdater {
+	return nil
+}
+
+func (c *CheckpointContext) RecordEvent(r record.EventRecorder, pod *corev1.Pod) {}
@@ -371,6 +371,17 @@ func (c *ContainerContext) GetUpdaters() []resourceexecutor.ResourceUpdater {
 	return c.updaters
 }
 
+func (c *ContainerContext) DryRunCopy() HooksProtocol {
+	return &ContainerContext{Request: c.Request}
+}
+
+func (c *ContainerContext) DryRunTarget() string {
+	if c.Request.ContainerMeta.Sandbox {
+		return c.Request.PodMeta.String() + "/sandbox"
+	}
+	return c.Request.PodMeta.String() + "/" + c.Request.ContainerMeta.Name
+}
+
 func (c *ContainerContext) Update() {
 	c.executor.UpdateBatch(true, c.updaters...)
 	c.updaters = nil
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protocol
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	runtimeapi "github.com/koordinator-sh/koordinator/apis/runtime/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+)
+
+type ContainerStatsRequest struct {
+	PodMeta                 PodMeta
+	ContainerMeta           ContainerMeta
+	PodLabels               map[string]string
+	PodAnnotations          map[string]string
+	ContainerAnnotations    map[string]string
+	CgroupParent            string
+	CPUUsageCoreNanoSeconds uint64
+	MemoryWorkingSetBytes   uint64
+}
+
+func (r *ContainerStatsRequest) FromProxy(req *runtimeapi.ContainerStatsHookRequest) {
+	r.PodMeta.FromProxy(req.GetPodMeta())
+	r.ContainerMeta.FromProxy(req.GetContainerMeta(), req.GetPodAnnotations())
+	r.PodLabels = req.GetPodLabels()
+	r.PodAnnotations = req.GetPodAnnotations()
+	r.ContainerAnnotations = req.GetContainerAnnotations()
+	r.CgroupParent = req.GetPodCgroupParent()
+	r.CPUUsageCoreNanoSeconds = req.GetCpuUsageCoreNanoSeconds()
+	r.MemoryWorkingSetBytes = req.GetMemoryWorkingSetBytes()
+}
+
+type ContainerStatsResponse struct {
+	// AddAnnotations are merged into the attributes of the container stats.
+	AddAnnotations map[string]string
+}
+
+func (r *ContainerStatsResponse) ProxyDone(resp *runtimeapi.ContainerStatsHookResponse) {
+	if len(r.AddAnnotations) == 0 {
+		return
+	}
+	if resp.ContainerAnnotations == nil {
+		resp.ContainerAnnotations = map[string]string{}
+	}
+	for k, v := range r.AddAnnotations {
+		resp.ContainerAnnotations[k] = v
+	}
+}
+
+// ContainerStatsContext is the context to enrich the container stats, which does not update any resource on the node.
+type ContainerStatsContext struct {
+	Request  ContainerStatsRequest
+	Response ContainerStatsResponse
+}
+
+func (c *ContainerStatsContext) FromProxy(req *runtimeapi.ContainerStatsHookRequest) {
+	c.Request.FromProxy(req)
+}
+
+func (c *ContainerStatsContext) ProxyDone(resp *runtimeapi.ContainerStatsHookResponse) {
+	c.Response.ProxyDone(resp)
+}
+
+func (c *ContainerStatsContext) ReconcilerDone(executor resourceexecutor.ResourceUpdateExecutor) {}
+
+func (c *ContainerStatsContext) Update() {}
+
+func (c *ContainerStatsContext) GetUpdaters() []resourceexecutor.ResourceUpdater {
+	return nil
+}
+
+func (c *ContainerStatsContext) RecordEvent(r record.EventRecorder, pod *corev1.Pod) {}
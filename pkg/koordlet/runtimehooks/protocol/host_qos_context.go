@@ -76,6 +76,14 @@ func (c *HostAppContext) GetUpdaters() []resourceexecutor.ResourceUpdater {
 	return c.updaters
 }
 
+func (c *HostAppContext) DryRunCopy() HooksProtocol {
+	return &HostAppContext{Request: c.Request}
+}
+
+func (c *HostAppContext) DryRunTarget() string {
+	return "hostapp/" + c.Request.Name
+}
+
 func (c *HostAppContext) Update() {
 	klog.V(5).Infof("")
 	c.executor.UpdateBatch(true, c.updaters...)
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protocol
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	runtimeapi "github.com/koordinator-sh/koordinator/apis/runtime/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+)
+
+type ImageRequest struct {
+	Image          string
+	Annotations    map[string]string
+	PodMeta        PodMeta
+	PodLabels      map[string]string
+	PodAnnotations map[string]string
+}
+
+func (r *ImageRequest) FromProxy(req *runtimeapi.ImageHookRequest) {
+	r.Image = req.GetImage()
+	r.Annotations = req.GetImageAnnotations()
+	r.PodMeta.FromProxy(req.GetPodMeta())
+	r.PodLabels = req.GetPodLabels()
+	r.PodAnnotations = req.GetPodAnnotations()
+}
+
+type ImageResponse struct {
+	// Image rewrites the image reference to pull if it is not empty.
+	Image          string
+	AddAnnotations map[string]string
+}
+
+func (r *ImageResponse) ProxyDone(resp *runtimeapi.ImageHookResponse) {
+	if r.Image != "" {
+		resp.Image = r.Image
+	}
+	if len(r.AddAnnotations) > 0 {
+		if resp.ImageAnnotations == nil {
+			resp.ImageAnnotations = map[string]string{}
+		}
+		for k, v := range r.AddAnnotations {
+			resp.ImageAnnotations[k] = v
+		}
+	}
+}
+
+// ImageContext is the context of the image pulling, which does not update any resource on the node.
+type ImageContext struct {
+	Request  ImageRequest
+	Response ImageResponse
+}
+
+func (i *ImageContext) FromProxy(req *runtimeapi.ImageHookRequest) {
+	i.Request.FromProxy(req)
+}
+
+func (i *ImageContext) ProxyDone(resp *runtimeapi.ImageHookResponse) {
+	i.Response.ProxyDone(resp)
+}
+
+func (i *ImageContext) ReconcilerDone(executor resourceexecutor.ResourceUpdateExecutor) {}
+
+func (i *ImageContext) Update() {}
+
+func (i *ImageContext) GetUpdaters() []resourceexecutor.ResourceUpdater {
+	return nil
+}
+
+func (i *ImageContext) RecordEvent(r record.EventRecorder, pod *corev1.Pod) {}
@@ -73,6 +73,14 @@ func (k *KubeQOSContext) GetUpdaters() []resourceexecutor.ResourceUpdater {
 	return k.updaters
 }
 
+func (k *KubeQOSContext) DryRunCopy() HooksProtocol {
+	return &KubeQOSContext{Request: k.Request}
+}
+
+func (k *KubeQOSContext) DryRunTarget() string {
+	return "kubeqos/" + string(k.Request.KubeQOSClass)
+}
+
 func (k *KubeQOSContext) Update() {
 	k.executor.UpdateBatch(true, k.updaters...)
 	k.updaters = nil
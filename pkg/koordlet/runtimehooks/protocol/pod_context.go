@@ -251,6 +251,14 @@ func (p *PodContext) GetUpdaters() []resourceexecutor.ResourceUpdater {
 	return p.updaters
 }
 
+func (p *PodContext) DryRunCopy() HooksProtocol {
+	return &PodContext{Request: p.Request}
+}
+
+func (p *PodContext) DryRunTarget() string {
+	return p.Request.PodMeta.String()
+}
+
 func (p *PodContext) Update() {
 	p.executor.UpdateBatch(true, p.updaters...)
 	p.updaters = nil
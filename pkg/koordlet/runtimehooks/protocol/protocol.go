@@ -37,6 +37,15 @@ type HooksProtocol interface {
 	RecordEvent(r record.EventRecorder, pod *corev1.Pod)
 }
 
+// DryRunProtocol is implemented by the hooks protocols whose hook results can be previewed without being applied.
+type DryRunProtocol interface {
+	HooksProtocol
+	// DryRunCopy returns a copy with the same request and an empty response.
+	DryRunCopy() HooksProtocol
+	// DryRunTarget returns the object which the hook results apply to.
+	DryRunTarget() string
+}
+
 type hooksProtocolBuilder struct {
 	KubeQOS   func(kubeQOS corev1.PodQOSClass) HooksProtocol
 	Pod       func(podMeta *statesinformer.PodMeta) HooksProtocol
@@ -150,3 +150,61 @@ func (s *server) PreUpdateContainerResourcesHook(ctx context.Context,
 		req.PodMeta.String(), req.ContainerMeta.String(), resp.String())
 	return resp, err
 }
+
+func (s *server) PrePullImageHook(ctx context.Context,
+	req *runtimeapi.ImageHookRequest) (*runtimeapi.ImageHookResponse, error) {
+	klog.V(5).Infof("receive PrePullImageHook request %v", req.String())
+	resp := &runtimeapi.ImageHookResponse{
+		Image:            req.GetImage(),
+		ImageAnnotations: req.GetImageAnnotations(),
+	}
+	imageCtx := &protocol.ImageContext{}
+	imageCtx.FromProxy(req)
+	err := hooks.RunHooks(s.options.PluginFailurePolicy, rmconfig.PrePullImage, imageCtx)
+	imageCtx.ProxyDone(resp)
+	klog.V(5).Infof("send PrePullImageHook for pod %v response %v", req.PodMeta.String(), resp.String())
+	return resp, err
+}
+
+func (s *server) PostContainerStatsHook(ctx context.Context,
+	req *runtimeapi.ContainerStatsHookRequest) (*runtimeapi.ContainerStatsHookResponse, error) {
+	klog.V(5).Infof("receive PostContainerStatsHook request %v", req.String())
+	resp := &runtimeapi.ContainerStatsHookResponse{}
+	statsCtx := &protocol.ContainerStatsContext{}
+	statsCtx.FromProxy(req)
+	err := hooks.RunHooks(s.options.PluginFailurePolicy, rmconfig.PostContainerStats, statsCtx)
+	statsCtx.ProxyDone(resp)
+	klog.V(5).Infof("send PostContainerStatsHook for pod %v container %v response %v",
+		req.PodMeta.String(), req.ContainerMeta.String(), resp.String())
+	return resp, err
+}
+
+func (s *server) PreCheckpointContainerHook(ctx context.Context,
+	req *runtimeapi.ContainerCheckpointHookRequest) (*runtimeapi.ContainerCheckpointHookResponse, error) {
+	return s.runCheckpointHooks(rmconfig.PreCheckpointContainer, req)
+}
+
+func (s *server) PostCheckpointContainerHook(ctx context.Context,
+	req *runtimeapi.ContainerCheckpointHookRequest) (*runtimeapi.ContainerCheckpointHookResponse, error) {
+	return s.runCheckpointHooks(rmconfig.PostCheckpointContainer, req)
+}
+
+func (s *server) PreRestoreContainerHook(ctx context.Context,
+	req *runtimeapi.ContainerCheckpointHookRequest) (*runtimeapi.ContainerCheckpointHookResponse, error) {
+	return s.runCheckpointHooks(rmconfig.PreRestoreContainer, req)
+}
+
+func (s *server) runCheckpointHooks(stage rmconfig.RuntimeHookType,
+	req *runtimeapi.ContainerCheckpointHookRequest) (*runtimeapi.ContainerCheckpointHookResponse, error) {
+	klog.V(5).Infof("receive %vHook request %v", stage, req.String())
+	resp := &runtimeapi.ContainerCheckpointHookResponse{
+		Location: req.GetLocation(),
+	}
+	checkpointCtx := &protocol.CheckpointContext{}
+	checkpointCtx.FromProxy(req)
+	err := hooks.RunHooks(s.options.PluginFailurePolicy, stage, checkpointCtx)
+	checkpointCtx.ProxyDone(resp)
+	klog.V(5).Infof("send %vHook for pod %v container %v response %v",
+		stage, req.PodMeta.String(), req.ContainerMeta.String(), resp.String())
+	return resp, err
+}
@@ -175,5 +175,52 @@ func TestServer(t *testing.T) {
 		})
 		assert.NoError(t, err)
 		assert.NotNil(t, containerResp)
+		// PrePullImageHook without pod meta
+		imageResp, err := ss.PrePullImageHook(context.TODO(), &runtimeapi.ImageHookRequest{
+			Image: "docker.io/library/nginx:latest",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "docker.io/library/nginx:latest", imageResp.GetImage())
+		// PostContainerStatsHook
+		statsResp, err := ss.PostContainerStatsHook(context.TODO(), &runtimeapi.ContainerStatsHookRequest{
+			PodMeta: &runtimeapi.PodSandboxMetadata{
+				Name:      "test-pod",
+				Namespace: "test-ns",
+				Uid:       "xxxxxx",
+			},
+			ContainerMeta: &runtimeapi.ContainerMetadata{
+				Name: "test-container",
+				Id:   "123",
+			},
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, statsResp)
+		// PreCheckpointContainerHook
+		checkpointResp, err := ss.PreCheckpointContainerHook(context.TODO(), &runtimeapi.ContainerCheckpointHookRequest{
+			PodMeta: &runtimeapi.PodSandboxMetadata{
+				Name:      "test-pod",
+				Namespace: "test-ns",
+				Uid:       "xxxxxx",
+			},
+			ContainerMeta: &runtimeapi.ContainerMetadata{
+				Name: "test-container",
+				Id:   "123",
+			},
+			Location: "/var/lib/checkpoints/test.tar",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "/var/lib/checkpoints/test.tar", checkpointResp.GetLocation())
+		// PostCheckpointContainerHook
+		checkpointResp, err = ss.PostCheckpointContainerHook(context.TODO(), &runtimeapi.ContainerCheckpointHookRequest{
+			Location: "/var/lib/checkpoints/test.tar",
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, checkpointResp)
+		// PreRestoreContainerHook
+		checkpointResp, err = ss.PreRestoreContainerHook(context.TODO(), &runtimeapi.ContainerCheckpointHookRequest{
+			Location: "/var/lib/checkpoints/test.tar",
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, checkpointResp)
 	})
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package reconciler
 
 import (
+	"fmt"
 	"reflect"
 	"sync"
 	"time"
@@ -24,9 +25,11 @@ import (
 	"k8s.io/klog/v2"
 
 	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/audit"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/runtimehooks/protocol"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/util"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/util/system"
 )
 
@@ -64,6 +67,7 @@ type hostReconciler struct {
 	hostAppMap        map[string]*slov1alpha1.HostApplicationSpec
 	appUpdated        chan struct{}
 	executor          resourceexecutor.ResourceUpdateExecutor
+	cgroupReader      resourceexecutor.CgroupReader
 	reconcileInterval time.Duration
 }
 
@@ -71,6 +75,7 @@ func NewHostAppReconciler(ctx Context) Reconciler {
 	r := &hostReconciler{
 		appUpdated:        make(chan struct{}, 1),
 		executor:          ctx.Executor,
+		cgroupReader:      resourceexecutor.NewCgroupReader(),
 		reconcileInterval: ctx.ReconcileInterval,
 	}
 	ctx.StatesInformer.RegisterCallbacks(statesinformer.RegisterTypeNodeSLOSpec, "host-app-reconciler",
@@ -103,19 +108,20 @@ func (r *hostReconciler) reconcile(stopCh <-chan struct{}) {
 }
 
 func (r *hostReconciler) appRefreshCallback(t statesinformer.RegisterType, mergedNodeSLOSpecIf interface{},
-	target *statesinformer.CallbackTarget) {
+	target *statesinformer.CallbackTarget) error {
 	if target == nil {
 		klog.Warningf("callback target is nil")
-		return
+		return fmt.Errorf("callback target is nil")
 	}
 	updated := r.parseHostApp(target.HostApplications)
 	if !updated {
 		klog.V(4).Infof("host application in node slo is not updated, no need to reconcile")
-		return
+		return nil
 	}
 	if len(r.appUpdated) == 0 {
 		r.appUpdated <- struct{}{}
 	}
+	return nil
 }
 
 func (r *hostReconciler) parseHostApp(hostApps []slov1alpha1.HostApplicationSpec) bool {
@@ -154,6 +160,9 @@ func (r *hostReconciler) doHostAppCgroup(stopCh <-chan struct{}) {
 		case <-r.appUpdated:
 			hostApps := r.getHostApps()
 			for name, app := range hostApps {
+				if util.IsHostAppProcessMigrated(app) {
+					r.migrateHostAppProcesses(app)
+				}
 				for _, appReconciler := range globalHostAppReconcilers.hostApps {
 					hostCtx := protocol.HooksProtocolBuilder.HostApp(app)
 					if err := appReconciler.fn(hostCtx); err != nil {
@@ -170,3 +179,25 @@ func (r *hostReconciler) doHostAppCgroup(stopCh <-chan struct{}) {
 		}
 	}
 }
+
+// migrateHostAppProcesses moves the selected processes of the host application into its cgroup, so the QoS of the
+// cgroup can take effect on the processes which are not isolated.
+func (r *hostReconciler) migrateHostAppProcesses(app *slov1alpha1.HostApplicationSpec) {
+	pids, err := util.GetHostAppPendingPIDs(app, r.cgroupReader)
+	if err != nil {
+		klog.Warningf("failed to get pending processes for host app %v, err: %v", app.Name, err)
+		return
+	}
+	cgroupDir := util.GetHostAppCgroupRelativePath(app)
+	for _, pid := range pids {
+		if err = system.MoveProcToCgroup(pid, cgroupDir); err != nil {
+			// the process may exit during the migration
+			klog.V(4).Infof("failed to move process %v into cgroup %v for host app %v, err: %v",
+				pid, cgroupDir, app.Name, err)
+			continue
+		}
+		_ = audit.V(3).Group(app.Name).Reason("runtime-hooks").Message(
+			"move process %v into host application cgroup %v", pid, cgroupDir).Do()
+		klog.V(5).Infof("move process %v into cgroup %v for host app %v", pid, cgroupDir, app.Name)
+	}
+}
@@ -18,6 +18,8 @@ package reconciler
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -209,6 +211,35 @@ func Test_hostReconciler_doHostAppCgroup(t *testing.T) {
 	}
 }
 
+func Test_hostReconciler_migrateHostAppProcesses(t *testing.T) {
+	helper := system.NewFileTestUtil(t)
+	defer helper.Cleanup()
+	helper.WriteProcSubFileContents(fmt.Sprintf("%d/cgroup", os.Getpid()), "4:cpu,cpuacct:/system.slice/koordlet.service\n")
+	helper.WriteProcSubFileContents("300/cmdline", "/usr/bin/fluent-bit\x00-c\x00/etc/fluent-bit.conf\x00")
+	helper.WriteProcSubFileContents("300/cgroup", "4:cpu,cpuacct:/\n")
+	helper.WriteProcSubFileContents("301/cmdline", "/usr/bin/node-exporter\x00")
+	helper.WriteProcSubFileContents("301/cgroup", "4:cpu,cpuacct:/\n")
+	helper.WriteProcSubFileContents("302/cmdline", "/usr/bin/fluent-bit\x00")
+	helper.WriteProcSubFileContents("302/cgroup", "4:cpu,cpuacct:/system.slice/fluent-bit.service\n")
+
+	r := &hostReconciler{
+		executor:     resourceexecutor.NewResourceUpdateExecutor(),
+		cgroupReader: resourceexecutor.NewCgroupReader(),
+	}
+	app := &slov1alpha1.HostApplicationSpec{
+		Name: "test-app",
+		QoS:  ext.QoSBE,
+		Selector: &slov1alpha1.HostApplicationSelector{
+			CmdlinePattern: "^/usr/bin/fluent-bit",
+		},
+	}
+	r.migrateHostAppProcesses(app)
+
+	cgroupDir := "host-best-effort/test-app"
+	assert.Equal(t, "300", helper.ReadCgroupFileContents(cgroupDir, system.CPUProcs))
+	assert.True(t, system.FileExists(filepath.Join(system.Conf.CgroupRootDir, system.CgroupMemDir, cgroupDir, system.CPUProcsName)))
+}
+
 func Test_hostReconciler_appRefreshCallback(t *testing.T) {
 	type args struct {
 		target         *statesinformer.CallbackTarget
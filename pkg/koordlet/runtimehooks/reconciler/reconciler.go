@@ -17,6 +17,7 @@ limitations under the License.
 package reconciler
 
 import (
+	"fmt"
 	"strconv"
 	"sync"
 	"time"
@@ -368,10 +369,10 @@ func (c *reconciler) Run(stopCh <-chan struct{}) error {
 	return nil
 }
 
-func (c *reconciler) podRefreshCallback(t statesinformer.RegisterType, o interface{}, target *statesinformer.CallbackTarget) {
+func (c *reconciler) podRefreshCallback(t statesinformer.RegisterType, o interface{}, target *statesinformer.CallbackTarget) error {
 	if target == nil {
 		klog.Warningf("callback target is nil")
-		return
+		return fmt.Errorf("callback target is nil")
 	}
 	c.podsMutex.Lock()
 	defer c.podsMutex.Unlock()
@@ -379,6 +380,7 @@ func (c *reconciler) podRefreshCallback(t statesinformer.RegisterType, o interfa
 	if len(c.podUpdated) == 0 {
 		c.podUpdated <- struct{}{}
 	}
+	return nil
 }
 
 func (c *reconciler) getPodsMeta() []*statesinformer.PodMeta {
@@ -17,10 +17,12 @@ limitations under the License.
 package rule
 
 import (
+	"fmt"
 	"reflect"
 	"runtime"
 	"sync"
 
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
 
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer"
@@ -60,14 +62,17 @@ func Register(name, description string, injectOpts ...InjectOption) *Rule {
 	return r
 }
 
-func (r *Rule) runUpdateCallbacks(target *statesinformer.CallbackTarget) {
+func (r *Rule) runUpdateCallbacks(target *statesinformer.CallbackTarget) error {
 	klog.V(6).Infof("run update callbacks for rules, target %s", target.String())
+	var errs []error
 	for _, callbackFn := range r.callbacks {
 		if err := callbackFn(target); err != nil {
 			cbName := runtime.FuncForPC(reflect.ValueOf(callbackFn).Pointer()).Name()
 			klog.Warningf("executing %s callback function %s failed, error %v", r.name, cbName, err.Error())
+			errs = append(errs, fmt.Errorf("rule %s callback failed, err: %w", r.name, err))
 		}
 	}
+	return utilerrors.NewAggregate(errs)
 }
 
 func find(name string) (*Rule, bool) {
@@ -81,7 +86,10 @@ func find(name string) (*Rule, bool) {
 	return newRule, false
 }
 
-func UpdateRules(ruleType statesinformer.RegisterType, ruleObj interface{}, targets *statesinformer.CallbackTarget) {
+// UpdateRules parses the rules of the ruleType and runs the callbacks of the updated rules.
+// It returns the aggregated error of the rules failed to parse or to run callbacks.
+func UpdateRules(ruleType statesinformer.RegisterType, ruleObj interface{}, targets *statesinformer.CallbackTarget) error {
+	var errs []error
 	klog.V(4).Infof("applying %v rules with new %v, detail: %v",
 		len(globalHookRules), ruleType.String(), util.DumpJSON(ruleObj))
 	for _, r := range globalHookRules {
@@ -98,12 +106,16 @@ func UpdateRules(ruleType statesinformer.RegisterType, ruleObj interface{}, targ
 		updated, err := r.parseRuleFn(ruleObj)
 		if err != nil {
 			klog.Warningf("parse rule %s from nodeSLO failed, error: %v", r.name, err)
+			errs = append(errs, fmt.Errorf("failed to parse rule %s, err: %w", r.name, err))
 			continue
 		}
 		if updated {
 			klog.V(3).Infof("rule %s is updated, run update callback for all %v pods and %v host applications",
 				r.name, len(targets.Pods), len(targets.HostApplications))
-			r.runUpdateCallbacks(targets)
+			if err = r.runUpdateCallbacks(targets); err != nil {
+				errs = append(errs, err)
+			}
 		}
 	}
+	return utilerrors.NewAggregate(errs)
 }
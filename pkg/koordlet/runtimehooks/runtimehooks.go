@@ -25,6 +25,7 @@ import (
 	clientset "k8s.io/client-go/kubernetes"
 	clientcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/component-base/featuregate"
 	"k8s.io/klog/v2"
 
 	"github.com/koordinator-sh/koordinator/pkg/features"
@@ -101,6 +102,15 @@ func NewRuntimeHook(si statesinformer.StatesInformer, cfg *Config, schema *apiru
 	}
 	cr := resourceexecutor.NewCgroupReader()
 	e := resourceexecutor.NewResourceUpdateExecutor()
+	var dryRunRecorder *resourceexecutor.DryRunRecorder
+	if cfg.RuntimeHooksDryRun || len(cfg.RuntimeHooksDryRunPlugins) > 0 {
+		dryRunRecorder = resourceexecutor.NewDryRunRecorder()
+		hooks.EnableDryRun(dryRunRecorder)
+	}
+	if cfg.RuntimeHooksDryRun {
+		klog.Infof("runtime hooks run in dry-run mode, resource updates are recorded but not applied")
+		e = dryRunRecorder.NewExecutor("", "")
+	}
 	newServerOptions := proxyserver.Options{
 		Network:             cfg.RuntimeHooksNetwork,
 		Address:             cfg.RuntimeHooksAddr,
@@ -168,7 +178,7 @@ func NewRuntimeHook(si statesinformer.StatesInformer, cfg *Config, schema *apiru
 		reader:            cr,
 		executor:          e,
 	}
-	registerPlugins(newPluginOptions)
+	registerPlugins(newPluginOptions, dryRunRecorder, getDryRunPlugins(cfg))
 	si.RegisterCallbacks(statesinformer.RegisterTypeNodeSLOSpec, "runtime-hooks-rule-node-slo",
 		"Update hooks rule can run callbacks if NodeSLO spec update",
 		rule.UpdateRules)
@@ -186,15 +196,45 @@ func NewRuntimeHook(si statesinformer.StatesInformer, cfg *Config, schema *apiru
 	return r, nil
 }
 
-func registerPlugins(op hooks.Options) {
+func registerPlugins(op hooks.Options, dryRunRecorder *resourceexecutor.DryRunRecorder, dryRunPlugins map[featuregate.Feature]bool) {
 	klog.V(5).Infof("start register plugins for runtime hook")
 	for hookFeature, hookPlugin := range runtimeHookPlugins {
 		enabled := features.DefaultKoordletFeatureGate.Enabled(hookFeature)
-		if enabled {
+		if enabled && dryRunPlugins[hookFeature] {
+			registerDryRunPlugin(hookFeature, hookPlugin, op, dryRunRecorder)
+		} else if enabled {
 			hookPlugin.Register(op)
 		}
-		klog.Infof("runtime hook plugin %s enable %v", hookFeature, enabled)
+		klog.Infof("runtime hook plugin %s enable %v, dry-run %v", hookFeature, enabled, dryRunPlugins[hookFeature])
+	}
+}
+
+// registerDryRunPlugin registers the plugin with an executor which only records the resource updates, and
+// marks the hooks registered by the plugin as dry-run.
+func registerDryRunPlugin(hookFeature featuregate.Feature, hookPlugin HookPlugin, op hooks.Options, dryRunRecorder *resourceexecutor.DryRunRecorder) {
+	op.Executor = dryRunRecorder.NewExecutor(string(hookFeature), "")
+	registered := hooks.GetHookNames()
+	hookPlugin.Register(op)
+	hooks.EnableDryRun(dryRunRecorder, hooks.GetHookNames().Difference(registered).List()...)
+}
+
+func getDryRunPlugins(cfg *Config) map[featuregate.Feature]bool {
+	dryRunPlugins := map[featuregate.Feature]bool{}
+	if cfg.RuntimeHooksDryRun {
+		for hookFeature := range runtimeHookPlugins {
+			dryRunPlugins[hookFeature] = true
+		}
+		return dryRunPlugins
+	}
+	for _, item := range cfg.RuntimeHooksDryRunPlugins {
+		hookFeature := featuregate.Feature(item)
+		if _, ok := runtimeHookPlugins[hookFeature]; !ok {
+			klog.Warningf("runtime hook plugin %s for dry-run is not found", item)
+			continue
+		}
+		dryRunPlugins[hookFeature] = true
 	}
+	return dryRunPlugins
 }
 
 func getDisableStagesMap(stagesSlice []string) map[string]struct{} {
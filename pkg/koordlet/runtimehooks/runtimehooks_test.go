@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/component-base/featuregate"
 
 	"github.com/koordinator-sh/koordinator/pkg/features"
 	mockstatesinformer "github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer/mockstatesinformer"
@@ -133,3 +134,10 @@ func Test_runtimeHook_Run(t *testing.T) {
 		})
 	}
 }
+
+func Test_getDryRunPlugins(t *testing.T) {
+	got := getDryRunPlugins(&Config{RuntimeHooksDryRunPlugins: []string{string(BatchResource), "unknown"}})
+	assert.Equal(t, map[featuregate.Feature]bool{BatchResource: true}, got)
+	got = getDryRunPlugins(&Config{RuntimeHooksDryRun: true})
+	assert.Len(t, got, len(runtimeHookPlugins))
+}
@@ -112,7 +112,9 @@ func (t *CallbackTarget) String() string {
 	return fmt.Sprintf("target: pods num %v, host apps num %v", len(t.Pods), len(t.HostApplications))
 }
 
-type UpdateCbFn func(t RegisterType, obj interface{}, target *CallbackTarget)
+// UpdateCbFn is the callback function for the object updates. It returns an error if the callback fails to apply the
+// update, which is reported in the NodeSLO status for the callbacks of RegisterTypeNodeSLOSpec.
+type UpdateCbFn func(t RegisterType, obj interface{}, target *CallbackTarget) error
 
 type StatesInformer interface {
 	Run(stopCh <-chan struct{}) error
@@ -98,13 +98,25 @@ func (s *callbackRunner) runCallbacks(objType statesinformer.RegisterType, obj i
 	callbackTarget := &statesinformer.CallbackTarget{
 		Pods: s.statesInformer.GetAllPods(),
 	}
-	if nodeSLO := s.statesInformer.GetNodeSLO(); nodeSLO != nil {
+	nodeSLO := s.statesInformer.GetNodeSLO()
+	if nodeSLO != nil {
 		callbackTarget.HostApplications = nodeSLO.Spec.HostApplications
 	}
 	for _, c := range callbacks {
 		klog.V(5).Infof("start running callback function %v for type %v, pod num %v, host app num %v",
 			c.name, objType.String(), len(callbackTarget.Pods), len(callbackTarget.HostApplications))
-		c.fn(objType, obj, callbackTarget)
+		err := c.fn(objType, obj, callbackTarget)
+		if err != nil {
+			klog.Warningf("failed to run callback function %v for type %v, err: %s", c.name, objType.String(), err)
+		}
+		if objType != statesinformer.RegisterTypeNodeSLOSpec {
+			continue
+		}
+		if err != nil {
+			statesinformer.RecordNodeSLOFailed(c.name, err.Error())
+		} else {
+			statesinformer.RecordNodeSLOApplied(c.name, nodeSLO)
+		}
 	}
 }
 
@@ -17,6 +17,7 @@ limitations under the License.
 package impl
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -78,8 +79,9 @@ func TestRegisterCallbacksAndRun(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			testVar := pointer.Bool(false)
-			callbackFn := func(t statesinformer.RegisterType, obj interface{}, target *statesinformer.CallbackTarget) {
+			callbackFn := func(t statesinformer.RegisterType, obj interface{}, target *statesinformer.CallbackTarget) error {
 				*testVar = true
+				return nil
 			}
 			si := &callbackRunner{
 				stateUpdateCallbacks: map[statesinformer.RegisterType][]updateCallback{
@@ -137,9 +139,10 @@ func Test_statesInformer_startCallbackRunners(t *testing.T) {
 				nodeSLO:     nodeSLO,
 				name:        "get value from node slo label",
 				description: "get value from node slo label",
-				fn: func(t statesinformer.RegisterType, obj interface{}, target *statesinformer.CallbackTarget) {
+				fn: func(t statesinformer.RegisterType, obj interface{}, target *statesinformer.CallbackTarget) error {
 					output <- nodeSLO.Labels["test-label-key"]
 					stopCh <- struct{}{}
+					return nil
 				},
 			},
 			wantOutput: "test-label-val1",
@@ -178,3 +181,51 @@ func Test_statesInformer_startCallbackRunners(t *testing.T) {
 		})
 	}
 }
+
+func Test_callbackRunner_recordNodeSLOStrategies(t *testing.T) {
+	statesinformer.ResetNodeSLOAppliedStrategies()
+	defer statesinformer.ResetNodeSLOAppliedStrategies()
+	nodeSLO := &slov1alpha1.NodeSLO{
+		ObjectMeta: metav1.ObjectMeta{
+			Generation: 2,
+		},
+	}
+	cr := &callbackRunner{
+		stateUpdateCallbacks: map[statesinformer.RegisterType][]updateCallback{
+			statesinformer.RegisterTypeNodeSLOSpec: {},
+		},
+		statesInformer: &statesInformer{
+			states: &PluginState{
+				informerPlugins: map[PluginName]informerPlugin{
+					nodeSLOInformerName: &nodeSLOInformer{
+						nodeSLO: nodeSLO,
+					},
+					podsInformerName: &podsInformer{
+						podMap: map[string]*statesinformer.PodMeta{},
+					},
+				},
+			},
+		},
+	}
+	cr.RegisterCallbacks(statesinformer.RegisterTypeNodeSLOSpec, "succeeded-callback", "callback succeeded",
+		func(t statesinformer.RegisterType, obj interface{}, target *statesinformer.CallbackTarget) error {
+			return nil
+		})
+	cr.RegisterCallbacks(statesinformer.RegisterTypeNodeSLOSpec, "failed-callback", "callback failed",
+		func(t statesinformer.RegisterType, obj interface{}, target *statesinformer.CallbackTarget) error {
+			return fmt.Errorf("expected error")
+		})
+	cr.runCallbacks(statesinformer.RegisterTypeNodeSLOSpec, nodeSLO)
+
+	got := statesinformer.GetNodeSLOAppliedStrategies()
+	assert.Equal(t, 2, len(got))
+	assert.Equal(t, "failed-callback", got[0].Name)
+	assert.Equal(t, slov1alpha1.NodeSLOStrategyFailed, got[0].State)
+	assert.Equal(t, "expected error", got[0].Message)
+	assert.Equal(t, int64(0), got[0].AppliedGeneration)
+	assert.Nil(t, got[0].LastAppliedTime)
+	assert.Equal(t, "succeeded-callback", got[1].Name)
+	assert.Equal(t, slov1alpha1.NodeSLOStrategyApplied, got[1].State)
+	assert.Equal(t, int64(2), got[1].AppliedGeneration)
+	assert.NotNil(t, got[1].LastAppliedTime)
+}
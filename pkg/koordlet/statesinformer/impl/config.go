@@ -24,29 +24,35 @@ import (
 )
 
 type Config struct {
-	KubeletPreferredAddressType string
-	KubeletSyncInterval         time.Duration
-	KubeletSyncTimeout          time.Duration
-	InsecureKubeletTLS          bool
-	KubeletReadOnlyPort         uint
-	NodeTopologySyncInterval    time.Duration
-	DisableQueryKubeletConfig   bool
-	EnableNodeMetricReport      bool
-	MetricReportInterval        time.Duration // Deprecated
-	EnablePodTaskIds            bool
+	KubeletPreferredAddressType    string
+	KubeletSyncInterval            time.Duration
+	KubeletSyncTimeout             time.Duration
+	InsecureKubeletTLS             bool
+	KubeletReadOnlyPort            uint
+	NodeTopologySyncInterval       time.Duration
+	DisableQueryKubeletConfig      bool
+	EnableNodeMetricReport         bool
+	MetricReportInterval           time.Duration // Deprecated
+	EnablePodTaskIds               bool
+	EnableNodeSLOStatusReport      bool
+	NodeSLOStatusReportInterval    time.Duration
+	NodeSLOStatusHeartbeatInterval time.Duration
 }
 
 func NewDefaultConfig() *Config {
 	return &Config{
-		KubeletPreferredAddressType: string(corev1.NodeInternalIP),
-		KubeletSyncInterval:         10 * time.Second,
-		KubeletSyncTimeout:          3 * time.Second,
-		InsecureKubeletTLS:          false,
-		KubeletReadOnlyPort:         10255,
-		NodeTopologySyncInterval:    3 * time.Second,
-		DisableQueryKubeletConfig:   false,
-		EnableNodeMetricReport:      true,
-		EnablePodTaskIds:            false,
+		KubeletPreferredAddressType:    string(corev1.NodeInternalIP),
+		KubeletSyncInterval:            10 * time.Second,
+		KubeletSyncTimeout:             3 * time.Second,
+		InsecureKubeletTLS:             false,
+		KubeletReadOnlyPort:            10255,
+		NodeTopologySyncInterval:       3 * time.Second,
+		DisableQueryKubeletConfig:      false,
+		EnableNodeMetricReport:         true,
+		EnablePodTaskIds:               false,
+		EnableNodeSLOStatusReport:      true,
+		NodeSLOStatusReportInterval:    60 * time.Second,
+		NodeSLOStatusHeartbeatInterval: 10 * time.Minute,
 	}
 }
 
@@ -61,4 +67,7 @@ func (c *Config) InitFlags(fs *flag.FlagSet) {
 	fs.DurationVar(&c.MetricReportInterval, "report-interval", c.MetricReportInterval, "Deprecated since v1.1, use ColocationStrategy.MetricReportIntervalSeconds in config map of slo-controller")
 	fs.BoolVar(&c.EnableNodeMetricReport, "enable-node-metric-report", c.EnableNodeMetricReport, "Enable status update of node metric crd.")
 	fs.BoolVar(&c.EnablePodTaskIds, "enable-pod-taskids", c.EnablePodTaskIds, "Enable pod taskids in statesinformer.")
+	fs.BoolVar(&c.EnableNodeSLOStatusReport, "enable-nodeslo-status-report", c.EnableNodeSLOStatusReport, "Enable status update of node slo crd.")
+	fs.DurationVar(&c.NodeSLOStatusReportInterval, "nodeslo-status-report-interval", c.NodeSLOStatusReportInterval, "The interval which Koordlet will report the node slo status, including the applied strategies, the kernel supported features and the enforcement errors.")
+	fs.DurationVar(&c.NodeSLOStatusHeartbeatInterval, "nodeslo-status-heartbeat-interval", c.NodeSLOStatusHeartbeatInterval, "The interval which Koordlet will refresh the node slo status when the status is not changed.")
 }
@@ -33,16 +33,19 @@ func TestNewDefaultConfig(t *testing.T) {
 		{
 			name: "config",
 			want: &Config{
-				KubeletPreferredAddressType: string(corev1.NodeInternalIP),
-				KubeletSyncInterval:         10 * time.Second,
-				KubeletSyncTimeout:          3 * time.Second,
-				InsecureKubeletTLS:          false,
-				KubeletReadOnlyPort:         10255,
-				NodeTopologySyncInterval:    3 * time.Second,
-				DisableQueryKubeletConfig:   false,
-				EnableNodeMetricReport:      true,
-				MetricReportInterval:        0,
-				EnablePodTaskIds:            false,
+				KubeletPreferredAddressType:    string(corev1.NodeInternalIP),
+				KubeletSyncInterval:            10 * time.Second,
+				KubeletSyncTimeout:             3 * time.Second,
+				InsecureKubeletTLS:             false,
+				KubeletReadOnlyPort:            10255,
+				NodeTopologySyncInterval:       3 * time.Second,
+				DisableQueryKubeletConfig:      false,
+				EnableNodeMetricReport:         true,
+				MetricReportInterval:           0,
+				EnablePodTaskIds:               false,
+				EnableNodeSLOStatusReport:      true,
+				NodeSLOStatusReportInterval:    60 * time.Second,
+				NodeSLOStatusHeartbeatInterval: 10 * time.Minute,
 			},
 		},
 	}
@@ -66,19 +69,25 @@ func TestConfig_InitFlags(t *testing.T) {
 		"--disable-query-kubelet-config=true",
 		"--enable-node-metric-report=false",
 		"--enable-pod-taskids=true",
+		"--enable-nodeslo-status-report=false",
+		"--nodeslo-status-report-interval=30s",
+		"--nodeslo-status-heartbeat-interval=5m",
 	}
 	fs := flag.NewFlagSet(cmdArgs[0], flag.ExitOnError)
 
 	type fields struct {
-		KubeletPreferredAddressType string
-		KubeletSyncInterval         time.Duration
-		KubeletSyncTimeout          time.Duration
-		InsecureKubeletTLS          bool
-		KubeletReadOnlyPort         uint
-		NodeTopologySyncInterval    time.Duration
-		DisableQueryKubeletConfig   bool
-		EnableNodeMetricReport      bool
-		EnablePodTaskIds            bool
+		KubeletPreferredAddressType    string
+		KubeletSyncInterval            time.Duration
+		KubeletSyncTimeout             time.Duration
+		InsecureKubeletTLS             bool
+		KubeletReadOnlyPort            uint
+		NodeTopologySyncInterval       time.Duration
+		DisableQueryKubeletConfig      bool
+		EnableNodeMetricReport         bool
+		EnablePodTaskIds               bool
+		EnableNodeSLOStatusReport      bool
+		NodeSLOStatusReportInterval    time.Duration
+		NodeSLOStatusHeartbeatInterval time.Duration
 	}
 	type args struct {
 		fs *flag.FlagSet
@@ -91,15 +100,18 @@ func TestConfig_InitFlags(t *testing.T) {
 		{
 			name: "not default",
 			fields: fields{
-				KubeletPreferredAddressType: "Hostname",
-				KubeletSyncInterval:         30 * time.Second,
-				KubeletSyncTimeout:          10 * time.Second,
-				InsecureKubeletTLS:          true,
-				KubeletReadOnlyPort:         10258,
-				NodeTopologySyncInterval:    10 * time.Second,
-				DisableQueryKubeletConfig:   true,
-				EnableNodeMetricReport:      false,
-				EnablePodTaskIds:            true,
+				KubeletPreferredAddressType:    "Hostname",
+				KubeletSyncInterval:            30 * time.Second,
+				KubeletSyncTimeout:             10 * time.Second,
+				InsecureKubeletTLS:             true,
+				KubeletReadOnlyPort:            10258,
+				NodeTopologySyncInterval:       10 * time.Second,
+				DisableQueryKubeletConfig:      true,
+				EnableNodeMetricReport:         false,
+				EnablePodTaskIds:               true,
+				EnableNodeSLOStatusReport:      false,
+				NodeSLOStatusReportInterval:    30 * time.Second,
+				NodeSLOStatusHeartbeatInterval: 5 * time.Minute,
 			},
 			args: args{fs: fs},
 		},
@@ -107,15 +119,18 @@ func TestConfig_InitFlags(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			raw := &Config{
-				KubeletPreferredAddressType: tt.fields.KubeletPreferredAddressType,
-				KubeletSyncInterval:         tt.fields.KubeletSyncInterval,
-				KubeletSyncTimeout:          tt.fields.KubeletSyncTimeout,
-				InsecureKubeletTLS:          tt.fields.InsecureKubeletTLS,
-				KubeletReadOnlyPort:         tt.fields.KubeletReadOnlyPort,
-				NodeTopologySyncInterval:    tt.fields.NodeTopologySyncInterval,
-				DisableQueryKubeletConfig:   tt.fields.DisableQueryKubeletConfig,
-				EnableNodeMetricReport:      tt.fields.EnableNodeMetricReport,
-				EnablePodTaskIds:            tt.fields.EnablePodTaskIds,
+				KubeletPreferredAddressType:    tt.fields.KubeletPreferredAddressType,
+				KubeletSyncInterval:            tt.fields.KubeletSyncInterval,
+				KubeletSyncTimeout:             tt.fields.KubeletSyncTimeout,
+				InsecureKubeletTLS:             tt.fields.InsecureKubeletTLS,
+				KubeletReadOnlyPort:            tt.fields.KubeletReadOnlyPort,
+				NodeTopologySyncInterval:       tt.fields.NodeTopologySyncInterval,
+				DisableQueryKubeletConfig:      tt.fields.DisableQueryKubeletConfig,
+				EnableNodeMetricReport:         tt.fields.EnableNodeMetricReport,
+				EnablePodTaskIds:               tt.fields.EnablePodTaskIds,
+				EnableNodeSLOStatusReport:      tt.fields.EnableNodeSLOStatusReport,
+				NodeSLOStatusReportInterval:    tt.fields.NodeSLOStatusReportInterval,
+				NodeSLOStatusHeartbeatInterval: tt.fields.NodeSLOStatusHeartbeatInterval,
 			}
 			c := NewDefaultConfig()
 			c.InitFlags(tt.args.fs)
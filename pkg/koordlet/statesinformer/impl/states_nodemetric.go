@@ -49,6 +49,7 @@ import (
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/metriccache"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/metrics"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/prediction"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer"
 	koordletutil "github.com/koordinator-sh/koordinator/pkg/koordlet/util"
 	"github.com/koordinator-sh/koordinator/pkg/koordlet/util/system"
@@ -359,6 +360,11 @@ func (r *nodeMetricInformer) collectMetric() (*slov1alpha1.NodeMetricInfo, []*sl
 		}
 	}
 
+	ephemeralStorageUsage := r.getEphemeralStorageUsage()
+	if ephemeralStorageUsage != nil && nodeMetricInfo.NodeUsage.ResourceList != nil {
+		nodeMetricInfo.NodeUsage.ResourceList[corev1.ResourceEphemeralStorage] = *resource.NewQuantity(ephemeralStorageUsage.Used, resource.BinarySI)
+	}
+
 	podsMeta := r.podsInformer.GetAllPods()
 	podsMetricInfo := make([]*slov1alpha1.PodMetricInfo, 0, len(podsMeta))
 	nodeSLO := r.nodeSLOInformer.GetNodeSLO()
@@ -370,6 +376,7 @@ func (r *nodeMetricInformer) collectMetric() (*slov1alpha1.NodeMetricInfo, []*sl
 	}
 	node := r.nodeInformer.GetNode()
 	prodPredictor := r.predictorFactory.New(prediction.ProdReclaimablePredictor, prediction.PredictorContext{Node: node})
+	peakPredictor := r.predictorFactory.NewPeakPredictor()
 	for _, podMeta := range podsMeta {
 		podMetric, err := r.collectPodMetric(podMeta, queryParam)
 		if err != nil {
@@ -382,6 +389,19 @@ func (r *nodeMetricInformer) collectMetric() (*slov1alpha1.NodeMetricInfo, []*sl
 			klog.V(4).Infof("predictor add pod aborted, pod %s, err: %v", podMeta.Key(), err)
 		}
 
+		// report the predicted peak if the prediction is ready
+		if podPeak, err := peakPredictor.GetPodPeak(podMeta.Pod); err == nil {
+			podMetric.PredictedPeak = &slov1alpha1.ResourceMap{ResourceList: podPeak}
+		} else {
+			klog.V(6).Infof("skip report predicted peak for pod %s, err: %v", podMeta.Key(), err)
+		}
+
+		if ephemeralStorageUsage != nil && podMetric.PodUsage.ResourceList != nil {
+			if podUsed, ok := ephemeralStorageUsage.PodsUsed[string(podMeta.Pod.UID)]; ok {
+				podMetric.PodUsage.ResourceList[corev1.ResourceEphemeralStorage] = *resource.NewQuantity(podUsed, resource.BinarySI)
+			}
+		}
+
 		r.fillExtensionMap(podMetric, podMeta.Pod)
 		if len(gpus) > 0 {
 			r.fillGPUMetrics(queryParam, podMetric, string(podMeta.Pod.UID), gpus)
@@ -394,18 +414,36 @@ func (r *nodeMetricInformer) collectMetric() (*slov1alpha1.NodeMetricInfo, []*sl
 		}
 		return podsMetricInfo[i].Name < podsMetricInfo[j].Name
 	})
+	cgroupReader := resourceexecutor.NewCgroupReader()
 	for _, hostApp := range nodeSLO.Spec.HostApplications {
+		status := koordletutil.GetHostAppStatus(&hostApp, cgroupReader)
 		appMetric, err := r.collectHostAppMetric(&hostApp, queryParam)
-		if err != nil {
+		if err != nil && status == nil {
 			klog.Warningf("query host application %v metric failed, err: %v", hostApp.Name, err)
 			continue
+		} else if err != nil {
+			// report the resolution status even if the metric is missing, e.g. the application is not found
+			klog.V(4).Infof("query host application %v metric failed, report status %v only, err: %v",
+				hostApp.Name, status.Phase, err)
+			appMetric = &slov1alpha1.HostApplicationMetricInfo{
+				Name:     hostApp.Name,
+				Priority: hostApp.Priority,
+				QoS:      hostApp.QoS,
+			}
 		}
+		appMetric.Status = status
 		hostAppMetricInfo = append(hostAppMetricInfo, appMetric)
 	}
 	sort.Slice(hostAppMetricInfo, func(i, j int) bool {
 		return hostAppMetricInfo[i].Name < hostAppMetricInfo[j].Name
 	})
 
+	if systemPeak, err := peakPredictor.GetSystemPeak(); err == nil {
+		nodeMetricInfo.SystemPredictedPeak = &slov1alpha1.ResourceMap{ResourceList: systemPeak}
+	} else {
+		klog.V(5).Infof("skip report predicted peak for system, err: %v", err)
+	}
+
 	prodReclaimable := &slov1alpha1.ReclaimableMetric{}
 	if p, err := prodPredictor.GetResult(); err != nil {
 		klog.Errorf("failed to get prediction, err %v", err)
@@ -423,6 +461,22 @@ func (r *nodeMetricInformer) collectMetric() (*slov1alpha1.NodeMetricInfo, []*sl
 	return nodeMetricInfo, podsMetricInfo, hostAppMetricInfo, prodReclaimable
 }
 
+// getEphemeralStorageUsage returns the ephemeral storage usages collected by the node storage collector.
+// It returns nil if the usages are not collected.
+func (r *nodeMetricInformer) getEphemeralStorageUsage() *metriccache.NodeEphemeralStorageUsage {
+	value, exist := r.metricCache.Get(metriccache.NodeEphemeralStorageUsageKey)
+	if !exist {
+		klog.V(6).Infof("got no ephemeral storage usage on node, skip ephemeral storage metric collection")
+		return nil
+	}
+	usage, ok := value.(*metriccache.NodeEphemeralStorageUsage)
+	if !ok {
+		klog.V(5).Infof("value type error, expect: %T, got %T", &metriccache.NodeEphemeralStorageUsage{}, value)
+		return nil
+	}
+	return usage
+}
+
 func (r *nodeMetricInformer) queryNodeMetric(start time.Time, end time.Time, aggregateType metriccache.AggregationType,
 	coldStartFilter bool) slov1alpha1.ResourceMap {
 	rm := slov1alpha1.ResourceMap{}
@@ -790,6 +844,21 @@ func (r *nodeMetricInformer) collectHostAppMetric(hostApp *slov1alpha1.HostAppli
 		Priority: hostApp.Priority,
 		QoS:      hostApp.QoS,
 	}
+
+	// report the peak usage for the resource reservation of the host application; ignore the failures
+	cpuPeak, cpuErr := cpuAggregateResult.Value(metriccache.AggregationTypeP95)
+	memPeak, memErr := memAggregateResult.Value(metriccache.AggregationTypeP95)
+	if cpuErr == nil && memErr == nil {
+		rtn.PeakUsage = &slov1alpha1.ResourceMap{
+			ResourceList: corev1.ResourceList{
+				corev1.ResourceCPU:    *resource.NewMilliQuantity(int64(cpuPeak*1000), resource.DecimalSI),
+				corev1.ResourceMemory: *resource.NewQuantity(int64(memPeak), resource.BinarySI),
+			},
+		}
+	} else {
+		klog.V(6).Infof("skip report peak usage for host application %s, cpu err: %v, memory err: %v",
+			hostApp.Name, cpuErr, memErr)
+	}
 	return rtn, nil
 }
 
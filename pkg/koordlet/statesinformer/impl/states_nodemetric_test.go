@@ -1387,6 +1387,12 @@ func Test_nodeMetricInformer_collectHostAppMetric(t *testing.T) {
 						v1.ResourceMemory: *resource.NewQuantity(int64(1024), resource.BinarySI),
 					},
 				},
+				PeakUsage: &slov1alpha1.ResourceMap{
+					ResourceList: v1.ResourceList{
+						v1.ResourceCPU:    *resource.NewMilliQuantity(int64(1000), resource.DecimalSI),
+						v1.ResourceMemory: *resource.NewQuantity(int64(1024), resource.BinarySI),
+					},
+				},
 				Priority: apiext.PriorityBatch,
 				QoS:      apiext.QoSBE,
 			},
@@ -1415,6 +1421,12 @@ func Test_nodeMetricInformer_collectHostAppMetric(t *testing.T) {
 						v1.ResourceMemory: *resource.NewQuantity(int64(1024), resource.BinarySI),
 					},
 				},
+				PeakUsage: &slov1alpha1.ResourceMap{
+					ResourceList: v1.ResourceList{
+						v1.ResourceCPU:    *resource.NewMilliQuantity(int64(1000), resource.DecimalSI),
+						v1.ResourceMemory: *resource.NewQuantity(int64(1024), resource.BinarySI),
+					},
+				},
 				Priority: apiext.PriorityBatch,
 				QoS:      apiext.QoSBE,
 			},
@@ -1443,6 +1455,12 @@ func Test_nodeMetricInformer_collectHostAppMetric(t *testing.T) {
 						v1.ResourceMemory: *resource.NewQuantity(int64(1024), resource.BinarySI),
 					},
 				},
+				PeakUsage: &slov1alpha1.ResourceMap{
+					ResourceList: v1.ResourceList{
+						v1.ResourceCPU:    *resource.NewMilliQuantity(int64(1000), resource.DecimalSI),
+						v1.ResourceMemory: *resource.NewQuantity(int64(1024), resource.BinarySI),
+					},
+				},
 				Priority: apiext.PriorityBatch,
 				QoS:      apiext.QoSBE,
 			},
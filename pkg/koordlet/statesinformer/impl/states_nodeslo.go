@@ -25,6 +25,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
@@ -44,6 +45,12 @@ type nodeSLOInformer struct {
 	nodeSLOInformer cache.SharedIndexInformer
 	nodeSLORWMutex  sync.RWMutex
 	nodeSLO         *slov1alpha1.NodeSLO
+	koordClient     koordclientset.Interface
+
+	statusReportEnabled     bool
+	statusReportInterval    time.Duration
+	statusHeartbeatInterval time.Duration
+	extensionStatuses       []slov1alpha1.NodeSLOExtensionStatus
 
 	callbackRunner *callbackRunner
 }
@@ -59,6 +66,10 @@ func (s *nodeSLOInformer) GetNodeSLO() *slov1alpha1.NodeSLO {
 }
 
 func (s *nodeSLOInformer) Setup(ctx *PluginOption, state *PluginState) {
+	s.koordClient = ctx.KoordClient
+	s.statusReportEnabled = ctx.config.EnableNodeSLOStatusReport
+	s.statusReportInterval = ctx.config.NodeSLOStatusReportInterval
+	s.statusHeartbeatInterval = ctx.config.NodeSLOStatusHeartbeatInterval
 	s.nodeSLOInformer = newNodeSLOInformer(ctx.KoordClient, ctx.NodeName)
 	s.nodeSLOInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
@@ -91,6 +102,9 @@ func (s *nodeSLOInformer) Setup(ctx *PluginOption, state *PluginState) {
 func (s *nodeSLOInformer) Start(stopCh <-chan struct{}) {
 	klog.V(2).Infof("starting node slo informer")
 	go s.nodeSLOInformer.Run(stopCh)
+	if s.statusReportEnabled && s.statusReportInterval > 0 {
+		go wait.Until(s.updateNodeSLOStatus, s.statusReportInterval, stopCh)
+	}
 	klog.V(2).Infof("node slo informer started")
 }
 
@@ -106,6 +120,11 @@ func (s *nodeSLOInformer) HasSynced() bool {
 func (s *nodeSLOInformer) updateNodeSLOSpec(nodeSLO *slov1alpha1.NodeSLO) {
 	s.setNodeSLOSpec(nodeSLO)
 	s.callbackRunner.SendCallback(statesinformer.RegisterTypeNodeSLOSpec)
+	// the extensions are checked with the raw spec since the unknown ones are dropped in the merged spec
+	s.setExtensionStatuses(getNodeSLOExtensionStatuses(nodeSLO))
+	if s.statusReportEnabled {
+		s.updateNodeSLOStatus()
+	}
 }
 
 func (s *nodeSLOInformer) setNodeSLOSpec(nodeSLO *slov1alpha1.NodeSLO) {
@@ -117,6 +136,8 @@ func (s *nodeSLOInformer) setNodeSLOSpec(nodeSLO *slov1alpha1.NodeSLO) {
 	if s.nodeSLO == nil {
 		s.nodeSLO = nodeSLO.DeepCopy()
 	} else {
+		// keep the object meta updated so that the strategies can report the applied generation
+		s.nodeSLO.ObjectMeta = *nodeSLO.ObjectMeta.DeepCopy()
 		s.nodeSLO.Spec = nodeSLO.Spec
 	}
 
@@ -0,0 +1,290 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer"
+	koordletutil "github.com/koordinator-sh/koordinator/pkg/koordlet/util"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/util/system"
+	"github.com/koordinator-sh/koordinator/pkg/util"
+	"github.com/koordinator-sh/koordinator/pkg/util/sloconfig"
+)
+
+func (s *nodeSLOInformer) setExtensionStatuses(statuses []slov1alpha1.NodeSLOExtensionStatus) {
+	s.nodeSLORWMutex.Lock()
+	defer s.nodeSLORWMutex.Unlock()
+	s.extensionStatuses = statuses
+}
+
+func (s *nodeSLOInformer) getExtensionStatuses() []slov1alpha1.NodeSLOExtensionStatus {
+	s.nodeSLORWMutex.RLock()
+	defer s.nodeSLORWMutex.RUnlock()
+	if s.extensionStatuses == nil {
+		return nil
+	}
+	statuses := make([]slov1alpha1.NodeSLOExtensionStatus, len(s.extensionStatuses))
+	copy(statuses, s.extensionStatuses)
+	return statuses
+}
+
+// updateNodeSLOStatus reports the status of the NodeSLO on the node, including the accepted extensions, the applied
+// strategies, the effective features and the recent enforcement errors.
+// The status is written only if it changes or the last reconcile time is older than the heartbeat interval.
+func (s *nodeSLOInformer) updateNodeSLOStatus() {
+	if s.koordClient == nil {
+		return
+	}
+	nodeSLO := s.GetNodeSLO()
+	if nodeSLO == nil {
+		klog.V(4).Infof("nodeSLO is not ready, skip updating the status")
+		return
+	}
+	newStatus := s.generateNodeSLOStatus(nodeSLO)
+	if !s.isNodeSLOStatusNeedUpdate(&nodeSLO.Status, newStatus) {
+		klog.V(5).Infof("NodeSLO %s status is not changed, skip updating", nodeSLO.Name)
+		return
+	}
+
+	skipped := false
+	retErr := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest, err := s.koordClient.SloV1alpha1().NodeSLOs().Get(context.TODO(), nodeSLO.Name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			klog.Warningf("NodeSLO %s not found, skip updating status", nodeSLO.Name)
+			skipped = true
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if !s.isNodeSLOStatusNeedUpdate(&latest.Status, newStatus) {
+			skipped = true
+			return nil
+		}
+		latest.Status = *newStatus
+		_, err = s.koordClient.SloV1alpha1().NodeSLOs().UpdateStatus(context.TODO(), latest, metav1.UpdateOptions{})
+		return err
+	})
+	if retErr != nil {
+		klog.Warningf("update NodeSLO %s status failed, status %v, err %v", nodeSLO.Name, util.DumpJSON(newStatus), retErr)
+	} else if skipped {
+		klog.V(5).Infof("NodeSLO %s status update is skipped", nodeSLO.Name)
+	} else {
+		klog.V(4).Infof("update NodeSLO %s status success, detail: %v", nodeSLO.Name, util.DumpJSON(newStatus))
+	}
+}
+
+// isNodeSLOStatusNeedUpdate returns true if the new status differs from the old one except the last reconcile time,
+// or the old status is not refreshed for the heartbeat interval.
+func (s *nodeSLOInformer) isNodeSLOStatusNeedUpdate(oldStatus, newStatus *slov1alpha1.NodeSLOStatus) bool {
+	if oldStatus.LastReconcileTime == nil || newStatus.LastReconcileTime == nil {
+		return true
+	}
+	if s.statusHeartbeatInterval <= 0 ||
+		newStatus.LastReconcileTime.Sub(oldStatus.LastReconcileTime.Time) >= s.statusHeartbeatInterval {
+		return true
+	}
+	oldCopy, newCopy := oldStatus.DeepCopy(), newStatus.DeepCopy()
+	oldCopy.LastReconcileTime, newCopy.LastReconcileTime = nil, nil
+	return !apiequality.Semantic.DeepEqual(oldCopy, newCopy)
+}
+
+func (s *nodeSLOInformer) generateNodeSLOStatus(nodeSLO *slov1alpha1.NodeSLO) *slov1alpha1.NodeSLOStatus {
+	return &slov1alpha1.NodeSLOStatus{
+		Extensions:        s.getExtensionStatuses(),
+		LastReconcileTime: &metav1.Time{Time: time.Now()},
+		Strategies:        statesinformer.GetNodeSLOAppliedStrategies(),
+		Features:          getNodeSLOFeatureStatuses(nodeSLO),
+		Errors:            getNodeSLOEnforcementErrors(),
+	}
+}
+
+// getNodeSLOExtensionStatuses decodes the extensions with registered schemas, and the unregistered ones are ignored.
+func getNodeSLOExtensionStatuses(nodeSLO *slov1alpha1.NodeSLO) []slov1alpha1.NodeSLOExtensionStatus {
+	if nodeSLO.Spec.Extensions == nil || len(nodeSLO.Spec.Extensions.Object) == 0 {
+		return nil
+	}
+	var statuses []slov1alpha1.NodeSLOExtensionStatus
+	for _, extKey := range sloconfig.GetNodeSLOExtensionSchemaKeys() {
+		if _, ok := nodeSLO.Spec.Extensions.Object[extKey]; !ok {
+			continue
+		}
+		status := slov1alpha1.NodeSLOExtensionStatus{
+			Name:               extKey,
+			State:              slov1alpha1.NodeSLOExtensionAccepted,
+			ObservedGeneration: nodeSLO.Generation,
+		}
+		if _, err := sloconfig.DecodeNodeSLOExtension(nodeSLO, extKey); err != nil {
+			klog.Warningf("NodeSLO %s extension %s is rejected, err: %v", nodeSLO.Name, extKey, err)
+			status.State = slov1alpha1.NodeSLOExtensionRejected
+			status.Message = err.Error()
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+func getNodeSLOEnforcementErrors() []slov1alpha1.NodeSLOEnforcementError {
+	records := resourceexecutor.GetRecentUpdateErrors()
+	if len(records) == 0 {
+		return nil
+	}
+	errs := make([]slov1alpha1.NodeSLOEnforcementError, 0, len(records))
+	for _, record := range records {
+		errs = append(errs, slov1alpha1.NodeSLOEnforcementError{
+			Resource: record.Resource,
+			Message:  record.Message,
+			Count:    record.Count,
+			LastTime: metav1.NewTime(record.LastTime).Rfc3339Copy(),
+		})
+	}
+	return errs
+}
+
+// getNodeSLOFeatureStatuses checks whether the QoS features are enabled in the NodeSLO and supported by the kernel.
+func getNodeSLOFeatureStatuses(nodeSLO *slov1alpha1.NodeSLO) []slov1alpha1.NodeSLOFeatureStatus {
+	qosStrategy := nodeSLO.Spec.ResourceQOSStrategy
+	cpuPolicy := slov1alpha1.CPUQOSPolicyGroupIdentity
+	if qosStrategy != nil && qosStrategy.Policies != nil && qosStrategy.Policies.CPUPolicy != nil {
+		cpuPolicy = *qosStrategy.Policies.CPUPolicy
+	}
+	isCPUQOSEnabled := isResourceQOSEnabled(qosStrategy, func(q *slov1alpha1.ResourceQOS) *bool {
+		if q.CPUQOS == nil {
+			return nil
+		}
+		return q.CPUQOS.Enable
+	})
+
+	features := []struct {
+		name        slov1alpha1.NodeSLOFeature
+		enabled     bool
+		isSupported func() (bool, string)
+	}{
+		{
+			name: slov1alpha1.NodeSLOFeatureResctrl,
+			enabled: isResourceQOSEnabled(qosStrategy, func(q *slov1alpha1.ResourceQOS) *bool {
+				if q.ResctrlQOS == nil {
+					return nil
+				}
+				return q.ResctrlQOS.Enable
+			}),
+			isSupported: isResctrlSupported,
+		},
+		{
+			name:        slov1alpha1.NodeSLOFeatureCoreSched,
+			enabled:     isCPUQOSEnabled && cpuPolicy == slov1alpha1.CPUQOSPolicyCoreSched,
+			isSupported: system.IsCoreSchedSupported,
+		},
+		{
+			name:        slov1alpha1.NodeSLOFeatureGroupIdentity,
+			enabled:     isCPUQOSEnabled && cpuPolicy == slov1alpha1.CPUQOSPolicyGroupIdentity,
+			isSupported: isGroupIdentitySupported,
+		},
+		{
+			name: slov1alpha1.NodeSLOFeatureMemoryQOSWmark,
+			enabled: isResourceQOSEnabled(qosStrategy, func(q *slov1alpha1.ResourceQOS) *bool {
+				if q.MemoryQOS == nil {
+					return nil
+				}
+				return q.MemoryQOS.Enable
+			}),
+			isSupported: func() (bool, string) {
+				return isCgroupResourceSupported(system.MemoryWmarkRatioName, "")
+			},
+		},
+		{
+			name: slov1alpha1.NodeSLOFeatureBlkIO,
+			enabled: isResourceQOSEnabled(qosStrategy, func(q *slov1alpha1.ResourceQOS) *bool {
+				if q.BlkIOQOS == nil {
+					return nil
+				}
+				return q.BlkIOQOS.Enable
+			}),
+			isSupported: func() (bool, string) {
+				return isCgroupResourceSupported(system.BlkioTRIopsName, "")
+			},
+		},
+	}
+
+	statuses := make([]slov1alpha1.NodeSLOFeatureStatus, 0, len(features))
+	for _, f := range features {
+		status := slov1alpha1.NodeSLOFeatureStatus{Name: f.name}
+		if supported, msg := f.isSupported(); !supported {
+			status.State = slov1alpha1.NodeSLOFeatureUnsupported
+			status.Message = msg
+		} else if f.enabled {
+			status.State = slov1alpha1.NodeSLOFeatureEffective
+		} else {
+			status.State = slov1alpha1.NodeSLOFeatureDisabled
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// isResourceQOSEnabled returns if the qos is enabled for any class of the LSR, LS and BE.
+func isResourceQOSEnabled(qosStrategy *slov1alpha1.ResourceQOSStrategy, getEnable func(q *slov1alpha1.ResourceQOS) *bool) bool {
+	if qosStrategy == nil {
+		return false
+	}
+	for _, q := range []*slov1alpha1.ResourceQOS{qosStrategy.LSRClass, qosStrategy.LSClass, qosStrategy.BEClass} {
+		if q == nil {
+			continue
+		}
+		if enable := getEnable(q); enable != nil && *enable {
+			return true
+		}
+	}
+	return false
+}
+
+func isResctrlSupported() (bool, string) {
+	supported, err := system.IsSupportResctrl()
+	if err != nil {
+		return false, fmt.Sprintf("failed to check resctrl, err: %s", err)
+	}
+	if !supported {
+		return false, "cpu does not support CAT/MBA"
+	}
+	return true, ""
+}
+
+func isGroupIdentitySupported() (bool, string) {
+	if system.IsGroupIdentitySysctlSupported() {
+		return true, ""
+	}
+	return isCgroupResourceSupported(system.CPUBVTWarpNsName, koordletutil.GetPodQoSRelativePath(corev1.PodQOSGuaranteed))
+}
+
+func isCgroupResourceSupported(resourceType system.ResourceType, parentDir string) (bool, string) {
+	r, err := system.GetCgroupResource(resourceType)
+	if err != nil {
+		return false, err.Error()
+	}
+	return r.IsSupported(parentDir)
+}
@@ -17,13 +17,22 @@ limitations under the License.
 package impl
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
 	apiext "github.com/koordinator-sh/koordinator/apis/extension"
 	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	koordfake "github.com/koordinator-sh/koordinator/pkg/client/clientset/versioned/fake"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/statesinformer"
+	koordletutil "github.com/koordinator-sh/koordinator/pkg/koordlet/util"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/util/system"
 	"github.com/koordinator-sh/koordinator/pkg/util"
 	"github.com/koordinator-sh/koordinator/pkg/util/sloconfig"
 )
@@ -460,3 +469,195 @@ func Test_mergeNoneResourceQOSIfDisabled(t *testing.T) {
 		})
 	}
 }
+
+func Test_updateNodeSLOExtensionStatus(t *testing.T) {
+	type testStrategy struct {
+		Enable  *bool  `json:"enable,omitempty"`
+		Percent *int64 `json:"percent,omitempty" validate:"omitempty,min=0,max=100"`
+	}
+	err := sloconfig.RegisterNodeSLOExtensionSchema("validExt", &sloconfig.NodeSLOExtensionSchema{
+		NewStrategy: func() interface{} { return &testStrategy{} },
+	})
+	assert.NoError(t, err)
+	defer sloconfig.UnregisterNodeSLOExtensionSchema("validExt")
+	err = sloconfig.RegisterNodeSLOExtensionSchema("invalidExt", &sloconfig.NodeSLOExtensionSchema{
+		NewStrategy: func() interface{} { return &testStrategy{} },
+	})
+	assert.NoError(t, err)
+	defer sloconfig.UnregisterNodeSLOExtensionSchema("invalidExt")
+
+	nodeSLO := &slov1alpha1.NodeSLO{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-node", Generation: 2},
+		Spec: slov1alpha1.NodeSLOSpec{
+			Extensions: &slov1alpha1.ExtensionsMap{
+				Object: map[string]interface{}{
+					"validExt":        map[string]interface{}{"enable": true, "percent": 50},
+					"invalidExt":      map[string]interface{}{"percent": 200},
+					"unregisteredExt": map[string]interface{}{"foo": "bar"},
+				},
+			},
+		},
+	}
+	client := koordfake.NewSimpleClientset(nodeSLO.DeepCopy())
+	r := nodeSLOInformer{
+		koordClient:         client,
+		statusReportEnabled: true,
+		callbackRunner:      NewCallbackRunner(),
+	}
+	r.updateNodeSLOSpec(nodeSLO)
+
+	got, err := client.SloV1alpha1().NodeSLOs().Get(context.TODO(), "test-node", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(got.Status.Extensions))
+	assert.Equal(t, "invalidExt", got.Status.Extensions[0].Name)
+	assert.Equal(t, slov1alpha1.NodeSLOExtensionRejected, got.Status.Extensions[0].State)
+	assert.NotEmpty(t, got.Status.Extensions[0].Message)
+	assert.Equal(t, int64(2), got.Status.Extensions[0].ObservedGeneration)
+	assert.Equal(t, slov1alpha1.NodeSLOExtensionStatus{
+		Name:               "validExt",
+		State:              slov1alpha1.NodeSLOExtensionAccepted,
+		ObservedGeneration: 2,
+	}, got.Status.Extensions[1])
+
+	// decode the accepted extension into the typed strategy
+	strategy, err := sloconfig.DecodeNodeSLOExtension(nodeSLO, "validExt")
+	assert.NoError(t, err)
+	assert.Equal(t, &testStrategy{Enable: pointer.Bool(true), Percent: pointer.Int64(50)}, strategy)
+}
+
+func Test_updateNodeSLOStatus(t *testing.T) {
+	helper := system.NewFileTestUtil(t)
+	defer helper.Cleanup()
+	statesinformer.ResetNodeSLOAppliedStrategies()
+	defer statesinformer.ResetNodeSLOAppliedStrategies()
+	resourceexecutor.ResetUpdateErrors()
+	defer resourceexecutor.ResetUpdateErrors()
+
+	// memory wmark is supported while blkio is not
+	helper.SetResourcesSupported(true, system.MemoryWmarkRatio)
+
+	nodeSLO := &slov1alpha1.NodeSLO{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-node", Generation: 3},
+		Spec:       sloconfig.DefaultNodeSLOSpecConfig(),
+	}
+	nodeSLO.Spec.ResourceQOSStrategy.BEClass.MemoryQOS.Enable = pointer.Bool(true)
+	nodeSLO.Spec.ResourceQOSStrategy.BEClass.BlkIOQOS.Enable = pointer.Bool(true)
+	client := koordfake.NewSimpleClientset(nodeSLO.DeepCopy())
+	r := nodeSLOInformer{
+		koordClient:    client,
+		callbackRunner: NewCallbackRunner(),
+	}
+	r.updateNodeSLOSpec(nodeSLO)
+	statesinformer.RecordNodeSLOApplied("testStrategy", r.GetNodeSLO())
+
+	r.updateNodeSLOStatus()
+	got, err := client.SloV1alpha1().NodeSLOs().Get(context.TODO(), "test-node", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, got.Status.LastReconcileTime)
+	assert.Equal(t, 1, len(got.Status.Strategies))
+	assert.Equal(t, "testStrategy", got.Status.Strategies[0].Name)
+	assert.Equal(t, int64(3), got.Status.Strategies[0].AppliedGeneration)
+	assert.Equal(t, slov1alpha1.NodeSLOStrategyApplied, got.Status.Strategies[0].State)
+	assert.Nil(t, got.Status.Errors)
+
+	gotFeatures := map[slov1alpha1.NodeSLOFeature]slov1alpha1.NodeSLOFeatureState{}
+	for _, f := range got.Status.Features {
+		gotFeatures[f.Name] = f.State
+	}
+	assert.Equal(t, 5, len(gotFeatures))
+	assert.Equal(t, slov1alpha1.NodeSLOFeatureEffective, gotFeatures[slov1alpha1.NodeSLOFeatureMemoryQOSWmark])
+	assert.Equal(t, slov1alpha1.NodeSLOFeatureUnsupported, gotFeatures[slov1alpha1.NodeSLOFeatureBlkIO])
+}
+
+func Test_updateNodeSLOStatusSkipUnchanged(t *testing.T) {
+	helper := system.NewFileTestUtil(t)
+	defer helper.Cleanup()
+	statesinformer.ResetNodeSLOAppliedStrategies()
+	defer statesinformer.ResetNodeSLOAppliedStrategies()
+	resourceexecutor.ResetUpdateErrors()
+	defer resourceexecutor.ResetUpdateErrors()
+
+	nodeSLO := &slov1alpha1.NodeSLO{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-node", Generation: 1},
+		Spec:       sloconfig.DefaultNodeSLOSpecConfig(),
+	}
+	client := koordfake.NewSimpleClientset(nodeSLO.DeepCopy())
+	r := nodeSLOInformer{
+		koordClient:             client,
+		callbackRunner:          NewCallbackRunner(),
+		statusHeartbeatInterval: time.Hour,
+	}
+	r.setNodeSLOSpec(nodeSLO)
+	statesinformer.RecordNodeSLOApplied("testStrategy", r.GetNodeSLO())
+	countStatusUpdates := func() int {
+		count := 0
+		for _, action := range client.Actions() {
+			if action.GetVerb() == "update" && action.GetSubresource() == "status" {
+				count++
+			}
+		}
+		return count
+	}
+
+	r.updateNodeSLOStatus()
+	assert.Equal(t, 1, countStatusUpdates())
+
+	// the status is not changed except the reconcile time
+	statesinformer.RecordNodeSLOApplied("testStrategy", r.GetNodeSLO())
+	r.updateNodeSLOStatus()
+	assert.Equal(t, 1, countStatusUpdates())
+
+	// the strategy status is changed
+	statesinformer.RecordNodeSLOFailed("testStrategy", "node is nil")
+	r.updateNodeSLOStatus()
+	assert.Equal(t, 2, countStatusUpdates())
+	got, err := client.SloV1alpha1().NodeSLOs().Get(context.TODO(), "test-node", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, slov1alpha1.NodeSLOStrategyFailed, got.Status.Strategies[0].State)
+	assert.Equal(t, "node is nil", got.Status.Strategies[0].Message)
+	assert.Equal(t, int64(1), got.Status.Strategies[0].AppliedGeneration)
+
+	// refresh the status after the heartbeat interval even if it is not changed
+	r.statusHeartbeatInterval = time.Nanosecond
+	r.updateNodeSLOStatus()
+	assert.Equal(t, 3, countStatusUpdates())
+}
+
+func Test_getNodeSLOFeatureStatuses(t *testing.T) {
+	helper := system.NewFileTestUtil(t)
+	defer helper.Cleanup()
+	helper.WriteCgroupFileContents(koordletutil.GetPodQoSRelativePath(corev1.PodQOSGuaranteed), system.CPUBVTWarpNs, "0")
+
+	nodeSLO := &slov1alpha1.NodeSLO{Spec: sloconfig.DefaultNodeSLOSpecConfig()}
+	nodeSLO.Spec.ResourceQOSStrategy.LSClass.CPUQOS.Enable = pointer.Bool(true)
+	got := getNodeSLOFeatureStatuses(nodeSLO)
+	assert.Equal(t, slov1alpha1.NodeSLOFeatureGroupIdentity, got[2].Name)
+	assert.Equal(t, slov1alpha1.NodeSLOFeatureEffective, got[2].State)
+
+	// group identity is not effective if the cpu qos policy is core sched
+	coreSched := slov1alpha1.CPUQOSPolicyCoreSched
+	nodeSLO.Spec.ResourceQOSStrategy.Policies = &slov1alpha1.ResourceQOSPolicies{CPUPolicy: &coreSched}
+	got = getNodeSLOFeatureStatuses(nodeSLO)
+	assert.Equal(t, slov1alpha1.NodeSLOFeatureDisabled, got[2].State)
+	assert.Equal(t, slov1alpha1.NodeSLOFeatureCoreSched, got[1].Name)
+	assert.Equal(t, slov1alpha1.NodeSLOFeatureUnsupported, got[1].State)
+	assert.NotEmpty(t, got[1].Message)
+}
+
+func Test_getNodeSLOEnforcementErrors(t *testing.T) {
+	helper := system.NewFileTestUtil(t)
+	defer helper.Cleanup()
+	resourceexecutor.ResetUpdateErrors()
+	defer resourceexecutor.ResetUpdateErrors()
+	assert.Nil(t, getNodeSLOEnforcementErrors())
+
+	helper.WriteCgroupFileContents("test", system.CPUShares, "1024")
+	u, err := resourceexecutor.NewCommonCgroupUpdater(system.CPUSharesName, "test", "-1", nil)
+	assert.NoError(t, err)
+	_, err = resourceexecutor.NewTestResourceExecutor().Update(false, u)
+	assert.Error(t, err)
+	got := getNodeSLOEnforcementErrors()
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, string(system.CPUSharesName), got[0].Resource)
+	assert.Equal(t, int64(1), got[0].Count)
+}
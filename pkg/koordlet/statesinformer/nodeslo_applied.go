@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statesinformer
+
+import (
+	"sort"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+)
+
+var nodeSLOAppliedRecorder = &appliedRecorder{strategies: map[string]slov1alpha1.NodeSLOStrategyStatus{}}
+
+type appliedRecorder struct {
+	lock       sync.RWMutex
+	strategies map[string]slov1alpha1.NodeSLOStrategyStatus
+}
+
+// RecordNodeSLOApplied records the NodeSLO generation which is applied by the strategy.
+// It is called by the strategies only after a round of successful reconciliation with the NodeSLO.
+func RecordNodeSLOApplied(strategy string, nodeSLO *slov1alpha1.NodeSLO) {
+	if nodeSLO == nil {
+		return
+	}
+	nodeSLOAppliedRecorder.lock.Lock()
+	defer nodeSLOAppliedRecorder.lock.Unlock()
+	status := nodeSLOAppliedRecorder.strategies[strategy]
+	// keep the applied time unchanged if the generation is not changed, so the status is not updated on every round
+	if status.State != slov1alpha1.NodeSLOStrategyApplied || status.AppliedGeneration != nodeSLO.Generation ||
+		status.LastAppliedTime == nil {
+		// truncate to seconds as the serialized time, so the status can be compared with the reported one
+		appliedTime := metav1.Now().Rfc3339Copy()
+		status.LastAppliedTime = &appliedTime
+	}
+	status.Name = strategy
+	status.State = slov1alpha1.NodeSLOStrategyApplied
+	status.Message = ""
+	status.AppliedGeneration = nodeSLO.Generation
+	nodeSLOAppliedRecorder.strategies[strategy] = status
+}
+
+// RecordNodeSLOSkipped records the strategy skips the reconciliation with the reason, e.g. the strategy is disabled.
+// The generation applied before is kept.
+func RecordNodeSLOSkipped(strategy string, reason string) {
+	recordNodeSLONotApplied(strategy, slov1alpha1.NodeSLOStrategySkipped, reason)
+}
+
+// RecordNodeSLOFailed records the strategy fails to reconcile with the reason, e.g. failed to read the cgroups.
+// The generation applied before is kept.
+func RecordNodeSLOFailed(strategy string, reason string) {
+	recordNodeSLONotApplied(strategy, slov1alpha1.NodeSLOStrategyFailed, reason)
+}
+
+func recordNodeSLONotApplied(strategy string, state slov1alpha1.NodeSLOStrategyState, reason string) {
+	nodeSLOAppliedRecorder.lock.Lock()
+	defer nodeSLOAppliedRecorder.lock.Unlock()
+	status := nodeSLOAppliedRecorder.strategies[strategy]
+	status.Name = strategy
+	status.State = state
+	status.Message = reason
+	nodeSLOAppliedRecorder.strategies[strategy] = status
+}
+
+// GetNodeSLOAppliedStrategies returns the applied statuses of the strategies in the order of names.
+func GetNodeSLOAppliedStrategies() []slov1alpha1.NodeSLOStrategyStatus {
+	nodeSLOAppliedRecorder.lock.RLock()
+	defer nodeSLOAppliedRecorder.lock.RUnlock()
+	if len(nodeSLOAppliedRecorder.strategies) == 0 {
+		return nil
+	}
+	statuses := make([]slov1alpha1.NodeSLOStrategyStatus, 0, len(nodeSLOAppliedRecorder.strategies))
+	for _, status := range nodeSLOAppliedRecorder.strategies {
+		statuses = append(statuses, *status.DeepCopy())
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+// ResetNodeSLOAppliedStrategies cleans up the applied statuses.
+// NOTE: Please DO NOT use it except unittests.
+func ResetNodeSLOAppliedStrategies() {
+	nodeSLOAppliedRecorder.lock.Lock()
+	defer nodeSLOAppliedRecorder.lock.Unlock()
+	nodeSLOAppliedRecorder.strategies = map[string]slov1alpha1.NodeSLOStrategyStatus{}
+}
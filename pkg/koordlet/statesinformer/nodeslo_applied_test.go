@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statesinformer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+)
+
+func TestRecordNodeSLOStrategies(t *testing.T) {
+	ResetNodeSLOAppliedStrategies()
+	defer ResetNodeSLOAppliedStrategies()
+	assert.Nil(t, GetNodeSLOAppliedStrategies())
+
+	nodeSLO := &slov1alpha1.NodeSLO{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+	RecordNodeSLOApplied("strategyA", nil)
+	RecordNodeSLOSkipped("strategyB", "feature disabled")
+	RecordNodeSLOApplied("strategyA", nodeSLO)
+	got := GetNodeSLOAppliedStrategies()
+	assert.Equal(t, 2, len(got))
+	assert.Equal(t, slov1alpha1.NodeSLOStrategyStatus{
+		Name:              "strategyA",
+		State:             slov1alpha1.NodeSLOStrategyApplied,
+		LastAppliedTime:   got[0].LastAppliedTime,
+		AppliedGeneration: 1,
+	}, got[0])
+	assert.NotNil(t, got[0].LastAppliedTime)
+	assert.Equal(t, slov1alpha1.NodeSLOStrategyStatus{
+		Name:    "strategyB",
+		State:   slov1alpha1.NodeSLOStrategySkipped,
+		Message: "feature disabled",
+	}, got[1])
+	appliedTime := got[0].LastAppliedTime
+
+	// the applied generation is kept when the strategy fails
+	RecordNodeSLOFailed("strategyA", "node is nil")
+	got = GetNodeSLOAppliedStrategies()
+	assert.Equal(t, slov1alpha1.NodeSLOStrategyFailed, got[0].State)
+	assert.Equal(t, "node is nil", got[0].Message)
+	assert.Equal(t, int64(1), got[0].AppliedGeneration)
+	assert.Equal(t, appliedTime, got[0].LastAppliedTime)
+
+	// the message is cleaned up when the strategy is applied again
+	nodeSLO.Generation = 2
+	RecordNodeSLOApplied("strategyA", nodeSLO)
+	got = GetNodeSLOAppliedStrategies()
+	assert.Equal(t, slov1alpha1.NodeSLOStrategyApplied, got[0].State)
+	assert.Empty(t, got[0].Message)
+	assert.Equal(t, int64(2), got[0].AppliedGeneration)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	cpuBenchmarkRounds     = 5
+	cpuBenchmarkIterations = 1 << 22
+)
+
+var (
+	cpuBenchmarkOnce  sync.Once
+	cpuBenchmarkScore float64
+)
+
+// GetCPUBenchmarkScore returns the single-core benchmark score of the node cpu.
+// If the result file is specified, the score produced by an external benchmark is read from the file. Otherwise, the
+// built-in benchmark runs once and the score is cached since the cpu model does not change during the runtime.
+func GetCPUBenchmarkScore(resultFile string) (float64, error) {
+	if len(resultFile) > 0 {
+		return readCPUBenchmarkResult(resultFile)
+	}
+	cpuBenchmarkOnce.Do(func() {
+		cpuBenchmarkScore = runCPUBenchmark(cpuBenchmarkRounds, cpuBenchmarkIterations)
+		klog.V(4).Infof("run cpu benchmark finished, score %v", cpuBenchmarkScore)
+	})
+	return cpuBenchmarkScore, nil
+}
+
+func readCPUBenchmarkResult(path string) (float64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read cpu benchmark result, err: %w", err)
+	}
+	score, err := strconv.ParseFloat(strings.TrimSpace(string(content)), 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse cpu benchmark result, err: %w", err)
+	}
+	if score <= 0 || math.IsInf(score, 0) || math.IsNaN(score) {
+		return 0, fmt.Errorf("invalid cpu benchmark result %v", score)
+	}
+	return score, nil
+}
+
+// runCPUBenchmark runs a lightweight single-thread workload for several rounds and returns the iterations per
+// microsecond of the fastest round, which is the least interfered by the other workloads on the node.
+func runCPUBenchmark(rounds, iterations int) float64 {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	var best time.Duration
+	var sink uint64
+	for i := 0; i < rounds; i++ {
+		start := time.Now()
+		sink += cpuBenchmarkWorkload(iterations)
+		elapsed := time.Since(start)
+		if best <= 0 || elapsed < best {
+			best = elapsed
+		}
+	}
+	klog.V(6).Infof("cpu benchmark finished, rounds %v, iterations %v, best %v, checksum %v",
+		rounds, iterations, best, sink)
+	if best <= 0 {
+		return 0
+	}
+	score := float64(iterations) / float64(best.Nanoseconds()) * 1000
+	return math.Round(score*100) / 100
+}
+
+// cpuBenchmarkWorkload mixes the integer arithmetic, the division and the branches to approximate the general
+// computing capability. It returns a checksum to keep the computation from being optimized away.
+func cpuBenchmarkWorkload(iterations int) uint64 {
+	x := uint64(88172645463325252)
+	var sum uint64
+	for i := 0; i < iterations; i++ {
+		x ^= x << 13
+		x ^= x >> 7
+		x ^= x << 17
+		if x&1 == 0 {
+			sum += x / (uint64(i) | 1)
+		} else {
+			sum ^= x * 2654435761
+		}
+	}
+	return sum
+}
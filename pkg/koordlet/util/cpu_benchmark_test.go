@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+)
+
+func TestGetCPUBenchmarkScore(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   *string
+		want      float64
+		wantErr   bool
+		wantScore bool
+	}{
+		{
+			name:    "read score from result file",
+			content: pointer.String("12.5\n"),
+			want:    12.5,
+		},
+		{
+			name:    "failed to parse result file",
+			content: pointer.String("abc"),
+			wantErr: true,
+		},
+		{
+			name:    "invalid score in result file",
+			content: pointer.String("-1"),
+			wantErr: true,
+		},
+		{
+			name:      "run built-in benchmark",
+			wantScore: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resultFile := ""
+			if tt.content != nil {
+				resultFile = filepath.Join(t.TempDir(), "cpu-benchmark")
+				assert.NoError(t, os.WriteFile(resultFile, []byte(*tt.content), 0644))
+			}
+			got, gotErr := GetCPUBenchmarkScore(resultFile)
+			assert.Equal(t, tt.wantErr, gotErr != nil, gotErr)
+			if tt.wantScore {
+				assert.Greater(t, got, float64(0))
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_runCPUBenchmark(t *testing.T) {
+	got := runCPUBenchmark(2, 1<<16)
+	assert.Greater(t, got, float64(0))
+}
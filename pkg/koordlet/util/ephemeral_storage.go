@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"k8s.io/klog/v2"
+
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/util/system"
+)
+
+// EphemeralStorageUsage is the usage of the node ephemeral storage, i.e. the filesystem of the kubelet root dir.
+type EphemeralStorageUsage struct {
+	// Capacity is the total bytes of the filesystem.
+	Capacity int64
+	// Used is the used bytes of the filesystem.
+	Used int64
+	// PodsUsed maps the pod UID into the bytes used by the pod under the kubelet pod dir, e.g. the emptyDir volumes.
+	// NOTE: The writable layers and the logs of the containers are not counted.
+	PodsUsed map[string]int64
+}
+
+func GetKubeletPodsDir() string {
+	return filepath.Join(system.Conf.VarLibKubeletRootDir, "pods")
+}
+
+// GetEphemeralStorageUsage collects the usages of the node ephemeral storage and the pods on it.
+func GetEphemeralStorageUsage() (*EphemeralStorageUsage, error) {
+	capacity, used, err := system.GetFilesystemUsage(system.Conf.VarLibKubeletRootDir)
+	if err != nil {
+		return nil, err
+	}
+	usage := &EphemeralStorageUsage{
+		Capacity: capacity,
+		Used:     used,
+		PodsUsed: map[string]int64{},
+	}
+
+	podsDir := GetKubeletPodsDir()
+	entries, err := os.ReadDir(podsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kubelet pods dir %s, err: %w", podsDir, err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		podUID := entry.Name()
+		podUsed, err := system.GetDirDiskUsage(filepath.Join(podsDir, podUID))
+		if err != nil {
+			// the pod can be deleted during the collection
+			klog.V(5).Infof("failed to get ephemeral storage usage of pod %s, err: %s", podUID, err)
+			continue
+		}
+		usage.PodsUsed[podUID] = podUsed
+	}
+	return usage, nil
+}
@@ -17,23 +17,35 @@ limitations under the License.
 package util
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
 
 	ext "github.com/koordinator-sh/koordinator/apis/extension"
 	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/util/system"
 )
 
 const (
 	defaultHostLSCgroupDir = "host-latency-sensitive"
 	defaultHostBECgroupDir = "host-best-effort"
+
+	defaultSystemdSlice = "system.slice"
 )
 
 func GetHostAppCgroupRelativePath(hostAppSpec *slov1alpha1.HostApplicationSpec) string {
 	if hostAppSpec == nil {
 		return ""
 	}
+	if hostAppSpec.CgroupPath == nil && hostAppSpec.Selector != nil && len(hostAppSpec.Selector.SystemdUnit) > 0 {
+		return getSystemdUnitCgroupDir(hostAppSpec.Selector.SystemdUnit)
+	}
 	if hostAppSpec.CgroupPath == nil {
 		cgroupBaseDir := ""
 		switch hostAppSpec.QoS {
@@ -58,3 +70,155 @@ func GetHostAppCgroupRelativePath(hostAppSpec *slov1alpha1.HostApplicationSpec)
 		return filepath.Join(cgroupBaseDir, hostAppSpec.CgroupPath.ParentDir, hostAppSpec.CgroupPath.RelativePath)
 	}
 }
+
+// getSystemdUnitCgroupDir returns the cgroup dir of the systemd unit, which is under the system.slice by default.
+func getSystemdUnitCgroupDir(unit string) string {
+	if strings.Contains(unit, "/") {
+		return filepath.Clean(unit)
+	}
+	return filepath.Join(defaultSystemdSlice, unit)
+}
+
+// IsHostAppProcessMigrated checks if the selected processes of the host application should be moved into its cgroup.
+func IsHostAppProcessMigrated(hostAppSpec *slov1alpha1.HostApplicationSpec) bool {
+	return hostAppSpec != nil && hostAppSpec.Selector != nil && len(hostAppSpec.Selector.CmdlinePattern) > 0
+}
+
+// GetHostAppSelectedPIDs returns the pids of the processes whose command lines match the pattern of the host
+// application. The kernel threads are ignored since they have no command line. Only the processes which can be
+// moved safely are selected, see isHostAppProcessMigratable.
+func GetHostAppSelectedPIDs(hostAppSpec *slov1alpha1.HostApplicationSpec) ([]uint32, error) {
+	if !IsHostAppProcessMigrated(hostAppSpec) {
+		return nil, nil
+	}
+	re, err := regexp.Compile(hostAppSpec.Selector.CmdlinePattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cmdline pattern, err: %w", err)
+	}
+	selfCgroupDir, err := system.GetProcCgroupDir(uint32(os.Getpid()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cgroup of koordlet, err: %w", err)
+	}
+	pids, err := system.GetProcPIDs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list processes, err: %w", err)
+	}
+	appCgroupDir := filepath.Clean("/" + GetHostAppCgroupRelativePath(hostAppSpec))
+	var selected []uint32
+	for _, pid := range pids {
+		args, err := system.ProcCmdLine(system.GetProcRootDir(), int(pid))
+		if err != nil || len(args) <= 0 { // process exited or kernel thread
+			continue
+		}
+		if !re.MatchString(strings.Join(args, " ")) {
+			continue
+		}
+		procCgroupDir, err := system.GetProcCgroupDir(pid)
+		if err != nil { // process exited
+			continue
+		}
+		if procCgroupDir != appCgroupDir && !isHostAppProcessMigratable(procCgroupDir, selfCgroupDir) {
+			klog.V(5).Infof("skip process %v of host application %s in cgroup %s", pid, hostAppSpec.Name, procCgroupDir)
+			continue
+		}
+		selected = append(selected, pid)
+	}
+	return selected, nil
+}
+
+// isHostAppProcessMigratable checks whether a process in the cgroup dir can be moved into the host application cgroup.
+// Only the processes in the root cgroup or the allow-listed slices are moved, so the processes of the systemd units,
+// the pods, the other host applications and koordlet itself are never moved.
+func isHostAppProcessMigratable(procCgroupDir, selfCgroupDir string) bool {
+	if strings.HasPrefix(procCgroupDir, "/..") { // out of the cgroup namespace
+		return false
+	}
+	procCgroupDir = filepath.Clean("/" + procCgroupDir)
+	if procCgroupDir == filepath.Clean("/"+selfCgroupDir) {
+		return false
+	}
+	if procCgroupDir == "/" {
+		return true
+	}
+	// the root of the pods is never allowed, e.g. "kubepods", "kubepods.slice"
+	if strings.HasPrefix(procCgroupDir, filepath.Clean("/"+system.KubeRootNameCgroupfs)) ||
+		strings.HasPrefix(procCgroupDir, filepath.Clean("/"+system.KubeRootNameSystemd)) {
+		return false
+	}
+	for _, slice := range strings.Split(system.Conf.HostAppMigratableSlices, ",") {
+		slice = strings.TrimSpace(slice)
+		if slice != "" && strings.HasSuffix(slice, ".slice") && procCgroupDir == filepath.Clean("/"+slice) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetHostAppPendingPIDs returns the selected pids of the host application which are not in its cgroup yet.
+func GetHostAppPendingPIDs(hostAppSpec *slov1alpha1.HostApplicationSpec, cgroupReader resourceexecutor.CgroupReader) ([]uint32, error) {
+	selected, err := GetHostAppSelectedPIDs(hostAppSpec)
+	if err != nil || len(selected) <= 0 {
+		return nil, err
+	}
+	cgroupDir := GetHostAppCgroupRelativePath(hostAppSpec)
+	procs, err := cgroupReader.ReadCPUProcs(cgroupDir)
+	if err != nil {
+		// the cgroup is not created yet
+		klog.V(5).Infof("failed to read procs of host application %s, err: %v", hostAppSpec.Name, err)
+	}
+	procSet := make(map[uint32]struct{}, len(procs))
+	for _, pid := range procs {
+		procSet[pid] = struct{}{}
+	}
+	var pending []uint32
+	for _, pid := range selected {
+		if _, ok := procSet[pid]; !ok {
+			pending = append(pending, pid)
+		}
+	}
+	return pending, nil
+}
+
+// GetHostAppStatus resolves the host application with the selector and returns the status of the resolution.
+func GetHostAppStatus(hostAppSpec *slov1alpha1.HostApplicationSpec, cgroupReader resourceexecutor.CgroupReader) *slov1alpha1.HostApplicationStatus {
+	if hostAppSpec == nil || hostAppSpec.Selector == nil {
+		return nil
+	}
+	status := &slov1alpha1.HostApplicationStatus{
+		CgroupDir: GetHostAppCgroupRelativePath(hostAppSpec),
+	}
+	if IsHostAppProcessMigrated(hostAppSpec) {
+		if system.GetCurrentCgroupVersion() == system.CgroupVersionV2 {
+			if err := system.CheckCgroupV2Controllers(status.CgroupDir); err != nil {
+				status.Phase = slov1alpha1.HostApplicationFailed
+				status.Message = err.Error()
+				return status
+			}
+		}
+		pending, err := GetHostAppPendingPIDs(hostAppSpec, cgroupReader)
+		if err != nil {
+			status.Phase = slov1alpha1.HostApplicationFailed
+			status.Message = err.Error()
+			return status
+		}
+		if len(pending) > 0 {
+			status.Phase = slov1alpha1.HostApplicationPending
+			status.Message = fmt.Sprintf("%d processes are not moved into the cgroup", len(pending))
+			return status
+		}
+	}
+	procs, err := cgroupReader.ReadCPUProcs(status.CgroupDir)
+	if err != nil {
+		status.Phase = slov1alpha1.HostApplicationNotFound
+		status.Message = fmt.Sprintf("cgroup is not found, err: %v", err)
+		return status
+	}
+	status.Processes = int32(len(procs))
+	if len(procs) <= 0 {
+		status.Phase = slov1alpha1.HostApplicationNotFound
+		status.Message = "no process in the cgroup"
+		return status
+	}
+	status.Phase = slov1alpha1.HostApplicationResolved
+	return status
+}
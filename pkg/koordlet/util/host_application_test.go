@@ -17,13 +17,18 @@ limitations under the License.
 package util
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 
 	ext "github.com/koordinator-sh/koordinator/apis/extension"
 	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/resourceexecutor"
+	"github.com/koordinator-sh/koordinator/pkg/koordlet/util/system"
 )
 
 func Test_getHostCgroupRelativePath(t *testing.T) {
@@ -122,6 +127,63 @@ func Test_getHostCgroupRelativePath(t *testing.T) {
 			},
 			want: filepath.Join(GetPodQoSRelativePath(corev1.PodQOSBestEffort), "host-be-app", "test-app"),
 		},
+		{
+			name: "app with systemd unit",
+			args: args{
+				hostAppSpec: &slov1alpha1.HostApplicationSpec{
+					Name: "test-app",
+					QoS:  ext.QoSLS,
+					Selector: &slov1alpha1.HostApplicationSelector{
+						SystemdUnit: "node-exporter.service",
+					},
+				},
+			},
+			want: filepath.Join("system.slice", "node-exporter.service"),
+		},
+		{
+			name: "app with systemd unit in custom slice",
+			args: args{
+				hostAppSpec: &slov1alpha1.HostApplicationSpec{
+					Name: "test-app",
+					QoS:  ext.QoSLS,
+					Selector: &slov1alpha1.HostApplicationSelector{
+						SystemdUnit: "monitor.slice/node-exporter.service",
+					},
+				},
+			},
+			want: filepath.Join("monitor.slice", "node-exporter.service"),
+		},
+		{
+			name: "cgroup path takes precedence over systemd unit",
+			args: args{
+				hostAppSpec: &slov1alpha1.HostApplicationSpec{
+					Name: "test-app",
+					QoS:  ext.QoSLS,
+					CgroupPath: &slov1alpha1.CgroupPath{
+						Base:         slov1alpha1.CgroupBaseTypeRoot,
+						ParentDir:    "host-ls-app",
+						RelativePath: "test-app",
+					},
+					Selector: &slov1alpha1.HostApplicationSelector{
+						SystemdUnit: "node-exporter.service",
+					},
+				},
+			},
+			want: filepath.Join("", "host-ls-app", "test-app"),
+		},
+		{
+			name: "app with cmdline pattern",
+			args: args{
+				hostAppSpec: &slov1alpha1.HostApplicationSpec{
+					Name: "test-app",
+					QoS:  ext.QoSBE,
+					Selector: &slov1alpha1.HostApplicationSelector{
+						CmdlinePattern: "^/usr/bin/fluent-bit",
+					},
+				},
+			},
+			want: filepath.Join(defaultHostBECgroupDir, "test-app"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -131,3 +193,191 @@ func Test_getHostCgroupRelativePath(t *testing.T) {
 		})
 	}
 }
+
+func TestGetHostAppStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		prepareFn   func(helper *system.FileTestUtil)
+		hostAppSpec *slov1alpha1.HostApplicationSpec
+		wantPending []uint32
+		want        *slov1alpha1.HostApplicationStatus
+	}{
+		{
+			name: "app without selector",
+			hostAppSpec: &slov1alpha1.HostApplicationSpec{
+				Name: "test-app",
+				QoS:  ext.QoSLS,
+			},
+			want: nil,
+		},
+		{
+			name: "systemd unit is resolved",
+			prepareFn: func(helper *system.FileTestUtil) {
+				helper.WriteCgroupFileContents("system.slice/node-exporter.service", system.CPUProcs, "100\n101\n")
+			},
+			hostAppSpec: &slov1alpha1.HostApplicationSpec{
+				Name: "test-app",
+				QoS:  ext.QoSLS,
+				Selector: &slov1alpha1.HostApplicationSelector{
+					SystemdUnit: "node-exporter.service",
+				},
+			},
+			want: &slov1alpha1.HostApplicationStatus{
+				Phase:     slov1alpha1.HostApplicationResolved,
+				CgroupDir: "system.slice/node-exporter.service",
+				Processes: 2,
+			},
+		},
+		{
+			name: "systemd unit is not found",
+			hostAppSpec: &slov1alpha1.HostApplicationSpec{
+				Name: "test-app",
+				QoS:  ext.QoSLS,
+				Selector: &slov1alpha1.HostApplicationSelector{
+					SystemdUnit: "node-exporter.service",
+				},
+			},
+			want: &slov1alpha1.HostApplicationStatus{
+				Phase:     slov1alpha1.HostApplicationNotFound,
+				CgroupDir: "system.slice/node-exporter.service",
+			},
+		},
+		{
+			name: "invalid cmdline pattern",
+			hostAppSpec: &slov1alpha1.HostApplicationSpec{
+				Name: "test-app",
+				QoS:  ext.QoSBE,
+				Selector: &slov1alpha1.HostApplicationSelector{
+					CmdlinePattern: "fluent-bit(",
+				},
+			},
+			want: &slov1alpha1.HostApplicationStatus{
+				Phase:     slov1alpha1.HostApplicationFailed,
+				CgroupDir: filepath.Join(defaultHostBECgroupDir, "test-app"),
+			},
+		},
+		{
+			name: "processes are not moved",
+			prepareFn: func(helper *system.FileTestUtil) {
+				helper.WriteProcSubFileContents("200/cmdline", "/usr/bin/fluent-bit\x00-c\x00/etc/fluent-bit.conf\x00")
+				helper.WriteProcSubFileContents("200/cgroup", "4:cpu,cpuacct:/host-best-effort/test-app\n")
+				helper.WriteProcSubFileContents("201/cmdline", "/usr/bin/fluent-bit\x00--dry-run\x00")
+				helper.WriteProcSubFileContents("201/cgroup", "5:memory:/\n4:cpu,cpuacct:/\n")
+				helper.WriteProcSubFileContents("202/cmdline", "/usr/bin/node-exporter\x00")
+				helper.WriteProcSubFileContents("202/cgroup", "4:cpu,cpuacct:/\n")
+				helper.WriteProcSubFileContents("203/cmdline", "/usr/bin/fluent-bit\x00")
+				helper.WriteProcSubFileContents("203/cgroup", "4:cpu,cpuacct:/system.slice/fluent-bit.service\n")
+				helper.WriteProcSubFileContents("204/cmdline", "/usr/bin/fluent-bit\x00")
+				helper.WriteProcSubFileContents("204/cgroup", "4:cpu,cpuacct:/kubepods.slice/kubepods-pod1234.slice/cri-containerd-5678.scope\n")
+				helper.WriteProcSubFileContents("205/cmdline", "/usr/bin/fluent-bit\x00")
+				helper.WriteProcSubFileContents("205/cgroup", "4:cpu,cpuacct:/legacy.slice\n")
+				helper.WriteProcSubFileContents("2/cmdline", "")
+				helper.WriteCgroupFileContents(filepath.Join(defaultHostBECgroupDir, "test-app"), system.CPUProcs, "200\n")
+			},
+			hostAppSpec: &slov1alpha1.HostApplicationSpec{
+				Name: "test-app",
+				QoS:  ext.QoSBE,
+				Selector: &slov1alpha1.HostApplicationSelector{
+					CmdlinePattern: "^/usr/bin/fluent-bit",
+				},
+			},
+			wantPending: []uint32{201},
+			want: &slov1alpha1.HostApplicationStatus{
+				Phase:     slov1alpha1.HostApplicationPending,
+				CgroupDir: filepath.Join(defaultHostBECgroupDir, "test-app"),
+			},
+		},
+		{
+			name: "processes in the allow-listed slice are not moved",
+			prepareFn: func(helper *system.FileTestUtil) {
+				helper.SetConf(func(conf *system.Config) {
+					conf.HostAppMigratableSlices = "legacy.slice, system.slice/fluent-bit.service"
+				}, func(conf *system.Config) {
+					conf.HostAppMigratableSlices = ""
+				})
+				helper.WriteProcSubFileContents("203/cmdline", "/usr/bin/fluent-bit\x00")
+				helper.WriteProcSubFileContents("203/cgroup", "4:cpu,cpuacct:/system.slice/fluent-bit.service\n")
+				helper.WriteProcSubFileContents("205/cmdline", "/usr/bin/fluent-bit\x00")
+				helper.WriteProcSubFileContents("205/cgroup", "4:cpu,cpuacct:/legacy.slice\n")
+			},
+			hostAppSpec: &slov1alpha1.HostApplicationSpec{
+				Name: "test-app",
+				QoS:  ext.QoSBE,
+				Selector: &slov1alpha1.HostApplicationSelector{
+					CmdlinePattern: "^/usr/bin/fluent-bit",
+				},
+			},
+			wantPending: []uint32{205},
+			want: &slov1alpha1.HostApplicationStatus{
+				Phase:     slov1alpha1.HostApplicationPending,
+				CgroupDir: filepath.Join(defaultHostBECgroupDir, "test-app"),
+			},
+		},
+		{
+			name: "cgroups-v2 controllers are not enabled in the root cgroup",
+			prepareFn: func(helper *system.FileTestUtil) {
+				helper.SetCgroupsV2(true)
+				helper.WriteFileContents(system.CgroupSubtreeControlName, "cpuset io")
+				helper.WriteProcSubFileContents("201/cmdline", "/usr/bin/fluent-bit\x00")
+				helper.WriteProcSubFileContents("201/cgroup", "0::/\n")
+			},
+			hostAppSpec: &slov1alpha1.HostApplicationSpec{
+				Name: "test-app",
+				QoS:  ext.QoSBE,
+				Selector: &slov1alpha1.HostApplicationSelector{
+					CmdlinePattern: "^/usr/bin/fluent-bit",
+				},
+			},
+			want: &slov1alpha1.HostApplicationStatus{
+				Phase:     slov1alpha1.HostApplicationFailed,
+				CgroupDir: filepath.Join(defaultHostBECgroupDir, "test-app"),
+			},
+		},
+		{
+			name: "processes are moved",
+			prepareFn: func(helper *system.FileTestUtil) {
+				helper.WriteProcSubFileContents("200/cmdline", "/usr/bin/fluent-bit\x00-c\x00/etc/fluent-bit.conf\x00")
+				helper.WriteProcSubFileContents("200/cgroup", "4:cpu,cpuacct:/host-best-effort/test-app\n")
+				helper.WriteProcSubFileContents("202/cmdline", "/usr/bin/node-exporter\x00")
+				helper.WriteProcSubFileContents("202/cgroup", "4:cpu,cpuacct:/\n")
+				helper.WriteCgroupFileContents(filepath.Join(defaultHostBECgroupDir, "test-app"), system.CPUProcs, "200\n")
+			},
+			hostAppSpec: &slov1alpha1.HostApplicationSpec{
+				Name: "test-app",
+				QoS:  ext.QoSBE,
+				Selector: &slov1alpha1.HostApplicationSelector{
+					CmdlinePattern: "^/usr/bin/fluent-bit",
+				},
+			},
+			want: &slov1alpha1.HostApplicationStatus{
+				Phase:     slov1alpha1.HostApplicationResolved,
+				CgroupDir: filepath.Join(defaultHostBECgroupDir, "test-app"),
+				Processes: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			helper := system.NewFileTestUtil(t)
+			defer helper.Cleanup()
+			helper.WriteProcSubFileContents(fmt.Sprintf("%d/cgroup", os.Getpid()), "4:cpu,cpuacct:/system.slice/koordlet.service\n")
+			if tt.prepareFn != nil {
+				tt.prepareFn(helper)
+			}
+			cgroupReader := resourceexecutor.NewCgroupReader()
+
+			if IsHostAppProcessMigrated(tt.hostAppSpec) && tt.want.Phase != slov1alpha1.HostApplicationFailed {
+				gotPending, err := GetHostAppPendingPIDs(tt.hostAppSpec, cgroupReader)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantPending, gotPending)
+			}
+
+			got := GetHostAppStatus(tt.hostAppSpec, cgroupReader)
+			if got != nil {
+				// ignore the message details
+				got.Message = ""
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -97,6 +97,17 @@ func (i *MemInfo) MemTotalBytes() uint64 {
 	return i.MemTotal * 1024
 }
 
+// SwapTotalBytes returns the mem info's swap total bytes.
+func (i *MemInfo) SwapTotalBytes() uint64 {
+	return i.SwapTotal * 1024
+}
+
+// SwapUsageBytes returns the mem info's swap usage bytes.
+func (i *MemInfo) SwapUsageBytes() uint64 {
+	// swap total - swap free
+	return (i.SwapTotal - i.SwapFree) * 1024
+}
+
 // MemUsageBytes returns the mem info's usage bytes.
 func (i *MemInfo) MemUsageBytes() uint64 {
 	// total - available
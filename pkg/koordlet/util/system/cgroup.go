@@ -19,6 +19,7 @@ package system
 import (
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -256,3 +257,146 @@ func MilliCPUToQuota(milliCPULimit int64) int64 {
 	}
 	return cfsQuota
 }
+
+const CgroupSubtreeControlName = "cgroup.subtree_control"
+
+var (
+	// hostProcCgroupV1Subfs are the cgroups-v1 subsystems in which a host process is moved.
+	hostProcCgroupV1Subfs = []string{CgroupCPUDir, CgroupCPUAcctDir, CgroupMemDir}
+	// hostProcCgroupV2Controllers are the cgroups-v2 controllers which must be enabled for a moved host process.
+	hostProcCgroupV2Controllers = []string{"cpu", "memory"}
+	// systemdUnitSuffixes are the suffixes of the systemd units which own cgroups.
+	systemdUnitSuffixes = []string{".slice", ".service", ".scope", ".socket", ".mount", ".swap"}
+)
+
+// IsSystemdUnitCgroup checks whether the last element of the cgroup dir is a cgroup owned by a systemd unit.
+func IsSystemdUnitCgroup(cgroupDir string) bool {
+	base := filepath.Base(filepath.Clean("/" + cgroupDir))
+	for _, suffix := range systemdUnitSuffixes {
+		if strings.HasSuffix(base, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// MoveProcToCgroup moves the process into the cgroup dir relative to the cgroup root. The missing cgroup dirs are
+// created unless they are owned by systemd units, which are left to systemd.
+// For cgroups-v1, the process is moved in the cpu, cpuacct and memory subsystems.
+// For cgroups-v2, the cpu and memory controllers must be enabled for the cgroup, and they are only enabled in the
+// ancestors not owned by systemd.
+func MoveProcToCgroup(pid uint32, cgroupDir string) error {
+	subfsList := []string{CgroupV2Dir}
+	if GetCurrentCgroupVersion() == CgroupVersionV1 {
+		subfsList = hostProcCgroupV1Subfs
+	}
+	for _, subfs := range subfsList {
+		if err := ensureCgroupDir(GetRootCgroupSubfsDir(subfs), cgroupDir); err != nil {
+			return err
+		}
+		if GetCurrentCgroupVersion() == CgroupVersionV2 {
+			if err := ensureCgroupV2Controllers(cgroupDir, true); err != nil {
+				return err
+			}
+		}
+		dir := filepath.Join(GetRootCgroupSubfsDir(subfs), cgroupDir)
+		procsPath := filepath.Join(dir, CPUProcsName)
+		if err := os.WriteFile(procsPath, []byte(strconv.FormatUint(uint64(pid), 10)), 0644); err != nil {
+			return fmt.Errorf("failed to write %s, err: %w", procsPath, err)
+		}
+	}
+	return nil
+}
+
+// ensureCgroupDir creates the missing dirs of the cgroup dir under the root dir, and fails if any missing dir is owned
+// by a systemd unit.
+func ensureCgroupDir(rootDir, cgroupDir string) error {
+	parentDir := "/"
+	for _, name := range strings.Split(strings.Trim(filepath.Clean("/"+cgroupDir), "/"), "/") {
+		if name == "" {
+			continue
+		}
+		curDir := filepath.Join(parentDir, name)
+		dir := filepath.Join(rootDir, curDir)
+		parentDir = curDir
+		if FileExists(dir) {
+			continue
+		}
+		if IsSystemdUnitCgroup(curDir) {
+			return fmt.Errorf("cgroup dir %s is owned by systemd but not found", curDir)
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create cgroup dir %s, err: %w", dir, err)
+		}
+	}
+	return nil
+}
+
+// CheckCgroupV2Controllers checks whether the cpu and memory controllers can be enabled for the cgroups-v2 dir, i.e.
+// they are enabled in the subtree_control of the root cgroup and the ancestors owned by systemd. The ancestors not
+// created yet are ignored.
+func CheckCgroupV2Controllers(cgroupDir string) error {
+	return ensureCgroupV2Controllers(cgroupDir, false)
+}
+
+// ensureCgroupV2Controllers checks the cpu and memory controllers in the subtree_control of the ancestors of the
+// cgroup dir, and enables the missing ones if enable is true. It fails if the controllers are disabled in the root
+// cgroup or an ancestor owned by systemd.
+func ensureCgroupV2Controllers(cgroupDir string, enable bool) error {
+	var ancestors []string
+	for dir := filepath.Dir(filepath.Clean("/" + cgroupDir)); ; dir = filepath.Dir(dir) {
+		ancestors = append([]string{dir}, ancestors...)
+		if dir == "/" {
+			break
+		}
+	}
+	for _, dir := range ancestors {
+		if !enable && !FileExists(filepath.Join(Conf.CgroupRootDir, dir)) {
+			return nil
+		}
+		missing, err := GetCgroupV2MissingControllers(dir)
+		if err != nil {
+			return err
+		}
+		if len(missing) <= 0 {
+			continue
+		}
+		if dir == "/" || IsSystemdUnitCgroup(dir) {
+			return fmt.Errorf("controllers %v are not enabled in the subtree_control of cgroup %s", missing, dir)
+		}
+		if !enable {
+			continue
+		}
+		subtreeControlPath := filepath.Join(Conf.CgroupRootDir, dir, CgroupSubtreeControlName)
+		content := "+" + strings.Join(missing, " +")
+		if err = os.WriteFile(subtreeControlPath, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to enable controllers %v in %s, err: %w", missing, subtreeControlPath, err)
+		}
+	}
+	return nil
+}
+
+// GetCgroupV2MissingControllers returns the cpu and memory controllers not enabled in the subtree_control of the
+// cgroups-v2 dir, i.e. the controllers unavailable for its children.
+func GetCgroupV2MissingControllers(cgroupDir string) ([]string, error) {
+	subtreeControlPath := filepath.Join(Conf.CgroupRootDir, cgroupDir, CgroupSubtreeControlName)
+	content, err := os.ReadFile(subtreeControlPath)
+	if err != nil && !os.IsNotExist(err) { // no controller is enabled if the file is missing
+		return nil, fmt.Errorf("failed to read %s, err: %w", subtreeControlPath, err)
+	}
+	enabled := strings.Fields(string(content))
+	var missing []string
+	for _, controller := range hostProcCgroupV2Controllers {
+		found := false
+		for _, c := range enabled {
+			if c == controller {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, controller)
+		}
+	}
+	return missing, nil
+}
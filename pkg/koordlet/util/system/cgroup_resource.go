@@ -163,6 +163,10 @@ const (
 	MemoryUsePriorityOomName   = "memory.use_priority_oom"
 	MemoryOomGroupName         = "memory.oom.group"
 	MemoryIdlePageStatsName    = "memory.idle_page_stats"
+	MemorySwapMaxName          = "memory.swap.max"     // cgroups-v2
+	MemorySwapHighName         = "memory.swap.high"    // cgroups-v2
+	MemorySwapCurrentName      = "memory.swap.current" // cgroups-v2
+	MemoryReclaimName          = "memory.reclaim"      // cgroups-v2, linux 5.19+
 
 	BlkioTRIopsName   = "blkio.throttle.read_iops_device"
 	BlkioTRBpsName    = "blkio.throttle.read_bps_device"
@@ -321,6 +325,10 @@ var (
 	MemoryPriorityV2         = DefaultFactory.NewV2(MemoryPriorityName, MemoryPriorityName).WithValidator(MemoryPriorityValidator).WithCheckSupported(SupportedIfFileExists)
 	MemoryUsePriorityOomV2   = DefaultFactory.NewV2(MemoryUsePriorityOomName, MemoryUsePriorityOomName).WithValidator(MemoryUsePriorityOomValidator).WithCheckSupported(SupportedIfFileExists)
 	MemoryOomGroupV2         = DefaultFactory.NewV2(MemoryOomGroupName, MemoryOomGroupName).WithValidator(MemoryOomGroupValidator).WithCheckSupported(SupportedIfFileExists)
+	MemorySwapMaxV2          = DefaultFactory.NewV2(MemorySwapMaxName, MemorySwapMaxName).WithValidator(NaturalInt64Validator).WithCheckSupported(SupportedIfFileExists)
+	MemorySwapHighV2         = DefaultFactory.NewV2(MemorySwapHighName, MemorySwapHighName).WithValidator(NaturalInt64Validator).WithCheckSupported(SupportedIfFileExists)
+	MemorySwapCurrentV2      = DefaultFactory.NewV2(MemorySwapCurrentName, MemorySwapCurrentName).WithCheckSupported(SupportedIfFileExists)
+	MemoryReclaimV2          = DefaultFactory.NewV2(MemoryReclaimName, MemoryReclaimName).WithValidator(NaturalInt64Validator).WithCheckSupported(SupportedIfFileExistsInKubepods).WithCheckOnce(true)
 
 	knownCgroupV2Resources = []Resource{
 		CPUCFSQuotaV2,
@@ -352,6 +360,10 @@ var (
 		MemoryPriorityV2,
 		MemoryUsePriorityOomV2,
 		MemoryOomGroupV2,
+		MemorySwapMaxV2,
+		MemorySwapHighV2,
+		MemorySwapCurrentV2,
+		MemoryReclaimV2,
 		// TODO: register BlkioIOWeight, BlkioIOQoS and BlkioIOModel
 
 		NetClsClassId,
@@ -17,7 +17,10 @@ limitations under the License.
 package system
 
 import (
+	"path/filepath"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestParseCPUStatRaw(t *testing.T) {
@@ -264,3 +267,43 @@ func TestCalcCPUThrottledRatio(t *testing.T) {
 		})
 	}
 }
+
+func TestMoveProcToCgroup(t *testing.T) {
+	t.Run("systemd unit cgroup is not created", func(t *testing.T) {
+		helper := NewFileTestUtil(t)
+		defer helper.Cleanup()
+
+		err := MoveProcToCgroup(100, "system.slice/node-exporter.service")
+		assert.Error(t, err)
+		assert.False(t, FileExists(filepath.Join(Conf.CgroupRootDir, CgroupCPUDir, "system.slice")))
+
+		helper.WriteCgroupFileContents("host-best-effort/test-app", CPUProcs, "")
+		err = MoveProcToCgroup(100, "host-best-effort/test-app")
+		assert.NoError(t, err)
+		assert.Equal(t, "100", helper.ReadCgroupFileContents("host-best-effort/test-app", CPUProcs))
+	})
+	t.Run("cgroups-v2 controllers are enabled in the ancestors", func(t *testing.T) {
+		helper := NewFileTestUtil(t)
+		defer helper.Cleanup()
+		helper.SetCgroupsV2(true)
+		helper.WriteFileContents(CgroupSubtreeControlName, "cpuset cpu io memory")
+
+		err := MoveProcToCgroup(100, "host-best-effort/test-app")
+		assert.NoError(t, err)
+		assert.Equal(t, "+cpu +memory", helper.ReadFileContents(filepath.Join("host-best-effort", CgroupSubtreeControlName)))
+		assert.Equal(t, "100", helper.ReadFileContents(filepath.Join("host-best-effort/test-app", CPUProcsName)))
+	})
+	t.Run("cgroups-v2 controllers are not enabled in a systemd slice", func(t *testing.T) {
+		helper := NewFileTestUtil(t)
+		defer helper.Cleanup()
+		helper.SetCgroupsV2(true)
+		helper.WriteFileContents(CgroupSubtreeControlName, "cpuset cpu io memory")
+		helper.WriteFileContents(filepath.Join("system.slice", CgroupSubtreeControlName), "cpu")
+		helper.MkDirAll("system.slice/node-exporter.service")
+
+		err := MoveProcToCgroup(100, "system.slice/node-exporter.service")
+		assert.Error(t, err)
+		assert.Error(t, CheckCgroupV2Controllers("system.slice/node-exporter.service"))
+		assert.NoError(t, CheckCgroupV2Controllers("host-best-effort/test-app"))
+	})
+}
@@ -51,6 +51,10 @@ type Config struct {
 	DefaultRuntimeType           string
 	HAMICoreLibraryDirectoryPath string
 	PodResourcesProxyPath        string
+
+	// HostAppMigratableSlices are the comma-separated systemd slices whose processes can be moved into the host
+	// application cgroups besides the processes in the root cgroup.
+	HostAppMigratableSlices string
 }
 
 func init() {
@@ -136,4 +140,6 @@ func (c *Config) InitFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.HAMICoreLibraryDirectoryPath, "hami-core-library-directory-path", c.HAMICoreLibraryDirectoryPath, "path of hami core library")
 
 	fs.StringVar(&c.PodResourcesProxyPath, "pod-resources-proxy-path", c.PodResourcesProxyPath, "The path of the socket file for the pod resource proxy")
+
+	fs.StringVar(&c.HostAppMigratableSlices, "host-app-migratable-slices", c.HostAppMigratableSlices, "The comma-separated systemd slices whose processes can be moved into the host application cgroups besides the root cgroup, e.g. \"legacy.slice\". The processes in systemd units are never moved.")
 }
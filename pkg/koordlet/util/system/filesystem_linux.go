@@ -0,0 +1,94 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package system
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// GetFilesystemUsage returns the capacity and the used bytes of the filesystem where the path locates.
+func GetFilesystemUsage(path string) (int64, int64, error) {
+	var st unix.Statfs_t
+	if err := unix.Statfs(path, &st); err != nil {
+		return 0, 0, fmt.Errorf("failed to statfs %s, err: %w", path, err)
+	}
+	capacity := int64(st.Blocks) * int64(st.Bsize)
+	used := int64(st.Blocks-st.Bfree) * int64(st.Bsize)
+	return capacity, used, nil
+}
+
+// GetDirDiskUsage returns the disk bytes used by the files under the dir like `du -sx`.
+// The files on the other filesystems (e.g. the mounted volumes) are not counted, and the hard links are counted once.
+func GetDirDiskUsage(dir string) (int64, error) {
+	rootInfo, err := os.Lstat(dir)
+	if err != nil {
+		return 0, err
+	}
+	rootStat, ok := rootInfo.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, fmt.Errorf("failed to get stat of dir %s", dir)
+	}
+
+	var used int64
+	linkedInodes := map[uint64]struct{}{}
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) { // the file is removed during the walk
+				return nil
+			}
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		st, ok := info.Sys().(*syscall.Stat_t)
+		if !ok {
+			return nil
+		}
+		if st.Dev != rootStat.Dev { // skip the mount points of the other filesystems
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if st.Nlink > 1 && !d.IsDir() {
+			if _, ok := linkedInodes[st.Ino]; ok {
+				return nil
+			}
+			linkedInodes[st.Ino] = struct{}{}
+		}
+		used += int64(st.Blocks) * 512 // st_blocks is in 512-byte units
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to walk dir %s, err: %w", dir, err)
+	}
+	return used, nil
+}
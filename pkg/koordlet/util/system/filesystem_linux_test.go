@@ -0,0 +1,63 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFilesystemUsage(t *testing.T) {
+	capacity, used, err := GetFilesystemUsage(t.TempDir())
+	assert.NoError(t, err)
+	assert.True(t, capacity > 0)
+	assert.True(t, used >= 0 && used <= capacity)
+
+	_, _, err = GetFilesystemUsage(filepath.Join(t.TempDir(), "not-exist"))
+	assert.Error(t, err)
+}
+
+func TestGetDirDiskUsage(t *testing.T) {
+	dir := t.TempDir()
+	emptyUsed, err := GetDirDiskUsage(dir)
+	assert.NoError(t, err)
+
+	subDir := filepath.Join(dir, "volumes", "kubernetes.io~empty-dir", "cache")
+	assert.NoError(t, os.MkdirAll(subDir, 0755))
+	data := make([]byte, 1024*1024)
+	for i := range data {
+		data[i] = 'a'
+	}
+	filePath := filepath.Join(subDir, "data")
+	assert.NoError(t, os.WriteFile(filePath, data, 0644))
+	// the hard link is counted once
+	assert.NoError(t, os.Link(filePath, filepath.Join(subDir, "data-link")))
+
+	used, err := GetDirDiskUsage(dir)
+	assert.NoError(t, err)
+	assert.True(t, used-emptyUsed >= int64(len(data)), "used %v, empty %v", used, emptyUsed)
+	assert.True(t, used-emptyUsed < int64(2*len(data)), "used %v, empty %v", used, emptyUsed)
+
+	_, err = GetDirDiskUsage(filepath.Join(dir, "not-exist"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package system
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CgroupControllersName is the cgroups-v2 file which lists the controllers available in the cgroup.
+const CgroupControllersName = "cgroup.controllers"
+
+// MemoryQOSResources are the cgroup interfaces of the memory qos.
+// The upstream kernel provides `memory.min`, `memory.low`, `memory.high` and `memory.oom.group` only on cgroups-v2,
+// while the Anolis OS provides them on cgroups-v1 too. `memory.swap.max` and `memory.swap.high` are only provided on
+// cgroups-v2, and the others are only provided by the Anolis OS.
+var MemoryQOSResources = []ResourceType{
+	MemoryMinName,
+	MemoryLowName,
+	MemoryHighName,
+	MemoryOomGroupName,
+	MemoryWmarkRatioName,
+	MemoryWmarkScaleFactorName,
+	MemoryWmarkMinAdjName,
+	MemoryPriorityName,
+	MemoryUsePriorityOomName,
+	MemorySwapMaxName,
+	MemorySwapHighName,
+}
+
+// MemoryQOSCapability is the support status of the memory qos interfaces on the node.
+type MemoryQOSCapability struct {
+	CgroupVersion CgroupVersion
+	// Unsupported records the unsupported interfaces and the reasons.
+	Unsupported map[ResourceType]string
+}
+
+func (c *MemoryQOSCapability) IsSupported(resourceType ResourceType) bool {
+	_, unsupported := c.Unsupported[resourceType]
+	return !unsupported
+}
+
+// GetUnsupported returns the unsupported interfaces in the order of MemoryQOSResources.
+func (c *MemoryQOSCapability) GetUnsupported() []ResourceType {
+	var resources []ResourceType
+	for _, t := range MemoryQOSResources {
+		if !c.IsSupported(t) {
+			resources = append(resources, t)
+		}
+	}
+	return resources
+}
+
+// DetectMemoryQOSCapability checks which memory qos interfaces are provided in the kubepods cgroup.
+// It returns an error if the kubepods memory cgroup is not ready.
+func DetectMemoryQOSCapability() (*MemoryQOSCapability, error) {
+	capability := &MemoryQOSCapability{
+		CgroupVersion: GetCurrentCgroupVersion(),
+		Unsupported:   map[ResourceType]string{},
+	}
+	parentDir := CgroupPathFormatter.ParentDir
+	usage, err := GetCgroupResource(MemoryUsageName)
+	if err != nil {
+		return nil, err
+	}
+	kubepodsDir := filepath.Dir(usage.Path(parentDir))
+	exists, err := PathExists(kubepodsDir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot check if kubepods memory cgroup exists, err: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("kubepods memory cgroup %s not exist", kubepodsDir)
+	}
+
+	if capability.CgroupVersion == CgroupVersionV2 && !isMemoryControllerEnabled(kubepodsDir) {
+		for _, t := range MemoryQOSResources {
+			capability.Unsupported[t] = "memory controller is not enabled in kubepods cgroup"
+		}
+		return capability, nil
+	}
+	for _, t := range MemoryQOSResources {
+		r, err := GetCgroupResource(t)
+		if err != nil {
+			capability.Unsupported[t] = err.Error()
+			continue
+		}
+		if supported, msg := r.IsSupported(parentDir); !supported {
+			capability.Unsupported[t] = msg
+			continue
+		}
+		if exists, _ := PathExists(r.Path(parentDir)); !exists {
+			capability.Unsupported[t] = "file not exist in kubepods cgroup"
+		}
+	}
+	return capability, nil
+}
+
+func isMemoryControllerEnabled(cgroupDir string) bool {
+	content, err := os.ReadFile(filepath.Join(cgroupDir, CgroupControllersName))
+	if err != nil {
+		// regard as enabled if the controllers are unknown, and the interfaces are checked later
+		return true
+	}
+	for _, controller := range strings.Fields(string(content)) {
+		if controller == "memory" {
+			return true
+		}
+	}
+	return false
+}
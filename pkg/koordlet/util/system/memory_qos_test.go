@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package system
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectMemoryQOSCapability(t *testing.T) {
+	t.Run("kubepods cgroup not ready", func(t *testing.T) {
+		helper := NewFileTestUtil(t)
+		defer helper.Cleanup()
+		helper.SetCgroupsV2(true)
+		got, err := DetectMemoryQOSCapability()
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("upstream kernel with cgroups-v2", func(t *testing.T) {
+		helper := NewFileTestUtil(t)
+		defer helper.Cleanup()
+		parentDir := CgroupPathFormatter.ParentDir
+		helper.WriteCgroupFileContents(parentDir, MemoryMinV2, "0")
+		helper.WriteCgroupFileContents(parentDir, MemoryLowV2, "0")
+		helper.WriteCgroupFileContents(parentDir, MemoryHighV2, "max")
+		helper.WriteCgroupFileContents(parentDir, MemoryUsageV2, "1048576")
+		helper.CreateCgroupFile(parentDir, MemoryOomGroupV2)
+		helper.WriteCgroupFileContents(parentDir, MemorySwapMaxV2, "max")
+		helper.WriteCgroupFileContents(parentDir, MemorySwapHighV2, "max")
+		helper.WriteFileContents(filepath.Join(parentDir, CgroupControllersName), "cpuset cpu io memory pids")
+
+		got, err := DetectMemoryQOSCapability()
+		assert.NoError(t, err)
+		assert.Equal(t, CgroupVersionV2, got.CgroupVersion)
+		assert.True(t, got.IsSupported(MemoryMinName))
+		assert.True(t, got.IsSupported(MemoryLowName))
+		assert.True(t, got.IsSupported(MemoryHighName))
+		assert.True(t, got.IsSupported(MemoryOomGroupName))
+		assert.True(t, got.IsSupported(MemorySwapMaxName))
+		assert.True(t, got.IsSupported(MemorySwapHighName))
+		assert.Equal(t, []ResourceType{MemoryWmarkRatioName, MemoryWmarkScaleFactorName, MemoryWmarkMinAdjName,
+			MemoryPriorityName, MemoryUsePriorityOomName}, got.GetUnsupported())
+	})
+	t.Run("memory controller disabled", func(t *testing.T) {
+		helper := NewFileTestUtil(t)
+		defer helper.Cleanup()
+		parentDir := CgroupPathFormatter.ParentDir
+		helper.WriteCgroupFileContents(parentDir, MemoryUsageV2, "1048576")
+		helper.WriteFileContents(filepath.Join(parentDir, CgroupControllersName), "cpuset cpu io pids")
+
+		got, err := DetectMemoryQOSCapability()
+		assert.NoError(t, err)
+		assert.Equal(t, MemoryQOSResources, got.GetUnsupported())
+		assert.Equal(t, "memory controller is not enabled in kubepods cgroup", got.Unsupported[MemoryMinName])
+	})
+	t.Run("swap interfaces unsupported on cgroups-v1", func(t *testing.T) {
+		helper := NewFileTestUtil(t)
+		defer helper.Cleanup()
+		helper.SetCgroupsV2(false)
+		parentDir := CgroupPathFormatter.ParentDir
+		helper.WriteCgroupFileContents(parentDir, MemoryUsage, "1048576")
+
+		got, err := DetectMemoryQOSCapability()
+		assert.NoError(t, err)
+		assert.Equal(t, CgroupVersionV1, got.CgroupVersion)
+		assert.False(t, got.IsSupported(MemorySwapMaxName))
+		assert.False(t, got.IsSupported(MemorySwapHighName))
+	})
+}
@@ -179,3 +179,56 @@ func GetContainerPGIDs(containerParentDir string) ([]uint32, error) {
 
 	return GetPGIDsForPIDs(pids)
 }
+
+// GetProcCgroupDir returns the cgroup dir of the process relative to the cgroup root, which is parsed from the
+// /proc/<pid>/cgroup. For cgroups-v1, it is the cgroup dir in the cpu subsystem.
+// The dir starts with "/.." if the process is out of the cgroup namespace of the reader.
+func GetProcCgroupDir(pid uint32) (string, error) {
+	cgroupPath := filepath.Join(GetProcRootDir(), strconv.FormatUint(uint64(pid), 10), "cgroup")
+	content, err := os.ReadFile(cgroupPath)
+	if err != nil {
+		return "", err
+	}
+	isCgroupV2 := GetCurrentCgroupVersion() == CgroupVersionV2
+	for _, line := range strings.Split(string(content), "\n") {
+		// hierarchy-ID:controller-list:cgroup-path, e.g. "0::/system.slice/sshd.service", "4:cpu,cpuacct:/"
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		if isCgroupV2 && fields[0] == "0" && fields[1] == "" {
+			return fields[2], nil
+		}
+		if !isCgroupV2 {
+			for _, controller := range strings.Split(fields[1], ",") {
+				if controller == "cpu" {
+					return fields[2], nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("cgroup of process %v not found in %s", pid, cgroupPath)
+}
+
+// GetProcPIDs returns the pids of all processes in the proc root dir in ascending order.
+func GetProcPIDs() ([]uint32, error) {
+	entries, err := os.ReadDir(Conf.ProcRootDir)
+	if err != nil {
+		return nil, err
+	}
+	var pids []uint32
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		pid, err := strconv.ParseUint(entry.Name(), 10, 32)
+		if err != nil { // not a process dir
+			continue
+		}
+		pids = append(pids, uint32(pid))
+	}
+	sort.Slice(pids, func(i, j int) bool {
+		return pids[i] < pids[j]
+	})
+	return pids, nil
+}
@@ -295,3 +295,23 @@ func TestGetContainerPGIDs(t *testing.T) {
 		})
 	}
 }
+
+func TestGetProcCgroupDir(t *testing.T) {
+	helper := NewFileTestUtil(t)
+	defer helper.Cleanup()
+	helper.WriteProcSubFileContents("100/cgroup", "12:memory:/system.slice/sshd.service\n4:cpu,cpuacct:/system.slice/sshd.service\n1:name=systemd:/system.slice/sshd.service\n")
+	helper.WriteProcSubFileContents("101/cgroup", "0::/\n")
+
+	got, err := GetProcCgroupDir(100)
+	assert.NoError(t, err)
+	assert.Equal(t, "/system.slice/sshd.service", got)
+	_, err = GetProcCgroupDir(101)
+	assert.Error(t, err)
+	_, err = GetProcCgroupDir(102)
+	assert.Error(t, err)
+
+	helper.SetCgroupsV2(true)
+	got, err = GetProcCgroupDir(101)
+	assert.NoError(t, err)
+	assert.Equal(t, "/", got)
+}
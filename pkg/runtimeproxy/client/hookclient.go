@@ -30,6 +30,8 @@ import (
 
 type HookServerClientManagerInterface interface {
 	RuntimeHookServerClient(serverPath HookServerPath) (*RuntimeHookClient, error)
+	// RemoveRuntimeHookServerClient closes the client, and a new connection is created when it is requested again.
+	RemoveRuntimeHookServerClient(serverPath HookServerPath)
 }
 
 type HookServerClientManager struct {
@@ -45,6 +47,11 @@ const (
 // TODO: garbage client gc
 func NewClientManager() *HookServerClientManager {
 	cache := lru.New(defaultCacheSize)
+	cache.OnEvicted = func(key lru.Key, value interface{}) {
+		if client, ok := value.(*RuntimeHookClient); ok {
+			client.Close()
+		}
+	}
 	return &HookServerClientManager{
 		cache: cache,
 	}
@@ -58,6 +65,16 @@ type HookServerPath struct {
 type RuntimeHookClient struct {
 	SockPath string
 	v1alpha1.RuntimeHookServiceClient
+	conn *grpc.ClientConn
+}
+
+func (c *RuntimeHookClient) Close() {
+	if c == nil || c.conn == nil {
+		return
+	}
+	if err := c.conn.Close(); err != nil {
+		klog.V(4).Infof("fail to close client %v, err: %v", c.SockPath, err)
+	}
 }
 
 func newRuntimeHookClient(sockPath string) (*RuntimeHookClient, error) {
@@ -70,6 +87,7 @@ func newRuntimeHookClient(sockPath string) (*RuntimeHookClient, error) {
 		return nil, err
 	}
 	client.RuntimeHookServiceClient = v1alpha1.NewRuntimeHookServiceClient(conn)
+	client.conn = conn
 	return client, nil
 }
 
@@ -89,3 +107,9 @@ func (cm *HookServerClientManager) RuntimeHookServerClient(serverPath HookServer
 	cm.cache.Add(serverPath, runtimeHookClient)
 	return runtimeHookClient, nil
 }
+
+func (cm *HookServerClientManager) RemoveRuntimeHookServerClient(serverPath HookServerPath) {
+	cm.Lock()
+	defer cm.Unlock()
+	cm.cache.Remove(serverPath)
+}
@@ -19,6 +19,7 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type FailurePolicyType string
@@ -58,13 +59,64 @@ const (
 	PreUpdateContainerResources RuntimeHookType = "PreUpdateContainerResources"
 	PostStopContainer           RuntimeHookType = "PostStopContainer"
 	PreRemoveRunPodSandbox      RuntimeHookType = "PreRemoveRunPodSandbox"
+	PrePullImage                RuntimeHookType = "PrePullImage"
+	PostContainerStats          RuntimeHookType = "PostContainerStats"
+	PreCheckpointContainer      RuntimeHookType = "PreCheckpointContainer"
+	PostCheckpointContainer     RuntimeHookType = "PostCheckpointContainer"
+	PreRestoreContainer         RuntimeHookType = "PreRestoreContainer"
 	NoneRuntimeHookType         RuntimeHookType = "NoneRuntimeHookType"
 )
 
+const (
+	defaultHookTimeout                    = 5 * time.Second
+	defaultCircuitBreakerFailureThreshold = 3
+	defaultCircuitBreakerOpenDuration     = 30 * time.Second
+)
+
 type RuntimeHookConfig struct {
 	RemoteEndpoint string            `json:"remote-endpoint,omitempty"`
 	FailurePolicy  FailurePolicyType `json:"failure-policy,omitempty"`
 	RuntimeHooks   []RuntimeHookType `json:"runtime-hooks,omitempty"`
+	// TimeoutSeconds is the timeout of each call to the hook server. Default is 5s.
+	TimeoutSeconds int64 `json:"timeout-seconds,omitempty"`
+	// CircuitBreaker stops calling the hook server for a while after it fails continuously,
+	// and the requests are handled by the FailurePolicy directly.
+	CircuitBreaker *CircuitBreakerConfig `json:"circuit-breaker,omitempty"`
+}
+
+type CircuitBreakerConfig struct {
+	// Disabled disables the circuit breaker.
+	Disabled bool `json:"disabled,omitempty"`
+	// FailureThreshold is the number of consecutive failures to open the circuit. Default is 3.
+	FailureThreshold int64 `json:"failure-threshold,omitempty"`
+	// OpenSeconds is the duration the circuit keeps open before probing the hook server again. Default is 30s.
+	OpenSeconds int64 `json:"open-seconds,omitempty"`
+}
+
+func (c *RuntimeHookConfig) GetTimeout() time.Duration {
+	if c.TimeoutSeconds > 0 {
+		return time.Duration(c.TimeoutSeconds) * time.Second
+	}
+	return defaultHookTimeout
+}
+
+// GetCircuitBreaker returns the failure threshold and the open duration of the circuit breaker.
+// The failure threshold is zero if the circuit breaker is disabled.
+func (c *RuntimeHookConfig) GetCircuitBreaker() (int64, time.Duration) {
+	threshold, openDuration := int64(defaultCircuitBreakerFailureThreshold), defaultCircuitBreakerOpenDuration
+	if c.CircuitBreaker == nil {
+		return threshold, openDuration
+	}
+	if c.CircuitBreaker.Disabled {
+		return 0, 0
+	}
+	if c.CircuitBreaker.FailureThreshold > 0 {
+		threshold = c.CircuitBreaker.FailureThreshold
+	}
+	if c.CircuitBreaker.OpenSeconds > 0 {
+		openDuration = time.Duration(c.CircuitBreaker.OpenSeconds) * time.Second
+	}
+	return threshold, openDuration
 }
 
 type RuntimeRequestPath string
@@ -76,6 +128,10 @@ const (
 	StartContainer           RuntimeRequestPath = "StartContainer"
 	UpdateContainerResources RuntimeRequestPath = "UpdateContainerResources"
 	StopContainer            RuntimeRequestPath = "StopContainer"
+	PullImage                RuntimeRequestPath = "PullImage"
+	ContainerStats           RuntimeRequestPath = "ContainerStats"
+	CheckpointContainer      RuntimeRequestPath = "CheckpointContainer"
+	RestoreContainer         RuntimeRequestPath = "RestoreContainer" // CreateContainer from a checkpoint archive
 	NoneRuntimeHookPath      RuntimeRequestPath = "NoneRuntimeHookPath"
 )
 
@@ -109,6 +165,26 @@ func (ht RuntimeHookType) OccursOn(path RuntimeRequestPath) bool {
 		if path == StopContainer {
 			return true
 		}
+	case PrePullImage:
+		if path == PullImage {
+			return true
+		}
+	case PostContainerStats:
+		if path == ContainerStats {
+			return true
+		}
+	case PreCheckpointContainer:
+		if path == CheckpointContainer {
+			return true
+		}
+	case PostCheckpointContainer:
+		if path == CheckpointContainer {
+			return true
+		}
+	case PreRestoreContainer:
+		if path == RestoreContainer {
+			return true
+		}
 	}
 	return false
 }
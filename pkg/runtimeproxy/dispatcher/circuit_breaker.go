@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"sync"
+	"time"
+
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/metrics"
+)
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitClosed:
+		return "Closed"
+	case circuitOpen:
+		return "Open"
+	case circuitHalfOpen:
+		return "HalfOpen"
+	}
+	return "Unknown"
+}
+
+// circuitBreaker opens after failureThreshold consecutive failures of a hook server, and rejects the requests
+// until openDuration elapses. Then one request is allowed to probe the hook server, which closes the circuit
+// if succeeded or opens it again if failed.
+type circuitBreaker struct {
+	sync.Mutex
+	endpoint         string
+	failureThreshold int64
+	openDuration     time.Duration
+
+	state    circuitState
+	failures int64
+	openedAt time.Time
+}
+
+func newCircuitBreaker(endpoint string) *circuitBreaker {
+	return &circuitBreaker{endpoint: endpoint}
+}
+
+func (b *circuitBreaker) setConfig(failureThreshold int64, openDuration time.Duration) {
+	b.Lock()
+	defer b.Unlock()
+	b.failureThreshold = failureThreshold
+	b.openDuration = openDuration
+	if failureThreshold <= 0 && b.state != circuitClosed {
+		b.setState(circuitClosed)
+		b.failures = 0
+	}
+}
+
+// allow returns whether the request can be sent to the hook server.
+func (b *circuitBreaker) allow(now time.Time) bool {
+	b.Lock()
+	defer b.Unlock()
+	switch b.state {
+	case circuitOpen:
+		if now.Sub(b.openedAt) < b.openDuration {
+			return false
+		}
+		// only one probe is allowed at the same time
+		b.setState(circuitHalfOpen)
+		return true
+	case circuitHalfOpen:
+		return false
+	}
+	return true
+}
+
+func (b *circuitBreaker) onSuccess() {
+	b.Lock()
+	defer b.Unlock()
+	b.failures = 0
+	if b.state != circuitClosed {
+		b.setState(circuitClosed)
+	}
+}
+
+// onFailure records a failure and returns true if the circuit is opened by it.
+func (b *circuitBreaker) onFailure(now time.Time) bool {
+	b.Lock()
+	defer b.Unlock()
+	if b.failureThreshold <= 0 {
+		return false
+	}
+	b.failures++
+	if b.state == circuitHalfOpen || b.failures >= b.failureThreshold {
+		b.openedAt = now
+		b.setState(circuitOpen)
+		return true
+	}
+	return false
+}
+
+func (b *circuitBreaker) setState(state circuitState) {
+	b.state = state
+	metrics.RecordHookServerCircuitBreakerState(b.endpoint, int(state))
+}
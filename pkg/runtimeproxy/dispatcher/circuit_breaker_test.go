@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	b := newCircuitBreaker("endpoint0")
+	b.setConfig(3, time.Minute)
+
+	assert.True(t, b.allow(now))
+	assert.False(t, b.onFailure(now))
+	assert.False(t, b.onFailure(now))
+	// a success resets the consecutive failures
+	b.onSuccess()
+	assert.False(t, b.onFailure(now))
+	assert.False(t, b.onFailure(now))
+	assert.True(t, b.onFailure(now))
+	assert.Equal(t, circuitOpen, b.state)
+	assert.False(t, b.allow(now.Add(30*time.Second)))
+
+	// only one probe is allowed when the circuit is half-open
+	assert.True(t, b.allow(now.Add(time.Minute)))
+	assert.Equal(t, circuitHalfOpen, b.state)
+	assert.False(t, b.allow(now.Add(time.Minute)))
+	// the probe failed and the circuit is open again
+	assert.True(t, b.onFailure(now.Add(time.Minute)))
+	assert.False(t, b.allow(now.Add(90*time.Second)))
+	assert.True(t, b.allow(now.Add(2*time.Minute)))
+	b.onSuccess()
+	assert.Equal(t, circuitClosed, b.state)
+	assert.True(t, b.allow(now.Add(2*time.Minute)))
+
+	// disabled
+	b.onFailure(now)
+	b.onFailure(now)
+	assert.True(t, b.onFailure(now))
+	b.setConfig(0, 0)
+	assert.Equal(t, circuitClosed, b.state)
+	assert.False(t, b.onFailure(now))
+	assert.True(t, b.allow(now))
+}
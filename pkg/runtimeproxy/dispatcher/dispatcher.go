@@ -19,6 +19,8 @@ package dispatcher
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -27,12 +29,17 @@ import (
 	"github.com/koordinator-sh/koordinator/apis/runtime/v1alpha1"
 	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/client"
 	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/config"
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/metrics"
 )
 
 // RuntimeHookDispatcher dispatches hook request to RuntimeHookServer(e.g. koordlet)
 type RuntimeHookDispatcher struct {
 	cm          client.HookServerClientManagerInterface
 	hookManager config.ManagerInterface
+
+	breakerLock sync.Mutex
+	// breakers records the circuit breaker of each hook server indexed by the remote endpoint
+	breakers map[string]*circuitBreaker
 }
 
 func NewRuntimeDispatcher() *RuntimeHookDispatcher {
@@ -61,6 +68,16 @@ func (rd *RuntimeHookDispatcher) dispatchInternal(ctx context.Context, hookType
 		return client.PostStartContainerHook(ctx, request.(*v1alpha1.ContainerResourceHookRequest))
 	case config.PostStopContainer:
 		return client.PostStopContainerHook(ctx, request.(*v1alpha1.ContainerResourceHookRequest))
+	case config.PrePullImage:
+		return client.PrePullImageHook(ctx, request.(*v1alpha1.ImageHookRequest))
+	case config.PostContainerStats:
+		return client.PostContainerStatsHook(ctx, request.(*v1alpha1.ContainerStatsHookRequest))
+	case config.PreCheckpointContainer:
+		return client.PreCheckpointContainerHook(ctx, request.(*v1alpha1.ContainerCheckpointHookRequest))
+	case config.PostCheckpointContainer:
+		return client.PostCheckpointContainerHook(ctx, request.(*v1alpha1.ContainerCheckpointHookRequest))
+	case config.PreRestoreContainer:
+		return client.PreRestoreContainerHook(ctx, request.(*v1alpha1.ContainerCheckpointHookRequest))
 	}
 	return nil, status.Errorf(codes.Unimplemented, fmt.Sprintf("method %v not implemented", string(hookType)))
 }
@@ -76,21 +93,55 @@ func (rd *RuntimeHookDispatcher) Dispatch(ctx context.Context, runtimeRequestPat
 			if hookType.HookStage() != stage {
 				continue
 			}
-			client, err := rd.cm.RuntimeHookServerClient(client.HookServerPath{
+			breaker := rd.getCircuitBreaker(hookServer)
+			if !breaker.allow(time.Now()) {
+				metrics.RecordHookServerRequestDurationMilliSeconds(hookServer.RemoteEndpoint, string(hookType), metrics.StatusRejected, 0)
+				return nil, fmt.Errorf("circuit breaker of hook server %v is open", hookServer.RemoteEndpoint), hookServer.FailurePolicy
+			}
+			serverPath := client.HookServerPath{
 				Path: hookServer.RemoteEndpoint,
-			})
+			}
+			client, err := rd.cm.RuntimeHookServerClient(serverPath)
 			if err != nil {
 				klog.Errorf("fail to get client %v", err)
+				breaker.onFailure(time.Now())
 				continue
 			}
 			// currently, only one hook be called during one runtime
 			// TODO: multi hook server to merge response
-			rsp, err := rd.dispatchInternal(ctx, hookType, client, request)
+			start := time.Now()
+			hookCtx, cancel := context.WithTimeout(ctx, hookServer.GetTimeout())
+			rsp, err := rd.dispatchInternal(hookCtx, hookType, client, request)
+			cancel()
 			if err != nil {
+				metrics.RecordHookServerRequestDurationMilliSeconds(hookServer.RemoteEndpoint, string(hookType), metrics.StatusFailed, time.Since(start).Seconds())
+				if breaker.onFailure(time.Now()) {
+					klog.Warningf("circuit breaker of hook server %v is open, err: %v", hookServer.RemoteEndpoint, err)
+					// reconnect when the hook server is probed again
+					rd.cm.RemoveRuntimeHookServerClient(serverPath)
+				}
 				return nil, err, hookServer.FailurePolicy
 			}
+			metrics.RecordHookServerRequestDurationMilliSeconds(hookServer.RemoteEndpoint, string(hookType), metrics.StatusSucceed, time.Since(start).Seconds())
+			breaker.onSuccess()
 			return rsp, err, hookServer.FailurePolicy
 		}
 	}
 	return nil, nil, config.PolicyNone
 }
+
+func (rd *RuntimeHookDispatcher) getCircuitBreaker(hookServer *config.RuntimeHookConfig) *circuitBreaker {
+	rd.breakerLock.Lock()
+	defer rd.breakerLock.Unlock()
+	if rd.breakers == nil {
+		rd.breakers = map[string]*circuitBreaker{}
+	}
+	breaker, ok := rd.breakers[hookServer.RemoteEndpoint]
+	if !ok {
+		breaker = newCircuitBreaker(hookServer.RemoteEndpoint)
+		rd.breakers[hookServer.RemoteEndpoint] = breaker
+	}
+	// the config may be updated
+	breaker.setConfig(hookServer.GetCircuitBreaker())
+	return breaker
+}
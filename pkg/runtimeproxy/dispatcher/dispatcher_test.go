@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
@@ -80,6 +81,40 @@ func TestRuntimeHookDispatcher_Dispatch(t *testing.T) {
 			expectedOperation: config.PolicyFail,
 			expectReturnErr:   true,
 		},
+		{
+			name:        "image hook hit, and hook server access ok",
+			requestPath: config.PullImage,
+			request:     &v1alpha1.ImageHookRequest{},
+			allHooks: []*config.RuntimeHookConfig{
+				{
+					RemoteEndpoint: "endpoint0",
+					FailurePolicy:  config.PolicyFail,
+					RuntimeHooks: []config.RuntimeHookType{
+						config.PrePullImage,
+					},
+				},
+			},
+			expectedOperation: config.PolicyFail,
+			expectReturnErr:   false,
+		},
+		{
+			name:               "checkpoint hook hit, and hook server access fail",
+			requestPath:        config.CheckpointContainer,
+			request:            &v1alpha1.ContainerCheckpointHookRequest{},
+			hookSeverReturnErr: fmt.Errorf("checkpoint is not allowed"),
+			allHooks: []*config.RuntimeHookConfig{
+				{
+					RemoteEndpoint: "endpoint0",
+					FailurePolicy:  config.PolicyFail,
+					RuntimeHooks: []config.RuntimeHookType{
+						config.PreCheckpointContainer,
+						config.PostCheckpointContainer,
+					},
+				},
+			},
+			expectedOperation: config.PolicyFail,
+			expectReturnErr:   true,
+		},
 		{
 			name:        "has hook but not the requested one",
 			requestPath: config.RunPodSandbox,
@@ -116,6 +151,46 @@ func TestRuntimeHookDispatcher_Dispatch(t *testing.T) {
 	}
 }
 
+func TestRuntimeHookDispatcher_CircuitBreaker(t *testing.T) {
+	hookServer := &config.RuntimeHookConfig{
+		RemoteEndpoint: "endpoint0",
+		FailurePolicy:  config.PolicyIgnore,
+		RuntimeHooks:   []config.RuntimeHookType{config.PreRunPodSandbox},
+		CircuitBreaker: &config.CircuitBreakerConfig{
+			FailureThreshold: 2,
+			OpenSeconds:      1,
+		},
+	}
+	clientManager := NewMockHookServerClientManager(fmt.Errorf("hook server is down"))
+	runtimeHookDispatcher := &RuntimeHookDispatcher{
+		hookManager: NewMockManager([]*config.RuntimeHookConfig{hookServer}),
+		cm:          clientManager,
+	}
+	dispatch := func() error {
+		_, err, policy := runtimeHookDispatcher.Dispatch(context.TODO(), config.RunPodSandbox, config.PreHook, &v1alpha1.PodSandboxHookRequest{})
+		assert.Equal(t, config.PolicyIgnore, policy)
+		return err
+	}
+
+	assert.Error(t, dispatch())
+	assert.Empty(t, clientManager.removed)
+	assert.Error(t, dispatch())
+	// the circuit is open, and the client is removed to reconnect later
+	assert.Equal(t, []client.HookServerPath{{Path: "endpoint0"}}, clientManager.removed)
+	breaker := runtimeHookDispatcher.breakers["endpoint0"]
+	assert.Equal(t, circuitOpen, breaker.state)
+
+	// the hook server recovers, but the requests are rejected until the circuit is half-open
+	clientManager.hookServerError = nil
+	err := dispatch()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "circuit breaker")
+
+	breaker.openedAt = breaker.openedAt.Add(-time.Second)
+	assert.NoError(t, dispatch())
+	assert.Equal(t, circuitClosed, breaker.state)
+}
+
 type mockManager struct {
 	allHooks []*config.RuntimeHookConfig
 }
@@ -136,6 +211,7 @@ func (m *mockManager) Run() error {
 
 type mockHookServerClientManager struct {
 	hookServerError error
+	removed         []client.HookServerPath
 }
 
 func NewMockHookServerClientManager(hookServerError error) *mockHookServerClientManager {
@@ -152,11 +228,18 @@ func (m *mockHookServerClientManager) RuntimeHookServerClient(serverPath client.
 	}, nil
 }
 
+func (m *mockHookServerClientManager) RemoveRuntimeHookServerClient(serverPath client.HookServerPath) {
+	m.removed = append(m.removed, serverPath)
+}
+
 type mockHookServerClient struct {
 	hookServerError error
 }
 
 func (m *mockHookServerClient) PreRunPodSandboxHook(ctx context.Context, in *v1alpha1.PodSandboxHookRequest, opts ...grpc.CallOption) (*v1alpha1.PodSandboxHookResponse, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, fmt.Errorf("no timeout")
+	}
 	return &v1alpha1.PodSandboxHookResponse{}, m.hookServerError
 }
 func (m *mockHookServerClient) PostStopPodSandboxHook(ctx context.Context, in *v1alpha1.PodSandboxHookRequest, opts ...grpc.CallOption) (*v1alpha1.PodSandboxHookResponse, error) {
@@ -177,3 +260,23 @@ func (m *mockHookServerClient) PostStopContainerHook(ctx context.Context, in *v1
 func (m *mockHookServerClient) PreUpdateContainerResourcesHook(ctx context.Context, in *v1alpha1.ContainerResourceHookRequest, opts ...grpc.CallOption) (*v1alpha1.ContainerResourceHookResponse, error) {
 	return nil, nil
 }
+
+func (m *mockHookServerClient) PrePullImageHook(ctx context.Context, in *v1alpha1.ImageHookRequest, opts ...grpc.CallOption) (*v1alpha1.ImageHookResponse, error) {
+	return &v1alpha1.ImageHookResponse{}, m.hookServerError
+}
+
+func (m *mockHookServerClient) PostContainerStatsHook(ctx context.Context, in *v1alpha1.ContainerStatsHookRequest, opts ...grpc.CallOption) (*v1alpha1.ContainerStatsHookResponse, error) {
+	return nil, nil
+}
+
+func (m *mockHookServerClient) PreCheckpointContainerHook(ctx context.Context, in *v1alpha1.ContainerCheckpointHookRequest, opts ...grpc.CallOption) (*v1alpha1.ContainerCheckpointHookResponse, error) {
+	return &v1alpha1.ContainerCheckpointHookResponse{}, m.hookServerError
+}
+
+func (m *mockHookServerClient) PostCheckpointContainerHook(ctx context.Context, in *v1alpha1.ContainerCheckpointHookRequest, opts ...grpc.CallOption) (*v1alpha1.ContainerCheckpointHookResponse, error) {
+	return nil, nil
+}
+
+func (m *mockHookServerClient) PreRestoreContainerHook(ctx context.Context, in *v1alpha1.ContainerCheckpointHookRequest, opts ...grpc.CallOption) (*v1alpha1.ContainerCheckpointHookResponse, error) {
+	return &v1alpha1.ContainerCheckpointHookResponse{}, m.hookServerError
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	RuntimeProxySubsystem = "koord_runtime_proxy"
+
+	// HookServerEndpoint represents the remote endpoint of the hook server.
+	HookServerEndpoint = "endpoint"
+	// RuntimeHookType represents the type of the runtime hook, e.g. PreRunPodSandbox.
+	RuntimeHookType = "hook"
+	StatusKey       = "status"
+
+	StatusSucceed = "succeed"
+	StatusFailed  = "failed"
+	// StatusRejected represents the request is not sent since the circuit breaker is open.
+	StatusRejected = "rejected"
+)
+
+var (
+	hookServerRequestDurationMilliSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: RuntimeProxySubsystem,
+		Name:      "hook_server_request_duration_milliseconds",
+		Help:      "time duration of the requests to the runtime hook servers",
+		// 1ms ~ 16.384s
+		Buckets: prometheus.ExponentialBuckets(1, 4, 8),
+	}, []string{HookServerEndpoint, RuntimeHookType, StatusKey})
+
+	hookServerCircuitBreakerState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: RuntimeProxySubsystem,
+		Name:      "hook_server_circuit_breaker_state",
+		Help:      "the circuit breaker state of the runtime hook servers, 0 for closed, 1 for open and 2 for half-open",
+	}, []string{HookServerEndpoint})
+
+	Collectors = []prometheus.Collector{
+		hookServerRequestDurationMilliSeconds,
+		hookServerCircuitBreakerState,
+	}
+
+	registerOnce sync.Once
+)
+
+func Register(registerer prometheus.Registerer) {
+	registerOnce.Do(func() {
+		registerer.MustRegister(Collectors...)
+	})
+}
+
+func RecordHookServerRequestDurationMilliSeconds(endpoint, hookType, status string, seconds float64) {
+	// convert seconds to milliseconds
+	hookServerRequestDurationMilliSeconds.WithLabelValues(endpoint, hookType, status).Observe(seconds * 1000)
+}
+
+func RecordHookServerCircuitBreakerState(endpoint string, state int) {
+	hookServerCircuitBreakerState.WithLabelValues(endpoint).Set(float64(state))
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cri
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/koordinator-sh/koordinator/apis/runtime/v1alpha1"
+	"github.com/koordinator-sh/koordinator/cmd/koord-runtime-proxy/options"
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/store"
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/utils"
+)
+
+// IsRestoreContainerRequest checks if the CreateContainerRequest restores the container from a checkpoint archive.
+// The backend runtime (e.g. CRI-O) restores the container when the image is the local path of a checkpoint archive,
+// so only the images in the configured checkpoint archive dir are regarded as restoring.
+func IsRestoreContainerRequest(request *runtimeapi.CreateContainerRequest) bool {
+	return isInCheckpointArchiveDir(request.GetConfig().GetImage().GetImage(), options.CheckpointArchiveDir)
+}
+
+func isInCheckpointArchiveDir(image, archiveDir string) bool {
+	if archiveDir == "" || !filepath.IsAbs(image) {
+		return false
+	}
+	rel, err := filepath.Rel(filepath.Clean(archiveDir), filepath.Clean(image))
+	return err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, "../")
+}
+
+// CheckpointResourceExecutor handles the CheckpointContainerRequest and the CreateContainerRequest restoring
+// the container from a checkpoint archive.
+type CheckpointResourceExecutor struct {
+	*v1alpha1.ContainerCheckpointHookRequest
+}
+
+func NewCheckpointResourceExecutor() *CheckpointResourceExecutor {
+	return &CheckpointResourceExecutor{}
+}
+
+func (c *CheckpointResourceExecutor) GetMetaInfo() string {
+	return fmt.Sprintf("pod(%v/%v)container(%v)checkpoint(%v)",
+		c.GetPodMeta().GetName(), c.GetPodMeta().GetUid(),
+		c.GetContainerMeta().GetName(), c.GetLocation())
+}
+
+func (c *CheckpointResourceExecutor) GenerateHookRequest() interface{} {
+	return c.ContainerCheckpointHookRequest
+}
+
+func (c *CheckpointResourceExecutor) ParseRequest(req interface{}) (utils.CallHookPluginOperation, error) {
+	switch request := req.(type) {
+	case *runtimeapi.CheckpointContainerRequest:
+		containerInfo := store.GetContainerInfo(request.GetContainerId())
+		if containerInfo == nil {
+			return utils.Unknown, fmt.Errorf("fail to load container(%v) from store during CheckpointContainer", request.GetContainerId())
+		}
+		c.ContainerCheckpointHookRequest = &v1alpha1.ContainerCheckpointHookRequest{
+			PodMeta:              containerInfo.GetPodMeta(),
+			ContainerMeta:        containerInfo.GetContainerMeta(),
+			ContainerAnnotations: containerInfo.GetContainerAnnotations(),
+			PodAnnotations:       containerInfo.GetPodAnnotations(),
+			PodLabels:            containerInfo.GetPodLabels(),
+			Location:             request.GetLocation(),
+			Timeout:              request.GetTimeout(),
+		}
+	case *runtimeapi.CreateContainerRequest:
+		podInfo := store.GetPodSandboxInfo(request.GetPodSandboxId())
+		if podInfo == nil {
+			return utils.Unknown, fmt.Errorf("fail to get pod(%v) related to container", request.GetPodSandboxId())
+		}
+		c.ContainerCheckpointHookRequest = &v1alpha1.ContainerCheckpointHookRequest{
+			PodMeta: podInfo.GetPodMeta(),
+			ContainerMeta: &v1alpha1.ContainerMetadata{
+				Name:    request.GetConfig().GetMetadata().GetName(),
+				Attempt: request.GetConfig().GetMetadata().GetAttempt(),
+			},
+			ContainerAnnotations: request.GetConfig().GetAnnotations(),
+			PodAnnotations:       podInfo.GetAnnotations(),
+			PodLabels:            podInfo.GetLabels(),
+			Location:             request.GetConfig().GetImage().GetImage(),
+		}
+	default:
+		return utils.Unknown, fmt.Errorf("request type %s not supported", reflect.TypeOf(req).String())
+	}
+	klog.V(4).Infof("success parse checkpoint info %v", c.GetMetaInfo())
+	if exist := IsKeyValExistInLabels(c.GetPodLabels(), options.RuntimeHookServerKey, options.RuntimeHookServerVal); exist {
+		return utils.ShouldNotCallHookPluginAlways, nil
+	}
+	return utils.ShouldCallHookPlugin, nil
+}
+
+func (c *CheckpointResourceExecutor) ResourceCheckPoint(rsp interface{}) error {
+	return nil
+}
+
+func (c *CheckpointResourceExecutor) DeleteCheckpointIfNeed(req interface{}) error {
+	return nil
+}
+
+// UpdateRequest will update CheckpointResourceExecutor from hook response and then update CRI request.
+func (c *CheckpointResourceExecutor) UpdateRequest(rsp interface{}, req interface{}) error {
+	response, ok := rsp.(*v1alpha1.ContainerCheckpointHookResponse)
+	if !ok {
+		return fmt.Errorf("response type not compatible. Should be ContainerCheckpointHookResponse, but got %s", reflect.TypeOf(rsp).String())
+	}
+	if response.GetLocation() == "" {
+		return nil
+	}
+	c.Location = response.GetLocation()
+
+	switch request := req.(type) {
+	case *runtimeapi.CheckpointContainerRequest:
+		request.Location = c.Location
+	case *runtimeapi.CreateContainerRequest:
+		if request.Config == nil {
+			return fmt.Errorf("container config is nil")
+		}
+		if request.Config.Image == nil {
+			request.Config.Image = &runtimeapi.ImageSpec{}
+		}
+		request.Config.Image.Image = c.Location
+	}
+	return nil
+}
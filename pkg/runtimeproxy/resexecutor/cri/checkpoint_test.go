@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/koordinator-sh/koordinator/apis/runtime/v1alpha1"
+	"github.com/koordinator-sh/koordinator/cmd/koord-runtime-proxy/options"
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/store"
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/utils"
+)
+
+func TestIsRestoreContainerRequest(t *testing.T) {
+	oldDir := options.CheckpointArchiveDir
+	defer func() { options.CheckpointArchiveDir = oldDir }()
+	options.CheckpointArchiveDir = "/var/lib/checkpoints"
+
+	newRequest := func(image string) *runtimeapi.CreateContainerRequest {
+		return &runtimeapi.CreateContainerRequest{
+			Config: &runtimeapi.ContainerConfig{Image: &runtimeapi.ImageSpec{Image: image}},
+		}
+	}
+	assert.False(t, IsRestoreContainerRequest(&runtimeapi.CreateContainerRequest{}))
+	assert.False(t, IsRestoreContainerRequest(newRequest("nginx:latest")))
+	assert.True(t, IsRestoreContainerRequest(newRequest("/var/lib/checkpoints/test.tar")))
+	assert.True(t, IsRestoreContainerRequest(newRequest("/var/lib/checkpoints/pod/test.tar")))
+	// absolute paths out of the archive dir are not regarded as checkpoint archives
+	assert.False(t, IsRestoreContainerRequest(newRequest("/var/lib/checkpoints")))
+	assert.False(t, IsRestoreContainerRequest(newRequest("/var/lib/checkpoints-other/test.tar")))
+	assert.False(t, IsRestoreContainerRequest(newRequest("/var/lib/checkpoints/../test.tar")))
+	assert.False(t, IsRestoreContainerRequest(newRequest("/data/test.tar")))
+
+	// restore hooks are disabled
+	options.CheckpointArchiveDir = ""
+	assert.False(t, IsRestoreContainerRequest(newRequest("/var/lib/checkpoints/test.tar")))
+}
+
+func TestCheckpointResourceExecutor(t *testing.T) {
+	podMeta := &v1alpha1.PodSandboxMetadata{Name: "test-pod", Namespace: "test-ns", Uid: "uid-1"}
+	err := store.WritePodSandboxInfo("pod-1", &store.PodSandboxInfo{
+		PodSandboxHookRequest: &v1alpha1.PodSandboxHookRequest{
+			PodMeta: podMeta,
+			Labels:  map[string]string{"app": "test"},
+		},
+	})
+	assert.NoError(t, err)
+	defer store.DeletePodSandboxInfo("pod-1")
+	err = store.WriteContainerInfo("container-1", &store.ContainerInfo{
+		ContainerResourceHookRequest: &v1alpha1.ContainerResourceHookRequest{
+			PodMeta:       podMeta,
+			ContainerMeta: &v1alpha1.ContainerMetadata{Name: "test-container", Id: "container-1"},
+			PodLabels:     map[string]string{"app": "test"},
+		},
+	})
+	assert.NoError(t, err)
+	defer store.DeleteContainerInfo("container-1")
+
+	// checkpoint
+	checkpointRequest := &runtimeapi.CheckpointContainerRequest{
+		ContainerId: "container-1",
+		Location:    "/var/lib/checkpoints/test.tar",
+		Timeout:     10,
+	}
+	executor := NewCheckpointResourceExecutor()
+	op, err := executor.ParseRequest(checkpointRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, utils.ShouldCallHookPlugin, op)
+	assert.Equal(t, &v1alpha1.ContainerCheckpointHookRequest{
+		PodMeta:       podMeta,
+		ContainerMeta: &v1alpha1.ContainerMetadata{Name: "test-container", Id: "container-1"},
+		PodLabels:     map[string]string{"app": "test"},
+		Location:      "/var/lib/checkpoints/test.tar",
+		Timeout:       10,
+	}, executor.GenerateHookRequest())
+	err = executor.UpdateRequest(&v1alpha1.ContainerCheckpointHookResponse{Location: "/data/test.tar"}, checkpointRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, "/data/test.tar", checkpointRequest.Location)
+
+	_, err = NewCheckpointResourceExecutor().ParseRequest(&runtimeapi.CheckpointContainerRequest{ContainerId: "unknown"})
+	assert.Error(t, err)
+
+	// restore
+	createRequest := &runtimeapi.CreateContainerRequest{
+		PodSandboxId: "pod-1",
+		Config: &runtimeapi.ContainerConfig{
+			Metadata: &runtimeapi.ContainerMetadata{Name: "test-container"},
+			Image:    &runtimeapi.ImageSpec{Image: "/var/lib/checkpoints/test.tar"},
+		},
+	}
+	executor = NewCheckpointResourceExecutor()
+	op, err = executor.ParseRequest(createRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, utils.ShouldCallHookPlugin, op)
+	assert.Equal(t, "/var/lib/checkpoints/test.tar", executor.GetLocation())
+	assert.Equal(t, podMeta, executor.GetPodMeta())
+	// the location is not changed if the response is empty
+	err = executor.UpdateRequest(&v1alpha1.ContainerCheckpointHookResponse{}, createRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, "/var/lib/checkpoints/test.tar", createRequest.Config.Image.Image)
+	err = executor.UpdateRequest(&v1alpha1.ContainerCheckpointHookResponse{Location: "/data/test.tar"}, createRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, "/data/test.tar", createRequest.Config.Image.Image)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/koordinator-sh/koordinator/apis/runtime/v1alpha1"
+	"github.com/koordinator-sh/koordinator/cmd/koord-runtime-proxy/options"
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/utils"
+)
+
+func TestImageResourceExecutor(t *testing.T) {
+	request := &runtimeapi.PullImageRequest{
+		Image: &runtimeapi.ImageSpec{
+			Image:       "docker.io/library/nginx:latest",
+			Annotations: map[string]string{"a": "b"},
+		},
+		SandboxConfig: &runtimeapi.PodSandboxConfig{
+			Metadata: &runtimeapi.PodSandboxMetadata{
+				Name:      "test-pod",
+				Namespace: "test-ns",
+				Uid:       "uid-1",
+			},
+			Labels: map[string]string{"app": "test"},
+		},
+	}
+	executor := NewImageResourceExecutor()
+	op, err := executor.ParseRequest(request)
+	assert.NoError(t, err)
+	assert.Equal(t, utils.ShouldCallHookPlugin, op)
+	assert.Equal(t, &v1alpha1.ImageHookRequest{
+		Image:            "docker.io/library/nginx:latest",
+		ImageAnnotations: map[string]string{"a": "b"},
+		PodMeta: &v1alpha1.PodSandboxMetadata{
+			Name:      "test-pod",
+			Namespace: "test-ns",
+			Uid:       "uid-1",
+		},
+		PodLabels: map[string]string{"app": "test"},
+	}, executor.GenerateHookRequest())
+
+	err = executor.UpdateRequest(&v1alpha1.ImageHookResponse{
+		Image:            "mirror.local/library/nginx:latest",
+		ImageAnnotations: map[string]string{"c": "d"},
+	}, request)
+	assert.NoError(t, err)
+	assert.Equal(t, "mirror.local/library/nginx:latest", request.Image.Image)
+	assert.Equal(t, map[string]string{"a": "b", "c": "d"}, request.Image.Annotations)
+
+	err = executor.UpdateRequest(&v1alpha1.ContainerResourceHookResponse{}, request)
+	assert.Error(t, err)
+
+	// skip the hook server itself
+	request.SandboxConfig.Labels = map[string]string{options.RuntimeHookServerKey: options.RuntimeHookServerVal}
+	op, err = NewImageResourceExecutor().ParseRequest(request)
+	assert.NoError(t, err)
+	assert.Equal(t, utils.ShouldNotCallHookPluginAlways, op)
+
+	_, err = NewImageResourceExecutor().ParseRequest(&runtimeapi.RemoveImageRequest{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cri
+
+import (
+	"fmt"
+	"reflect"
+
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/koordinator-sh/koordinator/apis/runtime/v1alpha1"
+	"github.com/koordinator-sh/koordinator/cmd/koord-runtime-proxy/options"
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/store"
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/utils"
+)
+
+// ContainerStatsExecutor enriches the container stats returned by the backend runtime.
+// Unlike the other executors, it works on the CRI response since there can be multiple stats in one response.
+type ContainerStatsExecutor struct {
+	*v1alpha1.ContainerStatsHookRequest
+}
+
+func NewContainerStatsExecutor() *ContainerStatsExecutor {
+	return &ContainerStatsExecutor{}
+}
+
+func (c *ContainerStatsExecutor) GetMetaInfo() string {
+	return fmt.Sprintf("pod(%v/%v)container(%v)",
+		c.GetPodMeta().GetName(), c.GetPodMeta().GetUid(),
+		c.GetContainerMeta().GetName())
+}
+
+func (c *ContainerStatsExecutor) GenerateHookRequest() interface{} {
+	return c.ContainerStatsHookRequest
+}
+
+// ParseStats parses the hook request from the container stats and the container info in the local store.
+func (c *ContainerStatsExecutor) ParseStats(stats *runtimeapi.ContainerStats) (utils.CallHookPluginOperation, error) {
+	containerID := stats.GetAttributes().GetId()
+	containerInfo := store.GetContainerInfo(containerID)
+	if containerInfo == nil {
+		return utils.Unknown, fmt.Errorf("fail to load container(%v) from store during ContainerStats", containerID)
+	}
+	c.ContainerStatsHookRequest = &v1alpha1.ContainerStatsHookRequest{
+		PodMeta:                 containerInfo.GetPodMeta(),
+		ContainerMeta:           containerInfo.GetContainerMeta(),
+		ContainerAnnotations:    stats.GetAttributes().GetAnnotations(),
+		PodAnnotations:          containerInfo.GetPodAnnotations(),
+		PodLabels:               containerInfo.GetPodLabels(),
+		PodCgroupParent:         containerInfo.GetPodCgroupParent(),
+		CpuUsageCoreNanoSeconds: stats.GetCpu().GetUsageCoreNanoSeconds().GetValue(),
+		MemoryWorkingSetBytes:   stats.GetMemory().GetWorkingSetBytes().GetValue(),
+	}
+	if exist := IsKeyValExistInLabels(c.GetPodLabels(), options.RuntimeHookServerKey, options.RuntimeHookServerVal); exist {
+		return utils.ShouldNotCallHookPluginAlways, nil
+	}
+	return utils.ShouldCallHookPlugin, nil
+}
+
+// UpdateStats merges the annotations in hook response into the container stats.
+func (c *ContainerStatsExecutor) UpdateStats(rsp interface{}, stats *runtimeapi.ContainerStats) error {
+	response, ok := rsp.(*v1alpha1.ContainerStatsHookResponse)
+	if !ok {
+		return fmt.Errorf("response type not compatible. Should be ContainerStatsHookResponse, but got %s", reflect.TypeOf(rsp).String())
+	}
+	if len(response.GetContainerAnnotations()) == 0 {
+		return nil
+	}
+	if stats.Attributes == nil {
+		stats.Attributes = &runtimeapi.ContainerAttributes{}
+	}
+	stats.Attributes.Annotations = utils.MergeMap(stats.Attributes.Annotations, response.GetContainerAnnotations())
+	return nil
+}
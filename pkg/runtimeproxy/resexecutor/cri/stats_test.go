@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/koordinator-sh/koordinator/apis/runtime/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/store"
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/utils"
+)
+
+func TestContainerStatsExecutor(t *testing.T) {
+	podMeta := &v1alpha1.PodSandboxMetadata{Name: "test-pod", Namespace: "test-ns", Uid: "uid-1"}
+	err := store.WriteContainerInfo("container-1", &store.ContainerInfo{
+		ContainerResourceHookRequest: &v1alpha1.ContainerResourceHookRequest{
+			PodMeta:         podMeta,
+			ContainerMeta:   &v1alpha1.ContainerMetadata{Name: "test-container", Id: "container-1"},
+			PodCgroupParent: "kubepods/pod-uid-1",
+		},
+	})
+	assert.NoError(t, err)
+	defer store.DeleteContainerInfo("container-1")
+
+	stats := &runtimeapi.ContainerStats{
+		Attributes: &runtimeapi.ContainerAttributes{
+			Id:          "container-1",
+			Annotations: map[string]string{"a": "b"},
+		},
+		Cpu:    &runtimeapi.CpuUsage{UsageCoreNanoSeconds: &runtimeapi.UInt64Value{Value: 1000}},
+		Memory: &runtimeapi.MemoryUsage{WorkingSetBytes: &runtimeapi.UInt64Value{Value: 2000}},
+	}
+	executor := NewContainerStatsExecutor()
+	op, err := executor.ParseStats(stats)
+	assert.NoError(t, err)
+	assert.Equal(t, utils.ShouldCallHookPlugin, op)
+	assert.Equal(t, &v1alpha1.ContainerStatsHookRequest{
+		PodMeta:                 podMeta,
+		ContainerMeta:           &v1alpha1.ContainerMetadata{Name: "test-container", Id: "container-1"},
+		ContainerAnnotations:    map[string]string{"a": "b"},
+		PodCgroupParent:         "kubepods/pod-uid-1",
+		CpuUsageCoreNanoSeconds: 1000,
+		MemoryWorkingSetBytes:   2000,
+	}, executor.GenerateHookRequest())
+
+	err = executor.UpdateStats(&v1alpha1.ContainerStatsHookResponse{
+		ContainerAnnotations: map[string]string{"c": "d"},
+	}, stats)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "b", "c": "d"}, stats.Attributes.Annotations)
+
+	_, err = NewContainerStatsExecutor().ParseStats(&runtimeapi.ContainerStats{
+		Attributes: &runtimeapi.ContainerAttributes{Id: "unknown"},
+	})
+	assert.Error(t, err)
+}
@@ -39,9 +39,11 @@ type RuntimeResourceExecutor interface {
 type RuntimeResourceType string
 
 const (
-	RuntimePodResource       RuntimeResourceType = "RuntimePodResource"
-	RuntimeContainerResource RuntimeResourceType = "RuntimeContainerResource"
-	RuntimeNoopResource      RuntimeResourceType = "RuntimeNoopResource"
+	RuntimePodResource        RuntimeResourceType = "RuntimePodResource"
+	RuntimeContainerResource  RuntimeResourceType = "RuntimeContainerResource"
+	RuntimeImageResource      RuntimeResourceType = "RuntimeImageResource"
+	RuntimeCheckpointResource RuntimeResourceType = "RuntimeCheckpointResource"
+	RuntimeNoopResource       RuntimeResourceType = "RuntimeNoopResource"
 )
 
 func NewRuntimeResourceExecutor(runtimeResourceType RuntimeResourceType) RuntimeResourceExecutor {
@@ -50,6 +52,10 @@ func NewRuntimeResourceExecutor(runtimeResourceType RuntimeResourceType) Runtime
 		return cri.NewPodResourceExecutor()
 	case RuntimeContainerResource:
 		return cri.NewContainerResourceExecutor()
+	case RuntimeImageResource:
+		return cri.NewImageResourceExecutor()
+	case RuntimeCheckpointResource:
+		return cri.NewCheckpointResourceExecutor()
 	}
 	return &NoopResourceExecutor{}
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/dispatcher"
 	resource_executor "github.com/koordinator-sh/koordinator/pkg/runtimeproxy/resexecutor"
 	cri_resource_executor "github.com/koordinator-sh/koordinator/pkg/runtimeproxy/resexecutor/cri"
+	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/store"
 	"github.com/koordinator-sh/koordinator/pkg/runtimeproxy/utils"
 )
 
@@ -41,18 +42,27 @@ const (
 
 type RuntimeRequestInterceptor interface {
 	InterceptRuntimeRequest(serviceType RuntimeServiceType, ctx context.Context, request interface{}, handler grpc.UnaryHandler, alphaRuntime bool) (interface{}, error)
+	// InterceptContainerStats calls the hook servers to enrich the container stats returned by the backend runtime.
+	InterceptContainerStats(ctx context.Context, stats []*runtimeapi.ContainerStats)
 }
 
 var _ runtimeapi.RuntimeServiceServer = &criServer{}
+var _ runtimeapi.ImageServiceServer = &imageServer{}
 
 type criServer struct {
 	RuntimeRequestInterceptor
 	backendRuntimeServiceClient runtimeapi.RuntimeServiceClient
 }
 
+type imageServer struct {
+	RuntimeRequestInterceptor
+	backendImageServiceClient runtimeapi.ImageServiceClient
+}
+
 type RuntimeManagerCriServer struct {
 	hookDispatcher *dispatcher.RuntimeHookDispatcher
 	criServer      *criServer
+	imageServer    *imageServer
 }
 
 func NewRuntimeManagerCriServer() *RuntimeManagerCriServer {
@@ -91,6 +101,9 @@ func (c *RuntimeManagerCriServer) Run() error {
 	if c.criServer != nil {
 		runtimeapi.RegisterRuntimeServiceServer(grpcServer, c.criServer)
 	}
+	if c.imageServer != nil {
+		runtimeapi.RegisterImageServiceServer(grpcServer, c.imageServer)
+	}
 	err = grpcServer.Serve(listener)
 	return err
 }
@@ -110,6 +123,12 @@ func (c *RuntimeManagerCriServer) getRuntimeHookInfo(serviceType RuntimeServiceT
 		return config.StopContainer, resource_executor.RuntimeContainerResource
 	case UpdateContainerResources:
 		return config.UpdateContainerResources, resource_executor.RuntimeContainerResource
+	case CheckpointContainer:
+		return config.CheckpointContainer, resource_executor.RuntimeCheckpointResource
+	case RestoreContainer:
+		return config.RestoreContainer, resource_executor.RuntimeCheckpointResource
+	case PullImage:
+		return config.PullImage, resource_executor.RuntimeImageResource
 	}
 	return config.NoneRuntimeHookPath, resource_executor.RuntimeNoopResource
 }
@@ -188,6 +207,36 @@ func (c *RuntimeManagerCriServer) InterceptRuntimeRequest(serviceType RuntimeSer
 	return res, err
 }
 
+func (c *RuntimeManagerCriServer) InterceptContainerStats(ctx context.Context, stats []*runtimeapi.ContainerStats) {
+	// the stats are polled frequently, so the hooks are only called when enabled explicitly
+	if !options.EnableContainerStatsHook {
+		return
+	}
+	for _, containerStats := range stats {
+		statsExecutor := cri_resource_executor.NewContainerStatsExecutor()
+		callHookOperation, err := statsExecutor.ParseStats(containerStats)
+		if err != nil {
+			klog.V(5).Infof("fail to parse container stats %v", err)
+			continue
+		}
+		if callHookOperation != utils.ShouldCallHookPlugin {
+			continue
+		}
+		response, err, _ := c.hookDispatcher.Dispatch(ctx, config.ContainerStats, config.PostHook, statsExecutor.GenerateHookRequest())
+		if err != nil {
+			klog.Errorf("fail to call hook server for %v stats %v", statsExecutor.GetMetaInfo(), err)
+			continue
+		}
+		if response == nil {
+			// no hook server is interested in the container stats
+			return
+		}
+		if err = statsExecutor.UpdateStats(response, containerStats); err != nil {
+			klog.Errorf("failed to update %v stats %v", statsExecutor.GetMetaInfo(), err)
+		}
+	}
+}
+
 func dialer(ctx context.Context, addr string) (net.Conn, error) {
 	return (&net.Dialer{}).DialContext(ctx, "unix", addr)
 }
@@ -213,6 +262,11 @@ func (c *RuntimeManagerCriServer) initCriServer(runtimeSockPath string) (*grpc.C
 			RuntimeRequestInterceptor:   c,
 			backendRuntimeServiceClient: runtimeapi.NewRuntimeServiceClient(runtimeConn),
 		}
+		// the image service is served on the same endpoint as the runtime service
+		c.imageServer = &imageServer{
+			RuntimeRequestInterceptor: c,
+			backendImageServiceClient: runtimeapi.NewImageServiceClient(runtimeConn),
+		}
 	}
 	if c.criServer == nil {
 		err = fmt.Errorf("%s", v1Err.Error())
@@ -233,7 +287,14 @@ func (c *RuntimeManagerCriServer) failOver() error {
 		}
 	}
 
-	for _, pod := range podResponse.Items {
+	// the infos restored from the local store are more complete than the ones rebuilt from the backend runtime,
+	// so only the missing ones are rebuilt
+	podIDs := make(map[string]struct{}, len(podResponse.GetItems()))
+	for _, pod := range podResponse.GetItems() {
+		podIDs[pod.GetId()] = struct{}{}
+		if store.GetPodSandboxInfo(pod.GetId()) != nil {
+			continue
+		}
 		podResourceExecutor := cri_resource_executor.NewPodResourceExecutor()
 		podResourceExecutor.ParsePod(pod)
 		podResourceExecutor.ResourceCheckPoint(&runtimeapi.RunPodSandboxResponse{
@@ -248,7 +309,12 @@ func (c *RuntimeManagerCriServer) failOver() error {
 			return err
 		}
 	}
-	for _, container := range containerResponse.Containers {
+	containerIDs := make(map[string]struct{}, len(containerResponse.GetContainers()))
+	for _, container := range containerResponse.GetContainers() {
+		containerIDs[container.GetId()] = struct{}{}
+		if store.GetContainerInfo(container.GetId()) != nil {
+			continue
+		}
 		containerExecutor := cri_resource_executor.NewContainerResourceExecutor()
 		if err := containerExecutor.ParseContainer(container); err != nil {
 			klog.Errorf("failed to parse container %s, err: %v", container.Id, err)
@@ -258,6 +324,7 @@ func (c *RuntimeManagerCriServer) failOver() error {
 			ContainerId: container.GetId(),
 		})
 	}
+	store.Prune(podIDs, containerIDs)
 
 	return nil
 }
@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cri
+
+import (
+	"context"
+
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+func (c *imageServer) ListImages(ctx context.Context, req *runtimeapi.ListImagesRequest) (*runtimeapi.ListImagesResponse, error) {
+	return c.backendImageServiceClient.ListImages(ctx, req)
+}
+
+func (c *imageServer) ImageStatus(ctx context.Context, req *runtimeapi.ImageStatusRequest) (*runtimeapi.ImageStatusResponse, error) {
+	return c.backendImageServiceClient.ImageStatus(ctx, req)
+}
+
+func (c *imageServer) PullImage(ctx context.Context, req *runtimeapi.PullImageRequest) (*runtimeapi.PullImageResponse, error) {
+	rsp, err := c.InterceptRuntimeRequest(PullImage, ctx, req,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return c.backendImageServiceClient.PullImage(ctx, req.(*runtimeapi.PullImageRequest))
+		}, false)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.(*runtimeapi.PullImageResponse), err
+}
+
+func (c *imageServer) RemoveImage(ctx context.Context, req *runtimeapi.RemoveImageRequest) (*runtimeapi.RemoveImageResponse, error) {
+	return c.backendImageServiceClient.RemoveImage(ctx, req)
+}
+
+func (c *imageServer) ImageFsInfo(ctx context.Context, req *runtimeapi.ImageFsInfoRequest) (*runtimeapi.ImageFsInfoResponse, error) {
+	return c.backendImageServiceClient.ImageFsInfo(ctx, req)
+}
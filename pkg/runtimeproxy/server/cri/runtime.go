@@ -23,6 +23,8 @@ import (
 	"google.golang.org/grpc/status"
 
 	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	cri_resource_executor "github.com/koordinator-sh/koordinator/pkg/runtimeproxy/resexecutor/cri"
 )
 
 func (c *criServer) Version(ctx context.Context, req *runtimeapi.VersionRequest) (*runtimeapi.VersionResponse, error) {
@@ -68,10 +70,20 @@ func (c *criServer) ListPodSandboxStats(ctx context.Context, req *runtimeapi.Lis
 }
 
 func (c *criServer) CreateContainer(ctx context.Context, req *runtimeapi.CreateContainerRequest) (*runtimeapi.CreateContainerResponse, error) {
-	rsp, err := c.InterceptRuntimeRequest(CreateContainer, ctx, req,
-		func(ctx context.Context, req interface{}) (interface{}, error) {
-			return c.backendRuntimeServiceClient.CreateContainer(ctx, req.(*runtimeapi.CreateContainerRequest))
-		}, false)
+	createContainer := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return c.InterceptRuntimeRequest(CreateContainer, ctx, req,
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return c.backendRuntimeServiceClient.CreateContainer(ctx, req.(*runtimeapi.CreateContainerRequest))
+			}, false)
+	}
+	var rsp interface{}
+	var err error
+	if cri_resource_executor.IsRestoreContainerRequest(req) {
+		// the restore hooks are called before the create hooks
+		rsp, err = c.InterceptRuntimeRequest(RestoreContainer, ctx, req, createContainer, false)
+	} else {
+		rsp, err = createContainer(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -131,10 +143,22 @@ func (c *criServer) UpdateContainerResources(ctx context.Context, req *runtimeap
 }
 
 func (c *criServer) ContainerStats(ctx context.Context, req *runtimeapi.ContainerStatsRequest) (*runtimeapi.ContainerStatsResponse, error) {
-	return c.backendRuntimeServiceClient.ContainerStats(ctx, req)
+	rsp, err := c.backendRuntimeServiceClient.ContainerStats(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if rsp.GetStats() != nil {
+		c.InterceptContainerStats(ctx, []*runtimeapi.ContainerStats{rsp.GetStats()})
+	}
+	return rsp, nil
 }
 func (c *criServer) ListContainerStats(ctx context.Context, req *runtimeapi.ListContainerStatsRequest) (*runtimeapi.ListContainerStatsResponse, error) {
-	return c.backendRuntimeServiceClient.ListContainerStats(ctx, req)
+	rsp, err := c.backendRuntimeServiceClient.ListContainerStats(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	c.InterceptContainerStats(ctx, rsp.GetStats())
+	return rsp, nil
 }
 
 func (c *criServer) Status(ctx context.Context, req *runtimeapi.StatusRequest) (*runtimeapi.StatusResponse, error) {
@@ -168,7 +192,14 @@ func (c *criServer) PodSandboxStats(ctx context.Context, in *runtimeapi.PodSandb
 }
 
 func (c *criServer) CheckpointContainer(ctx context.Context, req *runtimeapi.CheckpointContainerRequest) (*runtimeapi.CheckpointContainerResponse, error) {
-	return c.backendRuntimeServiceClient.CheckpointContainer(ctx, req)
+	rsp, err := c.InterceptRuntimeRequest(CheckpointContainer, ctx, req,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return c.backendRuntimeServiceClient.CheckpointContainer(ctx, req.(*runtimeapi.CheckpointContainerRequest))
+		}, false)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.(*runtimeapi.CheckpointContainerResponse), err
 }
 
 func (c *criServer) GetContainerEvents(req *runtimeapi.GetEventsRequest, server runtimeapi.RuntimeService_GetContainerEventsServer) error {
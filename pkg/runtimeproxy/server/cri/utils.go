@@ -33,6 +33,9 @@ const (
 	StopContainer
 	RemoveContainer
 	UpdateContainerResources
+	CheckpointContainer
+	RestoreContainer
+	PullImage
 )
 
 //func convert(
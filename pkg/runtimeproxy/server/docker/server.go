@@ -123,8 +123,16 @@ func (d *RuntimeManagerDockerServer) failOver(dockerClient proxyDockerClient) er
 		}
 	}
 
+	// the infos restored from the local store are more complete than the ones rebuilt from the backend runtime,
+	// so only the missing ones are rebuilt
+	podIDs := make(map[string]struct{}, len(sandboxes))
+	containerIDs := make(map[string]struct{}, len(containers))
 	// need to backup pod meta first
 	for _, s := range sandboxes {
+		podIDs[s.ID] = struct{}{}
+		if store.GetPodSandboxInfo(s.ID) != nil {
+			continue
+		}
 		labels, annos := splitLabelsAndAnnotations(s.Labels)
 		store.WritePodSandboxInfo(s.ID, &store.PodSandboxInfo{
 			PodSandboxHookRequest: &v1alpha1.PodSandboxHookRequest{
@@ -142,6 +150,10 @@ func (d *RuntimeManagerDockerServer) failOver(dockerClient proxyDockerClient) er
 	}
 
 	for _, c := range containers {
+		containerIDs[c.ID] = struct{}{}
+		if store.GetContainerInfo(c.ID) != nil {
+			continue
+		}
 		_, annos := splitLabelsAndAnnotations(c.Labels)
 		cInfo := &store.ContainerInfo{
 			ContainerResourceHookRequest: &v1alpha1.ContainerResourceHookRequest{
@@ -164,6 +176,7 @@ func (d *RuntimeManagerDockerServer) failOver(dockerClient proxyDockerClient) er
 		}
 		store.WriteContainerInfo(c.ID, cInfo)
 	}
+	store.Prune(podIDs, containerIDs)
 	info, err := dockerClient.Info(context.TODO())
 	if err != nil {
 		klog.Errorf("Failed to get docker server info, err: %v", err)
@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	podSandboxDir = "pods"
+	containerDir  = "containers"
+	tmpFileSuffix = ".tmp"
+)
+
+// fileCheckpointer persists each pod and container info into a single file under the state dir.
+// The file is written into a temporary file first and then renamed, so a crash never leaves a partial entry.
+type fileCheckpointer struct {
+	stateDir string
+}
+
+func newFileCheckpointer(stateDir string) (*fileCheckpointer, error) {
+	for _, dir := range []string{podSandboxDir, containerDir} {
+		if err := os.MkdirAll(filepath.Join(stateDir, dir), 0700); err != nil {
+			return nil, err
+		}
+	}
+	return &fileCheckpointer{stateDir: stateDir}, nil
+}
+
+func (f *fileCheckpointer) path(dir, key string) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("empty key")
+	}
+	return filepath.Join(f.stateDir, dir, url.PathEscape(key)), nil
+}
+
+func (f *fileCheckpointer) save(dir, key string, obj interface{}) error {
+	filename, err := f.path(dir, key)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	tmpFilename := filename + tmpFileSuffix
+	if err = os.WriteFile(tmpFilename, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpFilename, filename)
+}
+
+func (f *fileCheckpointer) remove(dir, key string) error {
+	filename, err := f.path(dir, key)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(filename); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// restore decodes all the entries in the dir by newObj. The corrupted entries are removed.
+func (f *fileCheckpointer) restore(dir string, newObj func(key string, data []byte) error) error {
+	entries, err := os.ReadDir(filepath.Join(f.stateDir, dir))
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		filename := filepath.Join(f.stateDir, dir, entry.Name())
+		if strings.HasSuffix(entry.Name(), tmpFileSuffix) {
+			klog.V(4).Infof("remove tmp checkpoint file %s, err: %v", filename, os.Remove(filename))
+			continue
+		}
+		key, err := url.PathUnescape(entry.Name())
+		if err != nil {
+			klog.Warningf("invalid checkpoint file name %s, skip it", filename)
+			continue
+		}
+		data, err := os.ReadFile(filename)
+		if err == nil {
+			err = newObj(key, data)
+		}
+		if err != nil {
+			klog.Warningf("failed to restore checkpoint %s, remove it, err: %v", filename, err)
+			_ = os.Remove(filename)
+		}
+	}
+	return nil
+}
@@ -17,8 +17,11 @@ limitations under the License.
 package store
 
 import (
+	"encoding/json"
 	"sync"
 
+	"k8s.io/klog/v2"
+
 	"github.com/koordinator-sh/koordinator/apis/runtime/v1alpha1"
 )
 
@@ -54,6 +57,8 @@ type metaManager struct {
 	sync.RWMutex
 	podInfos       map[string]*PodSandboxInfo
 	containerInfos map[string]*ContainerInfo
+	// checkpointer persists the infos if it is not nil, so that they can be restored after restarting.
+	checkpointer *fileCheckpointer
 }
 
 // reset. currently only used by test case
@@ -62,6 +67,7 @@ func (mm *metaManager) reset() {
 	defer mm.Unlock()
 	mm.podInfos = make(map[string]*PodSandboxInfo, defaultPoolSize)
 	mm.containerInfos = make(map[string]*ContainerInfo, defaultPoolSize)
+	mm.checkpointer = nil
 }
 
 var m = &metaManager{
@@ -69,11 +75,50 @@ var m = &metaManager{
 	containerInfos: make(map[string]*ContainerInfo, defaultPoolSize),
 }
 
+// Init persists the pod and container infos into the stateDir, and restores the infos persisted before.
+// The infos are only kept in memory if Init is not called.
+func Init(stateDir string) error {
+	checkpointer, err := newFileCheckpointer(stateDir)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	err = checkpointer.restore(podSandboxDir, func(key string, data []byte) error {
+		pod := &PodSandboxInfo{}
+		if err := json.Unmarshal(data, pod); err != nil {
+			return err
+		}
+		m.podInfos[key] = pod
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	err = checkpointer.restore(containerDir, func(key string, data []byte) error {
+		container := &ContainerInfo{}
+		if err := json.Unmarshal(data, container); err != nil {
+			return err
+		}
+		m.containerInfos[key] = container
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	m.checkpointer = checkpointer
+	klog.Infof("restore %d pods and %d containers from %s", len(m.podInfos), len(m.containerInfos), stateDir)
+	return nil
+}
+
 // WritePodSandboxInfo checkpoints the pod level info
 func WritePodSandboxInfo(podUID string, pod *PodSandboxInfo) error {
 	m.Lock()
 	defer m.Unlock()
 	m.podInfos[podUID] = pod
+	if m.checkpointer != nil && pod != nil {
+		return m.checkpointer.save(podSandboxDir, podUID, pod)
+	}
 	return nil
 }
 
@@ -82,6 +127,9 @@ func WriteContainerInfo(containerUID string, container *ContainerInfo) error {
 	m.Lock()
 	defer m.Unlock()
 	m.containerInfos[containerUID] = container
+	if m.checkpointer != nil && container != nil {
+		return m.checkpointer.save(containerDir, containerUID, container)
+	}
 	return nil
 }
 
@@ -102,12 +150,47 @@ func GetContainerInfo(containerUID string) *ContainerInfo {
 func DeletePodSandboxInfo(podUID string) {
 	m.Lock()
 	defer m.Unlock()
-	delete(m.podInfos, podUID)
+	m.deletePodSandboxInfo(podUID)
 }
 
 // DeleteContainerInfo delete container checkpoint indexed by containerUID
 func DeleteContainerInfo(containerUID string) {
 	m.Lock()
 	defer m.Unlock()
-	delete(m.containerInfos, containerUID)
+	m.deleteContainerInfo(containerUID)
+}
+
+// Prune deletes the pod and container checkpoints which are not in the given sets,
+// e.g. the ones removed from the backend runtime while the proxy is not running.
+func Prune(podUIDs, containerUIDs map[string]struct{}) {
+	m.Lock()
+	defer m.Unlock()
+	for podUID := range m.podInfos {
+		if _, ok := podUIDs[podUID]; !ok {
+			m.deletePodSandboxInfo(podUID)
+		}
+	}
+	for containerUID := range m.containerInfos {
+		if _, ok := containerUIDs[containerUID]; !ok {
+			m.deleteContainerInfo(containerUID)
+		}
+	}
+}
+
+func (mm *metaManager) deletePodSandboxInfo(podUID string) {
+	delete(mm.podInfos, podUID)
+	if mm.checkpointer != nil {
+		if err := mm.checkpointer.remove(podSandboxDir, podUID); err != nil {
+			klog.Warningf("failed to remove pod checkpoint %s, err: %v", podUID, err)
+		}
+	}
+}
+
+func (mm *metaManager) deleteContainerInfo(containerUID string) {
+	delete(mm.containerInfos, containerUID)
+	if mm.checkpointer != nil {
+		if err := mm.checkpointer.remove(containerDir, containerUID); err != nil {
+			klog.Warningf("failed to remove container checkpoint %s, err: %v", containerUID, err)
+		}
+	}
 }
@@ -18,6 +18,8 @@ package store
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -158,3 +160,40 @@ func TestReadWriteContainerInfo(t *testing.T) {
 		assert.Equal(t, realContainer, tt.want.expectedContainer)
 	}
 }
+
+func TestPersistentStore(t *testing.T) {
+	defer m.reset()
+	stateDir := t.TempDir()
+	m.reset()
+	assert.NoError(t, Init(stateDir))
+
+	assert.NoError(t, WritePodSandboxInfo("pod-1", generateSimplePodSandbox()))
+	assert.NoError(t, WritePodSandboxInfo("pod-2", generateSimplePodSandbox()))
+	assert.NoError(t, WriteContainerInfo("container-1", generateSimpleContainer()))
+	assert.NoError(t, WriteContainerInfo("container-2", generateSimpleContainer()))
+	DeletePodSandboxInfo("pod-2")
+	// a partial write and a corrupted entry are dropped during restoring
+	assert.NoError(t, os.WriteFile(filepath.Join(stateDir, containerDir, "container-3"+tmpFileSuffix), []byte("{"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(stateDir, containerDir, "container-4"), []byte("{"), 0600))
+
+	// restart
+	m.reset()
+	assert.NoError(t, Init(stateDir))
+	assert.Len(t, m.podInfos, 1)
+	assert.Equal(t, "name", GetPodSandboxInfo("pod-1").GetPodMeta().GetName())
+	assert.Len(t, m.containerInfos, 2)
+	assert.Equal(t, "name", GetContainerInfo("container-1").GetContainerMeta().GetName())
+	entries, err := os.ReadDir(filepath.Join(stateDir, containerDir))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	// the entries removed from the runtime are pruned
+	Prune(map[string]struct{}{"pod-1": {}}, map[string]struct{}{"container-2": {}})
+	assert.Nil(t, GetContainerInfo("container-1"))
+	assert.NotNil(t, GetContainerInfo("container-2"))
+	m.reset()
+	assert.NoError(t, Init(stateDir))
+	assert.Len(t, m.podInfos, 1)
+	assert.Len(t, m.containerInfos, 1)
+	assert.NotNil(t, GetContainerInfo("container-2"))
+}
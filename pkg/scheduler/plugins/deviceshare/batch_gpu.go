@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deviceshare
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	quotav1 "k8s.io/apiserver/pkg/quota/v1"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	apiext "github.com/koordinator-sh/koordinator/apis/extension"
+	schedulingv1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+)
+
+// convertBatchGPURequests converts the batch GPU resources requested by the Batch pods to the GPU resources,
+// so they can be allocated in the same way of the shared GPU requests.
+func convertBatchGPURequests(podRequests corev1.ResourceList) corev1.ResourceList {
+	for resourceName, batchResourceName := range apiext.BatchGPUResourceNameMap {
+		quantity, ok := podRequests[batchResourceName]
+		if !ok {
+			continue
+		}
+		podRequests[resourceName] = quantity
+		delete(podRequests, batchResourceName)
+	}
+	return podRequests
+}
+
+// updateBatchCacheUsed is used to update batchDeviceUsed when there is a Batch GPU pod created/deleted.
+// The GPU allocations of the Batch pods are accounted separately since they consume the GPU resources reclaimed
+// from the HP pods rather than the allocatable of the devices.
+func (n *nodeDevice) updateBatchCacheUsed(allocations []*apiext.DeviceAllocation, pod *corev1.Pod, add bool) {
+	podNamespacedName := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+	_, ok := n.batchAllocateSet[podNamespacedName]
+	if add == ok {
+		// for non-failover scenario, pod might already exist in cache after Reserve step.
+		return
+	}
+
+	if add && n.batchDeviceUsed == nil {
+		n.batchDeviceUsed = make(deviceResources)
+		n.batchAllocateSet = make(map[types.NamespacedName]deviceResources)
+	}
+	for _, allocation := range allocations {
+		minor := int(allocation.Minor)
+		if add {
+			n.batchDeviceUsed[minor] = quotav1.Add(n.batchDeviceUsed[minor], allocation.Resources)
+			continue
+		}
+		used := quotav1.SubtractWithNonNegativeResult(n.batchDeviceUsed[minor], allocation.Resources)
+		if quotav1.IsZero(used) {
+			delete(n.batchDeviceUsed, minor)
+		} else {
+			n.batchDeviceUsed[minor] = used
+		}
+	}
+
+	if add {
+		resources := make(deviceResources)
+		for _, allocation := range allocations {
+			resources[int(allocation.Minor)] = allocation.Resources.DeepCopy()
+		}
+		n.batchAllocateSet[podNamespacedName] = resources
+	} else {
+		delete(n.batchAllocateSet, podNamespacedName)
+	}
+}
+
+// getBatchGPUNodeDevice returns a view of the nodeDevice for allocating the batch GPU resources, whose GPU total is
+// the batch GPU resources of each device reported by the slo-controller and the GPU used is the allocations of
+// the Batch pods. The other device types keep the same as the nodeDevice.
+func (n *nodeDevice) getBatchGPUNodeDevice(node *corev1.Node) (*nodeDevice, *framework.Status) {
+	batchResources, err := apiext.GetNodeBatchGPUResources(node.Annotations)
+	if err != nil {
+		return nil, framework.NewStatus(framework.UnschedulableAndUnresolvable, fmt.Sprintf("invalid batch gpu resources, err: %v", err))
+	}
+	if len(batchResources) == 0 {
+		return nil, framework.NewStatus(framework.UnschedulableAndUnresolvable, "Insufficient batch gpu resources")
+	}
+
+	gpuTotal := n.deviceTotal[schedulingv1alpha1.GPU]
+	batchTotal := deviceResources{}
+	for _, r := range batchResources {
+		// skip the devices which are unhealthy or removed
+		if _, ok := gpuTotal[int(r.Minor)]; !ok {
+			continue
+		}
+		batchTotal[int(r.Minor)] = r.Resources.DeepCopy()
+	}
+	batchUsed := deviceResources{}
+	for minor, used := range n.batchDeviceUsed {
+		batchUsed[minor] = used.DeepCopy()
+	}
+
+	total := map[schedulingv1alpha1.DeviceType]deviceResources{}
+	used := map[schedulingv1alpha1.DeviceType]deviceResources{}
+	for deviceType, resources := range n.deviceTotal {
+		if deviceType == schedulingv1alpha1.GPU {
+			continue
+		}
+		total[deviceType] = resources.DeepCopy()
+		used[deviceType] = n.deviceUsed[deviceType].DeepCopy()
+	}
+	total[schedulingv1alpha1.GPU] = batchTotal
+	used[schedulingv1alpha1.GPU] = batchUsed
+
+	r := newNodeDevice()
+	r.deviceUsed = used
+	r.resetDeviceTotal(total)
+	r.vfAllocations = n.vfAllocations
+	r.numaTopology = n.numaTopology
+	r.deviceInfos = n.deviceInfos
+	r.secondaryDeviceWellPlanned = n.secondaryDeviceWellPlanned
+	r.gpuTopologyScope = n.gpuTopologyScope
+	return r, nil
+}
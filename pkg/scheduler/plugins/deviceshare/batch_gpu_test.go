@@ -0,0 +1,210 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deviceshare
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/utils/pointer"
+
+	apiext "github.com/koordinator-sh/koordinator/apis/extension"
+	schedulingv1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+)
+
+func newBatchGPUPod(name string, core, ratio int64) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							apiext.BatchGPUCore:        *resource.NewQuantity(core, resource.DecimalSI),
+							apiext.BatchGPUMemoryRatio: *resource.NewQuantity(ratio, resource.DecimalSI),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetPodDeviceRequestsWithBatchGPU(t *testing.T) {
+	got, err := GetPodDeviceRequests(newBatchGPUPod("test-pod", 50, 50))
+	assert.NoError(t, err)
+	expected := map[schedulingv1alpha1.DeviceType]corev1.ResourceList{
+		schedulingv1alpha1.GPU: {
+			apiext.ResourceGPUCore:        *resource.NewQuantity(50, resource.DecimalSI),
+			apiext.ResourceGPUMemoryRatio: *resource.NewQuantity(50, resource.DecimalSI),
+		},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func Test_Plugin_FilterBatchGPU(t *testing.T) {
+	testDevice := &schedulingv1alpha1.Device{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+		},
+		Spec: schedulingv1alpha1.DeviceSpec{
+			Devices: []schedulingv1alpha1.DeviceInfo{
+				{
+					Type:   schedulingv1alpha1.GPU,
+					Health: true,
+					UUID:   "123456-0",
+					Minor:  pointer.Int32(0),
+					Resources: corev1.ResourceList{
+						apiext.ResourceGPUCore:        resource.MustParse("100"),
+						apiext.ResourceGPUMemoryRatio: resource.MustParse("100"),
+						apiext.ResourceGPUMemory:      resource.MustParse("16Gi"),
+					},
+				},
+			},
+		},
+	}
+	testNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+		},
+	}
+	assert.NoError(t, apiext.SetNodeBatchGPUResources(testNode, apiext.NodeBatchGPUResources{
+		{
+			Minor: 0,
+			Resources: corev1.ResourceList{
+				apiext.ResourceGPUCore:        resource.MustParse("60"),
+				apiext.ResourceGPUMemoryRatio: resource.MustParse("60"),
+				apiext.ResourceGPUMemory:      resource.MustParse("9Gi"),
+			},
+		},
+	}))
+	testNodeInfo := &framework.NodeInfo{}
+	testNodeInfo.SetNode(testNode)
+	testNodeWithoutBatch := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+		},
+	}
+	testNodeInfoWithoutBatch := &framework.NodeInfo{}
+	testNodeInfoWithoutBatch.SetNode(testNodeWithoutBatch)
+
+	// the LS pod allocates the whole device
+	lsPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "ls-pod",
+		},
+	}
+	lsAllocations := apiext.DeviceAllocations{
+		schedulingv1alpha1.GPU: {
+			{
+				Minor: 0,
+				Resources: corev1.ResourceList{
+					apiext.ResourceGPUCore:        resource.MustParse("100"),
+					apiext.ResourceGPUMemoryRatio: resource.MustParse("100"),
+					apiext.ResourceGPUMemory:      resource.MustParse("16Gi"),
+				},
+			},
+		},
+	}
+	// the batch pod allocates the batch gpu resources
+	allocatedBatchPod := newBatchGPUPod("allocated-batch-pod", 40, 40)
+	batchAllocations := apiext.DeviceAllocations{
+		schedulingv1alpha1.GPU: {
+			{
+				Minor: 0,
+				Resources: corev1.ResourceList{
+					apiext.ResourceGPUCore:        resource.MustParse("40"),
+					apiext.ResourceGPUMemoryRatio: resource.MustParse("40"),
+					apiext.ResourceGPUMemory:      resource.MustParse("6554Mi"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		pod           *corev1.Pod
+		nodeInfo      *framework.NodeInfo
+		allocateBatch bool
+		wantCode      framework.Code
+	}{
+		{
+			name:     "batch pod fits the batch gpu resources",
+			pod:      newBatchGPUPod("batch-pod", 50, 50),
+			nodeInfo: testNodeInfo,
+			wantCode: framework.Success,
+		},
+		{
+			name:     "batch pod exceeds the batch gpu resources",
+			pod:      newBatchGPUPod("batch-pod", 70, 50),
+			nodeInfo: testNodeInfo,
+			wantCode: framework.Unschedulable,
+		},
+		{
+			name:          "batch gpu resources used by other batch pods",
+			pod:           newBatchGPUPod("batch-pod", 50, 50),
+			nodeInfo:      testNodeInfo,
+			allocateBatch: true,
+			wantCode:      framework.Unschedulable,
+		},
+		{
+			name:     "node has no batch gpu resources",
+			pod:      newBatchGPUPod("batch-pod", 50, 50),
+			nodeInfo: testNodeInfoWithoutBatch,
+			wantCode: framework.UnschedulableAndUnresolvable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := newNodeDeviceCache()
+			cache.updateNodeDevice("test-node", testDevice)
+			nd := cache.getNodeDevice("test-node", false)
+			nd.updateCacheUsed(lsAllocations, lsPod, true)
+			if tt.allocateBatch {
+				nd.updateCacheUsed(batchAllocations, allocatedBatchPod, true)
+				// the batch allocations should not consume the device resources
+				assert.Equal(t, lsAllocations[schedulingv1alpha1.GPU][0].Resources, nd.deviceUsed[schedulingv1alpha1.GPU][0])
+				assert.Equal(t, batchAllocations[schedulingv1alpha1.GPU][0].Resources, nd.batchDeviceUsed[0])
+			}
+
+			p := &Plugin{nodeDeviceCache: cache}
+			cycleState := framework.NewCycleState()
+			state, status := preparePod(tt.pod, testGPUSharedResourceTemplatesCache, testGPUSharedResourceTemplatesMatchedResources)
+			assert.True(t, status.IsSuccess())
+			assert.True(t, state.batchGPU)
+			cycleState.Write(stateKey, state)
+
+			status = p.Filter(context.TODO(), cycleState, tt.pod, tt.nodeInfo)
+			assert.Equal(t, tt.wantCode, status.Code(), status.Message())
+
+			if tt.allocateBatch {
+				nd.updateCacheUsed(batchAllocations, allocatedBatchPod, false)
+				assert.Equal(t, 0, len(nd.batchDeviceUsed))
+				assert.Equal(t, 0, len(nd.batchAllocateSet))
+			}
+		})
+	}
+}
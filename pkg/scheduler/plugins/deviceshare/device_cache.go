@@ -51,6 +51,11 @@ type nodeDevice struct {
 	allocateSet   map[schedulingv1alpha1.DeviceType]map[types.NamespacedName]deviceResources
 	deviceInfos   map[schedulingv1alpha1.DeviceType][]*schedulingv1alpha1.DeviceInfo
 
+	// batchDeviceUsed and batchAllocateSet record the GPU allocations of the Batch pods,
+	// which consume the batch GPU resources instead of the GPU devices. They are initialized lazily.
+	batchDeviceUsed  deviceResources
+	batchAllocateSet map[types.NamespacedName]deviceResources
+
 	numaTopology               *NUMATopology
 	secondaryDeviceWellPlanned bool
 
@@ -131,7 +136,12 @@ func (n *nodeDevice) resetDeviceTotal(resources map[schedulingv1alpha1.DeviceTyp
 // updateCacheUsed is used to update deviceUsed when there is a new pod created/deleted
 func (n *nodeDevice) updateCacheUsed(deviceAllocations apiext.DeviceAllocations, pod *corev1.Pod, add bool) {
 	if len(deviceAllocations) > 0 {
+		isBatchGPU := apiext.IsPodRequestBatchGPU(pod)
 		for deviceType, allocations := range deviceAllocations {
+			if deviceType == schedulingv1alpha1.GPU && isBatchGPU {
+				n.updateBatchCacheUsed(allocations, pod, add)
+				continue
+			}
 			if !n.isValid(deviceType, pod.Namespace, pod.Name, add) {
 				continue
 			}
@@ -94,6 +94,8 @@ type preFilterState struct {
 	preemptibleInRRs                  map[string]map[types.UID]map[schedulingv1alpha1.DeviceType]deviceResources
 
 	hasReservationAffinity bool
+	// batchGPU indicates the pod requests the batch GPU resources.
+	batchGPU bool
 }
 
 type GPURequirements struct {
@@ -122,6 +124,7 @@ func (s *preFilterState) Clone() framework.StateData {
 		podFitsSecondaryDeviceWellPlanned: s.podFitsSecondaryDeviceWellPlanned,
 		allocationResult:                  s.allocationResult,
 		hasReservationAffinity:            s.hasReservationAffinity,
+		batchGPU:                          s.batchGPU,
 	}
 
 	preemptibleDevices := map[string]map[schedulingv1alpha1.DeviceType]deviceResources{}
@@ -334,6 +337,10 @@ func (p *Plugin) Filter(ctx context.Context, cycleState *framework.CycleState, p
 		return nil
 	}
 
+	if state.batchGPU {
+		return p.filterBatchGPU(state, nodeDeviceInfo, node, pod)
+	}
+
 	reservationRestoreState := getReservationRestoreState(cycleState)
 	restoreState := reservationRestoreState.getNodeState(node.Name)
 	preemptible := appendAllocated(nil, restoreState.mergedUnmatchedUsed, state.preemptibleDevices[node.Name])
@@ -364,6 +371,26 @@ func (p *Plugin) Filter(ctx context.Context, cycleState *framework.CycleState, p
 	return status
 }
 
+// filterBatchGPU checks if the batch GPU resources of the node can satisfy the Batch pod.
+// The Batch pods do not allocate from the reservations or preempt other pods.
+func (p *Plugin) filterBatchGPU(state *preFilterState, nodeDeviceInfo *nodeDevice, node *corev1.Node, pod *corev1.Pod) *framework.Status {
+	nodeDeviceInfo.lock.RLock()
+	defer nodeDeviceInfo.lock.RUnlock()
+
+	batchNodeDevice, status := nodeDeviceInfo.getBatchGPUNodeDevice(node)
+	if !status.IsSuccess() {
+		return status
+	}
+	allocator := &AutopilotAllocator{
+		state:      state,
+		nodeDevice: batchNodeDevice,
+		node:       node,
+		pod:        pod,
+	}
+	_, status = allocator.Allocate(nil, nil, nil, nil)
+	return status
+}
+
 func (p *Plugin) FilterReservation(ctx context.Context, cycleState *framework.CycleState, pod *corev1.Pod, reservationInfo *frameworkext.ReservationInfo, nodeInfo *framework.NodeInfo) *framework.Status {
 	return nil
 }
@@ -474,10 +501,23 @@ func (p *Plugin) Reserve(ctx context.Context, cycleState *framework.CycleState,
 	nodeDeviceInfo.lock.Lock()
 	defer nodeDeviceInfo.lock.Unlock()
 
-	result, status := p.allocateWithNominatedReservation(
-		allocator, cycleState, state, restoreState, nodeInfo.Node(), pod, preemptible)
-	if !status.IsSuccess() {
-		return status
+	var result apiext.DeviceAllocations
+	if state.batchGPU {
+		batchNodeDevice, status := nodeDeviceInfo.getBatchGPUNodeDevice(nodeInfo.Node())
+		if !status.IsSuccess() {
+			return status
+		}
+		allocator.nodeDevice = batchNodeDevice
+		result, status = allocator.Allocate(nil, nil, nil, nil)
+		if !status.IsSuccess() {
+			return status
+		}
+	} else {
+		result, status = p.allocateWithNominatedReservation(
+			allocator, cycleState, state, restoreState, nodeInfo.Node(), pod, preemptible)
+		if !status.IsSuccess() {
+			return status
+		}
 	}
 	if len(result) == 0 {
 		preemptible = appendAllocated(preemptible, restoreState.mergedMatchedAllocatable)
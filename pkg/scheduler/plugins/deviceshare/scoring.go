@@ -80,6 +80,15 @@ func (p *Plugin) Score(ctx context.Context, cycleState *framework.CycleState, po
 	nodeDeviceInfo.lock.RLock()
 	defer nodeDeviceInfo.lock.RUnlock()
 
+	if state.batchGPU {
+		batchNodeDevice, status := nodeDeviceInfo.getBatchGPUNodeDevice(nodeInfo.Node())
+		if !status.IsSuccess() {
+			return 0, status
+		}
+		allocator.nodeDevice = batchNodeDevice
+		return allocator.score(nil, nil)
+	}
+
 	var reservationInfo *frameworkext.ReservationInfo
 	if reservationNominator := p.handle.GetReservationNominator(); reservationNominator != nil {
 		reservationInfo = reservationNominator.GetNominatedReservation(pod, nodeName)
@@ -44,7 +44,8 @@ func (p *Plugin) GetPodTopologyHints(ctx context.Context, cycleState *framework.
 		return nil, status
 	}
 
-	if state.skip {
+	// the Batch pods allocate the batch GPU resources without the NUMA alignment
+	if state.skip || state.batchGPU {
 		return nil, nil
 	}
 
@@ -71,7 +72,7 @@ func (p *Plugin) Allocate(ctx context.Context, cycleState *framework.CycleState,
 		return status
 	}
 
-	if state.skip {
+	if state.skip || state.batchGPU {
 		return nil
 	}
 
@@ -366,6 +366,7 @@ func preparePod(pod *corev1.Pod, gpuSharedResourceTemplatesCache *gpuSharedResou
 
 	state.podRequests = requests
 	state.skip = len(requests) == 0
+	state.batchGPU = apiext.IsPodRequestBatchGPU(pod)
 	if !state.skip {
 		err = parsePodDeviceShareExtensions(pod, requests, state)
 		if err != nil {
@@ -392,6 +393,7 @@ func preparePod(pod *corev1.Pod, gpuSharedResourceTemplatesCache *gpuSharedResou
 func GetPodDeviceRequests(pod *corev1.Pod) (map[schedulingv1alpha1.DeviceType]corev1.ResourceList, error) {
 	podRequests := resourceapi.PodRequests(pod, resourceapi.PodResourcesOptions{})
 	podRequests = quotav1.RemoveZeros(podRequests)
+	podRequests = convertBatchGPURequests(podRequests)
 
 	var requests map[schedulingv1alpha1.DeviceType]corev1.ResourceList
 	for deviceType, supportedResourceNames := range DeviceResourceNames {
@@ -185,6 +185,19 @@ func sumPodUsages(podMetrics map[types.NamespacedName]corev1.ResourceList, estim
 	return podUsages, estimatedPodsUsages
 }
 
+// addHostAppReservation adds the reservation of the high-priority host applications on the node into the estimated
+// usage, since the prod usage only sums the usages of the prod pods while the host applications are not pods.
+func addHostAppReservation(node *corev1.Node, estimatedUsed map[corev1.ResourceName]int64) {
+	reservation, err := extension.GetNodeHostApplicationReservation(node.Annotations)
+	if err != nil {
+		klog.V(5).InfoS("failed to get host application reservation", "node", node.Name, "err", err)
+		return
+	}
+	for resourceName, quantity := range reservation {
+		estimatedUsed[resourceName] += getResourceValue(resourceName, quantity)
+	}
+}
+
 // isDaemonSetPod returns true if the pod is a IsDaemonSetPod.
 func isDaemonSetPod(ownerRefList []metav1.OwnerReference) bool {
 	for _, ownerRef := range ownerRefList {
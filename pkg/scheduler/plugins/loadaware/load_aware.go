@@ -182,6 +182,9 @@ func (p *Plugin) Filter(ctx context.Context, state *framework.CycleState, pod *c
 		klog.ErrorS(err, "GetEstimatedUsed failed!", "node", node.Name)
 		return nil
 	}
+	if prodPod {
+		addHostAppReservation(node, estimatedUsed)
+	}
 	return filterNodeUsage(node.Name, pod, usageThresholds, estimatedUsed, allocatable, prodPod, filterProfile)
 }
 
@@ -238,6 +241,9 @@ func (p *Plugin) Score(ctx context.Context, state *framework.CycleState, pod *co
 		klog.ErrorS(err, "GetEstimatedUsed failed!", "node", node.Name)
 		return 0, nil
 	}
+	if prodPod {
+		addHostAppReservation(node, estimatedUsed)
+	}
 
 	allocatable, err := p.estimator.EstimateNode(node)
 	if err != nil {
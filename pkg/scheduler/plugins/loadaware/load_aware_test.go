@@ -562,6 +562,7 @@ func TestFilterUsage(t *testing.T) {
 		assignedPod               []*podAssignInfo
 		customAggregatedUsage     *extension.CustomAggregatedUsage
 		nodeName                  string
+		nodeAnnotations           map[string]string
 		nodeMetric                *slov1alpha1.NodeMetric
 		pods                      []*corev1.Pod
 		testPod                   *corev1.Pod
@@ -939,6 +940,74 @@ func TestFilterUsage(t *testing.T) {
 			testPod:    schedulertesting.MakePod().Namespace("default").Name("prod-pod-3").Priority(extension.PriorityProdValueMax).Obj(),
 			wantStatus: framework.NewStatus(framework.Unschedulable, fmt.Sprintf(ErrReasonUsageExceedThreshold, corev1.ResourceCPU)),
 		},
+		{
+			name:     "filter prod cpu usage with host application reservation",
+			nodeName: "test-node-1",
+			nodeAnnotations: map[string]string{
+				extension.AnnotationNodeHostApplicationReservation: `{"cpu":"12","memory":"20Gi"}`,
+			},
+			usageThresholds: map[corev1.ResourceName]int64{
+				corev1.ResourceCPU:    100,
+				corev1.ResourceMemory: 100,
+			},
+			prodUsageThresholds: map[corev1.ResourceName]int64{
+				corev1.ResourceCPU:    50,
+				corev1.ResourceMemory: 100,
+			},
+			nodeMetric: &slov1alpha1.NodeMetric{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-node-1",
+				},
+				Spec: slov1alpha1.NodeMetricSpec{
+					CollectPolicy: &slov1alpha1.NodeMetricCollectPolicy{
+						ReportIntervalSeconds: pointer.Int64(60),
+					},
+				},
+				Status: slov1alpha1.NodeMetricStatus{
+					UpdateTime: &metav1.Time{
+						Time: time.Now(),
+					},
+					NodeMetric: &slov1alpha1.NodeMetricInfo{
+						NodeUsage: slov1alpha1.ResourceMap{
+							ResourceList: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("50"),
+								corev1.ResourceMemory: resource.MustParse("300Gi"),
+							},
+						},
+					},
+					PodsMetric: []*slov1alpha1.PodMetricInfo{
+						{
+							Namespace: "default",
+							Name:      "prod-pod-1",
+							Priority:  extension.PriorityProd,
+							PodUsage: slov1alpha1.ResourceMap{
+								ResourceList: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("20"),
+									corev1.ResourceMemory: resource.MustParse("100Gi"),
+								},
+							},
+						},
+						{
+							Namespace: "default",
+							Name:      "prod-pod-2",
+							Priority:  extension.PriorityProd,
+							PodUsage: slov1alpha1.ResourceMap{
+								ResourceList: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("18"),
+									corev1.ResourceMemory: resource.MustParse("100Gi"),
+								},
+							},
+						},
+					},
+				},
+			},
+			pods: []*corev1.Pod{
+				schedulertesting.MakePod().Namespace("default").Name("prod-pod-1").Priority(extension.PriorityProdValueMax).Obj(),
+				schedulertesting.MakePod().Namespace("default").Name("prod-pod-2").Priority(extension.PriorityProdValueMax).Obj(),
+			},
+			testPod:    schedulertesting.MakePod().Namespace("default").Name("prod-pod-3").Priority(extension.PriorityProdValueMax).Obj(),
+			wantStatus: framework.NewStatus(framework.Unschedulable, fmt.Sprintf(ErrReasonUsageExceedThreshold, corev1.ResourceCPU)),
+		},
 		{
 			name:     "filter prod memory usage",
 			nodeName: "test-node-1",
@@ -1511,7 +1580,8 @@ func TestFilterUsage(t *testing.T) {
 			nodes := []*corev1.Node{
 				{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: tt.nodeName,
+						Name:        tt.nodeName,
+						Annotations: tt.nodeAnnotations,
 					},
 					Status: corev1.NodeStatus{
 						Allocatable: corev1.ResourceList{
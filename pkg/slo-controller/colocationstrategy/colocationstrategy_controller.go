@@ -0,0 +1,381 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colocationstrategy
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	configv1alpha1 "github.com/koordinator-sh/koordinator/apis/config/v1alpha1"
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/features"
+	utilfeature "github.com/koordinator-sh/koordinator/pkg/util/feature"
+)
+
+const Name = "colocationstrategy"
+
+const (
+	ReasonColocationStrategyInvalid = "ColocationStrategyInvalid"
+	ReasonColocationStrategyStep    = "ColocationStrategyStep"
+	ReasonColocationStrategyHalted  = "ColocationStrategyHalted"
+)
+
+var (
+	ReconcileInterval = 30 * time.Second
+)
+
+// Reconciler rolls out the ClusterColocationStrategy to the matched nodes in stages.
+// The strategy applied on a node is recorded in the node annotations, which are merged into the colocation
+// strategy of the node by the noderesource and nodemetric controllers.
+type Reconciler struct {
+	client.Client
+	Recorder record.EventRecorder
+	Scheme   *runtime.Scheme
+}
+
+func newReconciler(mgr ctrl.Manager) *Reconciler {
+	return &Reconciler{
+		Client:   mgr.GetClient(),
+		Recorder: mgr.GetEventRecorderFor(Name),
+		Scheme:   mgr.GetScheme(),
+	}
+}
+
+// +kubebuilder:rbac:groups=config.koordinator.sh,resources=clustercolocationstrategies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=config.koordinator.sh,resources=clustercolocationstrategies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=slo.koordinator.sh,resources=nodemetrics,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	strategy := &configv1alpha1.ClusterColocationStrategy{}
+	if err := r.Client.Get(ctx, req.NamespacedName, strategy); err != nil {
+		if !errors.IsNotFound(err) {
+			klog.ErrorS(err, "failed to get ClusterColocationStrategy", "strategy", req.Name)
+			return ctrl.Result{Requeue: true}, err
+		}
+		// the strategy is deleted, clean up the nodes it applied on
+		if err = r.cleanupNodes(ctx, req.Name, nil); err != nil {
+			klog.ErrorS(err, "failed to clean up nodes for deleted ClusterColocationStrategy", "strategy", req.Name)
+			return ctrl.Result{Requeue: true}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if strategy.DeletionTimestamp != nil { // skip for a terminating strategy
+		return ctrl.Result{}, nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := r.Client.List(ctx, nodeList); err != nil {
+		klog.ErrorS(err, "failed to list nodes for ClusterColocationStrategy", "strategy", strategy.Name)
+		return ctrl.Result{Requeue: true}, err
+	}
+	strategyList := &configv1alpha1.ClusterColocationStrategyList{}
+	if err := r.Client.List(ctx, strategyList); err != nil {
+		klog.ErrorS(err, "failed to list ClusterColocationStrategy", "strategy", strategy.Name)
+		return ctrl.Result{Requeue: true}, err
+	}
+	matchedNodes := getMatchedNodes(strategy, strategyList.Items, nodeList.Items)
+
+	// clean up the nodes which are no longer matched
+	matchedNames := make(map[string]struct{}, len(matchedNodes))
+	for _, node := range matchedNodes {
+		matchedNames[node.Name] = struct{}{}
+	}
+	if err := r.cleanupNodes(ctx, strategy.Name, matchedNames); err != nil {
+		klog.ErrorS(err, "failed to clean up unmatched nodes for ClusterColocationStrategy", "strategy", strategy.Name)
+		return ctrl.Result{Requeue: true}, err
+	}
+
+	newStatus, requeueAfter, err := r.rollout(ctx, strategy, matchedNodes)
+	if err != nil {
+		klog.ErrorS(err, "failed to roll out ClusterColocationStrategy", "strategy", strategy.Name)
+	}
+
+	if !reflect.DeepEqual(&strategy.Status, newStatus) {
+		strategy.Status = *newStatus
+		if updateErr := r.Client.Status().Update(ctx, strategy); updateErr != nil {
+			klog.ErrorS(updateErr, "failed to update status for ClusterColocationStrategy", "strategy", strategy.Name)
+			return ctrl.Result{Requeue: true}, updateErr
+		}
+	}
+	if err != nil {
+		return ctrl.Result{Requeue: true}, err
+	}
+
+	klog.V(4).InfoS("reconcile for ClusterColocationStrategy", "strategy", strategy.Name,
+		"revision", newStatus.CurrentRevision, "phase", newStatus.Phase,
+		"matched", newStatus.MatchedNodes, "updated", newStatus.UpdatedNodes, "degraded", newStatus.DegradedNodes)
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// rollout updates the next step of the matched nodes to the current revision if the rollout can continue,
+// and returns the new status of the strategy.
+func (r *Reconciler) rollout(ctx context.Context, strategy *configv1alpha1.ClusterColocationStrategy,
+	matchedNodes []*corev1.Node) (*configv1alpha1.ClusterColocationStrategyStatus, time.Duration, error) {
+	now := time.Now()
+	newStatus := &configv1alpha1.ClusterColocationStrategyStatus{
+		ObservedGeneration: strategy.Generation,
+		MatchedNodes:       int32(len(matchedNodes)),
+	}
+	if strategy.Status.LastStepTime != nil {
+		newStatus.LastStepTime = strategy.Status.LastStepTime.DeepCopy()
+	}
+
+	revision, data, err := getStrategyRevision(strategy)
+	if err != nil {
+		newStatus.Phase = configv1alpha1.ColocationStrategyInvalid
+		newStatus.Message = fmt.Sprintf("failed to get revision, err: %s", err)
+		return newStatus, ReconcileInterval, nil
+	}
+	newStatus.CurrentRevision = revision
+	if revision != strategy.Status.CurrentRevision { // a new revision starts the rollout from the first step
+		newStatus.LastStepTime = nil
+	}
+
+	colocationStrategy, err := parseColocationStrategy(data)
+	if err == nil {
+		err = validateColocationStrategy(colocationStrategy)
+	}
+	if err != nil {
+		newStatus.Phase = configv1alpha1.ColocationStrategyInvalid
+		newStatus.Message = err.Error()
+		nodeStatuses, _, _ := r.getNodeStatuses(ctx, strategy.Name, revision, matchedNodes, 0, now)
+		summarizeNodeStatuses(newStatus, nodeStatuses)
+		r.Recorder.Eventf(strategy, corev1.EventTypeWarning, ReasonColocationStrategyInvalid,
+			"colocation strategy revision %s is invalid, err: %s", revision, err)
+		return newStatus, ReconcileInterval, nil
+	}
+
+	degradeTimeMinutes := getDegradeTimeMinutes(colocationStrategy)
+	nodeStatuses, pendingNodes, err := r.getNodeStatuses(ctx, strategy.Name, revision, matchedNodes, degradeTimeMinutes, now)
+	if err != nil {
+		return &strategy.Status, ReconcileInterval, err
+	}
+	summarizeNodeStatuses(newStatus, nodeStatuses)
+
+	if newStatus.DegradedNodes > 0 {
+		newStatus.Phase = configv1alpha1.ColocationStrategyHalted
+		newStatus.Message = fmt.Sprintf("rollout is halted since %d updated nodes are degraded", newStatus.DegradedNodes)
+		if strategy.Status.Phase != configv1alpha1.ColocationStrategyHalted {
+			r.Recorder.Eventf(strategy, corev1.EventTypeWarning, ReasonColocationStrategyHalted,
+				"rollout of revision %s is halted, %d updated nodes are degraded", revision, newStatus.DegradedNodes)
+		}
+		return newStatus, ReconcileInterval, nil
+	}
+	if len(pendingNodes) <= 0 {
+		newStatus.Phase = configv1alpha1.ColocationStrategyCompleted
+		return newStatus, ReconcileInterval, nil
+	}
+	if strategy.Spec.Rollout.Paused {
+		newStatus.Phase = configv1alpha1.ColocationStrategyPaused
+		newStatus.Message = "rollout is paused"
+		return newStatus, ReconcileInterval, nil
+	}
+
+	newStatus.Phase = configv1alpha1.ColocationStrategyProgressing
+	if newStatus.LastStepTime != nil {
+		nextStepTime := newStatus.LastStepTime.Add(getStepInterval(strategy))
+		if now.Before(nextStepTime) {
+			newStatus.Message = fmt.Sprintf("waiting for the next step at %s", nextStepTime.Format(time.RFC3339))
+			return newStatus, minDuration(nextStepTime.Sub(now), ReconcileInterval), nil
+		}
+	}
+
+	stepSize := getStepSize(len(matchedNodes), getStepPercent(strategy))
+	if stepSize > len(pendingNodes) {
+		stepSize = len(pendingNodes)
+	}
+	for _, node := range pendingNodes[:stepSize] {
+		if err = r.updateNode(ctx, node, formatRevisionOnNode(strategy.Name, revision), data); err != nil {
+			return newStatus, ReconcileInterval, fmt.Errorf("update node %s failed, err: %w", node.Name, err)
+		}
+		for i := range nodeStatuses {
+			if nodeStatuses[i].Name == node.Name {
+				nodeStatuses[i].Revision = revision
+				nodeStatuses[i].Degraded = false
+			}
+		}
+	}
+	summarizeNodeStatuses(newStatus, nodeStatuses)
+	newStatus.LastStepTime = &metav1.Time{Time: now}
+	newStatus.Message = fmt.Sprintf("updated %d nodes in the last step", stepSize)
+	if int(newStatus.UpdatedNodes) >= len(matchedNodes) {
+		newStatus.Phase = configv1alpha1.ColocationStrategyCompleted
+		newStatus.Message = ""
+	}
+	r.Recorder.Eventf(strategy, corev1.EventTypeNormal, ReasonColocationStrategyStep,
+		"updated %d nodes to revision %s, %d/%d nodes are updated", stepSize, revision, newStatus.UpdatedNodes, len(matchedNodes))
+	return newStatus, minDuration(getStepInterval(strategy), ReconcileInterval), nil
+}
+
+// getNodeStatuses returns the revision statuses of the matched nodes and the nodes not updated to the revision.
+func (r *Reconciler) getNodeStatuses(ctx context.Context, name, revision string, matchedNodes []*corev1.Node,
+	degradeTimeMinutes int64, now time.Time) ([]configv1alpha1.ColocationStrategyNodeStatus, []*corev1.Node, error) {
+	var nodeStatuses []configv1alpha1.ColocationStrategyNodeStatus
+	var pendingNodes []*corev1.Node
+	for _, node := range matchedNodes {
+		nodeStatus := configv1alpha1.ColocationStrategyNodeStatus{Name: node.Name}
+		if owner, nodeRevision := getRevisionOnNode(node); owner == name {
+			nodeStatus.Revision = nodeRevision
+		}
+		if nodeStatus.Revision != revision {
+			pendingNodes = append(pendingNodes, node)
+		} else if degradeTimeMinutes > 0 {
+			nodeMetric := &slov1alpha1.NodeMetric{}
+			if err := r.Client.Get(ctx, types.NamespacedName{Name: node.Name}, nodeMetric); err != nil {
+				if !errors.IsNotFound(err) {
+					return nil, nil, err
+				}
+				nodeMetric = nil
+			}
+			nodeStatus.Degraded = isNodeMetricDegraded(nodeMetric, degradeTimeMinutes, now)
+		}
+		nodeStatuses = append(nodeStatuses, nodeStatus)
+	}
+	return nodeStatuses, pendingNodes, nil
+}
+
+func (r *Reconciler) updateNode(ctx context.Context, node *corev1.Node, revision string, data string) error {
+	newNode := node.DeepCopy()
+	if newNode.Annotations == nil {
+		newNode.Annotations = map[string]string{}
+	}
+	newNode.Annotations[extension.AnnotationNodeColocationStrategyRevision] = revision
+	newNode.Annotations[extension.AnnotationNodeClusterColocationStrategy] = data
+	return r.Client.Patch(ctx, newNode, client.MergeFrom(node))
+}
+
+// cleanupNodes removes the strategy annotations of the given strategy on the nodes not in the kept set.
+func (r *Reconciler) cleanupNodes(ctx context.Context, name string, kept map[string]struct{}) error {
+	nodeList := &corev1.NodeList{}
+	if err := r.Client.List(ctx, nodeList); err != nil {
+		return err
+	}
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if owner, _ := getRevisionOnNode(node); owner != name {
+			continue
+		}
+		if _, ok := kept[node.Name]; ok {
+			continue
+		}
+		newNode := node.DeepCopy()
+		delete(newNode.Annotations, extension.AnnotationNodeColocationStrategyRevision)
+		delete(newNode.Annotations, extension.AnnotationNodeClusterColocationStrategy)
+		if err := r.Client.Patch(ctx, newNode, client.MergeFrom(node)); err != nil {
+			return err
+		}
+		klog.V(4).InfoS("clean up colocation strategy on node", "strategy", name, "node", node.Name)
+	}
+	return nil
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&configv1alpha1.ClusterColocationStrategy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Node{}, handler.EnqueueRequestsFromMapFunc(r.mapNodeToStrategies),
+			builder.WithPredicates(nodeChangedPredicate())).
+		Named(Name).
+		Complete(r)
+}
+
+// mapNodeToStrategies enqueues the strategies matching the node and the strategy applied on the node.
+func (r *Reconciler) mapNodeToStrategies(ctx context.Context, obj client.Object) []reconcile.Request {
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		return nil
+	}
+	var requests []reconcile.Request
+	owner, _ := getRevisionOnNode(node)
+	if owner != "" {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: owner}})
+	}
+	strategyList := &configv1alpha1.ClusterColocationStrategyList{}
+	if err := r.Client.List(ctx, strategyList); err != nil {
+		klog.V(4).InfoS("failed to list ClusterColocationStrategy for node", "node", node.Name, "err", err)
+		return requests
+	}
+	for i := range strategyList.Items {
+		strategy := &strategyList.Items[i]
+		if strategy.Name != owner && isStrategyMatchNode(strategy, node) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: strategy.Name}})
+		}
+	}
+	return requests
+}
+
+// nodeChangedPredicate filters the node events which can change the matched nodes of the strategies.
+func nodeChangedPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNode, okOld := e.ObjectOld.(*corev1.Node)
+			newNode, okNew := e.ObjectNew.(*corev1.Node)
+			if !okOld || !okNew {
+				return false
+			}
+			return !reflect.DeepEqual(oldNode.Labels, newNode.Labels) ||
+				oldNode.Annotations[extension.AnnotationNodeColocationStrategyRevision] != newNode.Annotations[extension.AnnotationNodeColocationStrategyRevision]
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+func InitFlags(fs *flag.FlagSet) {
+	pflag.DurationVar(&ReconcileInterval, "colocation-strategy-reconcile-interval", ReconcileInterval, "The interval to reconcile ClusterColocationStrategy.")
+}
+
+func Add(mgr ctrl.Manager) error {
+	if !utilfeature.DefaultMutableFeatureGate.Enabled(features.ColocationStrategyController) {
+		klog.InfoS("ColocationStrategyController feature is disabled")
+		return nil
+	}
+
+	klog.InfoS("ColocationStrategyController is enabled, add the controller")
+	reconciler := newReconciler(mgr)
+	return reconciler.SetupWithManager(mgr)
+}
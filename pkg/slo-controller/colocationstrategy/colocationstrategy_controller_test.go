@@ -0,0 +1,291 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colocationstrategy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1alpha1 "github.com/koordinator-sh/koordinator/apis/config/v1alpha1"
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/features"
+	utilfeature "github.com/koordinator-sh/koordinator/pkg/util/feature"
+	"github.com/koordinator-sh/koordinator/pkg/util/sloconfig"
+)
+
+func getTestScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = slov1alpha1.AddToScheme(s)
+	_ = configv1alpha1.AddToScheme(s)
+	return s
+}
+
+func newTestNode(name string, pool string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"pool": pool},
+		},
+	}
+}
+
+func newTestNodeMetric(name string, updateTime time.Time) *slov1alpha1.NodeMetric {
+	return &slov1alpha1.NodeMetric{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: slov1alpha1.NodeMetricStatus{
+			UpdateTime: &metav1.Time{Time: updateTime},
+		},
+	}
+}
+
+func newTestStrategy(name string, pool string, stepPercent int32) *configv1alpha1.ClusterColocationStrategy {
+	return &configv1alpha1.ClusterColocationStrategy{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: configv1alpha1.ClusterColocationStrategySpec{
+			NodeSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"pool": pool},
+			},
+			Strategy: configv1alpha1.ColocationStrategy{
+				Enable:                     pointer.Bool(true),
+				CPUReclaimThresholdPercent: pointer.Int64(70),
+			},
+			Rollout: configv1alpha1.ColocationStrategyRollout{
+				StepPercent:         pointer.Int32(stepPercent),
+				StepIntervalSeconds: pointer.Int64(60),
+			},
+		},
+	}
+}
+
+func newTestReconciler(objs ...client.Object) *Reconciler {
+	c := fake.NewClientBuilder().WithScheme(getTestScheme()).
+		WithStatusSubresource(&configv1alpha1.ClusterColocationStrategy{}).
+		WithObjects(objs...).Build()
+	return &Reconciler{
+		Client:   c,
+		Recorder: record.NewFakeRecorder(1024),
+		Scheme:   getTestScheme(),
+	}
+}
+
+func reconcileStrategy(t *testing.T, r *Reconciler, name string) *configv1alpha1.ClusterColocationStrategy {
+	_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+	assert.NoError(t, err)
+	strategy := &configv1alpha1.ClusterColocationStrategy{}
+	err = r.Client.Get(context.TODO(), types.NamespacedName{Name: name}, strategy)
+	assert.NoError(t, err)
+	return strategy
+}
+
+func getNodeRevision(t *testing.T, r *Reconciler, name string) string {
+	node := &corev1.Node{}
+	err := r.Client.Get(context.TODO(), types.NamespacedName{Name: name}, node)
+	assert.NoError(t, err)
+	return node.Annotations[extension.AnnotationNodeColocationStrategyRevision]
+}
+
+func TestReconcileStagedRollout(t *testing.T) {
+	now := time.Now()
+	strategy := newTestStrategy("pool-a", "a", 50)
+	r := newTestReconciler(strategy,
+		newTestNode("node-0", "a"), newTestNode("node-1", "a"), newTestNode("node-2", "a"),
+		newTestNode("node-3", "b"),
+		newTestNodeMetric("node-0", now), newTestNodeMetric("node-1", now), newTestNodeMetric("node-2", now))
+	revision, _, err := getStrategyRevision(strategy)
+	assert.NoError(t, err)
+	revisionOnNode := formatRevisionOnNode("pool-a", revision)
+
+	// the first step updates ceil(3 * 50%) = 2 nodes
+	got := reconcileStrategy(t, r, "pool-a")
+	assert.Equal(t, configv1alpha1.ColocationStrategyProgressing, got.Status.Phase)
+	assert.Equal(t, revision, got.Status.CurrentRevision)
+	assert.Equal(t, int32(3), got.Status.MatchedNodes)
+	assert.Equal(t, int32(2), got.Status.UpdatedNodes)
+	assert.NotNil(t, got.Status.LastStepTime)
+	assert.Equal(t, []configv1alpha1.ColocationStrategyRevisionStatus{
+		{Revision: "", Nodes: 1},
+		{Revision: revision, Nodes: 2},
+	}, got.Status.Revisions)
+	assert.Equal(t, []configv1alpha1.ColocationStrategyNodeStatus{
+		{Name: "node-2"},
+	}, got.Status.UnreadyNodes)
+	assert.Equal(t, revisionOnNode, getNodeRevision(t, r, "node-0"))
+	assert.Equal(t, revisionOnNode, getNodeRevision(t, r, "node-1"))
+	assert.Equal(t, "", getNodeRevision(t, r, "node-2"))
+	assert.Equal(t, "", getNodeRevision(t, r, "node-3"))
+
+	defer utilfeature.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.ColocationStrategyController, true)()
+	node := &corev1.Node{}
+	assert.NoError(t, r.Client.Get(context.TODO(), types.NamespacedName{Name: "node-0"}, node))
+	nodeStrategy := sloconfig.GetNodeColocationStrategy(sloconfig.NewDefaultColocationCfg(), node)
+	assert.Equal(t, pointer.Bool(true), nodeStrategy.Enable)
+	assert.Equal(t, pointer.Int64(70), nodeStrategy.CPUReclaimThresholdPercent)
+
+	// wait for the step interval
+	got = reconcileStrategy(t, r, "pool-a")
+	assert.Equal(t, configv1alpha1.ColocationStrategyProgressing, got.Status.Phase)
+	assert.Equal(t, int32(2), got.Status.UpdatedNodes)
+	assert.Equal(t, "", getNodeRevision(t, r, "node-2"))
+
+	// the next step completes the rollout
+	got.Status.LastStepTime = &metav1.Time{Time: now.Add(-2 * time.Minute)}
+	assert.NoError(t, r.Client.Status().Update(context.TODO(), got))
+	got = reconcileStrategy(t, r, "pool-a")
+	assert.Equal(t, configv1alpha1.ColocationStrategyCompleted, got.Status.Phase)
+	assert.Equal(t, int32(3), got.Status.UpdatedNodes)
+	assert.Equal(t, []configv1alpha1.ColocationStrategyRevisionStatus{
+		{Revision: revision, Nodes: 3},
+	}, got.Status.Revisions)
+	assert.Nil(t, got.Status.UnreadyNodes)
+	assert.Equal(t, revisionOnNode, getNodeRevision(t, r, "node-2"))
+
+	// delete the strategy and clean up the nodes
+	assert.NoError(t, r.Client.Delete(context.TODO(), got))
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "pool-a"}})
+	assert.NoError(t, err)
+	for _, name := range []string{"node-0", "node-1", "node-2"} {
+		assert.NoError(t, r.Client.Get(context.TODO(), types.NamespacedName{Name: name}, node))
+		assert.NotContains(t, node.Annotations, extension.AnnotationNodeColocationStrategyRevision)
+		assert.NotContains(t, node.Annotations, extension.AnnotationNodeClusterColocationStrategy)
+	}
+}
+
+func TestReconcileHaltOnDegradedNodes(t *testing.T) {
+	now := time.Now()
+	strategy := newTestStrategy("pool-a", "a", 50)
+	revision, data, err := getStrategyRevision(strategy)
+	assert.NoError(t, err)
+	updatedNode := newTestNode("node-0", "a")
+	updatedNode.Annotations = map[string]string{
+		extension.AnnotationNodeColocationStrategyRevision: formatRevisionOnNode("pool-a", revision),
+		extension.AnnotationNodeClusterColocationStrategy:  data,
+	}
+	r := newTestReconciler(strategy, updatedNode, newTestNode("node-1", "a"),
+		newTestNodeMetric("node-0", now.Add(-time.Hour)), newTestNodeMetric("node-1", now))
+
+	got := reconcileStrategy(t, r, "pool-a")
+	assert.Equal(t, configv1alpha1.ColocationStrategyHalted, got.Status.Phase)
+	assert.Equal(t, int32(1), got.Status.UpdatedNodes)
+	assert.Equal(t, int32(1), got.Status.DegradedNodes)
+	assert.Equal(t, []configv1alpha1.ColocationStrategyNodeStatus{
+		{Name: "node-0", Revision: revision, Degraded: true},
+		{Name: "node-1"},
+	}, got.Status.UnreadyNodes)
+	assert.Equal(t, "", getNodeRevision(t, r, "node-1"))
+
+	// continue after the degraded node recovers
+	nodeMetric := &slov1alpha1.NodeMetric{}
+	assert.NoError(t, r.Client.Get(context.TODO(), types.NamespacedName{Name: "node-0"}, nodeMetric))
+	nodeMetric.Status.UpdateTime = &metav1.Time{Time: now}
+	assert.NoError(t, r.Client.Update(context.TODO(), nodeMetric))
+	got = reconcileStrategy(t, r, "pool-a")
+	assert.Equal(t, configv1alpha1.ColocationStrategyCompleted, got.Status.Phase)
+	assert.Equal(t, int32(2), got.Status.UpdatedNodes)
+	assert.Equal(t, int32(0), got.Status.DegradedNodes)
+}
+
+func TestReconcileInvalidOrPausedStrategy(t *testing.T) {
+	invalid := newTestStrategy("pool-a", "a", 100)
+	invalid.Spec.Strategy.CPUReclaimThresholdPercent = pointer.Int64(0)
+	paused := newTestStrategy("pool-b", "b", 100)
+	paused.Spec.Rollout.Paused = true
+	r := newTestReconciler(invalid, paused, newTestNode("node-0", "a"), newTestNode("node-1", "b"))
+
+	got := reconcileStrategy(t, r, "pool-a")
+	assert.Equal(t, configv1alpha1.ColocationStrategyInvalid, got.Status.Phase)
+	assert.Equal(t, int32(0), got.Status.UpdatedNodes)
+	assert.Equal(t, "", getNodeRevision(t, r, "node-0"))
+
+	got = reconcileStrategy(t, r, "pool-b")
+	assert.Equal(t, configv1alpha1.ColocationStrategyPaused, got.Status.Phase)
+	assert.Equal(t, int32(1), got.Status.MatchedNodes)
+	assert.Equal(t, "", getNodeRevision(t, r, "node-1"))
+}
+
+func TestGetMatchedNodes(t *testing.T) {
+	low := newTestStrategy("pool-all", "a", 100)
+	low.Spec.NodeSelector = &metav1.LabelSelector{}
+	high := newTestStrategy("pool-a", "a", 100)
+	high.Spec.Priority = 10
+	invalid := newTestStrategy("pool-nil", "a", 100)
+	invalid.Spec.NodeSelector = nil
+	strategies := []configv1alpha1.ClusterColocationStrategy{*low, *high, *invalid}
+	nodes := []corev1.Node{*newTestNode("node-1", "b"), *newTestNode("node-0", "a"), *newTestNode("node-2", "b")}
+
+	var names []string
+	for _, node := range getMatchedNodes(low, strategies, nodes) {
+		names = append(names, node.Name)
+	}
+	assert.Equal(t, []string{"node-1", "node-2"}, names)
+	names = nil
+	for _, node := range getMatchedNodes(high, strategies, nodes) {
+		names = append(names, node.Name)
+	}
+	assert.Equal(t, []string{"node-0"}, names)
+	assert.Nil(t, getMatchedNodes(invalid, strategies, nodes))
+}
+
+func TestGetStepSize(t *testing.T) {
+	assert.Equal(t, 1, getStepSize(0, 50))
+	assert.Equal(t, 1, getStepSize(3, 10))
+	assert.Equal(t, 2, getStepSize(3, 50))
+	assert.Equal(t, 10, getStepSize(10, 100))
+}
+
+func TestSummarizeNodeStatuses(t *testing.T) {
+	var nodeStatuses []configv1alpha1.ColocationStrategyNodeStatus
+	for i := 0; i < MaxUnreadyNodesInStatus+10; i++ {
+		nodeStatuses = append(nodeStatuses, configv1alpha1.ColocationStrategyNodeStatus{
+			Name:     fmt.Sprintf("node-%03d", i),
+			Revision: "old",
+		})
+	}
+	for i := 0; i < 5; i++ {
+		nodeStatuses = append(nodeStatuses, configv1alpha1.ColocationStrategyNodeStatus{
+			Name:     fmt.Sprintf("updated-%d", i),
+			Revision: "new",
+			Degraded: i == 4,
+		})
+	}
+	status := &configv1alpha1.ClusterColocationStrategyStatus{CurrentRevision: "new"}
+	summarizeNodeStatuses(status, nodeStatuses)
+	assert.Equal(t, int32(5), status.UpdatedNodes)
+	assert.Equal(t, int32(1), status.DegradedNodes)
+	assert.Equal(t, []configv1alpha1.ColocationStrategyRevisionStatus{
+		{Revision: "new", Nodes: 5},
+		{Revision: "old", Nodes: int32(MaxUnreadyNodesInStatus + 10)},
+	}, status.Revisions)
+	assert.Len(t, status.UnreadyNodes, MaxUnreadyNodesInStatus)
+	assert.Equal(t, configv1alpha1.ColocationStrategyNodeStatus{Name: "updated-4", Revision: "new", Degraded: true}, status.UnreadyNodes[0])
+	assert.Equal(t, "node-000", status.UnreadyNodes[1].Name)
+}
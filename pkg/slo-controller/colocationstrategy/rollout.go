@@ -0,0 +1,232 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package colocationstrategy
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	configv1alpha1 "github.com/koordinator-sh/koordinator/apis/config/v1alpha1"
+	"github.com/koordinator-sh/koordinator/apis/configuration"
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/util"
+	"github.com/koordinator-sh/koordinator/pkg/util/sloconfig"
+)
+
+const (
+	DefaultStepPercent         = 100
+	DefaultStepIntervalSeconds = 300
+
+	// MaxUnreadyNodesInStatus is the max number of unready nodes listed in the strategy status.
+	MaxUnreadyNodesInStatus = 20
+)
+
+// getStrategyRevision returns the revision of the strategy, which is the hash of the strategy content.
+func getStrategyRevision(strategy *configv1alpha1.ClusterColocationStrategy) (string, string, error) {
+	data, err := json.Marshal(&strategy.Spec.Strategy)
+	if err != nil {
+		return "", "", err
+	}
+	h := fnv.New32a()
+	h.Write(data)
+	return fmt.Sprintf("%x", h.Sum32()), string(data), nil
+}
+
+// parseColocationStrategy converts the typed strategy into the ColocationStrategy of the slo-controller-config.
+func parseColocationStrategy(data string) (*configuration.ColocationStrategy, error) {
+	strategy := &configuration.ColocationStrategy{}
+	if err := json.Unmarshal([]byte(data), strategy); err != nil {
+		return nil, err
+	}
+	return strategy, nil
+}
+
+// validateColocationStrategy checks if the strategy is valid after merged into the default strategy.
+func validateColocationStrategy(strategy *configuration.ColocationStrategy) error {
+	defaultStrategy := sloconfig.DefaultColocationStrategy()
+	merged, err := util.MergeCfg(&defaultStrategy, strategy)
+	if err != nil {
+		return err
+	}
+	mergedStrategy := merged.(*configuration.ColocationStrategy)
+	if !sloconfig.IsColocationStrategyValid(mergedStrategy) {
+		return fmt.Errorf("invalid colocation strategy")
+	}
+	info, err := sloconfig.GetValidatorInstance().StructWithTrans(mergedStrategy)
+	if err != nil {
+		return err
+	}
+	if len(info) > 0 {
+		return fmt.Errorf("invalid colocation strategy, %v", info)
+	}
+	return nil
+}
+
+func formatRevisionOnNode(name, revision string) string {
+	return name + "/" + revision
+}
+
+// getRevisionOnNode returns the name of the ClusterColocationStrategy and its revision applied on the node.
+func getRevisionOnNode(node *corev1.Node) (string, string) {
+	s, ok := node.Annotations[extension.AnnotationNodeColocationStrategyRevision]
+	if !ok {
+		return "", ""
+	}
+	name, revision, _ := strings.Cut(s, "/")
+	return name, revision
+}
+
+func isStrategyMatchNode(strategy *configv1alpha1.ClusterColocationStrategy, node *corev1.Node) bool {
+	if strategy.DeletionTimestamp != nil || strategy.Spec.NodeSelector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(strategy.Spec.NodeSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(node.Labels))
+}
+
+// isStrategyPreferred checks if the strategy a takes precedence over the strategy b.
+func isStrategyPreferred(a, b *configv1alpha1.ClusterColocationStrategy) bool {
+	if a.Spec.Priority != b.Spec.Priority {
+		return a.Spec.Priority > b.Spec.Priority
+	}
+	return a.Name < b.Name
+}
+
+// getMatchedNodes returns the nodes which the strategy applies to, i.e. the nodes matched by the strategy and not
+// preempted by another strategy with the higher precedence. The nodes are sorted by the names.
+func getMatchedNodes(strategy *configv1alpha1.ClusterColocationStrategy, strategies []configv1alpha1.ClusterColocationStrategy,
+	nodes []corev1.Node) []*corev1.Node {
+	var matched []*corev1.Node
+	for i := range nodes {
+		node := &nodes[i]
+		if !isStrategyMatchNode(strategy, node) {
+			continue
+		}
+		preempted := false
+		for j := range strategies {
+			other := &strategies[j]
+			if other.Name == strategy.Name || !isStrategyMatchNode(other, node) {
+				continue
+			}
+			if isStrategyPreferred(other, strategy) {
+				preempted = true
+				break
+			}
+		}
+		if !preempted {
+			matched = append(matched, node)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].Name < matched[j].Name
+	})
+	return matched
+}
+
+// isNodeMetricDegraded checks if the NodeMetric is not updated in the degrade time.
+func isNodeMetricDegraded(nodeMetric *slov1alpha1.NodeMetric, degradeTimeMinutes int64, now time.Time) bool {
+	if nodeMetric == nil || nodeMetric.Status.UpdateTime == nil {
+		return true
+	}
+	return now.After(nodeMetric.Status.UpdateTime.Add(time.Duration(degradeTimeMinutes) * time.Minute))
+}
+
+func getDegradeTimeMinutes(strategy *configuration.ColocationStrategy) int64 {
+	if strategy != nil && strategy.DegradeTimeMinutes != nil {
+		return *strategy.DegradeTimeMinutes
+	}
+	return *sloconfig.DefaultColocationStrategy().DegradeTimeMinutes
+}
+
+func getStepPercent(strategy *configv1alpha1.ClusterColocationStrategy) int32 {
+	if p := strategy.Spec.Rollout.StepPercent; p != nil && *p > 0 && *p <= 100 {
+		return *p
+	}
+	return DefaultStepPercent
+}
+
+func getStepInterval(strategy *configv1alpha1.ClusterColocationStrategy) time.Duration {
+	if s := strategy.Spec.Rollout.StepIntervalSeconds; s != nil && *s >= 0 {
+		return time.Duration(*s) * time.Second
+	}
+	return DefaultStepIntervalSeconds * time.Second
+}
+
+// getStepSize returns the number of nodes to update in one step, which is at least one.
+func getStepSize(matched int, stepPercent int32) int {
+	size := (matched*int(stepPercent) + 99) / 100
+	if size < 1 {
+		size = 1
+	}
+	return size
+}
+
+// summarizeNodeStatuses sets the node counts of each revision and the capped list of unready nodes in the status,
+// so the status size does not grow with the node pool.
+func summarizeNodeStatuses(status *configv1alpha1.ClusterColocationStrategyStatus,
+	nodeStatuses []configv1alpha1.ColocationStrategyNodeStatus) {
+	status.UpdatedNodes, status.DegradedNodes = 0, 0
+	revisionNodes := map[string]int32{}
+	var degradedNodes, pendingNodes []configv1alpha1.ColocationStrategyNodeStatus
+	for _, nodeStatus := range nodeStatuses {
+		revisionNodes[nodeStatus.Revision]++
+		if nodeStatus.Revision != status.CurrentRevision {
+			pendingNodes = append(pendingNodes, nodeStatus)
+			continue
+		}
+		status.UpdatedNodes++
+		if nodeStatus.Degraded {
+			status.DegradedNodes++
+			degradedNodes = append(degradedNodes, nodeStatus)
+		}
+	}
+
+	status.Revisions = nil
+	for revision, count := range revisionNodes {
+		status.Revisions = append(status.Revisions, configv1alpha1.ColocationStrategyRevisionStatus{
+			Revision: revision,
+			Nodes:    count,
+		})
+	}
+	sort.Slice(status.Revisions, func(i, j int) bool {
+		return status.Revisions[i].Revision < status.Revisions[j].Revision
+	})
+
+	sortByName := func(nodes []configv1alpha1.ColocationStrategyNodeStatus) {
+		sort.Slice(nodes, func(i, j int) bool {
+			return nodes[i].Name < nodes[j].Name
+		})
+	}
+	sortByName(degradedNodes)
+	sortByName(pendingNodes)
+	status.UnreadyNodes = append(degradedNodes, pendingNodes...)
+	if len(status.UnreadyNodes) > MaxUnreadyNodesInStatus {
+		status.UnreadyNodes = status.UnreadyNodes[:MaxUnreadyNodesInStatus]
+	}
+}
@@ -196,21 +196,23 @@ func Test_syncColocationConfigIfChanged(t *testing.T) {
 			wantField: &colocationCfgCache{
 				colocationCfg: configuration.ColocationCfg{
 					ColocationStrategy: configuration.ColocationStrategy{
-						Enable:                         pointer.Bool(false),
-						MetricAggregateDurationSeconds: pointer.Int64(60),
-						MetricReportIntervalSeconds:    pointer.Int64(20),
-						MetricAggregatePolicy:          sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
-						CPUReclaimThresholdPercent:     pointer.Int64(70),
-						CPUCalculatePolicy:             &cpuCalcPolicyByUsage,
-						MemoryReclaimThresholdPercent:  pointer.Int64(70),
-						MemoryCalculatePolicy:          &memoryCalcPolicyByUsage,
-						DegradeTimeMinutes:             pointer.Int64(15),
-						UpdateTimeThresholdSeconds:     pointer.Int64(100),
-						ResourceDiffThreshold:          pointer.Float64(0.1),
-						MetricMemoryCollectPolicy:      &defaultNodeMemoryCollectPolicy,
-						MidCPUThresholdPercent:         pointer.Int64(100),
-						MidMemoryThresholdPercent:      pointer.Int64(100),
-						MidUnallocatedPercent:          pointer.Int64(0),
+						Enable:                                  pointer.Bool(false),
+						MetricAggregateDurationSeconds:          pointer.Int64(60),
+						MetricReportIntervalSeconds:             pointer.Int64(20),
+						MetricAggregatePolicy:                   sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
+						CPUReclaimThresholdPercent:              pointer.Int64(70),
+						CPUCalculatePolicy:                      &cpuCalcPolicyByUsage,
+						MemoryReclaimThresholdPercent:           pointer.Int64(70),
+						MemoryCalculatePolicy:                   &memoryCalcPolicyByUsage,
+						DegradeTimeMinutes:                      pointer.Int64(15),
+						UpdateTimeThresholdSeconds:              pointer.Int64(100),
+						ResourceDiffThreshold:                   pointer.Float64(0.1),
+						MetricMemoryCollectPolicy:               &defaultNodeMemoryCollectPolicy,
+						EphemeralStorageReclaimThresholdPercent: pointer.Int64(65),
+						GPUReclaimThresholdPercent:              pointer.Int64(60),
+						MidCPUThresholdPercent:                  pointer.Int64(100),
+						MidMemoryThresholdPercent:               pointer.Int64(100),
+						MidUnallocatedPercent:                   pointer.Int64(0),
 					},
 				},
 				available:   true,
@@ -290,21 +292,23 @@ func Test_syncColocationConfigIfChanged(t *testing.T) {
 			wantField: &colocationCfgCache{
 				colocationCfg: configuration.ColocationCfg{
 					ColocationStrategy: configuration.ColocationStrategy{
-						Enable:                         pointer.Bool(true),
-						MetricAggregateDurationSeconds: pointer.Int64(30),
-						MetricReportIntervalSeconds:    pointer.Int64(20),
-						MetricAggregatePolicy:          sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
-						CPUReclaimThresholdPercent:     pointer.Int64(70),
-						CPUCalculatePolicy:             &cpuCalcPolicyByUsage,
-						MemoryReclaimThresholdPercent:  pointer.Int64(80),
-						MemoryCalculatePolicy:          &memoryCalcPolicyByUsage,
-						DegradeTimeMinutes:             pointer.Int64(5),
-						UpdateTimeThresholdSeconds:     pointer.Int64(300),
-						ResourceDiffThreshold:          pointer.Float64(0.1),
-						MetricMemoryCollectPolicy:      &defaultNodeMemoryCollectPolicy,
-						MidCPUThresholdPercent:         pointer.Int64(100),
-						MidMemoryThresholdPercent:      pointer.Int64(100),
-						MidUnallocatedPercent:          pointer.Int64(0),
+						Enable:                                  pointer.Bool(true),
+						MetricAggregateDurationSeconds:          pointer.Int64(30),
+						MetricReportIntervalSeconds:             pointer.Int64(20),
+						MetricAggregatePolicy:                   sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
+						CPUReclaimThresholdPercent:              pointer.Int64(70),
+						CPUCalculatePolicy:                      &cpuCalcPolicyByUsage,
+						MemoryReclaimThresholdPercent:           pointer.Int64(80),
+						MemoryCalculatePolicy:                   &memoryCalcPolicyByUsage,
+						DegradeTimeMinutes:                      pointer.Int64(5),
+						UpdateTimeThresholdSeconds:              pointer.Int64(300),
+						ResourceDiffThreshold:                   pointer.Float64(0.1),
+						MetricMemoryCollectPolicy:               &defaultNodeMemoryCollectPolicy,
+						EphemeralStorageReclaimThresholdPercent: pointer.Int64(65),
+						GPUReclaimThresholdPercent:              pointer.Int64(60),
+						MidCPUThresholdPercent:                  pointer.Int64(100),
+						MidMemoryThresholdPercent:               pointer.Int64(100),
+						MidUnallocatedPercent:                   pointer.Int64(0),
 					},
 					NodeConfigs: []configuration.NodeColocationCfg{
 						{
@@ -316,21 +320,23 @@ func Test_syncColocationConfigIfChanged(t *testing.T) {
 								},
 							},
 							ColocationStrategy: configuration.ColocationStrategy{
-								Enable:                         pointer.Bool(true),
-								MetricAggregateDurationSeconds: pointer.Int64(30),
-								MetricReportIntervalSeconds:    pointer.Int64(20),
-								MetricAggregatePolicy:          sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
-								CPUReclaimThresholdPercent:     pointer.Int64(70),
-								CPUCalculatePolicy:             &cpuCalcPolicyByUsage,
-								MemoryReclaimThresholdPercent:  pointer.Int64(80),
-								MemoryCalculatePolicy:          &memoryCalcPolicyByUsage,
-								DegradeTimeMinutes:             pointer.Int64(5),
-								UpdateTimeThresholdSeconds:     pointer.Int64(300),
-								ResourceDiffThreshold:          pointer.Float64(0.1),
-								MetricMemoryCollectPolicy:      &defaultNodeMemoryCollectPolicy,
-								MidCPUThresholdPercent:         pointer.Int64(100),
-								MidMemoryThresholdPercent:      pointer.Int64(100),
-								MidUnallocatedPercent:          pointer.Int64(0),
+								Enable:                                  pointer.Bool(true),
+								MetricAggregateDurationSeconds:          pointer.Int64(30),
+								MetricReportIntervalSeconds:             pointer.Int64(20),
+								MetricAggregatePolicy:                   sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
+								CPUReclaimThresholdPercent:              pointer.Int64(70),
+								CPUCalculatePolicy:                      &cpuCalcPolicyByUsage,
+								MemoryReclaimThresholdPercent:           pointer.Int64(80),
+								MemoryCalculatePolicy:                   &memoryCalcPolicyByUsage,
+								DegradeTimeMinutes:                      pointer.Int64(5),
+								UpdateTimeThresholdSeconds:              pointer.Int64(300),
+								ResourceDiffThreshold:                   pointer.Float64(0.1),
+								MetricMemoryCollectPolicy:               &defaultNodeMemoryCollectPolicy,
+								EphemeralStorageReclaimThresholdPercent: pointer.Int64(65),
+								GPUReclaimThresholdPercent:              pointer.Int64(60),
+								MidCPUThresholdPercent:                  pointer.Int64(100),
+								MidMemoryThresholdPercent:               pointer.Int64(100),
+								MidUnallocatedPercent:                   pointer.Int64(0),
 							},
 						},
 					},
@@ -364,21 +370,23 @@ func Test_syncColocationConfigIfChanged(t *testing.T) {
 			wantField: &colocationCfgCache{
 				colocationCfg: configuration.ColocationCfg{
 					ColocationStrategy: configuration.ColocationStrategy{
-						Enable:                         pointer.Bool(true),
-						MetricAggregateDurationSeconds: pointer.Int64(60),
-						MetricReportIntervalSeconds:    pointer.Int64(20),
-						MetricAggregatePolicy:          sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
-						CPUReclaimThresholdPercent:     pointer.Int64(70),
-						CPUCalculatePolicy:             &cpuCalcPolicyByUsage,
-						MemoryReclaimThresholdPercent:  pointer.Int64(80),
-						MemoryCalculatePolicy:          &memoryCalcPolicyByUsage,
-						DegradeTimeMinutes:             pointer.Int64(5),
-						UpdateTimeThresholdSeconds:     pointer.Int64(300),
-						ResourceDiffThreshold:          pointer.Float64(0.1),
-						MetricMemoryCollectPolicy:      &defaultNodeMemoryCollectPolicy,
-						MidCPUThresholdPercent:         pointer.Int64(100),
-						MidMemoryThresholdPercent:      pointer.Int64(100),
-						MidUnallocatedPercent:          pointer.Int64(0),
+						Enable:                                  pointer.Bool(true),
+						MetricAggregateDurationSeconds:          pointer.Int64(60),
+						MetricReportIntervalSeconds:             pointer.Int64(20),
+						MetricAggregatePolicy:                   sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
+						CPUReclaimThresholdPercent:              pointer.Int64(70),
+						CPUCalculatePolicy:                      &cpuCalcPolicyByUsage,
+						MemoryReclaimThresholdPercent:           pointer.Int64(80),
+						MemoryCalculatePolicy:                   &memoryCalcPolicyByUsage,
+						DegradeTimeMinutes:                      pointer.Int64(5),
+						UpdateTimeThresholdSeconds:              pointer.Int64(300),
+						ResourceDiffThreshold:                   pointer.Float64(0.1),
+						MetricMemoryCollectPolicy:               &defaultNodeMemoryCollectPolicy,
+						EphemeralStorageReclaimThresholdPercent: pointer.Int64(65),
+						GPUReclaimThresholdPercent:              pointer.Int64(60),
+						MidCPUThresholdPercent:                  pointer.Int64(100),
+						MidMemoryThresholdPercent:               pointer.Int64(100),
+						MidUnallocatedPercent:                   pointer.Int64(0),
 					},
 				},
 				available:   true,
@@ -390,21 +398,23 @@ func Test_syncColocationConfigIfChanged(t *testing.T) {
 			fields: fields{config: &colocationCfgCache{
 				colocationCfg: configuration.ColocationCfg{
 					ColocationStrategy: configuration.ColocationStrategy{
-						Enable:                         pointer.Bool(true),
-						MetricAggregateDurationSeconds: pointer.Int64(60),
-						MetricReportIntervalSeconds:    pointer.Int64(60),
-						MetricAggregatePolicy:          sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
-						CPUReclaimThresholdPercent:     pointer.Int64(70),
-						CPUCalculatePolicy:             &cpuCalcPolicyByUsage,
-						MemoryReclaimThresholdPercent:  pointer.Int64(80),
-						MemoryCalculatePolicy:          &memoryCalcPolicyByUsage,
-						DegradeTimeMinutes:             pointer.Int64(5),
-						UpdateTimeThresholdSeconds:     pointer.Int64(300),
-						ResourceDiffThreshold:          pointer.Float64(0.1),
-						MetricMemoryCollectPolicy:      &defaultNodeMemoryCollectPolicy,
-						MidCPUThresholdPercent:         pointer.Int64(100),
-						MidMemoryThresholdPercent:      pointer.Int64(100),
-						MidUnallocatedPercent:          pointer.Int64(0),
+						Enable:                                  pointer.Bool(true),
+						MetricAggregateDurationSeconds:          pointer.Int64(60),
+						MetricReportIntervalSeconds:             pointer.Int64(60),
+						MetricAggregatePolicy:                   sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
+						CPUReclaimThresholdPercent:              pointer.Int64(70),
+						CPUCalculatePolicy:                      &cpuCalcPolicyByUsage,
+						MemoryReclaimThresholdPercent:           pointer.Int64(80),
+						MemoryCalculatePolicy:                   &memoryCalcPolicyByUsage,
+						DegradeTimeMinutes:                      pointer.Int64(5),
+						UpdateTimeThresholdSeconds:              pointer.Int64(300),
+						ResourceDiffThreshold:                   pointer.Float64(0.1),
+						MetricMemoryCollectPolicy:               &defaultNodeMemoryCollectPolicy,
+						EphemeralStorageReclaimThresholdPercent: pointer.Int64(65),
+						GPUReclaimThresholdPercent:              pointer.Int64(60),
+						MidCPUThresholdPercent:                  pointer.Int64(100),
+						MidMemoryThresholdPercent:               pointer.Int64(100),
+						MidUnallocatedPercent:                   pointer.Int64(0),
 					},
 				},
 				available: true,
@@ -428,21 +438,23 @@ func Test_syncColocationConfigIfChanged(t *testing.T) {
 			wantField: &colocationCfgCache{
 				colocationCfg: configuration.ColocationCfg{
 					ColocationStrategy: configuration.ColocationStrategy{
-						Enable:                         pointer.Bool(true),
-						MetricAggregateDurationSeconds: pointer.Int64(60),
-						MetricReportIntervalSeconds:    pointer.Int64(60),
-						MetricAggregatePolicy:          sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
-						CPUReclaimThresholdPercent:     pointer.Int64(70),
-						CPUCalculatePolicy:             &cpuCalcPolicyByUsage,
-						MemoryReclaimThresholdPercent:  pointer.Int64(80),
-						MemoryCalculatePolicy:          &memoryCalcPolicyByUsage,
-						DegradeTimeMinutes:             pointer.Int64(5),
-						UpdateTimeThresholdSeconds:     pointer.Int64(300),
-						ResourceDiffThreshold:          pointer.Float64(0.1),
-						MetricMemoryCollectPolicy:      &defaultNodeMemoryCollectPolicy,
-						MidCPUThresholdPercent:         pointer.Int64(100),
-						MidMemoryThresholdPercent:      pointer.Int64(100),
-						MidUnallocatedPercent:          pointer.Int64(0),
+						Enable:                                  pointer.Bool(true),
+						MetricAggregateDurationSeconds:          pointer.Int64(60),
+						MetricReportIntervalSeconds:             pointer.Int64(60),
+						MetricAggregatePolicy:                   sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
+						CPUReclaimThresholdPercent:              pointer.Int64(70),
+						CPUCalculatePolicy:                      &cpuCalcPolicyByUsage,
+						MemoryReclaimThresholdPercent:           pointer.Int64(80),
+						MemoryCalculatePolicy:                   &memoryCalcPolicyByUsage,
+						DegradeTimeMinutes:                      pointer.Int64(5),
+						UpdateTimeThresholdSeconds:              pointer.Int64(300),
+						ResourceDiffThreshold:                   pointer.Float64(0.1),
+						MetricMemoryCollectPolicy:               &defaultNodeMemoryCollectPolicy,
+						EphemeralStorageReclaimThresholdPercent: pointer.Int64(65),
+						GPUReclaimThresholdPercent:              pointer.Int64(60),
+						MidCPUThresholdPercent:                  pointer.Int64(100),
+						MidMemoryThresholdPercent:               pointer.Int64(100),
+						MidUnallocatedPercent:                   pointer.Int64(0),
 					},
 				},
 				available:   true,
@@ -531,21 +543,23 @@ func Test_syncColocationConfigIfChanged(t *testing.T) {
 			wantField: &colocationCfgCache{
 				colocationCfg: configuration.ColocationCfg{
 					ColocationStrategy: configuration.ColocationStrategy{
-						Enable:                         pointer.Bool(true),
-						MetricAggregateDurationSeconds: pointer.Int64(60),
-						MetricReportIntervalSeconds:    pointer.Int64(20),
-						MetricAggregatePolicy:          sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
-						CPUReclaimThresholdPercent:     pointer.Int64(70),
-						CPUCalculatePolicy:             &cpuCalcPolicyByUsage,
-						MemoryReclaimThresholdPercent:  pointer.Int64(80),
-						MemoryCalculatePolicy:          &memoryCalcPolicyByUsage,
-						DegradeTimeMinutes:             pointer.Int64(5),
-						UpdateTimeThresholdSeconds:     pointer.Int64(300),
-						ResourceDiffThreshold:          pointer.Float64(0.1),
-						MidCPUThresholdPercent:         pointer.Int64(45),
-						MidMemoryThresholdPercent:      pointer.Int64(65),
-						MetricMemoryCollectPolicy:      &defaultNodeMemoryCollectPolicy,
-						MidUnallocatedPercent:          pointer.Int64(50),
+						Enable:                                  pointer.Bool(true),
+						MetricAggregateDurationSeconds:          pointer.Int64(60),
+						MetricReportIntervalSeconds:             pointer.Int64(20),
+						MetricAggregatePolicy:                   sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
+						CPUReclaimThresholdPercent:              pointer.Int64(70),
+						CPUCalculatePolicy:                      &cpuCalcPolicyByUsage,
+						MemoryReclaimThresholdPercent:           pointer.Int64(80),
+						MemoryCalculatePolicy:                   &memoryCalcPolicyByUsage,
+						DegradeTimeMinutes:                      pointer.Int64(5),
+						UpdateTimeThresholdSeconds:              pointer.Int64(300),
+						ResourceDiffThreshold:                   pointer.Float64(0.1),
+						EphemeralStorageReclaimThresholdPercent: pointer.Int64(65),
+						GPUReclaimThresholdPercent:              pointer.Int64(60),
+						MidCPUThresholdPercent:                  pointer.Int64(45),
+						MidMemoryThresholdPercent:               pointer.Int64(65),
+						MetricMemoryCollectPolicy:               &defaultNodeMemoryCollectPolicy,
+						MidUnallocatedPercent:                   pointer.Int64(50),
 					},
 					NodeConfigs: []configuration.NodeColocationCfg{
 						{
@@ -557,21 +571,23 @@ func Test_syncColocationConfigIfChanged(t *testing.T) {
 								},
 							},
 							ColocationStrategy: configuration.ColocationStrategy{
-								Enable:                         pointer.Bool(true),
-								MetricAggregateDurationSeconds: pointer.Int64(60),
-								MetricReportIntervalSeconds:    pointer.Int64(20),
-								MetricAggregatePolicy:          sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
-								CPUReclaimThresholdPercent:     pointer.Int64(70),
-								CPUCalculatePolicy:             &cpuCalcPolicyByUsage,
-								MemoryReclaimThresholdPercent:  pointer.Int64(80),
-								MemoryCalculatePolicy:          &memoryCalcPolicyByUsage,
-								DegradeTimeMinutes:             pointer.Int64(5),
-								UpdateTimeThresholdSeconds:     pointer.Int64(300),
-								ResourceDiffThreshold:          pointer.Float64(0.1),
-								MidCPUThresholdPercent:         pointer.Int64(45),
-								MidMemoryThresholdPercent:      pointer.Int64(65),
-								MetricMemoryCollectPolicy:      &defaultNodeMemoryCollectPolicy,
-								MidUnallocatedPercent:          pointer.Int64(50),
+								Enable:                                  pointer.Bool(true),
+								MetricAggregateDurationSeconds:          pointer.Int64(60),
+								MetricReportIntervalSeconds:             pointer.Int64(20),
+								MetricAggregatePolicy:                   sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
+								CPUReclaimThresholdPercent:              pointer.Int64(70),
+								CPUCalculatePolicy:                      &cpuCalcPolicyByUsage,
+								MemoryReclaimThresholdPercent:           pointer.Int64(80),
+								MemoryCalculatePolicy:                   &memoryCalcPolicyByUsage,
+								DegradeTimeMinutes:                      pointer.Int64(5),
+								UpdateTimeThresholdSeconds:              pointer.Int64(300),
+								ResourceDiffThreshold:                   pointer.Float64(0.1),
+								EphemeralStorageReclaimThresholdPercent: pointer.Int64(65),
+								GPUReclaimThresholdPercent:              pointer.Int64(60),
+								MidCPUThresholdPercent:                  pointer.Int64(45),
+								MidMemoryThresholdPercent:               pointer.Int64(65),
+								MetricMemoryCollectPolicy:               &defaultNodeMemoryCollectPolicy,
+								MidUnallocatedPercent:                   pointer.Int64(50),
 							},
 						},
 					},
@@ -637,21 +653,23 @@ func Test_syncColocationConfigIfChanged(t *testing.T) {
 			wantField: &colocationCfgCache{
 				colocationCfg: configuration.ColocationCfg{
 					ColocationStrategy: configuration.ColocationStrategy{
-						Enable:                         pointer.Bool(true),
-						MetricAggregateDurationSeconds: pointer.Int64(30),
-						MetricReportIntervalSeconds:    pointer.Int64(20),
-						MetricAggregatePolicy:          sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
-						CPUReclaimThresholdPercent:     pointer.Int64(70),
-						CPUCalculatePolicy:             &cpuCalcPolicyByUsage,
-						MemoryReclaimThresholdPercent:  pointer.Int64(80),
-						MemoryCalculatePolicy:          &memoryCalcPolicyByRequest,
-						DegradeTimeMinutes:             pointer.Int64(5),
-						UpdateTimeThresholdSeconds:     pointer.Int64(300),
-						ResourceDiffThreshold:          pointer.Float64(0.1),
-						MetricMemoryCollectPolicy:      &defaultNodeMemoryCollectPolicy,
-						MidCPUThresholdPercent:         pointer.Int64(100),
-						MidMemoryThresholdPercent:      pointer.Int64(100),
-						MidUnallocatedPercent:          pointer.Int64(0),
+						Enable:                                  pointer.Bool(true),
+						MetricAggregateDurationSeconds:          pointer.Int64(30),
+						MetricReportIntervalSeconds:             pointer.Int64(20),
+						MetricAggregatePolicy:                   sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
+						CPUReclaimThresholdPercent:              pointer.Int64(70),
+						CPUCalculatePolicy:                      &cpuCalcPolicyByUsage,
+						MemoryReclaimThresholdPercent:           pointer.Int64(80),
+						MemoryCalculatePolicy:                   &memoryCalcPolicyByRequest,
+						DegradeTimeMinutes:                      pointer.Int64(5),
+						UpdateTimeThresholdSeconds:              pointer.Int64(300),
+						ResourceDiffThreshold:                   pointer.Float64(0.1),
+						MetricMemoryCollectPolicy:               &defaultNodeMemoryCollectPolicy,
+						EphemeralStorageReclaimThresholdPercent: pointer.Int64(65),
+						GPUReclaimThresholdPercent:              pointer.Int64(60),
+						MidCPUThresholdPercent:                  pointer.Int64(100),
+						MidMemoryThresholdPercent:               pointer.Int64(100),
+						MidUnallocatedPercent:                   pointer.Int64(0),
 					},
 					NodeConfigs: []configuration.NodeColocationCfg{
 						{
@@ -664,19 +682,21 @@ func Test_syncColocationConfigIfChanged(t *testing.T) {
 								Name: "xxx-yyy",
 							},
 							ColocationStrategy: configuration.ColocationStrategy{
-								Enable:                         pointer.Bool(true),
-								MetricAggregateDurationSeconds: pointer.Int64(30),
-								MetricReportIntervalSeconds:    pointer.Int64(20),
-								MetricAggregatePolicy:          sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
-								MemoryReclaimThresholdPercent:  pointer.Int64(80),
-								MemoryCalculatePolicy:          &memoryCalcPolicyByRequest,
-								DegradeTimeMinutes:             pointer.Int64(5),
-								UpdateTimeThresholdSeconds:     pointer.Int64(300),
-								ResourceDiffThreshold:          pointer.Float64(0.1),
-								MetricMemoryCollectPolicy:      &defaultNodeMemoryCollectPolicy,
-								MidCPUThresholdPercent:         pointer.Int64(100),
-								MidMemoryThresholdPercent:      pointer.Int64(100),
-								MidUnallocatedPercent:          pointer.Int64(0),
+								Enable:                                  pointer.Bool(true),
+								MetricAggregateDurationSeconds:          pointer.Int64(30),
+								MetricReportIntervalSeconds:             pointer.Int64(20),
+								MetricAggregatePolicy:                   sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
+								MemoryReclaimThresholdPercent:           pointer.Int64(80),
+								MemoryCalculatePolicy:                   &memoryCalcPolicyByRequest,
+								DegradeTimeMinutes:                      pointer.Int64(5),
+								UpdateTimeThresholdSeconds:              pointer.Int64(300),
+								ResourceDiffThreshold:                   pointer.Float64(0.1),
+								MetricMemoryCollectPolicy:               &defaultNodeMemoryCollectPolicy,
+								EphemeralStorageReclaimThresholdPercent: pointer.Int64(65),
+								GPUReclaimThresholdPercent:              pointer.Int64(60),
+								MidCPUThresholdPercent:                  pointer.Int64(100),
+								MidMemoryThresholdPercent:               pointer.Int64(100),
+								MidUnallocatedPercent:                   pointer.Int64(0),
 								//change
 								CPUReclaimThresholdPercent: pointer.Int64(60),
 								CPUCalculatePolicy:         &cpuCalcPolicyNew,
@@ -773,21 +793,23 @@ func Test_IsCfgAvailable(t *testing.T) {
 			want: true,
 			wantField: &configuration.ColocationCfg{
 				ColocationStrategy: configuration.ColocationStrategy{
-					Enable:                         pointer.Bool(true),
-					MetricAggregateDurationSeconds: pointer.Int64(60),
-					MetricAggregatePolicy:          sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
-					CPUReclaimThresholdPercent:     pointer.Int64(70),
-					CPUCalculatePolicy:             &cpuCalcPolicyByUsage,
-					MemoryReclaimThresholdPercent:  pointer.Int64(80),
-					MemoryCalculatePolicy:          &memoryCalcPolicyByUsage,
-					DegradeTimeMinutes:             pointer.Int64(5),
-					UpdateTimeThresholdSeconds:     pointer.Int64(300),
-					ResourceDiffThreshold:          pointer.Float64(0.1),
-					MetricReportIntervalSeconds:    pointer.Int64(60),
-					MetricMemoryCollectPolicy:      &defaultNodeMemoryCollectPolicy,
-					MidCPUThresholdPercent:         pointer.Int64(100),
-					MidMemoryThresholdPercent:      pointer.Int64(100),
-					MidUnallocatedPercent:          pointer.Int64(0),
+					Enable:                                  pointer.Bool(true),
+					MetricAggregateDurationSeconds:          pointer.Int64(60),
+					MetricAggregatePolicy:                   sloconfig.DefaultColocationStrategy().MetricAggregatePolicy,
+					CPUReclaimThresholdPercent:              pointer.Int64(70),
+					CPUCalculatePolicy:                      &cpuCalcPolicyByUsage,
+					MemoryReclaimThresholdPercent:           pointer.Int64(80),
+					MemoryCalculatePolicy:                   &memoryCalcPolicyByUsage,
+					DegradeTimeMinutes:                      pointer.Int64(5),
+					UpdateTimeThresholdSeconds:              pointer.Int64(300),
+					ResourceDiffThreshold:                   pointer.Float64(0.1),
+					MetricReportIntervalSeconds:             pointer.Int64(60),
+					MetricMemoryCollectPolicy:               &defaultNodeMemoryCollectPolicy,
+					EphemeralStorageReclaimThresholdPercent: pointer.Int64(65),
+					GPUReclaimThresholdPercent:              pointer.Int64(60),
+					MidCPUThresholdPercent:                  pointer.Int64(100),
+					MidMemoryThresholdPercent:               pointer.Int64(100),
+					MidUnallocatedPercent:                   pointer.Int64(0),
 				},
 			},
 		},
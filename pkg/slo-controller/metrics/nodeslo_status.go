@@ -0,0 +1,71 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/koordinator-sh/koordinator/pkg/util/metrics/koordmanager"
+)
+
+func init() {
+	koordmanager.InternalMustRegister(NodeSLOStatusCollectors...)
+}
+
+const (
+	FeatureKey  = "feature"
+	StateKey    = "state"
+	StrategyKey = "strategy"
+)
+
+var (
+	NodeSLOFeatureNodes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: SLOControllerSubsystem,
+		Name:      "nodeslo_feature_nodes",
+		Help:      "the number of nodes whose NodeSLO feature is in the state reported by koordlet",
+	}, []string{FeatureKey, StateKey})
+
+	NodeSLOStrategyOutdatedNodes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: SLOControllerSubsystem,
+		Name:      "nodeslo_strategy_outdated_nodes",
+		Help:      "the number of nodes whose koordlet strategy has not applied the latest generation of the NodeSLO",
+	}, []string{StrategyKey})
+
+	NodeSLOEnforcementErrorNodes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: SLOControllerSubsystem,
+		Name:      "nodeslo_enforcement_error_nodes",
+		Help:      "the number of nodes which have recent errors of enforcing the NodeSLO on the resource",
+	}, []string{ResourceKey})
+
+	NodeSLOStatusCollectors = []prometheus.Collector{
+		NodeSLOFeatureNodes,
+		NodeSLOStrategyOutdatedNodes,
+		NodeSLOEnforcementErrorNodes,
+	}
+)
+
+func RecordNodeSLOFeatureNodes(feature, state string, count int) {
+	NodeSLOFeatureNodes.With(prometheus.Labels{FeatureKey: feature, StateKey: state}).Set(float64(count))
+}
+
+func RecordNodeSLOStrategyOutdatedNodes(strategy string, count int) {
+	NodeSLOStrategyOutdatedNodes.With(prometheus.Labels{StrategyKey: strategy}).Set(float64(count))
+}
+
+func RecordNodeSLOEnforcementErrorNodes(resource string, count int) {
+	NodeSLOEnforcementErrorNodes.With(prometheus.Labels{ResourceKey: resource}).Set(float64(count))
+}
@@ -27,6 +27,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/koordinator-sh/koordinator/apis/extension"
 )
 
 var _ handler.EventHandler = &EnqueueRequestForNode{}
@@ -75,10 +77,12 @@ func (n *EnqueueRequestForNode) Delete(ctx context.Context, e event.DeleteEvent,
 func (n *EnqueueRequestForNode) Generic(ctx context.Context, e event.GenericEvent, q workqueue.RateLimitingInterface) {
 }
 
-// isNodeUpdated returns whether the new node's allocatable or labels is different from the old one's
+// isNodeUpdated returns whether the new node's allocatable, labels or colocation strategy revision is different from
+// the old one's
 func isNodeUpdated(newNode *corev1.Node, oldNode *corev1.Node) bool {
 	if newNode == nil || oldNode == nil {
 		return false
 	}
-	return !reflect.DeepEqual(oldNode.Status.Allocatable, newNode.Status.Allocatable) || !reflect.DeepEqual(oldNode.Labels, newNode.Labels)
+	return !reflect.DeepEqual(oldNode.Status.Allocatable, newNode.Status.Allocatable) || !reflect.DeepEqual(oldNode.Labels, newNode.Labels) ||
+		oldNode.Annotations[extension.AnnotationNodeColocationStrategyRevision] != newNode.Annotations[extension.AnnotationNodeColocationStrategyRevision]
 }
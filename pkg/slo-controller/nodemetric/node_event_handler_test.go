@@ -27,6 +27,8 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/koordinator-sh/koordinator/apis/extension"
 )
 
 func Test_isNodeAllocatableUpdated(t *testing.T) {
@@ -179,6 +181,28 @@ func Test_EnqueueRequestForNode(t *testing.T) {
 			hasEvent:  true,
 			eventName: "node1",
 		},
+		{
+			name: "colocation strategy revision updated",
+			fn: func(handler *EnqueueRequestForNode, q workqueue.RateLimitingInterface) {
+				handler.Update(context.TODO(), event.UpdateEvent{
+					ObjectOld: &corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "node1",
+						},
+					},
+					ObjectNew: &corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "node1",
+							Annotations: map[string]string{
+								extension.AnnotationNodeColocationStrategyRevision: "pool-a/123456",
+							},
+						},
+					},
+				}, q)
+			},
+			hasEvent:  true,
+			eventName: "node1",
+		},
 		{
 			name: "allocatable and labels not updated",
 			fn: func(handler *EnqueueRequestForNode, q workqueue.RateLimitingInterface) {
@@ -0,0 +1,216 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batchephemeralstorage
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"github.com/koordinator-sh/koordinator/apis/configuration"
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/slo-controller/metrics"
+	"github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource/framework"
+	resutil "github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource/plugins/util"
+	"github.com/koordinator-sh/koordinator/pkg/util"
+)
+
+const PluginName = "BatchEphemeralStorage"
+
+// ResourceNames defines the Batch ephemeral storage extended resource names to update.
+var ResourceNames = []corev1.ResourceName{extension.BatchEphemeralStorage}
+
+var clk clock.WithTickerAndDelayedExecution = clock.RealClock{} // for testing
+
+type Plugin struct{}
+
+func (p *Plugin) Name() string {
+	return PluginName
+}
+
+func (p *Plugin) NeedSync(strategy *configuration.ColocationStrategy, oldNode, newNode *corev1.Node) (bool, string) {
+	for _, resourceName := range ResourceNames {
+		if util.IsResourceDiff(oldNode.Status.Allocatable, newNode.Status.Allocatable, resourceName,
+			*strategy.ResourceDiffThreshold) {
+			klog.V(4).Infof("node %v batch ephemeral storage %v diff bigger than %v, need sync",
+				newNode.Name, resourceName, *strategy.ResourceDiffThreshold)
+			return true, "batch ephemeral storage diff is big than threshold"
+		}
+	}
+
+	return false, ""
+}
+
+func (p *Plugin) Prepare(_ *configuration.ColocationStrategy, node *corev1.Node, nr *framework.NodeResource) error {
+	for _, resourceName := range ResourceNames {
+		resutil.PrepareNodeForResource(node, nr, resourceName)
+	}
+	return nil
+}
+
+func (p *Plugin) Reset(node *corev1.Node, message string) []framework.ResourceItem {
+	items := make([]framework.ResourceItem, len(ResourceNames))
+	for i := range ResourceNames {
+		items[i].Name = ResourceNames[i]
+		items[i].Message = message
+		items[i].Reset = true
+	}
+
+	return items
+}
+
+// Calculate calculates the Batch ephemeral storage using the formula below:
+// Allocatable[Batch-Ephemeral-Storage]' := max(Capacity * ReclaimThreshold - SystemUsed - HPUsed, 0).
+func (p *Plugin) Calculate(strategy *configuration.ColocationStrategy, node *corev1.Node, podList *corev1.PodList,
+	metrics *framework.ResourceMetrics) ([]framework.ResourceItem, error) {
+	if strategy == nil || node == nil || node.Status.Capacity == nil || podList == nil ||
+		metrics == nil || metrics.NodeMetric == nil {
+		return nil, fmt.Errorf("missing essential arguments")
+	}
+
+	if strategy.EphemeralStorageReclaimThresholdPercent == nil {
+		return p.Reset(node, "reset node batch ephemeral storage since the reclaim threshold is not set"), nil
+	}
+
+	// if the node metric is abnormal, do degraded calculation
+	if p.isDegradeNeeded(strategy, metrics.NodeMetric, node) {
+		klog.V(5).InfoS("node batch ephemeral storage need degradation, reset node resources", "node", node.Name)
+		return p.degradeCalculate(node,
+			"degrade node batch ephemeral storage because of abnormal nodeMetric, reason: degradedByBatchEphemeralStorage"), nil
+	}
+
+	return p.calculate(strategy, node, podList, metrics), nil
+}
+
+func (p *Plugin) isDegradeNeeded(strategy *configuration.ColocationStrategy, nodeMetric *slov1alpha1.NodeMetric, node *corev1.Node) bool {
+	if nodeMetric == nil || nodeMetric.Status.UpdateTime == nil {
+		klog.V(4).Infof("need degradation for batch ephemeral storage, err: invalid nodeMetric %v", nodeMetric)
+		return true
+	}
+
+	now := clk.Now()
+	if now.After(nodeMetric.Status.UpdateTime.Add(time.Duration(*strategy.DegradeTimeMinutes) * time.Minute)) {
+		klog.V(4).Infof("need degradation for batch ephemeral storage, err: timeout nodeMetric: %v, current timestamp: %v,"+
+			" metric last update timestamp: %v", nodeMetric.Name, now, nodeMetric.Status.UpdateTime)
+		return true
+	}
+
+	// the ephemeral storage usage is reported only when the koordlet collector is enabled
+	if nodeMetric.Status.NodeMetric == nil || nodeMetric.Status.NodeMetric.NodeUsage.ResourceList == nil {
+		klog.V(4).Infof("need degradation for batch ephemeral storage, err: nodeMetric %v has no node usage", nodeMetric.Name)
+		return true
+	}
+	if _, ok := nodeMetric.Status.NodeMetric.NodeUsage.ResourceList[corev1.ResourceEphemeralStorage]; !ok {
+		klog.V(4).Infof("need degradation for batch ephemeral storage, err: nodeMetric %v has no ephemeral storage usage",
+			nodeMetric.Name)
+		return true
+	}
+
+	return false
+}
+
+func (p *Plugin) degradeCalculate(node *corev1.Node, message string) []framework.ResourceItem {
+	return p.Reset(node, message)
+}
+
+func (p *Plugin) calculate(strategy *configuration.ColocationStrategy, node *corev1.Node, podList *corev1.PodList,
+	resourceMetrics *framework.ResourceMetrics) []framework.ResourceItem {
+	// Allocatable[Batch-Ephemeral-Storage]' := max(Capacity * ReclaimThreshold - SystemUsed - HPUsed, 0)
+	// SystemUsed := max(NodeUsed - PodsAllUsed, 0)
+	// HPUsed := sum(HP pods' usage, or request if the usage is missing) + sum(dangling HP pods' usage)
+	nodeMetric := resourceMetrics.NodeMetric
+
+	var podsAllUsed, podsHPUsed int64
+	podMetricMap := make(map[string]*slov1alpha1.PodMetricInfo)
+	podMetricDanglingMap := make(map[string]*slov1alpha1.PodMetricInfo)
+	for _, podMetric := range nodeMetric.Status.PodsMetric {
+		podKey := util.GetPodMetricKey(podMetric)
+		podMetricMap[podKey] = podMetric
+		podMetricDanglingMap[podKey] = podMetric
+		podsAllUsed += getPodMetricUsage(podMetric)
+	}
+
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.Phase != corev1.PodRunning && pod.Status.Phase != corev1.PodPending {
+			continue
+		}
+
+		podKey := util.GetPodKey(pod)
+		podMetric, hasMetric := podMetricMap[podKey]
+		if hasMetric {
+			delete(podMetricDanglingMap, podKey)
+		}
+
+		if priority := extension.GetPodPriorityClassWithDefault(pod); priority == extension.PriorityBatch ||
+			priority == extension.PriorityFree { // ignore LP pods
+			continue
+		}
+
+		if hasMetric {
+			podsHPUsed += getPodMetricUsage(podMetric)
+		} else {
+			podRequest := util.GetPodRequest(pod, corev1.ResourceEphemeralStorage)
+			podsHPUsed += podRequest.StorageEphemeral().Value()
+		}
+	}
+
+	// For the pods reported metrics but not shown in current list, count them according to the metric priority.
+	for _, podMetric := range podMetricDanglingMap {
+		if priority := podMetric.Priority; priority == extension.PriorityBatch || priority == extension.PriorityFree {
+			continue
+		}
+		podsHPUsed += getPodMetricUsage(podMetric)
+	}
+
+	nodeUsage := nodeMetric.Status.NodeMetric.NodeUsage.ResourceList[corev1.ResourceEphemeralStorage]
+	systemUsed := util.MaxInt64(nodeUsage.Value()-podsAllUsed, 0)
+
+	capacity := node.Status.Capacity.StorageEphemeral().Value()
+	thresholdRatio := float64(*strategy.EphemeralStorageReclaimThresholdPercent) / 100
+	reclaimable := int64(float64(capacity) * thresholdRatio)
+	batchEphemeralStorage := util.MaxInt64(reclaimable-systemUsed-podsHPUsed, 0)
+	quantity := resource.NewQuantity(batchEphemeralStorage, resource.BinarySI)
+
+	msg := fmt.Sprintf("batchAllocatable[EphemeralStorage(GB)]:%v = nodeCapacity:%v * thresholdRatio:%v - systemUsage:%v - podHPUsed:%v",
+		quantity.ScaledValue(resource.Giga), resource.NewQuantity(capacity, resource.BinarySI).ScaledValue(resource.Giga),
+		thresholdRatio, resource.NewQuantity(systemUsed, resource.BinarySI).ScaledValue(resource.Giga),
+		resource.NewQuantity(podsHPUsed, resource.BinarySI).ScaledValue(resource.Giga))
+
+	metrics.RecordNodeExtendedResourceAllocatableInternal(node, string(extension.BatchEphemeralStorage), metrics.UnitByte, float64(batchEphemeralStorage))
+	klog.V(6).Infof("calculated batch ephemeral storage for node %s, bytes %v, %s", node.Name, batchEphemeralStorage, msg)
+
+	return []framework.ResourceItem{
+		{
+			Name:     extension.BatchEphemeralStorage,
+			Quantity: quantity,
+			Message:  msg,
+		},
+	}
+}
+
+func getPodMetricUsage(info *slov1alpha1.PodMetricInfo) int64 {
+	if info == nil || info.PodUsage.ResourceList == nil {
+		return 0
+	}
+	return info.PodUsage.ResourceList.StorageEphemeral().Value()
+}
@@ -0,0 +1,397 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batchephemeralstorage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+
+	"github.com/koordinator-sh/koordinator/apis/configuration"
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource/framework"
+)
+
+func TestPlugin(t *testing.T) {
+	t.Run("test", func(t *testing.T) {
+		p := &Plugin{}
+		assert.Equal(t, PluginName, p.Name())
+	})
+}
+
+func TestPluginNeedSync(t *testing.T) {
+	strategy := &configuration.ColocationStrategy{
+		Enable:                pointer.Bool(true),
+		ResourceDiffThreshold: pointer.Float64(0.05),
+	}
+	testNode := getTestNode(corev1.ResourceList{
+		extension.BatchEphemeralStorage: resource.MustParse("100Gi"),
+	})
+	testNodeNotChange := getTestNode(corev1.ResourceList{
+		extension.BatchEphemeralStorage: resource.MustParse("101Gi"),
+	})
+	testNodeChanged := getTestNode(corev1.ResourceList{
+		extension.BatchEphemeralStorage: resource.MustParse("80Gi"),
+	})
+	p := &Plugin{}
+	got, got1 := p.NeedSync(strategy, testNode, testNodeNotChange)
+	assert.False(t, got)
+	assert.Equal(t, "", got1)
+	got, got1 = p.NeedSync(strategy, testNode, testNodeChanged)
+	assert.True(t, got)
+	assert.Equal(t, "batch ephemeral storage diff is big than threshold", got1)
+}
+
+func TestPluginPrepare(t *testing.T) {
+	p := &Plugin{}
+	testNode := getTestNode(nil)
+	err := p.Prepare(nil, testNode, &framework.NodeResource{
+		Resources: map[corev1.ResourceName]*resource.Quantity{
+			extension.BatchEphemeralStorage: resource.NewQuantity(50<<30, resource.BinarySI),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, getTestNode(corev1.ResourceList{
+		extension.BatchEphemeralStorage: *resource.NewQuantity(50<<30, resource.BinarySI),
+	}), testNode)
+
+	err = p.Prepare(nil, testNode, &framework.NodeResource{
+		Resources: map[corev1.ResourceName]*resource.Quantity{},
+		Resets: map[corev1.ResourceName]bool{
+			extension.BatchEphemeralStorage: true,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, getTestNode(nil, extension.BatchEphemeralStorage), testNode)
+}
+
+func TestPluginReset(t *testing.T) {
+	p := &Plugin{}
+	got := p.Reset(nil, "test reset node resources")
+	assert.Equal(t, []framework.ResourceItem{
+		{
+			Name:    extension.BatchEphemeralStorage,
+			Message: "test reset node resources",
+			Reset:   true,
+		},
+	}, got)
+}
+
+func TestPluginCalculate(t *testing.T) {
+	testStrategy := &configuration.ColocationStrategy{
+		Enable:                                  pointer.Bool(true),
+		DegradeTimeMinutes:                      pointer.Int64(15),
+		ResourceDiffThreshold:                   pointer.Float64(0.1),
+		EphemeralStorageReclaimThresholdPercent: pointer.Int64(80),
+	}
+	testNode := getTestNode(nil)
+	testPodList := &corev1.PodList{
+		Items: []corev1.Pod{
+			*getTestPod("test-ls-pod", extension.PriorityProd, corev1.PodRunning, "10Gi"),
+			*getTestPod("test-ls-pod-no-metric", extension.PriorityProd, corev1.PodRunning, "5Gi"),
+			*getTestPod("test-ls-pod-succeeded", extension.PriorityProd, corev1.PodSucceeded, "5Gi"),
+			*getTestPod("test-be-pod", extension.PriorityBatch, corev1.PodRunning, ""),
+		},
+	}
+	testNodeMetric := &slov1alpha1.NodeMetric{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+		},
+		Status: slov1alpha1.NodeMetricStatus{
+			UpdateTime: &metav1.Time{Time: time.Now()},
+			NodeMetric: &slov1alpha1.NodeMetricInfo{
+				NodeUsage: slov1alpha1.ResourceMap{
+					ResourceList: corev1.ResourceList{
+						corev1.ResourceCPU:              resource.MustParse("10"),
+						corev1.ResourceEphemeralStorage: resource.MustParse("80Gi"),
+					},
+				},
+			},
+			PodsMetric: []*slov1alpha1.PodMetricInfo{
+				getTestPodMetric("test-ls-pod", extension.PriorityProd, "20Gi"),
+				getTestPodMetric("test-be-pod", extension.PriorityBatch, "30Gi"),
+				getTestPodMetric("test-dangling-ls-pod", extension.PriorityProd, "5Gi"),
+				getTestPodMetric("test-dangling-be-pod", extension.PriorityBatch, "5Gi"),
+			},
+		},
+	}
+	type args struct {
+		strategy   *configuration.ColocationStrategy
+		node       *corev1.Node
+		podList    *corev1.PodList
+		nodeMetric *slov1alpha1.NodeMetric
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []framework.ResourceItem
+		wantErr bool
+	}{
+		{
+			name:    "missing essential arguments",
+			args:    args{},
+			wantErr: true,
+		},
+		{
+			name: "reset when the threshold is not set",
+			args: args{
+				strategy: &configuration.ColocationStrategy{
+					Enable:             pointer.Bool(true),
+					DegradeTimeMinutes: pointer.Int64(15),
+				},
+				node:       testNode,
+				podList:    testPodList,
+				nodeMetric: testNodeMetric,
+			},
+			want: []framework.ResourceItem{
+				{
+					Name:    extension.BatchEphemeralStorage,
+					Message: "reset node batch ephemeral storage since the reclaim threshold is not set",
+					Reset:   true,
+				},
+			},
+		},
+		{
+			name: "degrade when the ephemeral storage usage is missing",
+			args: args{
+				strategy: testStrategy,
+				node:     testNode,
+				podList:  testPodList,
+				nodeMetric: &slov1alpha1.NodeMetric{
+					Status: slov1alpha1.NodeMetricStatus{
+						UpdateTime: &metav1.Time{Time: time.Now()},
+						NodeMetric: &slov1alpha1.NodeMetricInfo{
+							NodeUsage: slov1alpha1.ResourceMap{
+								ResourceList: corev1.ResourceList{
+									corev1.ResourceCPU: resource.MustParse("10"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []framework.ResourceItem{
+				{
+					Name:    extension.BatchEphemeralStorage,
+					Message: "degrade node batch ephemeral storage because of abnormal nodeMetric, reason: degradedByBatchEphemeralStorage",
+					Reset:   true,
+				},
+			},
+		},
+		{
+			name: "calculate batch ephemeral storage",
+			args: args{
+				strategy:   testStrategy,
+				node:       testNode,
+				podList:    testPodList,
+				nodeMetric: testNodeMetric,
+			},
+			// capacity: 200Gi, reclaimable: 200Gi * 0.8 = 160Gi
+			// podsAllUsed: 60Gi, systemUsed: 80Gi - 60Gi = 20Gi
+			// podsHPUsed: 20Gi (ls) + 5Gi (ls request) + 5Gi (dangling ls) = 30Gi
+			// batch: 160Gi - 20Gi - 30Gi = 110Gi
+			want: []framework.ResourceItem{
+				{
+					Name:     extension.BatchEphemeralStorage,
+					Quantity: resource.NewQuantity(110<<30, resource.BinarySI),
+					Message:  "batchAllocatable[EphemeralStorage(GB)]:119 = nodeCapacity:215 * thresholdRatio:0.8 - systemUsage:22 - podHPUsed:33",
+				},
+			},
+		},
+		{
+			name: "calculate batch ephemeral storage no less than zero",
+			args: args{
+				strategy: &configuration.ColocationStrategy{
+					Enable:                                  pointer.Bool(true),
+					DegradeTimeMinutes:                      pointer.Int64(15),
+					EphemeralStorageReclaimThresholdPercent: pointer.Int64(20),
+				},
+				node:       testNode,
+				podList:    testPodList,
+				nodeMetric: testNodeMetric,
+			},
+			want: []framework.ResourceItem{
+				{
+					Name:     extension.BatchEphemeralStorage,
+					Quantity: resource.NewQuantity(0, resource.BinarySI),
+					Message:  "batchAllocatable[EphemeralStorage(GB)]:0 = nodeCapacity:215 * thresholdRatio:0.2 - systemUsage:22 - podHPUsed:33",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Plugin{}
+			var resourceMetrics *framework.ResourceMetrics
+			if tt.args.nodeMetric != nil {
+				resourceMetrics = &framework.ResourceMetrics{NodeMetric: tt.args.nodeMetric}
+			}
+			got, gotErr := p.Calculate(tt.args.strategy, tt.args.node, tt.args.podList, resourceMetrics)
+			assert.Equal(t, tt.wantErr, gotErr != nil, gotErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPlugin_isDegradeNeeded(t *testing.T) {
+	const degradeTimeoutMinutes = 10
+	strategy := &configuration.ColocationStrategy{
+		Enable:             pointer.Bool(true),
+		DegradeTimeMinutes: pointer.Int64(degradeTimeoutMinutes),
+	}
+	validNodeMetric := &slov1alpha1.NodeMetric{
+		Status: slov1alpha1.NodeMetricStatus{
+			UpdateTime: &metav1.Time{Time: time.Now()},
+			NodeMetric: &slov1alpha1.NodeMetricInfo{
+				NodeUsage: slov1alpha1.ResourceMap{
+					ResourceList: corev1.ResourceList{
+						corev1.ResourceEphemeralStorage: resource.MustParse("80Gi"),
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name       string
+		clock      *clock.FakeClock
+		nodeMetric *slov1alpha1.NodeMetric
+		want       bool
+	}{
+		{
+			name:       "empty NodeMetric should degrade",
+			nodeMetric: nil,
+			want:       true,
+		},
+		{
+			name:       "empty NodeMetric status should degrade",
+			nodeMetric: &slov1alpha1.NodeMetric{},
+			want:       true,
+		},
+		{
+			name:       "outdated NodeMetric status should degrade",
+			clock:      clock.NewFakeClock(time.Now().Add(time.Minute * (degradeTimeoutMinutes + 1))),
+			nodeMetric: validNodeMetric,
+			want:       true,
+		},
+		{
+			name: "NodeMetric without node usage should degrade",
+			nodeMetric: &slov1alpha1.NodeMetric{
+				Status: slov1alpha1.NodeMetricStatus{
+					UpdateTime: &metav1.Time{Time: time.Now()},
+				},
+			},
+			want: true,
+		},
+		{
+			name:       "valid NodeMetric should not degrade",
+			nodeMetric: validNodeMetric,
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldClock := clk
+			if tt.clock != nil {
+				clk = tt.clock
+			}
+			defer func() {
+				clk = oldClock
+			}()
+			p := &Plugin{}
+			got := p.isDegradeNeeded(strategy, tt.nodeMetric, getTestNode(nil))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func getTestNode(resourceList corev1.ResourceList, resetResourceNames ...corev1.ResourceName) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+		},
+		Status: corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("100"),
+				corev1.ResourceMemory:           resource.MustParse("400Gi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("200Gi"),
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("100"),
+				corev1.ResourceMemory:           resource.MustParse("380Gi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("180Gi"),
+			},
+		},
+	}
+	for name, q := range resourceList {
+		node.Status.Capacity[name] = q
+		node.Status.Allocatable[name] = q
+	}
+	for _, name := range resetResourceNames {
+		delete(node.Status.Capacity, name)
+		delete(node.Status.Allocatable, name)
+	}
+	return node
+}
+
+func getTestPod(name string, priority extension.PriorityClass, phase corev1.PodPhase, request string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+			Labels: map[string]string{
+				extension.LabelPodPriorityClass: string(priority),
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "main",
+				},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+		},
+	}
+	if request != "" {
+		pod.Spec.Containers[0].Resources.Requests = corev1.ResourceList{
+			corev1.ResourceEphemeralStorage: resource.MustParse(request),
+		}
+	}
+	return pod
+}
+
+func getTestPodMetric(name string, priority extension.PriorityClass, used string) *slov1alpha1.PodMetricInfo {
+	return &slov1alpha1.PodMetricInfo{
+		Name:      name,
+		Namespace: "test",
+		Priority:  priority,
+		PodUsage: slov1alpha1.ResourceMap{
+			ResourceList: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("1"),
+				corev1.ResourceEphemeralStorage: resource.MustParse(used),
+			},
+		},
+	}
+}
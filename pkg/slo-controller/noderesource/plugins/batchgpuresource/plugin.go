@@ -0,0 +1,357 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batchgpuresource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	quotav1 "k8s.io/apiserver/pkg/quota/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/koordinator-sh/koordinator/apis/configuration"
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	schedulingv1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/slo-controller/metrics"
+	"github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource/framework"
+	resutil "github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource/plugins/util"
+	"github.com/koordinator-sh/koordinator/pkg/util"
+)
+
+const PluginName = "BatchGPUResource"
+
+const (
+	NeedSyncForResourceDiffMsg    = "batch gpu resource diff is big than threshold"
+	NeedSyncForDeviceResourcesMsg = "batch gpu device resources changed"
+)
+
+// ResourceNames defines the batch GPU extended resource names to update.
+var ResourceNames = []corev1.ResourceName{extension.BatchGPUCore, extension.BatchGPUMemory, extension.BatchGPUMemoryRatio}
+
+// deviceResourceNames are the GPU resources of each device to reclaim.
+var deviceResourceNames = []corev1.ResourceName{extension.ResourceGPUCore, extension.ResourceGPUMemory, extension.ResourceGPUMemoryRatio}
+
+var clk clock.WithTickerAndDelayedExecution = clock.RealClock{} // for testing
+
+var client ctrlclient.Client
+
+type Plugin struct{}
+
+func (p *Plugin) Name() string {
+	return PluginName
+}
+
+// +kubebuilder:rbac:groups=scheduling.koordinator.sh,resources=devices,verbs=get;list;watch
+
+func (p *Plugin) Setup(opt *framework.Option) error {
+	client = opt.Client
+	return nil
+}
+
+func (p *Plugin) NeedSync(strategy *configuration.ColocationStrategy, oldNode, newNode *corev1.Node) (bool, string) {
+	for _, resourceName := range ResourceNames {
+		if util.IsResourceDiff(oldNode.Status.Allocatable, newNode.Status.Allocatable, resourceName,
+			*strategy.ResourceDiffThreshold) {
+			klog.V(4).Infof("node %v batch gpu resource %v diff bigger than %v, need sync",
+				newNode.Name, resourceName, *strategy.ResourceDiffThreshold)
+			return true, NeedSyncForResourceDiffMsg
+		}
+	}
+
+	return false, ""
+}
+
+func (p *Plugin) NeedSyncMeta(strategy *configuration.ColocationStrategy, oldNode, newNode *corev1.Node) (bool, string) {
+	oldResources, err := extension.GetNodeBatchGPUResources(oldNode.Annotations)
+	if err != nil {
+		klog.V(4).Infof("failed to get old batch gpu resources for node %s, err: %s", oldNode.Name, err)
+		return true, "old batch gpu resources parsed error"
+	}
+	newResources, err := extension.GetNodeBatchGPUResources(newNode.Annotations)
+	if err != nil {
+		klog.V(4).Infof("failed to get new batch gpu resources for node %s, err: %s", newNode.Name, err)
+		return false, "new batch gpu resources parsed error"
+	}
+	if len(oldResources) != len(newResources) {
+		return true, NeedSyncForDeviceResourcesMsg
+	}
+
+	oldResourcesMap := make(map[int32]corev1.ResourceList, len(oldResources))
+	for _, r := range oldResources {
+		oldResourcesMap[r.Minor] = r.Resources
+	}
+	for _, r := range newResources {
+		oldRL, ok := oldResourcesMap[r.Minor]
+		if !ok {
+			return true, NeedSyncForDeviceResourcesMsg
+		}
+		for _, resourceName := range deviceResourceNames {
+			if util.IsResourceDiff(oldRL, r.Resources, resourceName, *strategy.ResourceDiffThreshold) {
+				klog.V(4).Infof("node %v batch gpu %v resource %v diff bigger than %v, need sync",
+					newNode.Name, r.Minor, resourceName, *strategy.ResourceDiffThreshold)
+				return true, NeedSyncForDeviceResourcesMsg
+			}
+		}
+	}
+
+	return false, ""
+}
+
+func (p *Plugin) Prepare(_ *configuration.ColocationStrategy, node *corev1.Node, nr *framework.NodeResource) error {
+	for _, resourceName := range ResourceNames {
+		resutil.PrepareNodeForResource(node, nr, resourceName)
+	}
+
+	if nr.Resets[extension.BatchGPUCore] {
+		delete(node.Annotations, extension.AnnotationNodeBatchGPUResources)
+		return nil
+	}
+	if s, ok := nr.Annotations[extension.AnnotationNodeBatchGPUResources]; ok {
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
+		}
+		node.Annotations[extension.AnnotationNodeBatchGPUResources] = s
+	}
+	return nil
+}
+
+func (p *Plugin) Reset(node *corev1.Node, message string) []framework.ResourceItem {
+	items := make([]framework.ResourceItem, len(ResourceNames))
+	for i := range ResourceNames {
+		items[i].Name = ResourceNames[i]
+		items[i].Message = message
+		items[i].Reset = true
+	}
+
+	return items
+}
+
+// Calculate calculates the batch GPU resources for each GPU device using the formula below:
+// Allocatable[Batch-GPU(minor)]' := max(DeviceTotal(minor) * GPUReclaimThreshold - SystemUsed(minor) - HPUsed(minor), 0)
+// The node batch GPU resources are the sums of all healthy devices.
+func (p *Plugin) Calculate(strategy *configuration.ColocationStrategy, node *corev1.Node, podList *corev1.PodList,
+	metrics *framework.ResourceMetrics) ([]framework.ResourceItem, error) {
+	if strategy == nil || node == nil || podList == nil || metrics == nil || metrics.NodeMetric == nil {
+		return nil, fmt.Errorf("missing essential arguments")
+	}
+
+	if strategy.GPUReclaimThresholdPercent == nil {
+		return p.Reset(node, "reset node batch gpu resources since the reclaim threshold is not set"), nil
+	}
+
+	device := &schedulingv1alpha1.Device{}
+	if err := client.Get(context.TODO(), types.NamespacedName{Name: node.Name}, device); err != nil {
+		if !errors.IsNotFound(err) {
+			klog.V(4).InfoS("failed to get device for node", "node", node.Name, "err", err)
+			return nil, fmt.Errorf("failed to get device resources: %w", err)
+		}
+		return p.Reset(node, "reset node batch gpu resources since the device is not found"), nil
+	}
+	deviceTotal := getGPUDeviceTotal(device)
+	if len(deviceTotal) <= 0 {
+		return p.Reset(node, "reset node batch gpu resources since no healthy gpu is found"), nil
+	}
+
+	// if the node metric is abnormal, do degraded calculation
+	if p.isDegradeNeeded(strategy, metrics.NodeMetric, node) {
+		klog.V(5).InfoS("node batch gpu resources need degradation, reset node resources", "node", node.Name)
+		return p.degradeCalculate(node,
+			"degrade node batch gpu resources because of abnormal nodeMetric, reason: degradedByBatchGPUResource"), nil
+	}
+
+	return p.calculate(strategy, node, podList, metrics, deviceTotal)
+}
+
+func (p *Plugin) isDegradeNeeded(strategy *configuration.ColocationStrategy, nodeMetric *slov1alpha1.NodeMetric, node *corev1.Node) bool {
+	if nodeMetric == nil || nodeMetric.Status.UpdateTime == nil {
+		klog.V(4).Infof("need degradation for batch gpu resources, err: invalid nodeMetric %v", nodeMetric)
+		return true
+	}
+
+	now := clk.Now()
+	if now.After(nodeMetric.Status.UpdateTime.Add(time.Duration(*strategy.DegradeTimeMinutes) * time.Minute)) {
+		klog.V(4).Infof("need degradation for batch gpu resources, err: timeout nodeMetric: %v, current timestamp: %v,"+
+			" metric last update timestamp: %v", nodeMetric.Name, now, nodeMetric.Status.UpdateTime)
+		return true
+	}
+
+	// the gpu usage is reported only when the koordlet gpu collector is enabled
+	if nodeMetric.Status.NodeMetric == nil || len(nodeMetric.Status.NodeMetric.NodeUsage.Devices) <= 0 {
+		klog.V(4).Infof("need degradation for batch gpu resources, err: nodeMetric %v has no gpu usage", nodeMetric.Name)
+		return true
+	}
+
+	return false
+}
+
+func (p *Plugin) degradeCalculate(node *corev1.Node, message string) []framework.ResourceItem {
+	return p.Reset(node, message)
+}
+
+func (p *Plugin) calculate(strategy *configuration.ColocationStrategy, node *corev1.Node, podList *corev1.PodList,
+	resourceMetrics *framework.ResourceMetrics, deviceTotal map[int32]corev1.ResourceList) ([]framework.ResourceItem, error) {
+	// SystemUsed(minor) := max(NodeUsed(minor) - PodsAllUsed(minor), 0)
+	// HPUsed(minor) := sum(HP pods' usage, or allocated if the usage is missing) + sum(dangling HP pods' usage)
+	nodeMetric := resourceMetrics.NodeMetric
+
+	podsAllUsed := map[int32]corev1.ResourceList{}
+	podsHPUsed := map[int32]corev1.ResourceList{}
+	podMetricMap := make(map[string]*slov1alpha1.PodMetricInfo)
+	podMetricDanglingMap := make(map[string]*slov1alpha1.PodMetricInfo)
+	for _, podMetric := range nodeMetric.Status.PodsMetric {
+		podKey := util.GetPodMetricKey(podMetric)
+		podMetricMap[podKey] = podMetric
+		podMetricDanglingMap[podKey] = podMetric
+		addGPUDeviceUsage(podsAllUsed, podMetric.PodUsage.Devices)
+	}
+
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.Phase != corev1.PodRunning && pod.Status.Phase != corev1.PodPending {
+			continue
+		}
+
+		podKey := util.GetPodKey(pod)
+		podMetric, hasMetric := podMetricMap[podKey]
+		if hasMetric {
+			delete(podMetricDanglingMap, podKey)
+		}
+
+		if priority := extension.GetPodPriorityClassWithDefault(pod); priority == extension.PriorityBatch ||
+			priority == extension.PriorityFree { // ignore LP pods
+			continue
+		}
+
+		if hasMetric && len(podMetric.PodUsage.Devices) > 0 {
+			addGPUDeviceUsage(podsHPUsed, podMetric.PodUsage.Devices)
+			continue
+		}
+		// the pod is newly created or its gpu usage is not collected yet, count as the allocated
+		allocations, err := extension.GetDeviceAllocations(pod.Annotations)
+		if err != nil {
+			klog.V(5).InfoS("failed to get device allocations for pod", "pod", podKey, "err", err)
+			continue
+		}
+		for _, allocation := range allocations[schedulingv1alpha1.GPU] {
+			podsHPUsed[allocation.Minor] = quotav1.Add(podsHPUsed[allocation.Minor],
+				quotav1.Mask(allocation.Resources, deviceResourceNames))
+		}
+	}
+
+	// For the pods reported metrics but not shown in current list, count them according to the metric priority.
+	for _, podMetric := range podMetricDanglingMap {
+		if priority := podMetric.Priority; priority == extension.PriorityBatch || priority == extension.PriorityFree {
+			continue
+		}
+		addGPUDeviceUsage(podsHPUsed, podMetric.PodUsage.Devices)
+	}
+
+	nodeUsed := map[int32]corev1.ResourceList{}
+	addGPUDeviceUsage(nodeUsed, nodeMetric.Status.NodeMetric.NodeUsage.Devices)
+
+	thresholdRatio := float64(*strategy.GPUReclaimThresholdPercent) / 100
+	minors := make([]int32, 0, len(deviceTotal))
+	for minor := range deviceTotal {
+		minors = append(minors, minor)
+	}
+	sort.Slice(minors, func(i, j int) bool { return minors[i] < minors[j] })
+
+	batchTotal := corev1.ResourceList{}
+	batchDeviceResources := make(extension.NodeBatchGPUResources, 0, len(minors))
+	for _, minor := range minors {
+		total := deviceTotal[minor]
+		systemUsed := quotav1.SubtractWithNonNegativeResult(nodeUsed[minor], podsAllUsed[minor])
+		reclaimable := corev1.ResourceList{}
+		for _, resourceName := range deviceResourceNames {
+			q, ok := total[resourceName]
+			if !ok {
+				continue
+			}
+			reclaimable[resourceName] = *resource.NewQuantity(int64(float64(q.Value())*thresholdRatio), q.Format)
+		}
+		batch := quotav1.SubtractWithNonNegativeResult(reclaimable, quotav1.Add(systemUsed, podsHPUsed[minor]))
+		batchDeviceResources = append(batchDeviceResources, extension.NodeBatchGPUResource{
+			Minor:     minor,
+			Resources: batch,
+		})
+		batchTotal = quotav1.Add(batchTotal, batch)
+		klog.V(6).InfoS("calculate batch gpu resources for device", "node", node.Name, "minor", minor,
+			"total", total, "systemUsed", systemUsed, "hpUsed", podsHPUsed[minor], "batch", batch)
+	}
+
+	data, err := json.Marshal(batchDeviceResources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal batch gpu resources: %w", err)
+	}
+
+	items := make([]framework.ResourceItem, 0, len(ResourceNames)+1)
+	for _, resourceName := range deviceResourceNames {
+		batchResourceName := extension.BatchGPUResourceNameMap[resourceName]
+		q := batchTotal[resourceName]
+		if q.Format == "" { // the resource is missing in the device
+			q = *resource.NewQuantity(0, resource.DecimalSI)
+		}
+		items = append(items, framework.ResourceItem{
+			Name:     batchResourceName,
+			Quantity: &q,
+			Message: fmt.Sprintf("batchAllocatable[%s]:%v = sum(deviceTotal * thresholdRatio:%v - systemUsage - podHPUsed)",
+				resourceName, q.String(), thresholdRatio),
+		})
+		metrics.RecordNodeExtendedResourceAllocatableInternal(node, string(batchResourceName), metrics.UnitInteger, float64(q.Value()))
+	}
+	items = append(items, framework.ResourceItem{
+		Name: PluginName,
+		Annotations: map[string]string{
+			extension.AnnotationNodeBatchGPUResources: string(data),
+		},
+	})
+	klog.V(6).InfoS("calculated batch gpu resources", "node", node.Name, "resources", batchTotal,
+		"devices", string(data))
+
+	return items, nil
+}
+
+// getGPUDeviceTotal returns the GPU resources of each healthy GPU device.
+func getGPUDeviceTotal(device *schedulingv1alpha1.Device) map[int32]corev1.ResourceList {
+	deviceTotal := map[int32]corev1.ResourceList{}
+	for _, d := range device.Spec.Devices {
+		if d.Type != schedulingv1alpha1.GPU || !d.Health || d.Minor == nil {
+			continue
+		}
+		deviceTotal[*d.Minor] = quotav1.Mask(d.Resources, deviceResourceNames)
+	}
+	return deviceTotal
+}
+
+func addGPUDeviceUsage(used map[int32]corev1.ResourceList, devices []schedulingv1alpha1.DeviceInfo) {
+	for _, d := range devices {
+		if d.Type != schedulingv1alpha1.GPU || d.Minor == nil {
+			continue
+		}
+		used[*d.Minor] = quotav1.Add(used[*d.Minor], quotav1.Mask(d.Resources, deviceResourceNames))
+	}
+}
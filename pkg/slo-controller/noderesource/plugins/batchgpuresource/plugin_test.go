@@ -0,0 +1,432 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batchgpuresource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/koordinator-sh/koordinator/apis/configuration"
+	"github.com/koordinator-sh/koordinator/apis/extension"
+	schedulingv1alpha1 "github.com/koordinator-sh/koordinator/apis/scheduling/v1alpha1"
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource/framework"
+	"github.com/koordinator-sh/koordinator/pkg/util/testutil"
+)
+
+func makeGPUResources(core int64, memory string, ratio int64) corev1.ResourceList {
+	return corev1.ResourceList{
+		extension.ResourceGPUCore:        *resource.NewQuantity(core, resource.DecimalSI),
+		extension.ResourceGPUMemory:      resource.MustParse(memory),
+		extension.ResourceGPUMemoryRatio: *resource.NewQuantity(ratio, resource.DecimalSI),
+	}
+}
+
+func makeGPUDeviceInfo(minor int32, core int64, memory string, ratio int64) schedulingv1alpha1.DeviceInfo {
+	return schedulingv1alpha1.DeviceInfo{
+		Type:      schedulingv1alpha1.GPU,
+		Minor:     pointer.Int32(minor),
+		Health:    true,
+		Resources: makeGPUResources(core, memory, ratio),
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	t.Run("test", func(t *testing.T) {
+		p := &Plugin{}
+		assert.Equal(t, PluginName, p.Name())
+
+		testScheme := runtime.NewScheme()
+		testOpt := &framework.Option{
+			Scheme:  testScheme,
+			Client:  fake.NewClientBuilder().WithScheme(testScheme).Build(),
+			Builder: builder.ControllerManagedBy(&testutil.FakeManager{}),
+		}
+		err := p.Setup(testOpt)
+		assert.NoError(t, err)
+	})
+}
+
+func TestPluginNeedSync(t *testing.T) {
+	strategy := &configuration.ColocationStrategy{
+		Enable:                pointer.Bool(true),
+		ResourceDiffThreshold: pointer.Float64(0.1),
+	}
+	testNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				extension.BatchGPUCore:        resource.MustParse("100"),
+				extension.BatchGPUMemory:      resource.MustParse("8Gi"),
+				extension.BatchGPUMemoryRatio: resource.MustParse("50"),
+			},
+		},
+	}
+	testNode1 := testNode.DeepCopy()
+	testNode1.Status.Allocatable[extension.BatchGPUCore] = resource.MustParse("50")
+
+	p := &Plugin{}
+	got, got1 := p.NeedSync(strategy, testNode, testNode)
+	assert.False(t, got)
+	assert.Equal(t, "", got1)
+	got, got1 = p.NeedSync(strategy, testNode, testNode1)
+	assert.True(t, got)
+	assert.Equal(t, NeedSyncForResourceDiffMsg, got1)
+}
+
+func TestPluginNeedSyncMeta(t *testing.T) {
+	strategy := &configuration.ColocationStrategy{
+		Enable:                pointer.Bool(true),
+		ResourceDiffThreshold: pointer.Float64(0.1),
+	}
+	testNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+		},
+	}
+	assert.NoError(t, extension.SetNodeBatchGPUResources(testNode, extension.NodeBatchGPUResources{
+		{Minor: 0, Resources: makeGPUResources(60, "8Gi", 50)},
+	}))
+	testNodeSmallDiff := testNode.DeepCopy()
+	assert.NoError(t, extension.SetNodeBatchGPUResources(testNodeSmallDiff, extension.NodeBatchGPUResources{
+		{Minor: 0, Resources: makeGPUResources(62, "8Gi", 50)},
+	}))
+	testNodeBigDiff := testNode.DeepCopy()
+	assert.NoError(t, extension.SetNodeBatchGPUResources(testNodeBigDiff, extension.NodeBatchGPUResources{
+		{Minor: 0, Resources: makeGPUResources(20, "8Gi", 50)},
+	}))
+	testNodeMoreDevices := testNode.DeepCopy()
+	assert.NoError(t, extension.SetNodeBatchGPUResources(testNodeMoreDevices, extension.NodeBatchGPUResources{
+		{Minor: 0, Resources: makeGPUResources(60, "8Gi", 50)},
+		{Minor: 1, Resources: makeGPUResources(60, "8Gi", 50)},
+	}))
+	testNodeNoAnnotation := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+		},
+	}
+
+	p := &Plugin{}
+	got, got1 := p.NeedSyncMeta(strategy, testNode, testNodeSmallDiff)
+	assert.False(t, got)
+	assert.Equal(t, "", got1)
+	got, got1 = p.NeedSyncMeta(strategy, testNode, testNodeBigDiff)
+	assert.True(t, got)
+	assert.Equal(t, NeedSyncForDeviceResourcesMsg, got1)
+	got, got1 = p.NeedSyncMeta(strategy, testNode, testNodeMoreDevices)
+	assert.True(t, got)
+	assert.Equal(t, NeedSyncForDeviceResourcesMsg, got1)
+	got, got1 = p.NeedSyncMeta(strategy, testNode, testNodeNoAnnotation)
+	assert.True(t, got)
+	assert.Equal(t, NeedSyncForDeviceResourcesMsg, got1)
+}
+
+func TestPluginPrepare(t *testing.T) {
+	testNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+		},
+		Status: corev1.NodeStatus{
+			Capacity:    corev1.ResourceList{},
+			Allocatable: corev1.ResourceList{},
+		},
+	}
+	batchResources := `[{"minor":0,"resources":{"koordinator.sh/gpu-core":"60"}}]`
+	nr := framework.NewNodeResource()
+	nr.Set(framework.ResourceItem{
+		Name:     extension.BatchGPUCore,
+		Quantity: resource.NewQuantity(60, resource.DecimalSI),
+	}, framework.ResourceItem{
+		Name: PluginName,
+		Annotations: map[string]string{
+			extension.AnnotationNodeBatchGPUResources: batchResources,
+		},
+	})
+
+	p := &Plugin{}
+	err := p.Prepare(nil, testNode, nr)
+	assert.NoError(t, err)
+	assert.Equal(t, *resource.NewQuantity(60, resource.DecimalSI), testNode.Status.Allocatable[extension.BatchGPUCore])
+	assert.Equal(t, batchResources, testNode.Annotations[extension.AnnotationNodeBatchGPUResources])
+
+	nrReset := framework.NewNodeResource()
+	nrReset.Set(p.Reset(testNode, "reset")...)
+	err = p.Prepare(nil, testNode, nrReset)
+	assert.NoError(t, err)
+	_, ok := testNode.Status.Allocatable[extension.BatchGPUCore]
+	assert.False(t, ok)
+	_, ok = testNode.Annotations[extension.AnnotationNodeBatchGPUResources]
+	assert.False(t, ok)
+}
+
+func TestPluginCalculate(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	assert.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	assert.NoError(t, schedulingv1alpha1.AddToScheme(testScheme))
+	oldClock := clk
+	testNow := time.Now()
+	clk = clock.NewFakeClock(testNow)
+	defer func() {
+		clk = oldClock
+	}()
+
+	strategy := &configuration.ColocationStrategy{
+		Enable:                     pointer.Bool(true),
+		DegradeTimeMinutes:         pointer.Int64(15),
+		ResourceDiffThreshold:      pointer.Float64(0.1),
+		GPUReclaimThresholdPercent: pointer.Int64(60),
+	}
+	testNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+		},
+	}
+	testDevice := &schedulingv1alpha1.Device{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+		},
+		Spec: schedulingv1alpha1.DeviceSpec{
+			Devices: []schedulingv1alpha1.DeviceInfo{
+				makeGPUDeviceInfo(0, 100, "16Gi", 100),
+				makeGPUDeviceInfo(1, 100, "16Gi", 100),
+			},
+		},
+	}
+	testDeviceUnhealthy := testDevice.DeepCopy()
+	for i := range testDeviceUnhealthy.Spec.Devices {
+		testDeviceUnhealthy.Spec.Devices[i].Health = false
+	}
+	lsPodWithoutMetric := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ls-pod-1",
+			Namespace: "test",
+			Labels: map[string]string{
+				extension.LabelPodQoS: string(extension.QoSLS),
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+		},
+	}
+	assert.NoError(t, extension.SetDeviceAllocations(lsPodWithoutMetric, extension.DeviceAllocations{
+		schedulingv1alpha1.GPU: {
+			{Minor: 1, Resources: makeGPUResources(10, "2Gi", 12)},
+		},
+	}))
+	testPodList := &corev1.PodList{
+		Items: []corev1.Pod{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ls-pod-0",
+					Namespace: "test",
+					Labels: map[string]string{
+						extension.LabelPodQoS: string(extension.QoSLS),
+					},
+				},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+				},
+			},
+			*lsPodWithoutMetric,
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "be-pod-0",
+					Namespace: "test",
+					Labels: map[string]string{
+						extension.LabelPodQoS: string(extension.QoSBE),
+					},
+				},
+				Spec: corev1.PodSpec{
+					Priority: pointer.Int32(extension.PriorityBatchValueMax),
+				},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+				},
+			},
+		},
+	}
+	testNodeMetric := &slov1alpha1.NodeMetric{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-node",
+		},
+		Status: slov1alpha1.NodeMetricStatus{
+			UpdateTime: &metav1.Time{Time: testNow},
+			NodeMetric: &slov1alpha1.NodeMetricInfo{
+				NodeUsage: slov1alpha1.ResourceMap{
+					Devices: []schedulingv1alpha1.DeviceInfo{
+						makeGPUDeviceInfo(0, 30, "4Gi", 25),
+						makeGPUDeviceInfo(1, 5, "1Gi", 6),
+					},
+				},
+			},
+			PodsMetric: []*slov1alpha1.PodMetricInfo{
+				{
+					Namespace: "test",
+					Name:      "ls-pod-0",
+					PodUsage: slov1alpha1.ResourceMap{
+						Devices: []schedulingv1alpha1.DeviceInfo{
+							makeGPUDeviceInfo(0, 20, "3Gi", 18),
+						},
+					},
+				},
+				{
+					Namespace: "test",
+					Name:      "be-pod-0",
+					PodUsage: slov1alpha1.ResourceMap{
+						Devices: []schedulingv1alpha1.DeviceInfo{
+							makeGPUDeviceInfo(1, 5, "1Gi", 6),
+						},
+					},
+				},
+			},
+		},
+	}
+	testNodeMetricOutdated := testNodeMetric.DeepCopy()
+	testNodeMetricOutdated.Status.UpdateTime = &metav1.Time{Time: testNow.Add(-20 * time.Minute)}
+	testNodeMetricNoGPU := testNodeMetric.DeepCopy()
+	testNodeMetricNoGPU.Status.NodeMetric.NodeUsage.Devices = nil
+
+	tests := []struct {
+		name            string
+		strategy        *configuration.ColocationStrategy
+		device          *schedulingv1alpha1.Device
+		nodeMetric      *slov1alpha1.NodeMetric
+		wantReset       bool
+		wantMessage     string
+		wantResources   map[corev1.ResourceName]int64
+		wantDeviceBatch map[int32]map[corev1.ResourceName]int64
+		wantErr         bool
+	}{
+		{
+			name:        "reset when threshold not set",
+			strategy:    &configuration.ColocationStrategy{Enable: pointer.Bool(true)},
+			device:      testDevice,
+			nodeMetric:  testNodeMetric,
+			wantReset:   true,
+			wantMessage: "reset node batch gpu resources since the reclaim threshold is not set",
+		},
+		{
+			name:        "reset when device not found",
+			strategy:    strategy,
+			nodeMetric:  testNodeMetric,
+			wantReset:   true,
+			wantMessage: "reset node batch gpu resources since the device is not found",
+		},
+		{
+			name:        "reset when no healthy gpu",
+			strategy:    strategy,
+			device:      testDeviceUnhealthy,
+			nodeMetric:  testNodeMetric,
+			wantReset:   true,
+			wantMessage: "reset node batch gpu resources since no healthy gpu is found",
+		},
+		{
+			name:        "degrade when node metric outdated",
+			strategy:    strategy,
+			device:      testDevice,
+			nodeMetric:  testNodeMetricOutdated,
+			wantReset:   true,
+			wantMessage: "degrade node batch gpu resources because of abnormal nodeMetric, reason: degradedByBatchGPUResource",
+		},
+		{
+			name:        "degrade when gpu usage missing",
+			strategy:    strategy,
+			device:      testDevice,
+			nodeMetric:  testNodeMetricNoGPU,
+			wantReset:   true,
+			wantMessage: "degrade node batch gpu resources because of abnormal nodeMetric, reason: degradedByBatchGPUResource",
+		},
+		{
+			name:       "calculate batch gpu resources correctly",
+			strategy:   strategy,
+			device:     testDevice,
+			nodeMetric: testNodeMetric,
+			// minor 0: reclaimable(60, 9.6Gi, 60) - system(10, 1Gi, 7) - hp(20, 3Gi, 18) = (30, 5.6Gi, 35)
+			// minor 1: reclaimable(60, 9.6Gi, 60) - system(0, 0, 0) - hp(10, 2Gi, 12) = (50, 7.6Gi, 48)
+			wantResources: map[corev1.ResourceName]int64{
+				extension.BatchGPUCore:        80,
+				extension.BatchGPUMemory:      14173392076,
+				extension.BatchGPUMemoryRatio: 83,
+			},
+			wantDeviceBatch: map[int32]map[corev1.ResourceName]int64{
+				0: {
+					extension.ResourceGPUCore:        30,
+					extension.ResourceGPUMemory:      6012954214,
+					extension.ResourceGPUMemoryRatio: 35,
+				},
+				1: {
+					extension.ResourceGPUCore:        50,
+					extension.ResourceGPUMemory:      8160437862,
+					extension.ResourceGPUMemoryRatio: 48,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientBuilder := fake.NewClientBuilder().WithScheme(testScheme)
+			if tt.device != nil {
+				clientBuilder = clientBuilder.WithObjects(tt.device)
+			}
+			p := &Plugin{}
+			assert.NoError(t, p.Setup(&framework.Option{
+				Scheme:  testScheme,
+				Client:  clientBuilder.Build(),
+				Builder: builder.ControllerManagedBy(&testutil.FakeManager{}),
+			}))
+
+			got, gotErr := p.Calculate(tt.strategy, testNode, testPodList, &framework.ResourceMetrics{
+				NodeMetric: tt.nodeMetric,
+			})
+			assert.Equal(t, tt.wantErr, gotErr != nil, gotErr)
+			if tt.wantReset {
+				assert.Equal(t, p.Reset(testNode, tt.wantMessage), got)
+				return
+			}
+
+			nr := framework.NewNodeResource()
+			nr.Set(got...)
+			for resourceName, want := range tt.wantResources {
+				q := nr.Resources[resourceName]
+				assert.NotNil(t, q, resourceName)
+				assert.Equal(t, want, q.Value(), resourceName)
+			}
+			gotDevices, err := extension.GetNodeBatchGPUResources(nr.Annotations)
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.wantDeviceBatch), len(gotDevices))
+			for _, d := range gotDevices {
+				for resourceName, want := range tt.wantDeviceBatch[d.Minor] {
+					q := d.Resources[resourceName]
+					assert.Equal(t, want, q.Value(), d.Minor, resourceName)
+				}
+			}
+		})
+	}
+}
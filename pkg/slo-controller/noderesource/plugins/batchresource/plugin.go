@@ -18,6 +18,7 @@ package batchresource
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -43,6 +44,8 @@ import (
 
 const PluginName = "BatchResource"
 
+const NeedSyncForHostAppReservationMsg = "host application reservation changed"
+
 var (
 	ResourceNames = []corev1.ResourceName{extension.BatchCPU, extension.BatchMemory}
 )
@@ -106,6 +109,31 @@ func (p *Plugin) NeedSync(strategy *configuration.ColocationStrategy, oldNode, n
 	return false, ""
 }
 
+func (p *Plugin) NeedSyncMeta(strategy *configuration.ColocationStrategy, oldNode, newNode *corev1.Node) (bool, string) {
+	oldReservation, err := extension.GetNodeHostApplicationReservation(oldNode.Annotations)
+	if err != nil {
+		klog.V(4).Infof("failed to get old host application reservation for node %s, err: %s", oldNode.Name, err)
+		return true, "old host application reservation parsed error"
+	}
+	newReservation, err := extension.GetNodeHostApplicationReservation(newNode.Annotations)
+	if err != nil {
+		klog.V(4).Infof("failed to get new host application reservation for node %s, err: %s", newNode.Name, err)
+		return false, "new host application reservation parsed error"
+	}
+	if (oldReservation == nil) != (newReservation == nil) {
+		return true, NeedSyncForHostAppReservationMsg
+	}
+	for _, resourceName := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		if util.IsResourceDiff(oldReservation, newReservation, resourceName, *strategy.ResourceDiffThreshold) {
+			klog.V(4).Infof("node %v host application reservation %v diff bigger than %v, need sync",
+				newNode.Name, resourceName, *strategy.ResourceDiffThreshold)
+			return true, NeedSyncForHostAppReservationMsg
+		}
+	}
+
+	return false, ""
+}
+
 func (p *Plugin) Prepare(_ *configuration.ColocationStrategy, node *corev1.Node, nr *framework.NodeResource) error {
 	// prepare for node extended resources
 	for _, resourceName := range ResourceNames {
@@ -124,6 +152,16 @@ func (p *Plugin) Prepare(_ *configuration.ColocationStrategy, node *corev1.Node,
 		return err
 	}
 
+	// prepare for the host application reservation
+	if s, ok := nr.Annotations[extension.AnnotationNodeHostApplicationReservation]; ok && !nr.Resets[extension.BatchCPU] {
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
+		}
+		node.Annotations[extension.AnnotationNodeHostApplicationReservation] = s
+	} else {
+		delete(node.Annotations, extension.AnnotationNodeHostApplicationReservation)
+	}
+
 	if batchMilliCPU < 0 || batchMemory < 0 {
 		// batch resources are reset, no need to recalculate node status
 		return nil
@@ -160,7 +198,8 @@ func (p *Plugin) Reset(node *corev1.Node, message string) []framework.ResourceIt
 }
 
 // Calculate calculates Batch resources using the formula below:
-// Node.Total - Node.Reserved - System.Used - Pod(High-Priority).Used, System.Used = Node.Used - Pod(All).Used.
+// Node.Total - Node.Reserved - System.Used - Pod(High-Priority).Used - HostApp(High-Priority).Used - HostApp(Batch).Used,
+// System.Used = Node.Used - Pod(All).Used - HostApp(All).Used.
 // As node and podList are the nearly latest state at time T1, the resourceMetrics are the node metric and pod
 // metrics collected and snapshot at time T0 (T0 < T1). There can be gaps between the states of T0 and T1.
 // We firstly calculate an infimum of the batch allocatable at time T0.
@@ -193,7 +232,7 @@ func (p *Plugin) calculate(strategy *configuration.ColocationStrategy, node *cor
 		klog.ErrorS(err, "failed to calculate batch resource for NRT", "node", node.Name)
 	}
 
-	return []framework.ResourceItem{
+	items := []framework.ResourceItem{
 		{
 			Name:         extension.BatchCPU,
 			Quantity:     resource.NewQuantity(batchAllocatable.Cpu().MilliValue(), resource.DecimalSI),
@@ -206,7 +245,24 @@ func (p *Plugin) calculate(strategy *configuration.ColocationStrategy, node *cor
 			ZoneQuantity: batchZoneMemory,
 			Message:      memMsg,
 		},
-	}, nil
+	}
+
+	// surface the reservation of the high-priority host applications on the node for the scheduler
+	_, hostAppHPPeak := resutil.GetHostAppHPUsedAndPeak(resourceMetrics, extension.PriorityBatch)
+	if !quotav1.IsZero(hostAppHPPeak) {
+		data, err := json.Marshal(hostAppHPPeak)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal host application reservation: %w", err)
+		}
+		items = append(items, framework.ResourceItem{
+			Name: PluginName,
+			Annotations: map[string]string{
+				extension.AnnotationNodeHostApplicationReservation: string(data),
+			},
+		})
+	}
+
+	return items, nil
 }
 
 // In order to support the colocation requirements of different enterprise environments, a configurable colocation strategy is provided.
@@ -228,6 +284,8 @@ func (p *Plugin) calculateOnNode(strategy *configuration.ColocationStrategy, nod
 	podsHPRequest := util.NewZeroResourceList()
 	podsHPUsed := util.NewZeroResourceList()
 	podsHPMaxUsedReq := util.NewZeroResourceList()
+	// podsHPPeak is the sum of the predicted peaks of HP pods, which falls back to the usage if not predicted.
+	podsHPPeak := util.NewZeroResourceList()
 	// podsAllUsed is the sum usage of all pods reported in NodeMetric.
 	// podsKnownUsed is the sum usage of pods which are both reported in NodeMetric and shown in current pod list.
 	podsAllUsed := util.NewZeroResourceList()
@@ -267,39 +325,50 @@ func (p *Plugin) calculateOnNode(strategy *configuration.ColocationStrategy, nod
 		podsHPRequest = quotav1.Add(podsHPRequest, podRequest)
 		if !hasMetric {
 			podsHPUsed = quotav1.Add(podsHPUsed, podRequest)
+			podsHPPeak = quotav1.Add(podsHPPeak, podRequest)
 		} else if qos := extension.GetPodQoSClassWithDefault(pod); qos == extension.QoSLSE {
 			// NOTE: Currently qos=LSE pods does not reclaim CPU resource.
 			podUsed := resutil.GetPodMetricUsage(podMetric)
 			podsHPUsed = quotav1.Add(podsHPUsed, resutil.MixResourceListCPUAndMemory(podRequest, podUsed))
 			podsHPMaxUsedReq = quotav1.Add(podsHPMaxUsedReq, quotav1.Max(podRequest, podUsed))
+			podsHPPeak = quotav1.Add(podsHPPeak, resutil.MixResourceListCPUAndMemory(podRequest, resutil.GetPodMetricPeak(podMetric)))
 		} else {
 			podUsed := resutil.GetPodMetricUsage(podMetric)
 			podsHPUsed = quotav1.Add(podsHPUsed, podUsed)
 			podsHPMaxUsedReq = quotav1.Add(podsHPMaxUsedReq, quotav1.Max(podRequest, podUsed))
+			podsHPPeak = quotav1.Add(podsHPPeak, resutil.GetPodMetricPeak(podMetric))
 		}
 	}
 
-	hostAppHPUsed := resutil.GetHostAppHPUsed(resourceMetrics, extension.PriorityBatch)
 	// For the pods reported metrics but not shown in current list, count them according to the metric priority.
 	podsDanglingUsed := util.NewZeroResourceList()
+	podsDanglingPeak := util.NewZeroResourceList()
 	for _, podMetric := range podMetricDanglingMap {
 		if priority := podMetric.Priority; priority == extension.PriorityBatch || priority == extension.PriorityFree {
 			continue
 		}
 		podsDanglingUsed = quotav1.Add(podsDanglingUsed, resutil.GetPodMetricUsage(podMetric))
+		podsDanglingPeak = quotav1.Add(podsDanglingPeak, resutil.GetPodMetricPeak(podMetric))
 	}
 	podsHPUsed = quotav1.Add(podsHPUsed, podsDanglingUsed)
 	podsHPMaxUsedReq = quotav1.Add(podsHPMaxUsedReq, podsDanglingUsed)
+	podsHPPeak = quotav1.Add(podsHPPeak, podsDanglingPeak)
 	klog.V(6).InfoS("batch resource got dangling HP pods used", "node", node.Name,
 		"cpu", podsDanglingUsed.Cpu().String(), "memory", podsDanglingUsed.Memory().String())
 
+	// The host applications with high priority are reserved like the HP pods, since their usages are excluded from
+	// the system usage. The peak usage is regarded as the request because the host applications declare no request.
+	hostAppHPUsed, hostAppHPPeak := resutil.GetHostAppHPUsedAndPeak(resourceMetrics, extension.PriorityBatch)
+	podsHPRequest = quotav1.Add(podsHPRequest, hostAppHPPeak)
+	podsHPUsed = quotav1.Add(podsHPUsed, hostAppHPUsed)
+	podsHPMaxUsedReq = quotav1.Add(podsHPMaxUsedReq, quotav1.Max(hostAppHPPeak, hostAppHPUsed))
+	podsHPPeak = quotav1.Add(podsHPPeak, hostAppHPPeak)
+
 	nodeCapacity := resutil.GetNodeCapacity(node)
 	nodeSafetyMargin := resutil.GetNodeSafetyMargin(strategy, nodeCapacity)
 
 	systemUsed := resutil.GetResourceListForCPUAndMemory(nodeMetric.Status.NodeMetric.SystemUsage.ResourceList)
-	// resource usage of host applications with prod priority will be count as host system usage since they consumes the
-	// node reserved resource.
-	systemUsed = quotav1.Add(systemUsed, hostAppHPUsed)
+	systemPeak := resutil.GetSystemPeak(nodeMetric)
 
 	// System.Reserved = Node.Anno.Reserved, Node.Kubelet.Reserved)
 	nodeAnnoReserved := util.GetNodeReservationFromAnnotation(node.Annotations)
@@ -308,7 +377,10 @@ func (p *Plugin) calculateOnNode(strategy *configuration.ColocationStrategy, nod
 	nodeReserved := quotav1.Max(nodeKubeletReserved, nodeAnnoReserved)
 
 	batchAllocatable, cpuMsg, memMsg := resutil.CalculateBatchResourceByPolicy(strategy, nodeCapacity, nodeSafetyMargin, nodeReserved,
-		systemUsed, podsHPRequest, podsHPUsed, podsHPMaxUsedReq)
+		systemUsed, podsHPRequest, podsHPUsed, podsHPMaxUsedReq, systemPeak, podsHPPeak)
+	// the host applications with batch priority consume the batch resources like the batch pods
+	hostAppBatchUsed := resutil.GetHostAppUsedByPriority(resourceMetrics, extension.PriorityBatch)
+	batchAllocatable, cpuMsg, memMsg = resutil.CalculateBatchResourceWithHostApp(batchAllocatable, hostAppBatchUsed, cpuMsg, memMsg)
 	metrics.RecordNodeExtendedResourceAllocatableInternal(node, string(extension.BatchCPU), metrics.UnitInteger, float64(batchAllocatable.Cpu().MilliValue())/1000)
 	metrics.RecordNodeExtendedResourceAllocatableInternal(node, string(extension.BatchMemory), metrics.UnitByte, float64(batchAllocatable.Memory().Value()))
 	klog.V(6).InfoS("calculate batch resource for node", "node", node.Name, "batch resource",
@@ -346,17 +418,21 @@ func (p *Plugin) calculateOnNUMALevel(strategy *configuration.ColocationStrategy
 	nodeZoneReserve := make([]corev1.ResourceList, zoneNum)
 	systemZoneUsed := make([]corev1.ResourceList, zoneNum)
 	systemZoneReserved := make([]corev1.ResourceList, zoneNum)
+	systemZonePeak := make([]corev1.ResourceList, zoneNum)
 	podsUnknownUsed := make([]corev1.ResourceList, zoneNum)
+	podsUnknownPeak := make([]corev1.ResourceList, zoneNum)
 	podsHPZoneRequested := make([]corev1.ResourceList, zoneNum)
 	podsHPZoneUsed := make([]corev1.ResourceList, zoneNum)
 	podsHPZoneMaxUsedReq := make([]corev1.ResourceList, zoneNum)
+	podsHPZonePeak := make([]corev1.ResourceList, zoneNum)
 	batchZoneAllocatable := make([]corev1.ResourceList, zoneNum)
 
-	hostAppHPUsed := resutil.GetHostAppHPUsed(resourceMetrics, extension.PriorityBatch)
 	systemUsed := resutil.GetResourceListForCPUAndMemory(nodeMetric.Status.NodeMetric.SystemUsage.ResourceList)
-	// resource usage of host applications with prod priority will be count as host system usage since they consumes the
-	// node reserved resource. bind host app on single numa node is not supported yet. divide the usage by numa node number.
-	systemUsed = quotav1.Add(systemUsed, hostAppHPUsed)
+	systemPeak := resutil.GetSystemPeak(nodeMetric)
+	// The host applications with high priority are reserved like the HP pods, and the ones with batch priority consume
+	// the batch resources. bind host app on single numa node is not supported yet. divide the usage by numa node number.
+	hostAppHPUsed, hostAppHPPeak := resutil.GetHostAppHPUsedAndPeak(resourceMetrics, extension.PriorityBatch)
+	hostAppBatchUsed := resutil.GetHostAppUsedByPriority(resourceMetrics, extension.PriorityBatch)
 	nodeAnnoReserved := util.GetNodeReservationFromAnnotation(node.Annotations)
 	nodeKubeletReserved := util.GetNodeReservationFromKubelet(node)
 	nodeReserved := quotav1.Max(nodeKubeletReserved, nodeAnnoReserved)
@@ -365,9 +441,11 @@ func (p *Plugin) calculateOnNUMALevel(strategy *configuration.ColocationStrategy
 		zoneIdxMap[i] = zone.Name
 		nodeZoneAllocatable[i] = corev1.ResourceList{}
 		podsUnknownUsed[i] = util.NewZeroResourceList()
-		podsHPZoneRequested[i] = util.NewZeroResourceList()
-		podsHPZoneUsed[i] = util.NewZeroResourceList()
-		podsHPZoneMaxUsedReq[i] = util.NewZeroResourceList()
+		podsUnknownPeak[i] = util.NewZeroResourceList()
+		podsHPZoneRequested[i] = resutil.DivideResourceList(hostAppHPPeak, float64(zoneNum))
+		podsHPZoneUsed[i] = resutil.DivideResourceList(hostAppHPUsed, float64(zoneNum))
+		podsHPZoneMaxUsedReq[i] = resutil.DivideResourceList(quotav1.Max(hostAppHPPeak, hostAppHPUsed), float64(zoneNum))
+		podsHPZonePeak[i] = resutil.DivideResourceList(hostAppHPPeak, float64(zoneNum))
 		for _, resourceInfo := range zone.Resources {
 			if checkedNRTResourceSet.Has(resourceInfo.Name) {
 				nodeZoneAllocatable[i][corev1.ResourceName(resourceInfo.Name)] = resourceInfo.Allocatable.DeepCopy()
@@ -376,6 +454,7 @@ func (p *Plugin) calculateOnNUMALevel(strategy *configuration.ColocationStrategy
 		nodeZoneReserve[i] = resutil.GetNodeSafetyMargin(strategy, nodeZoneAllocatable[i])
 		systemZoneUsed[i] = resutil.DivideResourceList(systemUsed, float64(zoneNum))
 		systemZoneReserved[i] = resutil.DivideResourceList(nodeReserved, float64(zoneNum))
+		systemZonePeak[i] = resutil.DivideResourceList(systemPeak, float64(zoneNum))
 	}
 	podMetricMap := make(map[string]*slov1alpha1.PodMetricInfo)
 	podMetricUnknownMap := make(map[string]*slov1alpha1.PodMetricInfo)
@@ -395,11 +474,12 @@ func (p *Plugin) calculateOnNUMALevel(strategy *configuration.ColocationStrategy
 		podMetric, hasMetric := podMetricMap[podKey]
 		podRequest := util.GetPodRequest(pod, corev1.ResourceCPU, corev1.ResourceMemory)
 		var podUsage corev1.ResourceList
-		var podZoneRequests, podZoneUsages []corev1.ResourceList
+		var podZoneRequests, podZoneUsages, podZonePeaks []corev1.ResourceList
 		if hasMetric {
 			delete(podMetricUnknownMap, podKey)
 			podUsage = resutil.GetPodMetricUsage(podMetric)
 			podZoneRequests, podZoneUsages = resutil.GetPodNUMARequestAndUsage(pod, podRequest, podUsage, zoneNum)
+			_, podZonePeaks = resutil.GetPodNUMARequestAndUsage(pod, podRequest, resutil.GetPodMetricPeak(podMetric), zoneNum)
 		} else {
 			podUsage = podRequest
 			podZoneRequests, podZoneUsages = resutil.GetPodNUMARequestAndUsage(pod, podRequest, podUsage, zoneNum)
@@ -415,16 +495,20 @@ func (p *Plugin) calculateOnNUMALevel(strategy *configuration.ColocationStrategy
 		if !hasMetric {
 			podsHPZoneUsed = resutil.AddZoneResourceList(podsHPZoneUsed, podZoneRequests, zoneNum)
 			podsHPZoneMaxUsedReq = resutil.AddZoneResourceList(podsHPZoneMaxUsedReq, podZoneRequests, zoneNum)
+			podsHPZonePeak = resutil.AddZoneResourceList(podsHPZonePeak, podZoneRequests, zoneNum)
 		} else if qos := extension.GetPodQoSClassWithDefault(pod); qos == extension.QoSLSE {
 			// NOTE: Currently qos=LSE pods does not reclaim CPU resource.
 			podsHPZoneUsed = resutil.AddZoneResourceList(podsHPZoneUsed,
 				resutil.MinxZoneResourceListCPUAndMemory(podZoneRequests, podZoneUsages, zoneNum), zoneNum)
 			podsHPZoneMaxUsedReq = resutil.AddZoneResourceList(podsHPZoneMaxUsedReq,
 				resutil.MaxZoneResourceList(podZoneUsages, podZoneRequests, zoneNum), zoneNum)
+			podsHPZonePeak = resutil.AddZoneResourceList(podsHPZonePeak,
+				resutil.MinxZoneResourceListCPUAndMemory(podZoneRequests, podZonePeaks, zoneNum), zoneNum)
 		} else {
 			podsHPZoneUsed = resutil.AddZoneResourceList(podsHPZoneUsed, podZoneUsages, zoneNum)
 			podsHPZoneMaxUsedReq = resutil.AddZoneResourceList(podsHPZoneMaxUsedReq,
 				resutil.MaxZoneResourceList(podZoneUsages, podZoneRequests, zoneNum), zoneNum)
+			podsHPZonePeak = resutil.AddZoneResourceList(podsHPZonePeak, podZonePeaks, zoneNum)
 		}
 	}
 
@@ -435,9 +519,12 @@ func (p *Plugin) calculateOnNUMALevel(strategy *configuration.ColocationStrategy
 		}
 		podNUMAUsage := resutil.GetPodUnknownNUMAUsage(resutil.GetPodMetricUsage(podMetric), zoneNum)
 		podsUnknownUsed = resutil.AddZoneResourceList(podsUnknownUsed, podNUMAUsage, zoneNum)
+		podNUMAPeak := resutil.GetPodUnknownNUMAUsage(resutil.GetPodMetricPeak(podMetric), zoneNum)
+		podsUnknownPeak = resutil.AddZoneResourceList(podsUnknownPeak, podNUMAPeak, zoneNum)
 	}
 	podsHPZoneUsed = resutil.AddZoneResourceList(podsHPZoneUsed, podsUnknownUsed, zoneNum)
 	podsHPZoneMaxUsedReq = resutil.AddZoneResourceList(podsHPZoneMaxUsedReq, podsUnknownUsed, zoneNum)
+	podsHPZonePeak = resutil.AddZoneResourceList(podsHPZonePeak, podsUnknownPeak, zoneNum)
 
 	batchZoneCPU := map[string]resource.Quantity{}
 	batchZoneMemory := map[string]resource.Quantity{}
@@ -446,7 +533,9 @@ func (p *Plugin) calculateOnNUMALevel(strategy *configuration.ColocationStrategy
 		zoneName := zoneIdxMap[i]
 		batchZoneAllocatable[i], cpuMsg, memMsg = resutil.CalculateBatchResourceByPolicy(strategy, nodeZoneAllocatable[i],
 			nodeZoneReserve[i], systemZoneReserved[i], systemZoneUsed[i],
-			podsHPZoneRequested[i], podsHPZoneUsed[i], podsHPZoneMaxUsedReq[i])
+			podsHPZoneRequested[i], podsHPZoneUsed[i], podsHPZoneMaxUsedReq[i], systemZonePeak[i], podsHPZonePeak[i])
+		batchZoneAllocatable[i], cpuMsg, memMsg = resutil.CalculateBatchResourceWithHostApp(batchZoneAllocatable[i],
+			resutil.DivideResourceList(hostAppBatchUsed, float64(zoneNum)), cpuMsg, memMsg)
 		klog.V(6).InfoS("calculate batch resource in NUMA level", "node", node.Name, "zone", zoneName,
 			"batch resource", batchZoneAllocatable[i], "cpu", cpuMsg, "memory", memMsg)
 
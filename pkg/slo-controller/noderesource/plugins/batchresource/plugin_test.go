@@ -894,6 +894,81 @@ func TestPreUpdate(t *testing.T) {
 	}
 }
 
+func TestPluginNeedSyncMeta(t *testing.T) {
+	testStrategy := &configuration.ColocationStrategy{
+		ResourceDiffThreshold: pointer.Float64(0.1),
+	}
+	testNode := func(reservation string) *corev1.Node {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-node",
+			},
+		}
+		if len(reservation) > 0 {
+			node.Annotations = map[string]string{
+				extension.AnnotationNodeHostApplicationReservation: reservation,
+			}
+		}
+		return node
+	}
+	tests := []struct {
+		name     string
+		oldNode  *corev1.Node
+		newNode  *corev1.Node
+		wantSync bool
+	}{
+		{
+			name:     "no host application reservation",
+			oldNode:  testNode(""),
+			newNode:  testNode(""),
+			wantSync: false,
+		},
+		{
+			name:     "host application reservation added",
+			oldNode:  testNode(""),
+			newNode:  testNode(`{"cpu":"3","memory":"6G"}`),
+			wantSync: true,
+		},
+		{
+			name:     "host application reservation removed",
+			oldNode:  testNode(`{"cpu":"3","memory":"6G"}`),
+			newNode:  testNode(""),
+			wantSync: true,
+		},
+		{
+			name:     "host application reservation changes little",
+			oldNode:  testNode(`{"cpu":"3","memory":"6G"}`),
+			newNode:  testNode(`{"cpu":"3100m","memory":"6G"}`),
+			wantSync: false,
+		},
+		{
+			name:     "host application reservation changes a lot",
+			oldNode:  testNode(`{"cpu":"3","memory":"6G"}`),
+			newNode:  testNode(`{"cpu":"3","memory":"10G"}`),
+			wantSync: true,
+		},
+		{
+			name:     "old host application reservation is invalid",
+			oldNode:  testNode("invalid"),
+			newNode:  testNode(`{"cpu":"3","memory":"6G"}`),
+			wantSync: true,
+		},
+		{
+			name:     "new host application reservation is invalid",
+			oldNode:  testNode(`{"cpu":"3","memory":"6G"}`),
+			newNode:  testNode("invalid"),
+			wantSync: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Plugin{}
+			got, msg := p.NeedSyncMeta(testStrategy, tt.oldNode, tt.newNode)
+			assert.Equal(t, tt.wantSync, got, msg)
+		})
+	}
+}
+
 func TestPrepare(t *testing.T) {
 	testScheme := runtime.NewScheme()
 	err := clientgoscheme.AddToScheme(testScheme)
@@ -966,6 +1041,61 @@ func TestPrepare(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "update batch resources and host application reservation",
+			fields: fields{
+				client: fake.NewClientBuilder().WithScheme(testScheme).Build(),
+			},
+			args: args{
+				node: &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-node",
+					},
+					Status: corev1.NodeStatus{
+						Capacity: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("100"),
+							corev1.ResourceMemory: resource.MustParse("400Gi"),
+						},
+						Allocatable: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("100"),
+							corev1.ResourceMemory: resource.MustParse("380Gi"),
+						},
+					},
+				},
+				nr: &framework.NodeResource{
+					Resources: map[corev1.ResourceName]*resource.Quantity{
+						extension.BatchCPU:    resource.NewQuantity(50000, resource.DecimalSI),
+						extension.BatchMemory: resource.NewScaledQuantity(120, 9),
+					},
+					Annotations: map[string]string{
+						extension.AnnotationNodeHostApplicationReservation: `{"cpu":"3","memory":"6G"}`,
+					},
+				},
+			},
+			wantErr: false,
+			wantField: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-node",
+					Annotations: map[string]string{
+						extension.AnnotationNodeHostApplicationReservation: `{"cpu":"3","memory":"6G"}`,
+					},
+				},
+				Status: corev1.NodeStatus{
+					Capacity: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("100"),
+						corev1.ResourceMemory: resource.MustParse("400Gi"),
+						extension.BatchCPU:    *resource.NewQuantity(50000, resource.DecimalSI),
+						extension.BatchMemory: *resource.NewScaledQuantity(120, 9),
+					},
+					Allocatable: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("100"),
+						corev1.ResourceMemory: resource.MustParse("380Gi"),
+						extension.BatchCPU:    *resource.NewQuantity(50000, resource.DecimalSI),
+						extension.BatchMemory: *resource.NewScaledQuantity(120, 9),
+					},
+				},
+			},
+		},
 		{
 			name: "reset batch resources",
 			fields: fields{
@@ -975,6 +1105,9 @@ func TestPrepare(t *testing.T) {
 				node: &corev1.Node{
 					ObjectMeta: metav1.ObjectMeta{
 						Name: "test-node",
+						Annotations: map[string]string{
+							extension.AnnotationNodeHostApplicationReservation: `{"cpu":"3","memory":"6G"}`,
+						},
 					},
 					Status: corev1.NodeStatus{
 						Capacity: corev1.ResourceList{
@@ -1408,6 +1541,8 @@ func TestPrepare(t *testing.T) {
 			assert.Equal(t, tt.wantErr, gotErr != nil, gotErr)
 			testingCorrectResourceList(t, &tt.wantField.Status.Capacity, &tt.args.node.Status.Capacity)
 			testingCorrectResourceList(t, &tt.wantField.Status.Allocatable, &tt.args.node.Status.Allocatable)
+			assert.Equal(t, tt.wantField.Annotations[extension.AnnotationNodeHostApplicationReservation],
+				tt.args.node.Annotations[extension.AnnotationNodeHostApplicationReservation])
 		})
 	}
 }
@@ -1651,6 +1786,7 @@ func TestPluginCalculate(t *testing.T) {
 	assert.NoError(t, err)
 	memoryCalculateByReq := configuration.CalculateByPodRequest
 	cpuCalculateByMaxUsageReq := configuration.CalculateByPodMaxUsageRequest
+	calculateByPrediction := configuration.CalculateByPodPrediction
 	type fields struct {
 		client  ctrlclient.Client
 		checkFn func(t *testing.T, client ctrlclient.Client)
@@ -3632,6 +3768,52 @@ func TestPluginCalculate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "calculate with cpu and memory prediction",
+			args: args{
+				strategy: &configuration.ColocationStrategy{
+					Enable:                        pointer.Bool(true),
+					DegradeTimeMinutes:            pointer.Int64(15),
+					UpdateTimeThresholdSeconds:    pointer.Int64(300),
+					ResourceDiffThreshold:         pointer.Float64(0.1),
+					CPUReclaimThresholdPercent:    pointer.Int64(65),
+					CPUCalculatePolicy:            &calculateByPrediction,
+					MemoryReclaimThresholdPercent: pointer.Int64(65),
+					MemoryCalculatePolicy:         &calculateByPrediction,
+				},
+				node: &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-node1",
+					},
+					Status: makeNodeStat("100", "120G"),
+				},
+				resourceMetrics: func() *framework.ResourceMetrics {
+					resourceMetrics := getTestResourceMetrics()
+					nodeMetric := resourceMetrics.NodeMetric
+					nodeMetric.Status.NodeMetric.SystemPredictedPeak = &slov1alpha1.ResourceMap{
+						ResourceList: makeResourceList("9", "14G"),
+					}
+					// podA has the predicted peak, podC falls back to the usage
+					nodeMetric.Status.PodsMetric[0].PredictedPeak = &slov1alpha1.ResourceMap{
+						ResourceList: makeResourceList("15", "14G"),
+					}
+					return resourceMetrics
+				}(),
+			},
+			want: []framework.ResourceItem{
+				{
+					Name:     extension.BatchCPU,
+					Quantity: resource.NewQuantity(19000, resource.DecimalSI),
+					Message:  "batchAllocatable[CPU(Milli-Core)]:19000 = nodeCapacity:100000 - nodeSafetyMargin:35000 - systemPeakOrNodeReserved:9000 - podHPPeak:37000",
+				},
+				{
+					Name:     extension.BatchMemory,
+					Quantity: resource.NewScaledQuantity(28, 9),
+					Message:  "batchAllocatable[Mem(GB)]:28 = nodeCapacity:120 - nodeSafetyMargin:42 - systemPeakOrNodeReserved:14 - podHPPeak:36",
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "calculate with adjusted reclaim ratio",
 			args: args{
@@ -3721,12 +3903,89 @@ func TestPluginCalculate(t *testing.T) {
 				{
 					Name:     extension.BatchCPU,
 					Quantity: resource.NewQuantity(25000, resource.DecimalSI),
-					Message:  "batchAllocatable[CPU(Milli-Core)]:25000 = nodeCapacity:100000 - nodeSafetyMargin:35000 - systemUsageOrNodeReserved:7000 - podHPUsed:33000",
+					Message:  "batchAllocatable[CPU(Milli-Core)]:25000 = nodeCapacity:100000 - nodeSafetyMargin:35000 - systemUsageOrNodeReserved:4000 - podHPUsed:36000",
 				},
 				{
 					Name:     extension.BatchMemory,
 					Quantity: resource.NewScaledQuantity(33, 9),
-					Message:  "batchAllocatable[Mem(GB)]:33 = nodeCapacity:120 - nodeSafetyMargin:42 - systemUsage:12 - podHPUsed:33",
+					Message:  "batchAllocatable[Mem(GB)]:33 = nodeCapacity:120 - nodeSafetyMargin:42 - systemUsage:6 - podHPUsed:39",
+				},
+				{
+					Name: PluginName,
+					Annotations: map[string]string{
+						extension.AnnotationNodeHostApplicationReservation: `{"cpu":"3","memory":"6G"}`,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "calculate with memory request, including LS host application peak usage",
+			args: args{
+				strategy: &configuration.ColocationStrategy{
+					Enable:                        pointer.Bool(true),
+					CPUReclaimThresholdPercent:    pointer.Int64(65),
+					MemoryReclaimThresholdPercent: pointer.Int64(65),
+					MemoryCalculatePolicy:         &memoryCalculateByReq,
+					DegradeTimeMinutes:            pointer.Int64(15),
+					UpdateTimeThresholdSeconds:    pointer.Int64(300),
+					ResourceDiffThreshold:         pointer.Float64(0.1),
+				},
+				node: &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-node1",
+					},
+					Status: makeNodeStat("100", "120G"),
+				},
+				resourceMetrics: &framework.ResourceMetrics{
+					NodeMetric: &slov1alpha1.NodeMetric{
+						Status: slov1alpha1.NodeMetricStatus{
+							UpdateTime: &metav1.Time{Time: time.Now()},
+							NodeMetric: &slov1alpha1.NodeMetricInfo{
+								NodeUsage: slov1alpha1.ResourceMap{
+									ResourceList: makeResourceList("50", "55G"),
+								},
+								SystemUsage: slov1alpha1.ResourceMap{
+									ResourceList: makeResourceList("4", "6G"),
+								},
+							},
+							PodsMetric: []*slov1alpha1.PodMetricInfo{
+								genPodMetric("test", "podA", "11", "11G"),
+								genPodMetric("test", "podB", "10", "10G"),
+								genPodMetric("test", "podC", "22", "22G"),
+							},
+							HostApplicationMetric: []*slov1alpha1.HostApplicationMetricInfo{
+								{
+									Name: "test-ls-host-application",
+									Usage: slov1alpha1.ResourceMap{
+										ResourceList: makeResourceList("3", "6G"),
+									},
+									PeakUsage: &slov1alpha1.ResourceMap{
+										ResourceList: makeResourceList("5", "10G"),
+									},
+									QoS: extension.QoSLS,
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []framework.ResourceItem{
+				{
+					Name:     extension.BatchCPU,
+					Quantity: resource.NewQuantity(25000, resource.DecimalSI),
+					Message:  "batchAllocatable[CPU(Milli-Core)]:25000 = nodeCapacity:100000 - nodeSafetyMargin:35000 - systemUsageOrNodeReserved:4000 - podHPUsed:36000",
+				},
+				{
+					Name:     extension.BatchMemory,
+					Quantity: resource.NewScaledQuantity(8, 9),
+					Message:  "batchAllocatable[Mem(GB)]:8 = nodeCapacity:120 - nodeSafetyMargin:42 - nodeReserved:0 - podHPRequest:70",
+				},
+				{
+					Name: PluginName,
+					Annotations: map[string]string{
+						extension.AnnotationNodeHostApplicationReservation: `{"cpu":"5","memory":"10G"}`,
+					},
 				},
 			},
 			wantErr: false,
@@ -3782,12 +4041,18 @@ func TestPluginCalculate(t *testing.T) {
 				{
 					Name:     extension.BatchCPU,
 					Quantity: resource.NewQuantity(25000, resource.DecimalSI),
-					Message:  "batchAllocatable[CPU(Milli-Core)]:25000 = nodeCapacity:100000 - nodeSafetyMargin:35000 - systemUsageOrNodeReserved:7000 - podHPUsed:33000",
+					Message:  "batchAllocatable[CPU(Milli-Core)]:25000 = nodeCapacity:100000 - nodeSafetyMargin:35000 - systemUsageOrNodeReserved:4000 - podHPUsed:36000",
 				},
 				{
 					Name:     extension.BatchMemory,
 					Quantity: resource.NewScaledQuantity(33, 9),
-					Message:  "batchAllocatable[Mem(GB)]:33 = nodeCapacity:120 - nodeSafetyMargin:42 - systemUsage:12 - podHPUsed:33",
+					Message:  "batchAllocatable[Mem(GB)]:33 = nodeCapacity:120 - nodeSafetyMargin:42 - systemUsage:6 - podHPUsed:39",
+				},
+				{
+					Name: PluginName,
+					Annotations: map[string]string{
+						extension.AnnotationNodeHostApplicationReservation: `{"cpu":"3","memory":"6G"}`,
+					},
 				},
 			},
 			wantErr: false,
@@ -3842,13 +4107,13 @@ func TestPluginCalculate(t *testing.T) {
 			want: []framework.ResourceItem{
 				{
 					Name:     extension.BatchCPU,
-					Quantity: resource.NewQuantity(28000, resource.DecimalSI),
-					Message:  "batchAllocatable[CPU(Milli-Core)]:28000 = nodeCapacity:100000 - nodeSafetyMargin:35000 - systemUsageOrNodeReserved:4000 - podHPUsed:33000",
+					Quantity: resource.NewQuantity(25000, resource.DecimalSI),
+					Message:  "batchAllocatable[CPU(Milli-Core)]:28000 = nodeCapacity:100000 - nodeSafetyMargin:35000 - systemUsageOrNodeReserved:4000 - podHPUsed:33000, batchAllocatable[CPU(Milli-Core)]:25000 = 28000 - hostAppBatchUsed:3000",
 				},
 				{
 					Name:     extension.BatchMemory,
-					Quantity: resource.NewScaledQuantity(39, 9),
-					Message:  "batchAllocatable[Mem(GB)]:39 = nodeCapacity:120 - nodeSafetyMargin:42 - systemUsage:6 - podHPUsed:33",
+					Quantity: resource.NewScaledQuantity(33, 9),
+					Message:  "batchAllocatable[Mem(GB)]:39 = nodeCapacity:120 - nodeSafetyMargin:42 - systemUsage:6 - podHPUsed:33, batchAllocatable[Mem(GB)]:33 = 39 - hostAppBatchUsed:6",
 				},
 			},
 			wantErr: false,
@@ -4258,7 +4523,9 @@ func testingCorrectResourceItems(t *testing.T, want, got []framework.ResourceIte
 			}
 		}
 		assert.Equal(t, want[i], got[i], "equal fields for resource "+want[i].Name)
-		assert.Equal(t, qWant.MilliValue(), qGot.MilliValue(), "equal values for resource "+want[i].Name)
+		if assert.Equal(t, qWant == nil, qGot == nil) && qWant != nil {
+			assert.Equal(t, qWant.MilliValue(), qGot.MilliValue(), "equal values for resource "+want[i].Name)
+		}
 		want[i].Quantity, got[i].Quantity = qWant, qGot
 		if zoneQWant != nil {
 			want[i].ZoneQuantity, got[i].ZoneQuantity = zoneQWant, zoneQGot
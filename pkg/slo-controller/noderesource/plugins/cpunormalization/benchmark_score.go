@@ -0,0 +1,224 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cpunormalization
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/koordinator-sh/koordinator/apis/configuration"
+	"github.com/koordinator-sh/koordinator/apis/extension"
+)
+
+// benchmarkScoreDiffThreshold is the relative difference of the benchmark scores regarded as the benchmark noise.
+// The scores within the threshold are regarded as unchanged, so the nodes are not recalculated for every report.
+const benchmarkScoreDiffThreshold = 0.05
+
+// benchmarkScoreCache caches the cpu benchmark scores reported by the nodes, so the scores of the same CPU model can
+// be averaged to reduce the noise of a single node.
+type benchmarkScoreCache struct {
+	lock   sync.RWMutex
+	scores map[string]nodeBenchmarkScore
+}
+
+type nodeBenchmarkScore struct {
+	cpuModel string
+	key      string
+	score    float64
+}
+
+func newBenchmarkScoreCache() *benchmarkScoreCache {
+	return &benchmarkScoreCache{
+		scores: map[string]nodeBenchmarkScore{},
+	}
+}
+
+// Update updates the benchmark score of the node. The node is removed if the info has no benchmark score.
+// It returns the keys and the CPU models whose average scores are changed by the update.
+func (c *benchmarkScoreCache) Update(nodeName string, info *extension.CPUBasicInfo) (sets.String, sets.String) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	keys, models := sets.NewString(), sets.NewString()
+	if old, ok := c.scores[nodeName]; ok {
+		keys.Insert(old.key)
+		models.Insert(old.cpuModel)
+	}
+	if info != nil && info.BenchmarkScore > 0 {
+		keys.Insert(info.Key())
+		models.Insert(info.CPUModel)
+	}
+	oldKeyScores, oldModelScores := c.getKeyScoresLocked(keys), c.getModelScoresLocked(models)
+
+	if info == nil || info.BenchmarkScore <= 0 {
+		delete(c.scores, nodeName)
+	} else {
+		c.scores[nodeName] = nodeBenchmarkScore{
+			cpuModel: info.CPUModel,
+			key:      info.Key(),
+			score:    info.BenchmarkScore,
+		}
+	}
+
+	return getChangedScores(oldKeyScores, c.getKeyScoresLocked(keys)),
+		getChangedScores(oldModelScores, c.getModelScoresLocked(models))
+}
+
+// Delete removes the node, and returns the keys and the CPU models whose average scores are changed.
+func (c *benchmarkScoreCache) Delete(nodeName string) (sets.String, sets.String) {
+	return c.Update(nodeName, nil)
+}
+
+// ListNodes returns the nodes whose benchmark scores are cached and match the filter.
+func (c *benchmarkScoreCache) ListNodes(filterFn func(key, cpuModel string) bool) []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	var nodeNames []string
+	for nodeName, s := range c.scores {
+		if filterFn(s.key, s.cpuModel) {
+			nodeNames = append(nodeNames, nodeName)
+		}
+	}
+	return nodeNames
+}
+
+// GetKeyScore returns the average score of the nodes with the same CPU model, Hyper Thread and Turbo status.
+func (c *benchmarkScoreCache) GetKeyScore(key string) (float64, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.getAverageScoreLocked(func(s nodeBenchmarkScore) bool {
+		return s.key == key
+	})
+}
+
+// GetModelScore returns the average score of the nodes with the CPU model.
+func (c *benchmarkScoreCache) GetModelScore(cpuModel string) (float64, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.getAverageScoreLocked(func(s nodeBenchmarkScore) bool {
+		return s.cpuModel == cpuModel
+	})
+}
+
+func (c *benchmarkScoreCache) getKeyScoresLocked(keys sets.String) map[string]float64 {
+	scores := map[string]float64{}
+	for key := range keys {
+		if score, ok := c.getAverageScoreLocked(func(s nodeBenchmarkScore) bool {
+			return s.key == key
+		}); ok {
+			scores[key] = score
+		}
+	}
+	return scores
+}
+
+func (c *benchmarkScoreCache) getModelScoresLocked(models sets.String) map[string]float64 {
+	scores := map[string]float64{}
+	for model := range models {
+		if score, ok := c.getAverageScoreLocked(func(s nodeBenchmarkScore) bool {
+			return s.cpuModel == model
+		}); ok {
+			scores[model] = score
+		}
+	}
+	return scores
+}
+
+func (c *benchmarkScoreCache) getAverageScoreLocked(filterFn func(s nodeBenchmarkScore) bool) (float64, bool) {
+	sum, count := 0.0, 0
+	for _, s := range c.scores {
+		if !filterFn(s) {
+			continue
+		}
+		sum += s.score
+		count++
+	}
+	if count <= 0 {
+		return -1, false
+	}
+	return sum / float64(count), true
+}
+
+// getChangedScores returns the names whose scores are added, removed or changed beyond the diff threshold.
+func getChangedScores(oldScores, newScores map[string]float64) sets.String {
+	changed := sets.NewString()
+	for name, oldScore := range oldScores {
+		if newScore, ok := newScores[name]; !ok || isBenchmarkScoreDifferent(oldScore, newScore) {
+			changed.Insert(name)
+		}
+	}
+	for name := range newScores {
+		if _, ok := oldScores[name]; !ok {
+			changed.Insert(name)
+		}
+	}
+	return changed
+}
+
+// isBenchmarkScoreDifferent returns whether the relative difference of the scores exceeds the diff threshold.
+func isBenchmarkScoreDifferent(oldScore, newScore float64) bool {
+	if oldScore <= 0 || newScore <= 0 {
+		return oldScore != newScore
+	}
+	return math.Abs(newScore-oldScore) > oldScore*benchmarkScoreDiffThreshold
+}
+
+// getCPUNormalizationRatioFromBenchmark derives the ratio by dividing the average score of the node's CPU model by
+// the reference score. The derived ratio is clamped into the valid range.
+func getCPUNormalizationRatioFromBenchmark(info *extension.CPUBasicInfo, strategy *configuration.CPUNormalizationStrategy,
+	cache *benchmarkScoreCache) (float64, error) {
+	cfg := strategy.BenchmarkRatio
+	if cfg == nil || cfg.Enable == nil || !*cfg.Enable {
+		return -1, fmt.Errorf("benchmark ratio is disabled")
+	}
+	if cache == nil {
+		return -1, fmt.Errorf("benchmark score cache is nil")
+	}
+
+	var referenceScore float64
+	if cfg.ReferenceScore != nil {
+		referenceScore = *cfg.ReferenceScore
+	} else if cfg.ReferenceModel != nil {
+		score, ok := cache.GetModelScore(*cfg.ReferenceModel)
+		if !ok {
+			return -1, fmt.Errorf("no benchmark score for reference CPU %s", *cfg.ReferenceModel)
+		}
+		referenceScore = score
+	}
+	if referenceScore <= 0 {
+		return -1, fmt.Errorf("invalid reference score %v", referenceScore)
+	}
+
+	score, ok := cache.GetKeyScore(info.Key())
+	if !ok {
+		if info.BenchmarkScore <= 0 {
+			return -1, fmt.Errorf("no benchmark score for CPU %s", info.CPUModel)
+		}
+		score = info.BenchmarkScore
+	}
+
+	ratio := score / referenceScore
+	if ratio < defaultMinRatio {
+		ratio = defaultMinRatio
+	} else if ratio > defaultMaxRatio {
+		ratio = defaultMaxRatio
+	}
+	return ratio, nil
+}
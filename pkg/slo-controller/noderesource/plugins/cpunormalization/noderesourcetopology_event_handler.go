@@ -21,12 +21,14 @@ import (
 
 	topologyv1alpha1 "github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/koordinator-sh/koordinator/apis/configuration"
 	"github.com/koordinator-sh/koordinator/apis/extension"
 )
 
@@ -43,6 +45,7 @@ func (h *nrtHandler) Create(ctx context.Context, evt event.CreateEvent, q workqu
 	if !isNRTCPUBasicInfoCreated(nrt) {
 		return
 	}
+	updateBenchmarkScore(nrt, q)
 
 	q.Add(reconcile.Request{
 		NamespacedName: types.NamespacedName{
@@ -65,6 +68,7 @@ func (h *nrtHandler) Update(ctx context.Context, evt event.UpdateEvent, q workqu
 	if !isNRTCPUBasicInfoChanged(nrtOld, nrtNew) {
 		return
 	}
+	updateBenchmarkScore(nrtNew, q)
 
 	q.Add(reconcile.Request{
 		NamespacedName: types.NamespacedName{
@@ -74,6 +78,12 @@ func (h *nrtHandler) Update(ctx context.Context, evt event.UpdateEvent, q workqu
 }
 
 func (h *nrtHandler) Delete(ctx context.Context, evt event.DeleteEvent, q workqueue.RateLimitingInterface) {
+	nrt, ok := evt.Object.(*topologyv1alpha1.NodeResourceTopology)
+	if !ok || scoreCache == nil {
+		return
+	}
+	changedKeys, changedModels := scoreCache.Delete(nrt.Name)
+	enqueueNodesForChangedScores(changedKeys, changedModels, q)
 }
 
 func (h *nrtHandler) Generic(ctx context.Context, evt event.GenericEvent, q workqueue.RateLimitingInterface) {
@@ -113,3 +123,55 @@ func isNRTCPUBasicInfoChanged(nrtOld, nrtNew *topologyv1alpha1.NodeResourceTopol
 
 	return false
 }
+
+func updateBenchmarkScore(nrt *topologyv1alpha1.NodeResourceTopology, q workqueue.RateLimitingInterface) {
+	if scoreCache == nil {
+		return
+	}
+	info, err := extension.GetCPUBasicInfo(nrt.Annotations)
+	if err != nil {
+		return
+	}
+	changedKeys, changedModels := scoreCache.Update(nrt.Name, info)
+	enqueueNodesForChangedScores(changedKeys, changedModels, q)
+}
+
+// enqueueNodesForChangedScores enqueues the nodes whose ratios are derived from the changed average scores.
+// If the average score of a reference model changes, the ratios of all nodes with benchmark scores can change.
+func enqueueNodesForChangedScores(changedKeys, changedModels sets.String, q workqueue.RateLimitingInterface) {
+	if changedKeys.Len() <= 0 && changedModels.Len() <= 0 {
+		return
+	}
+	isReferenceChanged := changedModels.HasAny(getBenchmarkReferenceModels().UnsortedList()...)
+	nodeNames := scoreCache.ListNodes(func(key, cpuModel string) bool {
+		return isReferenceChanged || changedKeys.Has(key)
+	})
+	klog.V(5).InfoS("benchmark scores changed, enqueue nodes", "keys", changedKeys.List(),
+		"models", changedModels.List(), "reference changed", isReferenceChanged, "nodes", len(nodeNames))
+	for _, nodeName := range nodeNames {
+		q.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name: nodeName,
+			},
+		})
+	}
+}
+
+// getBenchmarkReferenceModels returns the reference models in the cluster and node-level strategies.
+func getBenchmarkReferenceModels() sets.String {
+	models := sets.NewString()
+	if cfgHandler == nil || !cfgHandler.IsCfgAvailable() {
+		return models
+	}
+	cfg := cfgHandler.GetCfgCopy()
+	addReferenceModel := func(strategy *configuration.CPUNormalizationStrategy) {
+		if strategy.BenchmarkRatio != nil && strategy.BenchmarkRatio.ReferenceModel != nil {
+			models.Insert(*strategy.BenchmarkRatio.ReferenceModel)
+		}
+	}
+	addReferenceModel(&cfg.CPUNormalizationStrategy)
+	for i := range cfg.NodeConfigs {
+		addReferenceModel(&cfg.NodeConfigs[i].CPUNormalizationStrategy)
+	}
+	return models
+}
@@ -24,10 +24,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/koordinator-sh/koordinator/apis/configuration"
 	"github.com/koordinator-sh/koordinator/apis/extension"
 )
 
@@ -143,6 +146,46 @@ func Test_isNRTCPUBasicInfoChanged(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "benchmark scores are different",
+			args: args{
+				nrtOld: &topologyv1alpha1.NodeResourceTopology{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							extension.AnnotationCPUBasicInfo: `{"cpuModel": "XXX", "benchmarkScore": 10.5}`,
+						},
+					},
+				},
+				nrtNew: &topologyv1alpha1.NodeResourceTopology{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							extension.AnnotationCPUBasicInfo: `{"cpuModel": "XXX", "benchmarkScore": 12}`,
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "benchmark scores are close",
+			args: args{
+				nrtOld: &topologyv1alpha1.NodeResourceTopology{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							extension.AnnotationCPUBasicInfo: `{"cpuModel": "XXX", "benchmarkScore": 10.5}`,
+						},
+					},
+				},
+				nrtNew: &topologyv1alpha1.NodeResourceTopology{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							extension.AnnotationCPUBasicInfo: `{"cpuModel": "XXX", "benchmarkScore": 10.6}`,
+						},
+					},
+				},
+			},
+			want: false,
+		},
 		{
 			name: "old ratio parse failed",
 			args: args{
@@ -329,3 +372,75 @@ func Test_EnqueueRequestForNodeResourceTopology(t *testing.T) {
 		})
 	}
 }
+
+func Test_enqueueNodesForChangedScores(t *testing.T) {
+	newNRT := func(name string, info string) *topologyv1alpha1.NodeResourceTopology {
+		return &topologyv1alpha1.NodeResourceTopology{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				ResourceVersion: "1",
+				Annotations: map[string]string{
+					extension.AnnotationCPUBasicInfo: info,
+				},
+			},
+		}
+	}
+	getQueuedNodes := func(q workqueue.RateLimitingInterface) sets.String {
+		nodeNames := sets.NewString()
+		for q.Len() > 0 {
+			e, _ := q.Get()
+			nodeNames.Insert(e.(reconcile.Request).Name)
+			q.Done(e)
+		}
+		return nodeNames
+	}
+
+	scoreCache = newBenchmarkScoreCache()
+	cfgHandler = &configHandler{
+		cache: &cfgCache{
+			available: true,
+			config: &configuration.CPUNormalizationCfg{
+				CPUNormalizationStrategy: configuration.CPUNormalizationStrategy{
+					BenchmarkRatio: &configuration.BenchmarkRatioCfg{
+						Enable:         pointer.Bool(true),
+						ReferenceModel: pointer.String("base"),
+					},
+				},
+			},
+		},
+	}
+	defer testPluginCleanup()
+	handler := &nrtHandler{}
+	q := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	handler.Create(context.TODO(), event.CreateEvent{Object: newNRT("node-0", `{"cpuModel": "base", "benchmarkScore": 100}`)}, q)
+	handler.Create(context.TODO(), event.CreateEvent{Object: newNRT("node-1", `{"cpuModel": "fast", "benchmarkScore": 150}`)}, q)
+	handler.Create(context.TODO(), event.CreateEvent{Object: newNRT("node-2", `{"cpuModel": "fast", "benchmarkScore": 150}`)}, q)
+	handler.Create(context.TODO(), event.CreateEvent{Object: newNRT("node-3", `{"cpuModel": "other", "benchmarkScore": 120}`)}, q)
+	getQueuedNodes(q)
+
+	// the score changes within the threshold
+	handler.Update(context.TODO(), event.UpdateEvent{
+		ObjectOld: newNRT("node-1", `{"cpuModel": "fast", "benchmarkScore": 150}`),
+		ObjectNew: func() *topologyv1alpha1.NodeResourceTopology {
+			nrt := newNRT("node-1", `{"cpuModel": "fast", "benchmarkScore": 151}`)
+			nrt.ResourceVersion = "2"
+			return nrt
+		}(),
+	}, q)
+	assert.Equal(t, sets.NewString(), getQueuedNodes(q))
+
+	// the average score of the key changes
+	handler.Update(context.TODO(), event.UpdateEvent{
+		ObjectOld: newNRT("node-1", `{"cpuModel": "fast", "benchmarkScore": 150}`),
+		ObjectNew: func() *topologyv1alpha1.NodeResourceTopology {
+			nrt := newNRT("node-1", `{"cpuModel": "fast", "benchmarkScore": 200}`)
+			nrt.ResourceVersion = "2"
+			return nrt
+		}(),
+	}, q)
+	assert.Equal(t, sets.NewString("node-1", "node-2"), getQueuedNodes(q))
+
+	// the average score of the reference model changes
+	handler.Delete(context.TODO(), event.DeleteEvent{Object: newNRT("node-0", `{"cpuModel": "base", "benchmarkScore": 100}`)}, q)
+	assert.Equal(t, sets.NewString("node-1", "node-2", "node-3"), getQueuedNodes(q))
+}
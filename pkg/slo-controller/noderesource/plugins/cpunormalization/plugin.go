@@ -46,6 +46,7 @@ const (
 var (
 	client     ctrlclient.Client
 	cfgHandler *configHandler
+	scoreCache *benchmarkScoreCache
 )
 
 type Plugin struct{}
@@ -66,6 +67,7 @@ func (p *Plugin) Setup(opt *framework.Option) error {
 	if err := topologyv1alpha1.AddToScheme(clientgoscheme.Scheme); err != nil {
 		return fmt.Errorf("failed to add client go scheme for NodeResourceTopology, err: %w", err)
 	}
+	scoreCache = newBenchmarkScoreCache()
 	opt.Builder = opt.Builder.Watches(&topologyv1alpha1.NodeResourceTopology{}, &nrtHandler{})
 
 	cfgHandler = newConfigHandler(opt.Client, DefaultCPUNormalizationCfg(), opt.Recorder)
@@ -173,6 +175,9 @@ func (p *Plugin) Calculate(_ *configuration.ColocationStrategy, node *corev1.Nod
 	if basicInfo == nil {
 		return nil, fmt.Errorf("failed to get CPUBasicInfo in cpu normalization calculation, err: info is missing")
 	}
+	if scoreCache != nil {
+		scoreCache.Update(node.Name, basicInfo)
+	}
 
 	ratio, err := getCPUNormalizationRatio(basicInfo, strategy)
 	if err != nil {
@@ -215,12 +220,26 @@ func isCPUBasicInfoChanged(infoOld, infoNew *extension.CPUBasicInfo) (bool, stri
 	if infoOld.TurboEnabled != infoNew.TurboEnabled {
 		return true, "Turbo status changed"
 	}
+	if isBenchmarkScoreDifferent(infoOld.BenchmarkScore, infoNew.BenchmarkScore) {
+		return true, "benchmark score changed"
+	}
 
 	return false, ""
 }
 
+// getCPUNormalizationRatio gets the ratio in the order of the RatioModel, the benchmark scores and the DefaultRatio.
 func getCPUNormalizationRatio(info *extension.CPUBasicInfo, strategy *configuration.CPUNormalizationStrategy) (float64, error) {
 	ratio, err := getCPUNormalizationRatioFromModel(info, strategy)
+	if err != nil && strategy.BenchmarkRatio != nil {
+		var benchmarkErr error
+		ratio, benchmarkErr = getCPUNormalizationRatioFromBenchmark(info, strategy, scoreCache)
+		if benchmarkErr != nil {
+			klog.V(6).Infof("get no cpu ratio from benchmark, err: %s", benchmarkErr)
+		} else {
+			klog.V(6).Infof("get no cpu ratio from model, use the ratio %v derived from benchmark", ratio)
+			err = nil
+		}
+	}
 	if err != nil {
 		if strategy.DefaultRatio != nil {
 			klog.V(6).Infof("get no cpu ratio from model, use the default ratio %v", *strategy.DefaultRatio)
@@ -900,10 +900,11 @@ func Test_getCPUNormalizationRatio(t *testing.T) {
 		strategy *configuration.CPUNormalizationStrategy
 	}
 	tests := []struct {
-		name    string
-		args    args
-		want    float64
-		wantErr bool
+		name           string
+		args           args
+		benchmarkInfos map[string]*extension.CPUBasicInfo
+		want           float64
+		wantErr        bool
 	}{
 		{
 			name: "nil model and nil default ratio",
@@ -968,9 +969,115 @@ func Test_getCPUNormalizationRatio(t *testing.T) {
 			want:    2.2,
 			wantErr: false,
 		},
+		{
+			name: "model takes precedence over the benchmark",
+			args: args{
+				info: &extension.CPUBasicInfo{
+					CPUModel:       "CPU XXX",
+					BenchmarkScore: 20,
+				},
+				strategy: &configuration.CPUNormalizationStrategy{
+					Enable: pointer.Bool(true),
+					RatioModel: map[string]configuration.ModelRatioCfg{
+						"CPU XXX": {
+							BaseRatio: pointer.Float64(1.9),
+						},
+					},
+					BenchmarkRatio: &configuration.BenchmarkRatioCfg{
+						Enable:         pointer.Bool(true),
+						ReferenceScore: pointer.Float64(10),
+					},
+				},
+			},
+			want:    1.9,
+			wantErr: false,
+		},
+		{
+			name: "derive ratio from the benchmark of the reference model",
+			args: args{
+				info: &extension.CPUBasicInfo{
+					CPUModel:       "CPU XXX",
+					BenchmarkScore: 21,
+				},
+				strategy: &configuration.CPUNormalizationStrategy{
+					Enable:       pointer.Bool(true),
+					DefaultRatio: pointer.Float64(2.2),
+					BenchmarkRatio: &configuration.BenchmarkRatioCfg{
+						Enable:         pointer.Bool(true),
+						ReferenceModel: pointer.String("CPU YYY"),
+					},
+				},
+			},
+			benchmarkInfos: map[string]*extension.CPUBasicInfo{
+				"test-node-0": {
+					CPUModel:       "CPU XXX",
+					BenchmarkScore: 21,
+				},
+				"test-node-1": {
+					CPUModel:       "CPU XXX",
+					BenchmarkScore: 19,
+				},
+				"test-node-2": {
+					CPUModel:       "CPU YYY",
+					BenchmarkScore: 8,
+				},
+			},
+			want:    2.5,
+			wantErr: false,
+		},
+		{
+			name: "use the default ratio when the reference model has no benchmark",
+			args: args{
+				info: &extension.CPUBasicInfo{
+					CPUModel:       "CPU XXX",
+					BenchmarkScore: 21,
+				},
+				strategy: &configuration.CPUNormalizationStrategy{
+					Enable:       pointer.Bool(true),
+					DefaultRatio: pointer.Float64(2.2),
+					BenchmarkRatio: &configuration.BenchmarkRatioCfg{
+						Enable:         pointer.Bool(true),
+						ReferenceModel: pointer.String("CPU YYY"),
+					},
+				},
+			},
+			benchmarkInfos: map[string]*extension.CPUBasicInfo{
+				"test-node-0": {
+					CPUModel:       "CPU XXX",
+					BenchmarkScore: 21,
+				},
+			},
+			want:    2.2,
+			wantErr: false,
+		},
+		{
+			name: "clamp the ratio derived from the benchmark",
+			args: args{
+				info: &extension.CPUBasicInfo{
+					CPUModel:       "CPU XXX",
+					BenchmarkScore: 8,
+				},
+				strategy: &configuration.CPUNormalizationStrategy{
+					Enable: pointer.Bool(true),
+					BenchmarkRatio: &configuration.BenchmarkRatioCfg{
+						Enable:         pointer.Bool(true),
+						ReferenceScore: pointer.Float64(10),
+					},
+				},
+			},
+			want:    1.0,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			scoreCache = newBenchmarkScoreCache()
+			defer func() {
+				scoreCache = nil
+			}()
+			for nodeName, info := range tt.benchmarkInfos {
+				scoreCache.Update(nodeName, info)
+			}
 			got, gotErr := getCPUNormalizationRatio(tt.args.info, tt.args.strategy)
 			assert.Equal(t, tt.want, got)
 			assert.Equal(t, tt.wantErr, gotErr != nil)
@@ -981,4 +1088,5 @@ func Test_getCPUNormalizationRatio(t *testing.T) {
 func testPluginCleanup() {
 	client = nil
 	cfgHandler = nil
+	scoreCache = nil
 }
@@ -48,7 +48,7 @@ const (
 )
 
 func CalculateBatchResourceByPolicy(strategy *configuration.ColocationStrategy, nodeCapacity, nodeSafetyMargin, nodeReserved,
-	systemUsed, podHPReq, podHPUsed, podHPMaxUsedReq corev1.ResourceList) (corev1.ResourceList, string, string) {
+	systemUsed, podHPReq, podHPUsed, podHPMaxUsedReq, systemPeak, podHPPeak corev1.ResourceList) (corev1.ResourceList, string, string) {
 	// Node(Batch).Alloc[usage] := Node.Total - Node.SafetyMargin - System.Used - sum(Pod(Prod/Mid).Used)
 	// System.Used = max(Node.Used - Pod(All).Used, Node.Anno.Reserved, Node.Kubelet.Reserved)
 	systemUsed = quotav1.Max(systemUsed, nodeReserved)
@@ -64,15 +64,26 @@ func CalculateBatchResourceByPolicy(strategy *configuration.ColocationStrategy,
 	batchAllocatableByMaxUsageRequest := quotav1.Max(quotav1.Subtract(quotav1.Subtract(quotav1.Subtract(
 		nodeCapacity, nodeSafetyMargin), systemUsed), podHPMaxUsedReq), util.NewZeroResourceList())
 
+	// Node(Batch).Alloc[prediction] := Node.Total - Node.SafetyMargin - System.Peak - sum(Pod(Prod/Mid).Peak)
+	// System.Peak = max(System.PredictedPeak, Node.Anno.Reserved, Node.Kubelet.Reserved)
+	systemPeak = quotav1.Max(systemPeak, nodeReserved)
+	batchAllocatableByPrediction := quotav1.Max(quotav1.Subtract(quotav1.Subtract(quotav1.Subtract(
+		nodeCapacity, nodeSafetyMargin), systemPeak), podHPPeak), util.NewZeroResourceList())
+
 	batchAllocatable := batchAllocatableByUsage
 
 	var cpuMsg string
-	// batch cpu support policy "usage" and "maxUsageRequest"
+	// batch cpu support policy "usage", "maxUsageRequest" and "prediction"
 	if strategy != nil && strategy.CPUCalculatePolicy != nil && *strategy.CPUCalculatePolicy == configuration.CalculateByPodMaxUsageRequest {
 		batchAllocatable[corev1.ResourceCPU] = *batchAllocatableByMaxUsageRequest.Cpu()
 		cpuMsg = fmt.Sprintf("batchAllocatable[CPU(Milli-Core)]:%v = nodeCapacity:%v - nodeSafetyMargin:%v - systemUsageOrNodeReserved:%v - podHPMaxUsedRequest:%v",
 			batchAllocatable.Cpu().MilliValue(), nodeCapacity.Cpu().MilliValue(), nodeSafetyMargin.Cpu().MilliValue(),
 			systemUsed.Cpu().MilliValue(), podHPMaxUsedReq.Cpu().MilliValue())
+	} else if strategy != nil && strategy.CPUCalculatePolicy != nil && *strategy.CPUCalculatePolicy == configuration.CalculateByPodPrediction {
+		batchAllocatable[corev1.ResourceCPU] = *batchAllocatableByPrediction.Cpu()
+		cpuMsg = fmt.Sprintf("batchAllocatable[CPU(Milli-Core)]:%v = nodeCapacity:%v - nodeSafetyMargin:%v - systemPeakOrNodeReserved:%v - podHPPeak:%v",
+			batchAllocatable.Cpu().MilliValue(), nodeCapacity.Cpu().MilliValue(), nodeSafetyMargin.Cpu().MilliValue(),
+			systemPeak.Cpu().MilliValue(), podHPPeak.Cpu().MilliValue())
 	} else { // use CalculatePolicy "usage" by default
 		cpuMsg = fmt.Sprintf("batchAllocatable[CPU(Milli-Core)]:%v = nodeCapacity:%v - nodeSafetyMargin:%v - systemUsageOrNodeReserved:%v - podHPUsed:%v",
 			batchAllocatable.Cpu().MilliValue(), nodeCapacity.Cpu().MilliValue(), nodeSafetyMargin.Cpu().MilliValue(),
@@ -80,7 +91,7 @@ func CalculateBatchResourceByPolicy(strategy *configuration.ColocationStrategy,
 	}
 
 	var memMsg string
-	// batch memory support policy "usage", "request" and "maxUsageRequest"
+	// batch memory support policy "usage", "request", "maxUsageRequest" and "prediction"
 	if strategy != nil && strategy.MemoryCalculatePolicy != nil && *strategy.MemoryCalculatePolicy == configuration.CalculateByPodRequest {
 		batchAllocatable[corev1.ResourceMemory] = *batchAllocatableByRequest.Memory()
 		memMsg = fmt.Sprintf("batchAllocatable[Mem(GB)]:%v = nodeCapacity:%v - nodeSafetyMargin:%v - nodeReserved:%v - podHPRequest:%v",
@@ -93,6 +104,12 @@ func CalculateBatchResourceByPolicy(strategy *configuration.ColocationStrategy,
 			batchAllocatable.Memory().ScaledValue(resource.Giga), nodeCapacity.Memory().ScaledValue(resource.Giga),
 			nodeSafetyMargin.Memory().ScaledValue(resource.Giga), systemUsed.Memory().ScaledValue(resource.Giga),
 			podHPMaxUsedReq.Memory().ScaledValue(resource.Giga))
+	} else if strategy != nil && strategy.MemoryCalculatePolicy != nil && *strategy.MemoryCalculatePolicy == configuration.CalculateByPodPrediction {
+		batchAllocatable[corev1.ResourceMemory] = *batchAllocatableByPrediction.Memory()
+		memMsg = fmt.Sprintf("batchAllocatable[Mem(GB)]:%v = nodeCapacity:%v - nodeSafetyMargin:%v - systemPeakOrNodeReserved:%v - podHPPeak:%v",
+			batchAllocatable.Memory().ScaledValue(resource.Giga), nodeCapacity.Memory().ScaledValue(resource.Giga),
+			nodeSafetyMargin.Memory().ScaledValue(resource.Giga), systemPeak.Memory().ScaledValue(resource.Giga),
+			podHPPeak.Memory().ScaledValue(resource.Giga))
 	} else { // use CalculatePolicy "usage" by default
 		memMsg = fmt.Sprintf("batchAllocatable[Mem(GB)]:%v = nodeCapacity:%v - nodeSafetyMargin:%v - systemUsage:%v - podHPUsed:%v",
 			batchAllocatable.Memory().ScaledValue(resource.Giga), nodeCapacity.Memory().ScaledValue(resource.Giga),
@@ -192,17 +209,73 @@ func GetPodMetricUsage(info *slov1alpha1.PodMetricInfo) corev1.ResourceList {
 	return GetResourceListForCPUAndMemory(info.PodUsage.ResourceList)
 }
 
+// GetPodMetricPeak returns the predicted peak of the pod. It returns the pod usage instead if the predicted peak is
+// not reported, e.g. the pod is in the cold start.
+func GetPodMetricPeak(info *slov1alpha1.PodMetricInfo) corev1.ResourceList {
+	if info.PredictedPeak == nil || info.PredictedPeak.ResourceList == nil {
+		return GetPodMetricUsage(info)
+	}
+	return GetResourceListForCPUAndMemory(info.PredictedPeak.ResourceList)
+}
+
+// GetSystemPeak returns the predicted peak of the system. It returns the system usage instead if the predicted peak is
+// not reported.
+func GetSystemPeak(nodeMetric *slov1alpha1.NodeMetric) corev1.ResourceList {
+	nodeMetricInfo := nodeMetric.Status.NodeMetric
+	if nodeMetricInfo.SystemPredictedPeak == nil || nodeMetricInfo.SystemPredictedPeak.ResourceList == nil {
+		return GetResourceListForCPUAndMemory(nodeMetricInfo.SystemUsage.ResourceList)
+	}
+	return GetResourceListForCPUAndMemory(nodeMetricInfo.SystemPredictedPeak.ResourceList)
+}
+
 func GetHostAppHPUsed(resourceMetrics *framework.ResourceMetrics, resPriority extension.PriorityClass) corev1.ResourceList {
+	hostAppHPUsed, _ := GetHostAppHPUsedAndPeak(resourceMetrics, resPriority)
+	return hostAppHPUsed
+}
+
+// GetHostAppHPUsedAndPeak returns the sum usage and the sum peak of the host applications whose priority is higher
+// than the resPriority.
+func GetHostAppHPUsedAndPeak(resourceMetrics *framework.ResourceMetrics, resPriority extension.PriorityClass) (corev1.ResourceList, corev1.ResourceList) {
 	hostAppHPUsed := util.NewZeroResourceList()
+	hostAppHPPeak := util.NewZeroResourceList()
 	for _, hostAppMetric := range resourceMetrics.NodeMetric.Status.HostApplicationMetric {
-		if extension.GetDefaultPriorityByPriorityClass(hostAppMetric.Priority) <= extension.GetDefaultPriorityByPriorityClass(resPriority) {
+		if extension.GetDefaultPriorityByPriorityClass(GetHostAppPriorityClass(hostAppMetric)) <= extension.GetDefaultPriorityByPriorityClass(resPriority) {
 			// consider higher priority usage for mid or batch allocatable
 			// now only support product and batch(hadoop-yarn) priority for host application
 			continue
 		}
 		hostAppHPUsed = quotav1.Add(hostAppHPUsed, GetHostAppMetricUsage(hostAppMetric))
+		hostAppHPPeak = quotav1.Add(hostAppHPPeak, GetHostAppMetricPeak(hostAppMetric))
+	}
+	return hostAppHPUsed, hostAppHPPeak
+}
+
+// GetHostAppUsedByPriority returns the sum usage of the host applications with the specified priority.
+func GetHostAppUsedByPriority(resourceMetrics *framework.ResourceMetrics, priority extension.PriorityClass) corev1.ResourceList {
+	hostAppUsed := util.NewZeroResourceList()
+	for _, hostAppMetric := range resourceMetrics.NodeMetric.Status.HostApplicationMetric {
+		if GetHostAppPriorityClass(hostAppMetric) != priority {
+			continue
+		}
+		hostAppUsed = quotav1.Add(hostAppUsed, GetHostAppMetricUsage(hostAppMetric))
+	}
+	return hostAppUsed
+}
+
+// GetHostAppPriorityClass returns the priority class of the host application. If the priority is not declared, it is
+// derived from the QoS class, where the BE applications are considered as batch and the others are considered as prod.
+func GetHostAppPriorityClass(info *slov1alpha1.HostApplicationMetricInfo) extension.PriorityClass {
+	if info.Priority != "" {
+		return info.Priority
+	}
+	switch info.QoS {
+	case extension.QoSBE:
+		return extension.PriorityBatch
+	case extension.QoSSystem, extension.QoSLSE, extension.QoSLSR, extension.QoSLS:
+		return extension.PriorityProd
+	default:
+		return extension.PriorityNone
 	}
-	return hostAppHPUsed
 }
 
 // GetHostAppMetricUsage gets host application usage from HostApplicationMetricInfo
@@ -210,6 +283,30 @@ func GetHostAppMetricUsage(info *slov1alpha1.HostApplicationMetricInfo) corev1.R
 	return GetResourceListForCPUAndMemory(info.Usage.ResourceList)
 }
 
+// GetHostAppMetricPeak gets host application peak usage from HostApplicationMetricInfo.
+// It falls back to the usage if the peak is not reported.
+func GetHostAppMetricPeak(info *slov1alpha1.HostApplicationMetricInfo) corev1.ResourceList {
+	if info.PeakUsage == nil || info.PeakUsage.ResourceList == nil {
+		return GetHostAppMetricUsage(info)
+	}
+	return quotav1.Max(GetHostAppMetricUsage(info), GetResourceListForCPUAndMemory(info.PeakUsage.ResourceList))
+}
+
+// CalculateBatchResourceWithHostApp deducts the usage of the batch host applications from the batch allocatable,
+// since the batch host applications consume the batch resources like the batch pods.
+func CalculateBatchResourceWithHostApp(batchAllocatable, hostAppBatchUsed corev1.ResourceList, cpuMsg, memMsg string) (corev1.ResourceList, string, string) {
+	if quotav1.IsZero(hostAppBatchUsed) {
+		return batchAllocatable, cpuMsg, memMsg
+	}
+	result := quotav1.Max(quotav1.Subtract(batchAllocatable, hostAppBatchUsed), util.NewZeroResourceList())
+	cpuMsg = fmt.Sprintf("%s, batchAllocatable[CPU(Milli-Core)]:%v = %v - hostAppBatchUsed:%v", cpuMsg,
+		result.Cpu().MilliValue(), batchAllocatable.Cpu().MilliValue(), hostAppBatchUsed.Cpu().MilliValue())
+	memMsg = fmt.Sprintf("%s, batchAllocatable[Mem(GB)]:%v = %v - hostAppBatchUsed:%v", memMsg,
+		result.Memory().ScaledValue(resource.Giga), batchAllocatable.Memory().ScaledValue(resource.Giga),
+		hostAppBatchUsed.Memory().ScaledValue(resource.Giga))
+	return result, cpuMsg, memMsg
+}
+
 // GetPodNUMARequestAndUsage returns the pod request and usage on each NUMA nodes.
 // It averages the metrics over all sharepools when the pod does not allocate any sharepool or use all sharepools.
 func GetPodNUMARequestAndUsage(pod *corev1.Pod, podRequest, podUsage corev1.ResourceList, numaNum int) ([]corev1.ResourceList, []corev1.ResourceList) {
@@ -28,6 +28,7 @@ import (
 	"github.com/koordinator-sh/koordinator/apis/configuration"
 	"github.com/koordinator-sh/koordinator/apis/extension"
 	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource/framework"
 	"github.com/koordinator-sh/koordinator/pkg/util"
 )
 
@@ -78,6 +79,53 @@ func Test_getPodMetricUsage(t *testing.T) {
 	}
 }
 
+func Test_getPodMetricPeak(t *testing.T) {
+	type args struct {
+		info *slov1alpha1.PodMetricInfo
+	}
+	tests := []struct {
+		name string
+		args args
+		want corev1.ResourceList
+	}{
+		{
+			name: "fallback to usage when peak is not predicted",
+			args: args{
+				info: &slov1alpha1.PodMetricInfo{
+					PodUsage: slov1alpha1.ResourceMap{
+						ResourceList: makeResourceList("4", "10Gi"),
+					},
+				},
+			},
+			want: makeResourceList("4", "10Gi"),
+		},
+		{
+			name: "get predicted peak",
+			args: args{
+				info: &slov1alpha1.PodMetricInfo{
+					PodUsage: slov1alpha1.ResourceMap{
+						ResourceList: makeResourceList("4", "10Gi"),
+					},
+					PredictedPeak: &slov1alpha1.ResourceMap{
+						ResourceList: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("6"),
+							corev1.ResourceMemory: resource.MustParse("12Gi"),
+							"unknown_resource":    resource.MustParse("1"),
+						},
+					},
+				},
+			},
+			want: makeResourceList("6", "12Gi"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetPodMetricPeak(tt.args.info)
+			testingCorrectResourceList(t, &tt.want, &got)
+		})
+	}
+}
+
 func Test_getResourceListForCPUAndMemory(t *testing.T) {
 	type args struct {
 		rl corev1.ResourceList
@@ -703,6 +751,220 @@ func Test_getHostAppMetricUsage(t *testing.T) {
 	}
 }
 
+func Test_getHostAppMetricPeak(t *testing.T) {
+	type args struct {
+		info *slov1alpha1.HostApplicationMetricInfo
+	}
+	tests := []struct {
+		name string
+		args args
+		want corev1.ResourceList
+	}{
+		{
+			name: "fallback to usage when peak is not reported",
+			args: args{
+				info: &slov1alpha1.HostApplicationMetricInfo{
+					Usage: slov1alpha1.ResourceMap{
+						ResourceList: makeResourceList("4", "10Gi"),
+					},
+				},
+			},
+			want: makeResourceList("4", "10Gi"),
+		},
+		{
+			name: "get reported peak",
+			args: args{
+				info: &slov1alpha1.HostApplicationMetricInfo{
+					Usage: slov1alpha1.ResourceMap{
+						ResourceList: makeResourceList("4", "10Gi"),
+					},
+					PeakUsage: &slov1alpha1.ResourceMap{
+						ResourceList: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("6"),
+							corev1.ResourceMemory: resource.MustParse("12Gi"),
+							"unknown_resource":    resource.MustParse("1"),
+						},
+					},
+				},
+			},
+			want: makeResourceList("6", "12Gi"),
+		},
+		{
+			name: "peak is no less than usage",
+			args: args{
+				info: &slov1alpha1.HostApplicationMetricInfo{
+					Usage: slov1alpha1.ResourceMap{
+						ResourceList: makeResourceList("4", "10Gi"),
+					},
+					PeakUsage: &slov1alpha1.ResourceMap{
+						ResourceList: makeResourceList("2", "12Gi"),
+					},
+				},
+			},
+			want: makeResourceList("4", "12Gi"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetHostAppMetricPeak(tt.args.info)
+			testingCorrectResourceList(t, &tt.want, &got)
+		})
+	}
+}
+
+func Test_getHostAppPriorityClass(t *testing.T) {
+	tests := []struct {
+		name string
+		info *slov1alpha1.HostApplicationMetricInfo
+		want extension.PriorityClass
+	}{
+		{
+			name: "use the declared priority",
+			info: &slov1alpha1.HostApplicationMetricInfo{
+				Priority: extension.PriorityMid,
+				QoS:      extension.QoSBE,
+			},
+			want: extension.PriorityMid,
+		},
+		{
+			name: "derive batch priority from BE",
+			info: &slov1alpha1.HostApplicationMetricInfo{
+				QoS: extension.QoSBE,
+			},
+			want: extension.PriorityBatch,
+		},
+		{
+			name: "derive prod priority from LS",
+			info: &slov1alpha1.HostApplicationMetricInfo{
+				QoS: extension.QoSLS,
+			},
+			want: extension.PriorityProd,
+		},
+		{
+			name: "neither priority nor qos is declared",
+			info: &slov1alpha1.HostApplicationMetricInfo{},
+			want: extension.PriorityNone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, GetHostAppPriorityClass(tt.info))
+		})
+	}
+}
+
+func Test_getHostAppUsedAndPeak(t *testing.T) {
+	resourceMetrics := &framework.ResourceMetrics{
+		NodeMetric: &slov1alpha1.NodeMetric{
+			Status: slov1alpha1.NodeMetricStatus{
+				HostApplicationMetric: []*slov1alpha1.HostApplicationMetricInfo{
+					{
+						Name: "prod-app",
+						Usage: slov1alpha1.ResourceMap{
+							ResourceList: makeResourceList("2", "4Gi"),
+						},
+						PeakUsage: &slov1alpha1.ResourceMap{
+							ResourceList: makeResourceList("3", "5Gi"),
+						},
+						Priority: extension.PriorityProd,
+					},
+					{
+						Name: "ls-app",
+						Usage: slov1alpha1.ResourceMap{
+							ResourceList: makeResourceList("1", "2Gi"),
+						},
+						QoS: extension.QoSLS,
+					},
+					{
+						Name: "mid-app",
+						Usage: slov1alpha1.ResourceMap{
+							ResourceList: makeResourceList("1", "1Gi"),
+						},
+						Priority: extension.PriorityMid,
+					},
+					{
+						Name: "batch-app",
+						Usage: slov1alpha1.ResourceMap{
+							ResourceList: makeResourceList("4", "8Gi"),
+						},
+						Priority: extension.PriorityBatch,
+					},
+					{
+						Name: "be-app",
+						Usage: slov1alpha1.ResourceMap{
+							ResourceList: makeResourceList("1", "1Gi"),
+						},
+						QoS: extension.QoSBE,
+					},
+					{
+						Name: "unknown-app",
+						Usage: slov1alpha1.ResourceMap{
+							ResourceList: makeResourceList("1", "1Gi"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	hpUsed, hpPeak := GetHostAppHPUsedAndPeak(resourceMetrics, extension.PriorityBatch)
+	wantHPUsed, wantHPPeak := makeResourceList("4", "7Gi"), makeResourceList("5", "8Gi")
+	testingCorrectResourceList(t, &wantHPUsed, &hpUsed)
+	testingCorrectResourceList(t, &wantHPPeak, &hpPeak)
+
+	midHPUsed := GetHostAppHPUsed(resourceMetrics, extension.PriorityMid)
+	wantMidHPUsed := makeResourceList("3", "6Gi")
+	testingCorrectResourceList(t, &wantMidHPUsed, &midHPUsed)
+
+	batchUsed := GetHostAppUsedByPriority(resourceMetrics, extension.PriorityBatch)
+	wantBatchUsed := makeResourceList("5", "9Gi")
+	testingCorrectResourceList(t, &wantBatchUsed, &batchUsed)
+}
+
+func Test_calculateBatchResourceWithHostApp(t *testing.T) {
+	tests := []struct {
+		name             string
+		batchAllocatable corev1.ResourceList
+		hostAppBatchUsed corev1.ResourceList
+		want             corev1.ResourceList
+		wantCPUMsg       string
+		wantMemMsg       string
+	}{
+		{
+			name:             "no batch host application",
+			batchAllocatable: makeResourceList("20", "40G"),
+			hostAppBatchUsed: util.NewZeroResourceList(),
+			want:             makeResourceList("20", "40G"),
+			wantCPUMsg:       "cpu",
+			wantMemMsg:       "memory",
+		},
+		{
+			name:             "subtract batch host application usage",
+			batchAllocatable: makeResourceList("20", "40G"),
+			hostAppBatchUsed: makeResourceList("4", "8G"),
+			want:             makeResourceList("16", "32G"),
+			wantCPUMsg:       "cpu, batchAllocatable[CPU(Milli-Core)]:16000 = 20000 - hostAppBatchUsed:4000",
+			wantMemMsg:       "memory, batchAllocatable[Mem(GB)]:32 = 40 - hostAppBatchUsed:8",
+		},
+		{
+			name:             "batch host application usage exceeds batch allocatable",
+			batchAllocatable: makeResourceList("2", "40G"),
+			hostAppBatchUsed: makeResourceList("4", "8G"),
+			want:             makeResourceList("0", "32G"),
+			wantCPUMsg:       "cpu, batchAllocatable[CPU(Milli-Core)]:0 = 2000 - hostAppBatchUsed:4000",
+			wantMemMsg:       "memory, batchAllocatable[Mem(GB)]:32 = 40 - hostAppBatchUsed:8",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotCPUMsg, gotMemMsg := CalculateBatchResourceWithHostApp(tt.batchAllocatable, tt.hostAppBatchUsed, "cpu", "memory")
+			testingCorrectResourceList(t, &tt.want, &got)
+			assert.Equal(t, tt.wantCPUMsg, gotCPUMsg)
+			assert.Equal(t, tt.wantMemMsg, gotMemMsg)
+		})
+	}
+}
+
 func testingCorrectResourceList(t *testing.T, want, got *corev1.ResourceList) {
 	assert.Equal(t, want.Cpu().MilliValue(), got.Cpu().MilliValue(), "should get correct cpu request")
 	assert.Equal(t, want.Memory().Value(), got.Memory().Value(), "should get correct memory request")
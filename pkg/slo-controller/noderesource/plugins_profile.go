@@ -18,6 +18,8 @@ package noderesource
 
 import (
 	"github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource/framework"
+	"github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource/plugins/batchephemeralstorage"
+	"github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource/plugins/batchgpuresource"
 	"github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource/plugins/batchresource"
 	"github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource/plugins/cpunormalization"
 	"github.com/koordinator-sh/koordinator/pkg/slo-controller/noderesource/plugins/gpudeviceresource"
@@ -36,6 +38,8 @@ func init() {
 	addPluginOption(&resourceamplification.Plugin{}, true)
 	addPluginOption(&gpudeviceresource.Plugin{}, true)
 	addPluginOption(&rdmadeviceresource.Plugin{}, true)
+	addPluginOption(&batchephemeralstorage.Plugin{}, false)
+	addPluginOption(&batchgpuresource.Plugin{}, false)
 }
 
 func addPlugins(filter framework.FilterFn) {
@@ -56,6 +60,7 @@ var (
 		&batchresource.Plugin{},
 		&gpudeviceresource.Plugin{},
 		&rdmadeviceresource.Plugin{},
+		&batchgpuresource.Plugin{},
 	}
 	// NodePreUpdatePlugin implements node resource pre-updating.
 	nodePreUpdatePlugins = []framework.NodePreUpdatePlugin{
@@ -69,6 +74,8 @@ var (
 		&batchresource.Plugin{},
 		&gpudeviceresource.Plugin{},
 		&rdmadeviceresource.Plugin{},
+		&batchephemeralstorage.Plugin{},
+		&batchgpuresource.Plugin{},
 	}
 	// NodeSyncPlugin implements the check of resource updating.
 	nodeStatusCheckPlugins = []framework.NodeStatusCheckPlugin{
@@ -76,12 +83,16 @@ var (
 		&batchresource.Plugin{},
 		&gpudeviceresource.Plugin{},
 		&rdmadeviceresource.Plugin{},
+		&batchephemeralstorage.Plugin{},
+		&batchgpuresource.Plugin{},
 	}
 	// nodeMetaCheckPlugins implements the check of node meta updating.
 	nodeMetaCheckPlugins = []framework.NodeMetaCheckPlugin{
 		&cpunormalization.Plugin{},
 		&resourceamplification.Plugin{},
+		&batchresource.Plugin{},
 		&gpudeviceresource.Plugin{},
+		&batchgpuresource.Plugin{},
 	}
 	// ResourceCalculatePlugin implements resource counting and overcommitment algorithms.
 	resourceCalculatePlugins = []framework.ResourceCalculatePlugin{
@@ -91,5 +102,7 @@ var (
 		&batchresource.Plugin{},
 		&gpudeviceresource.Plugin{},
 		&rdmadeviceresource.Plugin{},
+		&batchephemeralstorage.Plugin{},
+		&batchgpuresource.Plugin{},
 	}
 )
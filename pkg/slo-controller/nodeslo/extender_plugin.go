@@ -17,16 +17,21 @@ limitations under the License.
 package nodeslo
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 
+	"github.com/mohae/deepcopy"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 
 	"github.com/koordinator-sh/koordinator/apis/configuration"
 	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
 	"github.com/koordinator-sh/koordinator/pkg/slo-controller/metrics"
+	"github.com/koordinator-sh/koordinator/pkg/util/sloconfig"
 )
 
 var (
@@ -77,6 +82,14 @@ func getExtensionsConfigSpec(node *corev1.Node, oldSpec *slov1alpha1.NodeSLOSpec
 			klog.Warningf("run get nodeSLO extender %v failed, error %v", name, err)
 			continue
 		}
+		if schema, ok := sloconfig.GetNodeSLOExtensionSchema(extKey); ok && extStrategy != nil {
+			// keep the last valid strategy instead of propagating an invalid one to the node
+			if _, err = schema.DecodeExtensionStrategy(extStrategy); err != nil {
+				metrics.RecordNodeSLOSpecParseCount(false, "getNodeSLOExtension")
+				klog.Warningf("run get nodeSLO extender %v failed, extension %v is invalid, error %v", name, extKey, err)
+				continue
+			}
+		}
 		if extStrategy == nil {
 			delete(extMap.Object, extKey)
 		} else {
@@ -98,3 +111,106 @@ type NodeSLOMergedPlugin interface {
 	// get each extension config spec
 	GetNodeSLOExtension(node *corev1.Node, cfg *configuration.ExtensionCfgMap) (string, interface{}, error)
 }
+
+// RegisterNodeSLOExtensionWithSchema registers the extension schema and a merged extender driven by the schema, so the
+// extension plugin only needs to define the typed strategy. The extension config in the slo-controller ConfigMap is
+// validated by the webhook with the schema, and each node strategy is merged on the cluster strategy.
+func RegisterNodeSLOExtensionWithSchema(extKey string, schema *sloconfig.NodeSLOExtensionSchema) error {
+	if schema == nil || schema.ConfigKey == "" {
+		return fmt.Errorf("invalid schema for nodeSLO extension %s, ConfigKey is required", extKey)
+	}
+	if err := sloconfig.RegisterNodeSLOExtensionSchema(extKey, schema); err != nil {
+		return err
+	}
+	if err := RegisterNodeSLOMergedExtender(extKey, &schemaMergedPlugin{extKey: extKey, schema: schema}); err != nil {
+		sloconfig.UnregisterNodeSLOExtensionSchema(extKey)
+		return err
+	}
+	return nil
+}
+
+func UnregisterNodeSLOExtensionWithSchema(extKey string) {
+	UnregisterNodeSLOMergedExtender(extKey)
+	sloconfig.UnregisterNodeSLOExtensionSchema(extKey)
+}
+
+var _ NodeSLOMergedPlugin = &schemaMergedPlugin{}
+
+// schemaMergedPlugin merges the extension config with the registered schema.
+type schemaMergedPlugin struct {
+	extKey string
+	schema *sloconfig.NodeSLOExtensionSchema
+}
+
+func (p *schemaMergedPlugin) MergeNodeSLOExtension(oldCfgMap configuration.ExtensionCfgMap, configMap *corev1.ConfigMap, recorder record.EventRecorder) (configuration.ExtensionCfgMap, error) {
+	newCfgMap := *oldCfgMap.DeepCopy()
+	if newCfgMap.Object == nil {
+		newCfgMap.Object = map[string]configuration.ExtensionCfg{}
+	}
+	cfgStr, ok := configMap.Data[p.schema.ConfigKey]
+	if !ok {
+		delete(newCfgMap.Object, p.extKey)
+		return newCfgMap, nil
+	}
+	clusterData, nodeStrategies, err := sloconfig.ParseNodeSLOExtensionConfig(cfgStr)
+	if err != nil {
+		return oldCfgMap, fmt.Errorf("failed to parse extension %s config, err: %w", p.extKey, err)
+	}
+	extCfg := configuration.ExtensionCfg{}
+	if len(clusterData) > 0 {
+		clusterStrategy, err := p.decodeMerged(clusterData, nil)
+		if err != nil {
+			return oldCfgMap, fmt.Errorf("extension %s clusterStrategy invalid, err: %w", p.extKey, err)
+		}
+		extCfg.ClusterStrategy = clusterStrategy
+	}
+	for _, nodeStrategy := range nodeStrategies {
+		mergedStrategy, err := p.decodeMerged(clusterData, nodeStrategy.Strategy)
+		if err != nil {
+			return oldCfgMap, fmt.Errorf("extension %s nodeStrategy %s invalid, err: %w", p.extKey, nodeStrategy.Profile.Name, err)
+		}
+		extCfg.NodeStrategies = append(extCfg.NodeStrategies, configuration.NodeExtensionStrategy{
+			NodeCfgProfile: nodeStrategy.Profile,
+			NodeStrategy:   mergedStrategy,
+		})
+	}
+	newCfgMap.Object[p.extKey] = extCfg
+	return newCfgMap, nil
+}
+
+func (p *schemaMergedPlugin) GetNodeSLOExtension(node *corev1.Node, cfgMap *configuration.ExtensionCfgMap) (string, interface{}, error) {
+	extCfg, ok := cfgMap.Object[p.extKey]
+	if !ok {
+		return p.extKey, nil, nil
+	}
+	nodeLabels := labels.Set(node.Labels)
+	for _, nodeStrategy := range extCfg.NodeStrategies {
+		selector, err := metav1.LabelSelectorAsSelector(nodeStrategy.NodeSelector)
+		if err != nil {
+			klog.Errorf("failed to parse node selector %v for extension %s, err: %v", nodeStrategy.NodeSelector, p.extKey, err)
+			continue
+		}
+		if selector.Matches(nodeLabels) {
+			return p.extKey, deepcopy.Copy(nodeStrategy.NodeStrategy), nil
+		}
+	}
+	return p.extKey, deepcopy.Copy(extCfg.ClusterStrategy), nil
+}
+
+// decodeMerged decodes and validates the merged strategy, and returns it in the unstructured form as it is in the
+// NodeSLO spec, so that the unchanged strategy is not regarded as changed.
+func (p *schemaMergedPlugin) decodeMerged(clusterData, nodeData []byte) (map[string]interface{}, error) {
+	strategy, err := p.schema.DecodeMergedExtensionStrategyBytes(clusterData, nodeData)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(strategy)
+	if err != nil {
+		return nil, err
+	}
+	unstructured := map[string]interface{}{}
+	if err = json.Unmarshal(data, &unstructured); err != nil {
+		return nil, err
+	}
+	return unstructured, nil
+}
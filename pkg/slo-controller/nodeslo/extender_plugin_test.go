@@ -26,6 +26,7 @@ import (
 
 	"github.com/koordinator-sh/koordinator/apis/configuration"
 	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/util/sloconfig"
 )
 
 const (
@@ -103,3 +104,84 @@ func Test_RegistAlreadyExistNodeMergedExtender(t *testing.T) {
 		UnregisterNodeSLOMergedExtender(pluginName)
 	})
 }
+
+func Test_NodeMergedExtenderWithSchema(t *testing.T) {
+	type testStrategy struct {
+		Enable *bool `json:"enable,omitempty"`
+	}
+	pluginName := "test-plugin-name"
+	err := RegisterNodeSLOMergedExtender(pluginName, &ManageNodeSLO{})
+	assert.NoError(t, err)
+	defer UnregisterNodeSLOMergedExtender(pluginName)
+	err = sloconfig.RegisterNodeSLOExtensionSchema(testExtKey, &sloconfig.NodeSLOExtensionSchema{
+		NewStrategy: func() interface{} { return &testStrategy{} },
+	})
+	assert.NoError(t, err)
+	defer sloconfig.UnregisterNodeSLOExtensionSchema(testExtKey)
+
+	oldStrategy := map[string]interface{}{"enable": true}
+	oldSpec := &slov1alpha1.NodeSLOSpec{
+		Extensions: &slov1alpha1.ExtensionsMap{
+			Object: map[string]interface{}{testExtKey: oldStrategy},
+		},
+	}
+	node := &corev1.Node{}
+
+	// valid strategy is propagated
+	cfgMap := &configuration.ExtensionCfgMap{Object: map[string]configuration.ExtensionCfg{
+		testExtKey: {ClusterStrategy: map[string]interface{}{"enable": false}},
+	}}
+	extMap := getExtensionsConfigSpec(node, oldSpec, cfgMap)
+	assert.Equal(t, map[string]interface{}{"enable": false}, extMap.Object[testExtKey])
+
+	// invalid strategy keeps the old one
+	cfgMap = &configuration.ExtensionCfgMap{Object: map[string]configuration.ExtensionCfg{
+		testExtKey: {ClusterStrategy: map[string]interface{}{"enabled": false}},
+	}}
+	extMap = getExtensionsConfigSpec(node, oldSpec, cfgMap)
+	assert.Equal(t, oldStrategy, extMap.Object[testExtKey])
+}
+
+func Test_RegisterNodeSLOExtensionWithSchema(t *testing.T) {
+	type testStrategy struct {
+		Level  *int64 `json:"level,omitempty" validate:"required,min=1"`
+		Enable *bool  `json:"enable,omitempty"`
+	}
+	testConfigKey := "test-ext-config"
+	assert.Error(t, RegisterNodeSLOExtensionWithSchema(testExtKey, &sloconfig.NodeSLOExtensionSchema{
+		NewStrategy: func() interface{} { return &testStrategy{} },
+	}), "ConfigKey is required")
+	err := RegisterNodeSLOExtensionWithSchema(testExtKey, &sloconfig.NodeSLOExtensionSchema{
+		ConfigKey:   testConfigKey,
+		NewStrategy: func() interface{} { return &testStrategy{} },
+	})
+	assert.NoError(t, err)
+	defer UnregisterNodeSLOExtensionWithSchema(testExtKey)
+	_, ok := sloconfig.GetNodeSLOExtensionSchema(testExtKey)
+	assert.True(t, ok)
+
+	configMap := &corev1.ConfigMap{
+		Data: map[string]string{
+			testConfigKey: `{"clusterStrategy":{"level":2,"enable":true},` +
+				`"nodeStrategies":[{"name":"node-a","nodeSelector":{"matchLabels":{"a":"true"}},"enable":false}]}`,
+		},
+	}
+	cfgMap := calculateExtensionsCfgMerged(configuration.ExtensionCfgMap{}, configMap, &record.FakeRecorder{})
+	nodeA := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"a": "true"}}}
+	extMap := getExtensionsConfigSpec(nodeA, nil, &cfgMap)
+	assert.Equal(t, map[string]interface{}{"level": float64(2), "enable": false}, extMap.Object[testExtKey])
+	nodeB := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{}}}
+	extMap = getExtensionsConfigSpec(nodeB, nil, &cfgMap)
+	assert.Equal(t, map[string]interface{}{"level": float64(2), "enable": true}, extMap.Object[testExtKey])
+
+	// invalid config keeps the last merged one
+	configMap.Data[testConfigKey] = `{"clusterStrategy":{"enable":true}}`
+	gotCfgMap := calculateExtensionsCfgMerged(cfgMap, configMap, &record.FakeRecorder{})
+	assert.Equal(t, cfgMap, gotCfgMap)
+
+	// the extension is removed when the config is deleted
+	delete(configMap.Data, testConfigKey)
+	gotCfgMap = calculateExtensionsCfgMerged(cfgMap, configMap, &record.FakeRecorder{})
+	extMap = getExtensionsConfigSpec(nodeB, &slov1alpha1.NodeSLOSpec{Extensions: extMap}, &gotCfgMap)
+	assert.NotContains(t, extMap.Object, testExtKey)
+}
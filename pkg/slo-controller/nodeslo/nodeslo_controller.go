@@ -238,6 +238,7 @@ func (r *NodeSLOReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			Client: r.Client,
 		}).
 		Watches(&corev1.ConfigMap{}, configMapCacheHandler).
+		Watches(&slov1alpha1.NodeSLO{}, NewNodeSLOStatusHandler()).
 		Named(Name).
 		Complete(r)
 }
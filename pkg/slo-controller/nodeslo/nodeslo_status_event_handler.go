@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeslo
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/slo-controller/metrics"
+)
+
+var _ handler.EventHandler = &NodeSLOStatusHandler{}
+
+// NodeSLOStatusHandler aggregates the NodeSLO statuses reported by koordlet into the cluster metrics.
+// It never enqueues requests since the status does not affect the NodeSLO spec.
+type NodeSLOStatusHandler struct {
+	lock      sync.Mutex
+	summaries map[string]*nodeSLOStatusSummary
+
+	featureNodes  map[featureStateKey]int
+	outdatedNodes map[string]int
+	errorNodes    map[string]int
+}
+
+type featureStateKey struct {
+	feature string
+	state   string
+}
+
+type nodeSLOStatusSummary struct {
+	features           []featureStateKey
+	outdatedStrategies []string
+	errorResources     []string
+}
+
+func NewNodeSLOStatusHandler() *NodeSLOStatusHandler {
+	return &NodeSLOStatusHandler{
+		summaries:     map[string]*nodeSLOStatusSummary{},
+		featureNodes:  map[featureStateKey]int{},
+		outdatedNodes: map[string]int{},
+		errorNodes:    map[string]int{},
+	}
+}
+
+func (h *NodeSLOStatusHandler) Create(ctx context.Context, evt event.CreateEvent, q workqueue.RateLimitingInterface) {
+	if nodeSLO, ok := evt.Object.(*slov1alpha1.NodeSLO); ok {
+		h.updateSummary(nodeSLO.Name, summarizeNodeSLOStatus(nodeSLO))
+	}
+}
+
+func (h *NodeSLOStatusHandler) Update(ctx context.Context, evt event.UpdateEvent, q workqueue.RateLimitingInterface) {
+	if nodeSLO, ok := evt.ObjectNew.(*slov1alpha1.NodeSLO); ok {
+		h.updateSummary(nodeSLO.Name, summarizeNodeSLOStatus(nodeSLO))
+	}
+}
+
+func (h *NodeSLOStatusHandler) Delete(ctx context.Context, evt event.DeleteEvent, q workqueue.RateLimitingInterface) {
+	if nodeSLO, ok := evt.Object.(*slov1alpha1.NodeSLO); ok {
+		h.updateSummary(nodeSLO.Name, nil)
+	}
+}
+
+func (h *NodeSLOStatusHandler) Generic(ctx context.Context, evt event.GenericEvent, q workqueue.RateLimitingInterface) {
+}
+
+func summarizeNodeSLOStatus(nodeSLO *slov1alpha1.NodeSLO) *nodeSLOStatusSummary {
+	summary := &nodeSLOStatusSummary{}
+	for _, f := range nodeSLO.Status.Features {
+		summary.features = append(summary.features, featureStateKey{feature: string(f.Name), state: string(f.State)})
+	}
+	for _, s := range nodeSLO.Status.Strategies {
+		if s.AppliedGeneration < nodeSLO.Generation {
+			summary.outdatedStrategies = append(summary.outdatedStrategies, s.Name)
+		}
+	}
+	for _, e := range nodeSLO.Status.Errors {
+		summary.errorResources = append(summary.errorResources, e.Resource)
+	}
+	return summary
+}
+
+// updateSummary replaces the summary of the node, and updates the metrics whose counts are changed.
+// The node summary is removed if the new summary is nil.
+func (h *NodeSLOStatusHandler) updateSummary(nodeName string, summary *nodeSLOStatusSummary) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	changedFeatures := map[featureStateKey]struct{}{}
+	changedStrategies := map[string]struct{}{}
+	changedResources := map[string]struct{}{}
+	apply := func(s *nodeSLOStatusSummary, delta int) {
+		if s == nil {
+			return
+		}
+		for _, f := range s.features {
+			h.featureNodes[f] += delta
+			changedFeatures[f] = struct{}{}
+		}
+		for _, strategy := range s.outdatedStrategies {
+			h.outdatedNodes[strategy] += delta
+			changedStrategies[strategy] = struct{}{}
+		}
+		for _, resource := range s.errorResources {
+			h.errorNodes[resource] += delta
+			changedResources[resource] = struct{}{}
+		}
+	}
+	apply(h.summaries[nodeName], -1)
+	apply(summary, 1)
+	if summary == nil {
+		delete(h.summaries, nodeName)
+	} else {
+		h.summaries[nodeName] = summary
+	}
+
+	for f := range changedFeatures {
+		metrics.RecordNodeSLOFeatureNodes(f.feature, f.state, h.featureNodes[f])
+	}
+	for strategy := range changedStrategies {
+		metrics.RecordNodeSLOStrategyOutdatedNodes(strategy, h.outdatedNodes[strategy])
+	}
+	for resource := range changedResources {
+		metrics.RecordNodeSLOEnforcementErrorNodes(resource, h.errorNodes[resource])
+	}
+}
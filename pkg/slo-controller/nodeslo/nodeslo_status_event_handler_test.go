@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeslo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/slo-controller/metrics"
+)
+
+func TestNodeSLOStatusHandler(t *testing.T) {
+	newNodeSLO := func(name string, generation int64, gi slov1alpha1.NodeSLOFeatureState, applied int64, errResource string) *slov1alpha1.NodeSLO {
+		nodeSLO := &slov1alpha1.NodeSLO{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Generation: generation},
+			Status: slov1alpha1.NodeSLOStatus{
+				Features: []slov1alpha1.NodeSLOFeatureStatus{
+					{Name: slov1alpha1.NodeSLOFeatureGroupIdentity, State: gi},
+				},
+				Strategies: []slov1alpha1.NodeSLOStrategyStatus{
+					{Name: "cgroupReconcile", AppliedGeneration: applied},
+				},
+			},
+		}
+		if errResource != "" {
+			nodeSLO.Status.Errors = []slov1alpha1.NodeSLOEnforcementError{{Resource: errResource, Count: 1}}
+		}
+		return nodeSLO
+	}
+	featureNodes := func(state slov1alpha1.NodeSLOFeatureState) float64 {
+		return testutil.ToFloat64(metrics.NodeSLOFeatureNodes.With(prometheus.Labels{
+			metrics.FeatureKey: string(slov1alpha1.NodeSLOFeatureGroupIdentity), metrics.StateKey: string(state)}))
+	}
+	outdatedNodes := func() float64 {
+		return testutil.ToFloat64(metrics.NodeSLOStrategyOutdatedNodes.With(prometheus.Labels{metrics.StrategyKey: "cgroupReconcile"}))
+	}
+	errorNodes := func() float64 {
+		return testutil.ToFloat64(metrics.NodeSLOEnforcementErrorNodes.With(prometheus.Labels{metrics.ResourceKey: "cpu.shares"}))
+	}
+
+	h := NewNodeSLOStatusHandler()
+	h.Create(context.TODO(), event.CreateEvent{Object: newNodeSLO("node-0", 2, slov1alpha1.NodeSLOFeatureEffective, 2, "")}, nil)
+	h.Create(context.TODO(), event.CreateEvent{Object: newNodeSLO("node-1", 2, slov1alpha1.NodeSLOFeatureUnsupported, 1, "cpu.shares")}, nil)
+	assert.Equal(t, float64(1), featureNodes(slov1alpha1.NodeSLOFeatureEffective))
+	assert.Equal(t, float64(1), featureNodes(slov1alpha1.NodeSLOFeatureUnsupported))
+	assert.Equal(t, float64(1), outdatedNodes())
+	assert.Equal(t, float64(1), errorNodes())
+
+	// node-0 becomes outdated and gets errors
+	h.Update(context.TODO(), event.UpdateEvent{
+		ObjectOld: newNodeSLO("node-0", 2, slov1alpha1.NodeSLOFeatureEffective, 2, ""),
+		ObjectNew: newNodeSLO("node-0", 3, slov1alpha1.NodeSLOFeatureEffective, 2, "cpu.shares"),
+	}, nil)
+	assert.Equal(t, float64(1), featureNodes(slov1alpha1.NodeSLOFeatureEffective))
+	assert.Equal(t, float64(2), outdatedNodes())
+	assert.Equal(t, float64(2), errorNodes())
+
+	// node-1 is deleted
+	h.Delete(context.TODO(), event.DeleteEvent{Object: newNodeSLO("node-1", 2, slov1alpha1.NodeSLOFeatureUnsupported, 1, "cpu.shares")}, nil)
+	assert.Equal(t, float64(0), featureNodes(slov1alpha1.NodeSLOFeatureUnsupported))
+	assert.Equal(t, float64(1), outdatedNodes())
+	assert.Equal(t, float64(1), errorNodes())
+	assert.Equal(t, 1, len(h.summaries))
+}
@@ -29,6 +29,9 @@ import (
 	apiv1alpha1 "github.com/koordinator-sh/koordinator/apis/thirdparty/scheduler-plugins/pkg/apis/scheduling/v1alpha1"
 )
 
+// IndexPodByColocationProfile indexes the pods by the colocation profiles recorded in the pod annotation.
+const IndexPodByColocationProfile = "annotation.colocationProfiles"
+
 var registerOnce sync.Once
 
 type fieldIndexDescriptor struct {
@@ -68,6 +71,18 @@ var indexDescriptors = []fieldIndexDescriptor{
 			return []string{pod.Labels[extension.LabelQuotaName]}
 		},
 	},
+	{
+		description: "index pod by annotation.colocationProfiles",
+		obj:         &corev1.Pod{},
+		field:       IndexPodByColocationProfile,
+		indexerFunc: func(obj client.Object) []string {
+			pod, ok := obj.(*corev1.Pod)
+			if !ok {
+				return []string{}
+			}
+			return extension.GetColocationProfilesOfPod(pod)
+		},
+	},
 	{
 		description: "index elastic quota by annotation.namespaces",
 		obj:         &apiv1alpha1.ElasticQuota{},
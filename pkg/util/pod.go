@@ -18,8 +18,11 @@ package util
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 
 	apiext "github.com/koordinator-sh/koordinator/apis/extension"
 	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
@@ -83,3 +86,78 @@ func GetCPUSetFromPod(podAnnotations map[string]string) (string, error) {
 	}
 	return podAlloc.CPUSet, nil
 }
+
+// GetPodChangedFields returns the sorted paths of the fields changed from the oldPod to the newPod,
+// e.g. labels/<key>, annotations/<key>, spec.schedulerName and spec.containers[<name>].resources.
+// The spec fields not listed explicitly are reported as spec.
+func GetPodChangedFields(oldPod, newPod *corev1.Pod) []string {
+	var fields []string
+	fields = append(fields, getChangedMapKeys("labels", oldPod.Labels, newPod.Labels)...)
+	fields = append(fields, getChangedMapKeys("annotations", oldPod.Annotations, newPod.Annotations)...)
+
+	oldSpec, newSpec := oldPod.Spec.DeepCopy(), newPod.Spec.DeepCopy()
+	if oldSpec.SchedulerName != newSpec.SchedulerName {
+		fields = append(fields, "spec.schedulerName")
+	}
+	if oldSpec.PriorityClassName != newSpec.PriorityClassName {
+		fields = append(fields, "spec.priorityClassName")
+	}
+	if !reflect.DeepEqual(oldSpec.Priority, newSpec.Priority) {
+		fields = append(fields, "spec.priority")
+	}
+	if !reflect.DeepEqual(oldSpec.PreemptionPolicy, newSpec.PreemptionPolicy) {
+		fields = append(fields, "spec.preemptionPolicy")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Overhead, newSpec.Overhead) {
+		fields = append(fields, "spec.overhead")
+	}
+	fields = append(fields, getChangedContainerResources("spec.initContainers", oldSpec.InitContainers, newSpec.InitContainers)...)
+	fields = append(fields, getChangedContainerResources("spec.containers", oldSpec.Containers, newSpec.Containers)...)
+
+	// compare the rest of the spec
+	for _, spec := range []*corev1.PodSpec{oldSpec, newSpec} {
+		spec.SchedulerName, spec.PriorityClassName, spec.Priority, spec.PreemptionPolicy, spec.Overhead = "", "", nil, nil, nil
+		for i := range spec.InitContainers {
+			spec.InitContainers[i].Resources = corev1.ResourceRequirements{}
+		}
+		for i := range spec.Containers {
+			spec.Containers[i].Resources = corev1.ResourceRequirements{}
+		}
+	}
+	if !equality.Semantic.DeepEqual(oldSpec, newSpec) {
+		fields = append(fields, "spec")
+	}
+
+	sort.Strings(fields)
+	return fields
+}
+
+func getChangedMapKeys(prefix string, oldMap, newMap map[string]string) []string {
+	var keys []string
+	for k, v := range newMap {
+		if oldV, ok := oldMap[k]; !ok || oldV != v {
+			keys = append(keys, prefix+"/"+k)
+		}
+	}
+	for k := range oldMap {
+		if _, ok := newMap[k]; !ok {
+			keys = append(keys, prefix+"/"+k)
+		}
+	}
+	return keys
+}
+
+func getChangedContainerResources(prefix string, oldContainers, newContainers []corev1.Container) []string {
+	oldResources := map[string]corev1.ResourceRequirements{}
+	for i := range oldContainers {
+		oldResources[oldContainers[i].Name] = oldContainers[i].Resources
+	}
+	var fields []string
+	for i := range newContainers {
+		c := &newContainers[i]
+		if r, ok := oldResources[c.Name]; ok && !equality.Semantic.DeepEqual(r, c.Resources) {
+			fields = append(fields, fmt.Sprintf("%s[%s].resources", prefix, c.Name))
+		}
+	}
+	return fields
+}
@@ -20,6 +20,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	apiext "github.com/koordinator-sh/koordinator/apis/extension"
 )
@@ -59,3 +62,66 @@ func Test_GetCPUSetFromPod(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPodChangedFields(t *testing.T) {
+	oldPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-pod",
+			Labels: map[string]string{
+				"a": "1",
+				"b": "2",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "main",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("1"),
+						},
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name   string
+		mutate func(pod *corev1.Pod)
+		want   []string
+	}{
+		{
+			name:   "nothing changed",
+			mutate: func(pod *corev1.Pod) {},
+			want:   nil,
+		},
+		{
+			name: "labels and annotations changed",
+			mutate: func(pod *corev1.Pod) {
+				pod.Labels["a"] = "3"
+				delete(pod.Labels, "b")
+				pod.Annotations = map[string]string{"c": "4"}
+			},
+			want: []string{"annotations/c", "labels/a", "labels/b"},
+		},
+		{
+			name: "spec changed",
+			mutate: func(pod *corev1.Pod) {
+				pod.Spec.SchedulerName = "koord-scheduler"
+				pod.Spec.Containers[0].Resources.Requests = corev1.ResourceList{
+					apiext.BatchCPU: resource.MustParse("1000"),
+				}
+				pod.Spec.NodeSelector = map[string]string{"d": "5"}
+			},
+			want: []string{"spec", "spec.containers[main].resources", "spec.schedulerName"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newPod := oldPod.DeepCopy()
+			tt.mutate(newPod)
+			got := GetPodChangedFields(oldPod, newPod)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -32,7 +32,9 @@ import (
 	"github.com/koordinator-sh/koordinator/apis/configuration"
 	"github.com/koordinator-sh/koordinator/apis/extension"
 	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/features"
 	"github.com/koordinator-sh/koordinator/pkg/util"
+	utilfeature "github.com/koordinator-sh/koordinator/pkg/util/feature"
 )
 
 func NewDefaultColocationCfg() *configuration.ColocationCfg {
@@ -60,17 +62,19 @@ func DefaultColocationStrategy() configuration.ColocationStrategy {
 				{Duration: 30 * time.Minute},
 			},
 		},
-		MetricMemoryCollectPolicy:     &defaultMemoryCollectPolicy,
-		CPUReclaimThresholdPercent:    pointer.Int64(60),
-		CPUCalculatePolicy:            &cpuCalculatePolicy,
-		MemoryReclaimThresholdPercent: pointer.Int64(65),
-		MemoryCalculatePolicy:         &memoryCalculatePolicy,
-		DegradeTimeMinutes:            pointer.Int64(15),
-		UpdateTimeThresholdSeconds:    pointer.Int64(300),
-		ResourceDiffThreshold:         pointer.Float64(0.1),
-		MidCPUThresholdPercent:        pointer.Int64(100),
-		MidMemoryThresholdPercent:     pointer.Int64(100),
-		MidUnallocatedPercent:         pointer.Int64(0),
+		MetricMemoryCollectPolicy:               &defaultMemoryCollectPolicy,
+		CPUReclaimThresholdPercent:              pointer.Int64(60),
+		CPUCalculatePolicy:                      &cpuCalculatePolicy,
+		MemoryReclaimThresholdPercent:           pointer.Int64(65),
+		MemoryCalculatePolicy:                   &memoryCalculatePolicy,
+		DegradeTimeMinutes:                      pointer.Int64(15),
+		UpdateTimeThresholdSeconds:              pointer.Int64(300),
+		ResourceDiffThreshold:                   pointer.Float64(0.1),
+		EphemeralStorageReclaimThresholdPercent: pointer.Int64(65),
+		GPUReclaimThresholdPercent:              pointer.Int64(60),
+		MidCPUThresholdPercent:                  pointer.Int64(100),
+		MidMemoryThresholdPercent:               pointer.Int64(100),
+		MidUnallocatedPercent:                   pointer.Int64(0),
 	}
 	cfg.ColocationStrategyExtender = defaultColocationStrategyExtender
 	return cfg
@@ -86,6 +90,8 @@ func IsColocationStrategyValid(strategy *configuration.ColocationStrategy) bool
 		(strategy.UpdateTimeThresholdSeconds == nil || *strategy.UpdateTimeThresholdSeconds > 0) &&
 		(strategy.ResourceDiffThreshold == nil || *strategy.ResourceDiffThreshold > 0) &&
 		(strategy.MetricMemoryCollectPolicy == nil || len(*strategy.MetricMemoryCollectPolicy) > 0) &&
+		(strategy.EphemeralStorageReclaimThresholdPercent == nil || (*strategy.EphemeralStorageReclaimThresholdPercent >= 0 && *strategy.EphemeralStorageReclaimThresholdPercent <= 100)) &&
+		(strategy.GPUReclaimThresholdPercent == nil || (*strategy.GPUReclaimThresholdPercent >= 0 && *strategy.GPUReclaimThresholdPercent <= 100)) &&
 		(strategy.MidCPUThresholdPercent == nil || (*strategy.MidCPUThresholdPercent >= 0 && *strategy.MidCPUThresholdPercent <= 100)) &&
 		(strategy.MidMemoryThresholdPercent == nil || (*strategy.MidMemoryThresholdPercent >= 0 && *strategy.MidMemoryThresholdPercent <= 100)) &&
 		(strategy.MidUnallocatedPercent == nil || (*strategy.MidUnallocatedPercent >= 0 && *strategy.MidUnallocatedPercent <= 100))
@@ -135,6 +141,20 @@ func GetNodeColocationStrategy(cfg *configuration.ColocationCfg, node *corev1.No
 }
 
 func UpdateColocationStrategyForNode(strategy *configuration.ColocationStrategy, node *corev1.Node) {
+	// the strategy rolled out by the ClusterColocationStrategy is ignored when the controller is disabled, since
+	// the stale annotations are no longer cleaned up
+	if utilfeature.DefaultFeatureGate.Enabled(features.ColocationStrategyController) {
+		clusterStrategyOnNode, err := GetClusterColocationStrategyOnNode(node)
+		if err != nil {
+			klog.V(5).Infof("failed to parse cluster colocation strategy for node %s, err: %s", node.Name, err)
+		} else if clusterStrategyOnNode != nil {
+			merged, _ := util.MergeCfg(strategy, clusterStrategyOnNode)
+			*strategy = *(merged.(*configuration.ColocationStrategy))
+			klog.V(6).Infof("node %s use merged colocation strategy from ClusterColocationStrategy %s, merged: %+v",
+				node.Name, node.Annotations[extension.AnnotationNodeColocationStrategyRevision], strategy)
+		}
+	}
+
 	strategyOnNode, err := GetColocationStrategyOnNode(node)
 	if err != nil {
 		klog.V(5).Infof("failed to parse node colocation strategy for node %s, err: %s", node.Name, err)
@@ -162,11 +182,21 @@ func UpdateColocationStrategyForNode(strategy *configuration.ColocationStrategy,
 
 // GetColocationStrategyOnNode gets the colocation strategy in the node annotations.
 func GetColocationStrategyOnNode(node *corev1.Node) (*configuration.ColocationStrategy, error) {
+	return getColocationStrategyInAnnotation(node, extension.AnnotationNodeColocationStrategy)
+}
+
+// GetClusterColocationStrategyOnNode gets the colocation strategy rolled out by the ClusterColocationStrategy
+// in the node annotations.
+func GetClusterColocationStrategyOnNode(node *corev1.Node) (*configuration.ColocationStrategy, error) {
+	return getColocationStrategyInAnnotation(node, extension.AnnotationNodeClusterColocationStrategy)
+}
+
+func getColocationStrategyInAnnotation(node *corev1.Node, key string) (*configuration.ColocationStrategy, error) {
 	if node.Annotations == nil {
 		return nil, nil
 	}
 
-	s, ok := node.Annotations[extension.AnnotationNodeColocationStrategy]
+	s, ok := node.Annotations[key]
 	if !ok {
 		return nil, nil
 	}
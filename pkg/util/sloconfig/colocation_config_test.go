@@ -28,6 +28,8 @@ import (
 	"github.com/koordinator-sh/koordinator/apis/configuration"
 	"github.com/koordinator-sh/koordinator/apis/extension"
 	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+	"github.com/koordinator-sh/koordinator/pkg/features"
+	utilfeature "github.com/koordinator-sh/koordinator/pkg/util/feature"
 )
 
 func Test_GetNodeColocationStrategy(t *testing.T) {
@@ -186,21 +188,23 @@ func Test_GetNodeColocationStrategy(t *testing.T) {
 				},
 			},
 			want: &configuration.ColocationStrategy{
-				Enable:                         pointer.Bool(true),
-				MetricAggregateDurationSeconds: pointer.Int64(300),
-				MetricReportIntervalSeconds:    pointer.Int64(60),
-				MetricAggregatePolicy:          DefaultColocationStrategy().MetricAggregatePolicy,
-				CPUReclaimThresholdPercent:     pointer.Int64(60),
-				CPUCalculatePolicy:             &cpuCalcPolicyByUsage,
-				MemoryReclaimThresholdPercent:  pointer.Int64(65),
-				MemoryCalculatePolicy:          &memoryCalcPolicyByUsage,
-				DegradeTimeMinutes:             pointer.Int64(15),
-				UpdateTimeThresholdSeconds:     pointer.Int64(300),
-				ResourceDiffThreshold:          pointer.Float64(0.1),
-				MetricMemoryCollectPolicy:      &defaultMemoryCollectPolicy,
-				MidCPUThresholdPercent:         pointer.Int64(100),
-				MidMemoryThresholdPercent:      pointer.Int64(100),
-				MidUnallocatedPercent:          pointer.Int64(0),
+				Enable:                                  pointer.Bool(true),
+				MetricAggregateDurationSeconds:          pointer.Int64(300),
+				MetricReportIntervalSeconds:             pointer.Int64(60),
+				MetricAggregatePolicy:                   DefaultColocationStrategy().MetricAggregatePolicy,
+				CPUReclaimThresholdPercent:              pointer.Int64(60),
+				CPUCalculatePolicy:                      &cpuCalcPolicyByUsage,
+				MemoryReclaimThresholdPercent:           pointer.Int64(65),
+				MemoryCalculatePolicy:                   &memoryCalcPolicyByUsage,
+				DegradeTimeMinutes:                      pointer.Int64(15),
+				UpdateTimeThresholdSeconds:              pointer.Int64(300),
+				ResourceDiffThreshold:                   pointer.Float64(0.1),
+				MetricMemoryCollectPolicy:               &defaultMemoryCollectPolicy,
+				EphemeralStorageReclaimThresholdPercent: pointer.Int64(65),
+				GPUReclaimThresholdPercent:              pointer.Int64(60),
+				MidCPUThresholdPercent:                  pointer.Int64(100),
+				MidMemoryThresholdPercent:               pointer.Int64(100),
+				MidUnallocatedPercent:                   pointer.Int64(0),
 			},
 		},
 		{
@@ -420,9 +424,10 @@ func TestUpdateColocationStrategyForNode(t *testing.T) {
 		node     *corev1.Node
 	}
 	tests := []struct {
-		name      string
-		args      args
-		wantField *configuration.ColocationStrategy
+		name                string
+		args                args
+		disableStrategyGate bool
+		wantField           *configuration.ColocationStrategy
 	}{
 		{
 			name: "no node-level modification",
@@ -478,9 +483,47 @@ func TestUpdateColocationStrategyForNode(t *testing.T) {
 			},
 			wantField: cfg2,
 		},
+		{
+			name: "update strategy according to cluster strategy and node annotations",
+			args: args{
+				strategy: defaultCfg.DeepCopy(),
+				node: &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							extension.AnnotationNodeClusterColocationStrategy:  `{"enable": true, "cpuReclaimThresholdPercent": 70}`,
+							extension.AnnotationNodeColocationStrategyRevision: `pool-a/123456`,
+							extension.AnnotationNodeColocationStrategy:         `{"enable": false}`,
+						},
+					},
+				},
+			},
+			wantField: func() *configuration.ColocationStrategy {
+				cfg := disabledCfg.DeepCopy()
+				cfg.CPUReclaimThresholdPercent = pointer.Int64(70)
+				return cfg
+			}(),
+		},
+		{
+			name: "ignore cluster strategy when the strategy controller is disabled",
+			args: args{
+				strategy: defaultCfg.DeepCopy(),
+				node: &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							extension.AnnotationNodeClusterColocationStrategy:  `{"enable": true, "cpuReclaimThresholdPercent": 70}`,
+							extension.AnnotationNodeColocationStrategyRevision: `pool-a/123456`,
+							extension.AnnotationNodeColocationStrategy:         `{"enable": false}`,
+						},
+					},
+				},
+			},
+			disableStrategyGate: true,
+			wantField:           disabledCfg,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			defer utilfeature.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.ColocationStrategyController, !tt.disableStrategyGate)()
 			UpdateColocationStrategyForNode(tt.args.strategy, tt.args.node)
 			assert.Equal(t, tt.wantField, tt.args.strategy)
 		})
@@ -0,0 +1,217 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sloconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/koordinator-sh/koordinator/apis/configuration"
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+)
+
+var (
+	nodeSLOExtensionSchemasLock sync.RWMutex
+	nodeSLOExtensionSchemas     = map[string]*NodeSLOExtensionSchema{}
+)
+
+// NodeSLOExtensionSchema is the typed schema of a NodeSLO extension. It is registered by the extension plugin with
+// the extension key in the NodeSLO spec, so that the slo-controller ConfigMap webhook can validate the extension
+// config, and the koordlet can decode the extension into the typed strategy.
+type NodeSLOExtensionSchema struct {
+	// ConfigKey is the key of the extension config in the slo-controller ConfigMap, whose format is the
+	// `ExtensionCfg`. The config is not checked by the webhook if the key is empty.
+	ConfigKey string
+	// NewStrategy returns an empty typed strategy, e.g. `&MyStrategy{}`. The unknown fields are rejected when
+	// decoding, and the fields are validated with the `validate` tags.
+	NewStrategy func() interface{}
+	// Validate additionally validates the decoded strategy. It is optional.
+	Validate func(strategy interface{}) error
+}
+
+func RegisterNodeSLOExtensionSchema(extKey string, schema *NodeSLOExtensionSchema) error {
+	if schema == nil || schema.NewStrategy == nil {
+		return fmt.Errorf("invalid schema for nodeSLO extension %s, NewStrategy is required", extKey)
+	}
+	nodeSLOExtensionSchemasLock.Lock()
+	defer nodeSLOExtensionSchemasLock.Unlock()
+	if _, exist := nodeSLOExtensionSchemas[extKey]; exist {
+		return fmt.Errorf("schema of nodeSLO extension %s already exist", extKey)
+	}
+	nodeSLOExtensionSchemas[extKey] = schema
+	return nil
+}
+
+func UnregisterNodeSLOExtensionSchema(extKey string) {
+	nodeSLOExtensionSchemasLock.Lock()
+	defer nodeSLOExtensionSchemasLock.Unlock()
+	delete(nodeSLOExtensionSchemas, extKey)
+}
+
+func GetNodeSLOExtensionSchema(extKey string) (*NodeSLOExtensionSchema, bool) {
+	nodeSLOExtensionSchemasLock.RLock()
+	defer nodeSLOExtensionSchemasLock.RUnlock()
+	schema, ok := nodeSLOExtensionSchemas[extKey]
+	return schema, ok
+}
+
+// GetNodeSLOExtensionSchemaKeys returns the sorted extension keys of the registered schemas.
+func GetNodeSLOExtensionSchemaKeys() []string {
+	nodeSLOExtensionSchemasLock.RLock()
+	defer nodeSLOExtensionSchemasLock.RUnlock()
+	keys := make([]string, 0, len(nodeSLOExtensionSchemas))
+	for extKey := range nodeSLOExtensionSchemas {
+		keys = append(keys, extKey)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// DecodeExtensionStrategy decodes the raw strategy into the typed strategy of the schema and validates it.
+func (s *NodeSLOExtensionSchema) DecodeExtensionStrategy(raw interface{}) (interface{}, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal strategy, err: %w", err)
+	}
+	return s.DecodeExtensionStrategyBytes(data)
+}
+
+// DecodeExtensionStrategyBytes decodes the strategy json into the typed strategy of the schema and validates it.
+func (s *NodeSLOExtensionSchema) DecodeExtensionStrategyBytes(data []byte) (interface{}, error) {
+	strategy := s.NewStrategy()
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(strategy); err != nil {
+		return nil, fmt.Errorf("failed to decode strategy, err: %w", err)
+	}
+	if err := s.ValidateExtensionStrategy(strategy); err != nil {
+		return nil, err
+	}
+	return strategy, nil
+}
+
+// DecodeMergedExtensionStrategyBytes decodes the node strategy json merged on the cluster strategy json into the
+// typed strategy of the schema and validates the merged one, since a node strategy only overrides some fields of the
+// cluster strategy, e.g. the required fields can be omitted in the node strategy.
+func (s *NodeSLOExtensionSchema) DecodeMergedExtensionStrategyBytes(clusterData, nodeData []byte) (interface{}, error) {
+	strategy := s.NewStrategy()
+	for _, data := range [][]byte{clusterData, nodeData} {
+		if len(data) <= 0 || string(data) == "null" {
+			continue
+		}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(strategy); err != nil {
+			return nil, fmt.Errorf("failed to decode strategy, err: %w", err)
+		}
+	}
+	if err := s.ValidateExtensionStrategy(strategy); err != nil {
+		return nil, err
+	}
+	return strategy, nil
+}
+
+// ValidateExtensionStrategy validates the typed strategy with the `validate` tags and the Validate function.
+func (s *NodeSLOExtensionSchema) ValidateExtensionStrategy(strategy interface{}) error {
+	info, err := GetValidatorInstance().StructWithTrans(strategy)
+	if err != nil {
+		return fmt.Errorf("failed to validate strategy, err: %w", err)
+	}
+	if len(info) > 0 {
+		var messages []string
+		for _, msg := range info {
+			messages = append(messages, msg)
+		}
+		sort.Strings(messages)
+		return fmt.Errorf("invalid strategy, %s", strings.Join(messages, "; "))
+	}
+	if s.Validate != nil {
+		if err = s.Validate(strategy); err != nil {
+			return fmt.Errorf("invalid strategy, %w", err)
+		}
+	}
+	return nil
+}
+
+// NodeSLOExtensionNodeStrategy is a node strategy of the extension config in the slo-controller ConfigMap.
+type NodeSLOExtensionNodeStrategy struct {
+	Profile configuration.NodeCfgProfile
+	// Strategy is the json of the strategy fields inlined in the node strategy.
+	Strategy json.RawMessage
+}
+
+// ParseNodeSLOExtensionConfig parses the extension config whose format is the `ExtensionCfg`, and the node
+// strategies are split into the node profiles and the inline strategy fields.
+func ParseNodeSLOExtensionConfig(configStr string) (json.RawMessage, []NodeSLOExtensionNodeStrategy, error) {
+	cfg := struct {
+		ClusterStrategy json.RawMessage              `json:"clusterStrategy,omitempty"`
+		NodeStrategies  []map[string]json.RawMessage `json:"nodeStrategies,omitempty"`
+	}{}
+	if err := json.Unmarshal([]byte(configStr), &cfg); err != nil {
+		return nil, nil, err
+	}
+	var clusterStrategy json.RawMessage
+	if string(cfg.ClusterStrategy) != "null" {
+		clusterStrategy = cfg.ClusterStrategy
+	}
+	nodeStrategies := make([]NodeSLOExtensionNodeStrategy, 0, len(cfg.NodeStrategies))
+	for _, nodeCfg := range cfg.NodeStrategies {
+		profileFields := map[string]json.RawMessage{}
+		strategyFields := map[string]json.RawMessage{}
+		for field, value := range nodeCfg {
+			if field == "name" || field == "nodeSelector" {
+				profileFields[field] = value
+			} else {
+				strategyFields[field] = value
+			}
+		}
+		nodeStrategy := NodeSLOExtensionNodeStrategy{}
+		profileBytes, err := json.Marshal(profileFields)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = json.Unmarshal(profileBytes, &nodeStrategy.Profile); err != nil {
+			return nil, nil, err
+		}
+		if nodeStrategy.Strategy, err = json.Marshal(strategyFields); err != nil {
+			return nil, nil, err
+		}
+		nodeStrategies = append(nodeStrategies, nodeStrategy)
+	}
+	return clusterStrategy, nodeStrategies, nil
+}
+
+// DecodeNodeSLOExtension decodes the extension of the NodeSLO into the typed strategy of the registered schema.
+// It returns nil if the extension is not set in the NodeSLO.
+func DecodeNodeSLOExtension(nodeSLO *slov1alpha1.NodeSLO, extKey string) (interface{}, error) {
+	schema, ok := GetNodeSLOExtensionSchema(extKey)
+	if !ok {
+		return nil, fmt.Errorf("schema of nodeSLO extension %s not registered", extKey)
+	}
+	if nodeSLO == nil || nodeSLO.Spec.Extensions == nil || nodeSLO.Spec.Extensions.Object == nil {
+		return nil, nil
+	}
+	raw, ok := nodeSLO.Spec.Extensions.Object[extKey]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	return schema.DecodeExtensionStrategy(raw)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sloconfig
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+
+	slov1alpha1 "github.com/koordinator-sh/koordinator/apis/slo/v1alpha1"
+)
+
+type testExtensionStrategy struct {
+	Enable  *bool  `json:"enable,omitempty"`
+	Percent *int64 `json:"percent,omitempty" validate:"omitempty,min=0,max=100"`
+	Mode    string `json:"mode,omitempty"`
+}
+
+func newTestExtensionSchema() *NodeSLOExtensionSchema {
+	return &NodeSLOExtensionSchema{
+		ConfigKey:   "test-ext-config",
+		NewStrategy: func() interface{} { return &testExtensionStrategy{} },
+		Validate: func(strategy interface{}) error {
+			s := strategy.(*testExtensionStrategy)
+			if s.Mode != "" && s.Mode != "auto" {
+				return fmt.Errorf("unknown mode %s", s.Mode)
+			}
+			return nil
+		},
+	}
+}
+
+func TestRegisterNodeSLOExtensionSchema(t *testing.T) {
+	assert.Error(t, RegisterNodeSLOExtensionSchema("testExt", nil))
+	assert.Error(t, RegisterNodeSLOExtensionSchema("testExt", &NodeSLOExtensionSchema{}))
+	assert.NoError(t, RegisterNodeSLOExtensionSchema("testExt", newTestExtensionSchema()))
+	defer UnregisterNodeSLOExtensionSchema("testExt")
+	assert.Error(t, RegisterNodeSLOExtensionSchema("testExt", newTestExtensionSchema()), "register duplicate")
+	assert.NoError(t, RegisterNodeSLOExtensionSchema("aaExt", newTestExtensionSchema()))
+	assert.Equal(t, []string{"aaExt", "testExt"}, GetNodeSLOExtensionSchemaKeys())
+	UnregisterNodeSLOExtensionSchema("aaExt")
+	_, ok := GetNodeSLOExtensionSchema("aaExt")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"testExt"}, GetNodeSLOExtensionSchemaKeys())
+}
+
+func TestNodeSLOExtensionSchema_DecodeExtensionStrategy(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "decode valid strategy",
+			raw:  map[string]interface{}{"enable": true, "percent": 50, "mode": "auto"},
+			want: &testExtensionStrategy{Enable: pointer.Bool(true), Percent: pointer.Int64(50), Mode: "auto"},
+		},
+		{
+			name:    "reject unknown field",
+			raw:     map[string]interface{}{"enabled": true},
+			wantErr: true,
+		},
+		{
+			name:    "reject invalid type",
+			raw:     map[string]interface{}{"percent": "50"},
+			wantErr: true,
+		},
+		{
+			name:    "reject by validate tag",
+			raw:     map[string]interface{}{"percent": 101},
+			wantErr: true,
+		},
+		{
+			name:    "reject by validate function",
+			raw:     map[string]interface{}{"mode": "manual"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newTestExtensionSchema().DecodeExtensionStrategy(tt.raw)
+			assert.Equal(t, tt.wantErr, err != nil, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecodeNodeSLOExtension(t *testing.T) {
+	nodeSLO := &slov1alpha1.NodeSLO{
+		Spec: slov1alpha1.NodeSLOSpec{
+			Extensions: &slov1alpha1.ExtensionsMap{
+				Object: map[string]interface{}{
+					"testExt": map[string]interface{}{"enable": true},
+				},
+			},
+		},
+	}
+	_, err := DecodeNodeSLOExtension(nodeSLO, "testExt")
+	assert.Error(t, err, "schema not registered")
+
+	assert.NoError(t, RegisterNodeSLOExtensionSchema("testExt", newTestExtensionSchema()))
+	defer UnregisterNodeSLOExtensionSchema("testExt")
+	got, err := DecodeNodeSLOExtension(nodeSLO, "testExt")
+	assert.NoError(t, err)
+	assert.Equal(t, &testExtensionStrategy{Enable: pointer.Bool(true)}, got)
+
+	got, err = DecodeNodeSLOExtension(&slov1alpha1.NodeSLO{}, "testExt")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestNodeSLOExtensionSchema_DecodeMergedExtensionStrategyBytes(t *testing.T) {
+	schema := newTestExtensionSchema()
+	got, err := schema.DecodeMergedExtensionStrategyBytes([]byte(`{"enable":true,"percent":50}`), []byte(`{"percent":20}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &testExtensionStrategy{Enable: pointer.Bool(true), Percent: pointer.Int64(20)}, got)
+
+	got, err = schema.DecodeMergedExtensionStrategyBytes(nil, []byte(`{"mode":"auto"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &testExtensionStrategy{Mode: "auto"}, got)
+
+	_, err = schema.DecodeMergedExtensionStrategyBytes([]byte(`{"enable":true}`), []byte(`{"percent":101}`))
+	assert.Error(t, err)
+	_, err = schema.DecodeMergedExtensionStrategyBytes([]byte(`{"enable":true}`), []byte(`{"enabled":true}`))
+	assert.Error(t, err)
+}
+
+func TestParseNodeSLOExtensionConfig(t *testing.T) {
+	clusterStrategy, nodeStrategies, err := ParseNodeSLOExtensionConfig(`{"clusterStrategy":{"enable":true},` +
+		`"nodeStrategies":[{"name":"node-a","nodeSelector":{"matchLabels":{"a":"true"}},"percent":20}]}`)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"enable":true}`, string(clusterStrategy))
+	assert.Len(t, nodeStrategies, 1)
+	assert.Equal(t, "node-a", nodeStrategies[0].Profile.Name)
+	assert.Equal(t, map[string]string{"a": "true"}, nodeStrategies[0].Profile.NodeSelector.MatchLabels)
+	assert.JSONEq(t, `{"percent":20}`, string(nodeStrategies[0].Strategy))
+
+	clusterStrategy, nodeStrategies, err = ParseNodeSLOExtensionConfig(`{}`)
+	assert.NoError(t, err)
+	assert.Nil(t, clusterStrategy)
+	assert.Empty(t, nodeStrategies)
+
+	_, _, err = ParseNodeSLOExtensionConfig(`{"clusterStrategy":`)
+	assert.Error(t, err)
+}
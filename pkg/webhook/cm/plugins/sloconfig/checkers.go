@@ -38,13 +38,14 @@ func CreateCheckersChanged(oldConfig *corev1.ConfigMap, config *corev1.ConfigMap
 }
 
 func CreateCheckersAll(oldConfig *corev1.ConfigMap, config *corev1.ConfigMap, needUnmarshal bool) checkers {
-	return checkers{
+	all := checkers{
 		NewColocationConfigChecker(oldConfig, config, needUnmarshal),
 		NewResourceThresholdChecker(oldConfig, config, needUnmarshal),
 		NewResourceQOSChecker(oldConfig, config, needUnmarshal),
 		NewSystemConfigChecker(oldConfig, config, needUnmarshal),
 		NewCPUBurstChecker(oldConfig, config, needUnmarshal),
 	}
+	return append(all, createExtensionCheckers(oldConfig, config, needUnmarshal)...)
 }
 
 func (c checkers) CheckConfigContents() error {
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sloconfig
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/koordinator-sh/koordinator/apis/configuration"
+	"github.com/koordinator-sh/koordinator/pkg/util/sloconfig"
+)
+
+var _ ConfigChecker = &ExtensionChecker{}
+
+// ExtensionChecker checks the extension config with the schema registered by the NodeSLO extension plugin.
+type ExtensionChecker struct {
+	extKey string
+	schema *sloconfig.NodeSLOExtensionSchema
+
+	clusterStrategy json.RawMessage
+	nodeStrategies  []sloconfig.NodeSLOExtensionNodeStrategy
+	CommonChecker
+}
+
+func NewExtensionChecker(oldConfig, newConfig *corev1.ConfigMap, needUnmarshal bool, extKey string, schema *sloconfig.NodeSLOExtensionSchema) *ExtensionChecker {
+	checker := &ExtensionChecker{extKey: extKey, schema: schema, CommonChecker: CommonChecker{OldConfigMap: oldConfig, NewConfigMap: newConfig, configKey: schema.ConfigKey, initStatus: NotInit}}
+	if !checker.IsCfgNotEmptyAndChanged() && !needUnmarshal {
+		return checker
+	}
+	if err := checker.initConfig(); err != nil {
+		checker.initStatus = err.Error()
+	} else {
+		checker.initStatus = InitSuccess
+	}
+	return checker
+}
+
+// createExtensionCheckers creates the checkers of the registered extension schemas in the order of extension keys.
+func createExtensionCheckers(oldConfig, newConfig *corev1.ConfigMap, needUnmarshal bool) []ConfigChecker {
+	var extCheckers []ConfigChecker
+	for _, extKey := range sloconfig.GetNodeSLOExtensionSchemaKeys() {
+		schema, ok := sloconfig.GetNodeSLOExtensionSchema(extKey)
+		if !ok || schema.ConfigKey == "" {
+			continue
+		}
+		extCheckers = append(extCheckers, NewExtensionChecker(oldConfig, newConfig, needUnmarshal, extKey, schema))
+	}
+	return extCheckers
+}
+
+func (c *ExtensionChecker) ConfigParamValid() error {
+	if len(c.clusterStrategy) > 0 {
+		if _, err := c.schema.DecodeExtensionStrategyBytes(c.clusterStrategy); err != nil {
+			return buildParamInvalidError(fmt.Errorf("extension %s clusterStrategy invalid, err: %s", c.extKey, err.Error()))
+		}
+	}
+	// the node strategy overrides the cluster strategy, so the merged one is validated
+	for _, nodeStrategy := range c.nodeStrategies {
+		if _, err := c.schema.DecodeMergedExtensionStrategyBytes(c.clusterStrategy, nodeStrategy.Strategy); err != nil {
+			return buildParamInvalidError(fmt.Errorf("extension %s nodeStrategy %s invalid, err: %s", c.extKey, nodeStrategy.Profile.Name, err.Error()))
+		}
+	}
+	return nil
+}
+
+func (c *ExtensionChecker) initConfig() error {
+	configStr := c.NewConfigMap.Data[c.configKey]
+	clusterStrategy, nodeStrategies, err := sloconfig.ParseNodeSLOExtensionConfig(configStr)
+	if err != nil {
+		message := fmt.Sprintf("Failed to parse extension %s config in configmap %s/%s, err: %s",
+			c.extKey, c.NewConfigMap.Namespace, c.NewConfigMap.Name, err.Error())
+		klog.Error(message)
+		return buildJsonError(ReasonParseFail, message)
+	}
+	c.clusterStrategy = clusterStrategy
+	c.nodeStrategies = nodeStrategies
+
+	c.NodeConfigProfileChecker, err = CreateNodeConfigProfileChecker(c.configKey, c.getConfigProfiles)
+	if err != nil {
+		klog.Error(fmt.Sprintf("Failed to parse extension %s config in configmap %s/%s, err: %s",
+			c.extKey, c.NewConfigMap.Namespace, c.NewConfigMap.Name, err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (c *ExtensionChecker) getConfigProfiles() []configuration.NodeCfgProfile {
+	var profiles []configuration.NodeCfgProfile
+	for _, nodeStrategy := range c.nodeStrategies {
+		profiles = append(profiles, nodeStrategy.Profile)
+	}
+	return profiles
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sloconfig
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/koordinator-sh/koordinator/pkg/util/sloconfig"
+)
+
+type testExtensionStrategy struct {
+	Enable  *bool  `json:"enable,omitempty"`
+	Percent *int64 `json:"percent,omitempty" validate:"omitempty,min=0,max=100"`
+	Mode    string `json:"mode,omitempty"`
+}
+
+const (
+	testExtensionKey       = "testExt"
+	testExtensionConfigKey = "test-ext-config"
+)
+
+func Test_ExtensionChecker(t *testing.T) {
+	err := sloconfig.RegisterNodeSLOExtensionSchema(testExtensionKey, &sloconfig.NodeSLOExtensionSchema{
+		ConfigKey:   testExtensionConfigKey,
+		NewStrategy: func() interface{} { return &testExtensionStrategy{} },
+		Validate: func(strategy interface{}) error {
+			s := strategy.(*testExtensionStrategy)
+			if s.Mode != "" && s.Mode != "auto" && s.Mode != "manual" {
+				return fmt.Errorf("unknown mode %s", s.Mode)
+			}
+			return nil
+		},
+	})
+	assert.NoError(t, err)
+	defer sloconfig.UnregisterNodeSLOExtensionSchema(testExtensionKey)
+
+	tests := []struct {
+		name           string
+		oldConfig      *corev1.ConfigMap
+		newConfig      *corev1.ConfigMap
+		wantChanged    bool
+		wantInitStatus string
+		wantErr        bool
+	}{
+		{
+			name: "config not changed",
+			oldConfig: &corev1.ConfigMap{
+				Data: map[string]string{testExtensionConfigKey: `{"clusterStrategy":{"enable":true}}`},
+			},
+			newConfig: &corev1.ConfigMap{
+				Data: map[string]string{testExtensionConfigKey: `{"clusterStrategy":{"enable":true}}`},
+			},
+			wantChanged:    false,
+			wantInitStatus: NotInit,
+		},
+		{
+			name: "config is not a valid json",
+			newConfig: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "koordinator-system", Name: "slo-controller-config"},
+				Data:       map[string]string{testExtensionConfigKey: `{"clusterStrategy":`},
+			},
+			wantChanged:    true,
+			wantInitStatus: "err",
+		},
+		{
+			name: "valid cluster and node strategies",
+			newConfig: &corev1.ConfigMap{
+				Data: map[string]string{testExtensionConfigKey: `{"clusterStrategy":{"enable":true,"percent":50},` +
+					`"nodeStrategies":[{"name":"node-a","nodeSelector":{"matchLabels":{"a":"true"}},"enable":false,"mode":"auto"}]}`},
+			},
+			wantChanged:    true,
+			wantInitStatus: InitSuccess,
+		},
+		{
+			name: "cluster strategy has an unknown field",
+			newConfig: &corev1.ConfigMap{
+				Data: map[string]string{testExtensionConfigKey: `{"clusterStrategy":{"enabled":true}}`},
+			},
+			wantChanged:    true,
+			wantInitStatus: InitSuccess,
+			wantErr:        true,
+		},
+		{
+			name: "cluster strategy is out of range",
+			newConfig: &corev1.ConfigMap{
+				Data: map[string]string{testExtensionConfigKey: `{"clusterStrategy":{"percent":200}}`},
+			},
+			wantChanged:    true,
+			wantInitStatus: InitSuccess,
+			wantErr:        true,
+		},
+		{
+			name: "node strategy is rejected by the custom validation",
+			newConfig: &corev1.ConfigMap{
+				Data: map[string]string{testExtensionConfigKey: `{"clusterStrategy":{"enable":true},` +
+					`"nodeStrategies":[{"name":"node-a","nodeSelector":{"matchLabels":{"a":"true"}},"mode":"unknown"}]}`},
+			},
+			wantChanged:    true,
+			wantInitStatus: InitSuccess,
+			wantErr:        true,
+		},
+		{
+			name: "node strategy has an empty node selector",
+			newConfig: &corev1.ConfigMap{
+				Data: map[string]string{testExtensionConfigKey: `{"nodeStrategies":[{"name":"node-a","enable":true}]}`},
+			},
+			wantChanged:    true,
+			wantInitStatus: "err",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkers := CreateCheckersAll(tt.oldConfig, tt.newConfig, false)
+			var checker *ExtensionChecker
+			for _, c := range checkers {
+				if extChecker, ok := c.(*ExtensionChecker); ok && extChecker.extKey == testExtensionKey {
+					checker = extChecker
+				}
+			}
+			assert.NotNil(t, checker)
+			assert.Equal(t, tt.wantChanged, checker.IsCfgNotEmptyAndChanged())
+			if tt.wantInitStatus == "err" {
+				assert.NotEqual(t, InitSuccess, checker.InitStatus())
+				assert.NotEqual(t, NotInit, checker.InitStatus())
+				return
+			}
+			assert.Equal(t, tt.wantInitStatus, checker.InitStatus())
+			if tt.wantInitStatus != InitSuccess {
+				return
+			}
+			err := checker.ConfigParamValid()
+			assert.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
+
+func Test_ExtensionCheckerMergedNodeStrategy(t *testing.T) {
+	type requiredStrategy struct {
+		Level  *int64 `json:"level,omitempty" validate:"required,min=1"`
+		Enable *bool  `json:"enable,omitempty"`
+	}
+	err := sloconfig.RegisterNodeSLOExtensionSchema(testExtensionKey, &sloconfig.NodeSLOExtensionSchema{
+		ConfigKey:   testExtensionConfigKey,
+		NewStrategy: func() interface{} { return &requiredStrategy{} },
+	})
+	assert.NoError(t, err)
+	defer sloconfig.UnregisterNodeSLOExtensionSchema(testExtensionKey)
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{
+			name: "node strategy omits the required field set in the cluster strategy",
+			config: `{"clusterStrategy":{"level":2},` +
+				`"nodeStrategies":[{"name":"node-a","nodeSelector":{"matchLabels":{"a":"true"}},"enable":false}]}`,
+		},
+		{
+			name: "node strategy overrides the required field with an invalid value",
+			config: `{"clusterStrategy":{"level":2},` +
+				`"nodeStrategies":[{"name":"node-a","nodeSelector":{"matchLabels":{"a":"true"}},"level":0}]}`,
+			wantErr: true,
+		},
+		{
+			name: "required field is missing in both the cluster and node strategies",
+			config: `{"clusterStrategy":{"enable":true},` +
+				`"nodeStrategies":[{"name":"node-a","nodeSelector":{"matchLabels":{"a":"true"}},"enable":false}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newConfig := &corev1.ConfigMap{Data: map[string]string{testExtensionConfigKey: tt.config}}
+			schema, _ := sloconfig.GetNodeSLOExtensionSchema(testExtensionKey)
+			checker := NewExtensionChecker(nil, newConfig, false, testExtensionKey, schema)
+			assert.Equal(t, InitSuccess, checker.InitStatus())
+			err := checker.ConfigParamValid()
+			assert.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2022 The Koordinator Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	colocationProfileMutatedPods = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KoordManagerWebhookSubsystem,
+			Name:      "colocation_profile_mutated_pods",
+			Help:      "The number of pods mutated by the colocation profile, or would be mutated if the profile is in the dry-run mode",
+		},
+		[]string{ColocationProfileNameKey, DryRunKey},
+	)
+	ColocationProfileCollector = []prometheus.Collector{
+		colocationProfileMutatedPods,
+	}
+)
+
+func RecordColocationProfileMutatedPod(profileName string, dryRun bool) {
+	colocationProfileMutatedPods.WithLabelValues(profileName, strconv.FormatBool(dryRun)).Inc()
+}
@@ -20,6 +20,8 @@ const (
 	KoordManagerWebhookSubsystem = "koord_manager_webhook"
 	ElasticQuotaNameKey          = "elasticquota_name"
 	ResourceNameKey              = "resource_name"
+	ColocationProfileNameKey     = "colocation_profile_name"
+	DryRunKey                    = "dry_run"
 	OperationKey                 = "operation"
 	PluginNameKey                = "plugin_name"
 	StatusKey                    = "status"
@@ -42,6 +42,7 @@ import (
 	"github.com/koordinator-sh/koordinator/pkg/util"
 	utilclient "github.com/koordinator-sh/koordinator/pkg/util/client"
 	utilfeature "github.com/koordinator-sh/koordinator/pkg/util/feature"
+	"github.com/koordinator-sh/koordinator/pkg/webhook/metrics"
 )
 
 var (
@@ -92,8 +93,12 @@ func (h *PodMutatingHandler) clusterColocationProfileMutatingPod(ctx context.Con
 		return matchedProfiles[i].Name < matchedProfiles[j].Name
 	})
 	skipUpdateResourceFromProfile := false
+	dryRunProfiles := 0
 	for _, profile := range matchedProfiles {
-		if extension.ShouldSkipUpdateResource(profile) {
+		dryRun := extension.IsColocationProfileDryRun(profile)
+		if dryRun {
+			dryRunProfiles++
+		} else if extension.ShouldSkipUpdateResource(profile) {
 			skipUpdateResourceFromProfile = true
 		}
 		skip, err := shouldSkipProfile(profile)
@@ -104,18 +109,43 @@ func (h *PodMutatingHandler) clusterColocationProfileMutatingPod(ctx context.Con
 			klog.V(4).Infof("skip mutate Pod %s/%s by clusterColocationProfile %s", pod.Namespace, pod.Name, profile.Name)
 			continue
 		}
+		if dryRun {
+			h.dryRunMutateByColocationProfile(ctx, pod, profile)
+			continue
+		}
 		err = h.doMutateByColocationProfile(ctx, pod, profile)
 		if err != nil {
 			return err
 		}
+		extension.AddColocationProfileToPod(pod, profile.Name)
+		metrics.RecordColocationProfileMutatedPod(profile.Name, false)
 		klog.V(4).Infof("mutate Pod %s/%s by clusterColocationProfile %s", pod.Namespace, pod.Name, profile.Name)
 	}
-	if skipUpdateResourceFromProfile || utilfeature.DefaultFeatureGate.Enabled(features.ColocationProfileSkipMutatingResources) {
+	// the pod matched only by the dry-run profiles should keep unchanged
+	if dryRunProfiles == len(matchedProfiles) ||
+		skipUpdateResourceFromProfile || utilfeature.DefaultFeatureGate.Enabled(features.ColocationProfileSkipMutatingResources) {
 		return nil
 	}
 	return h.mutatePodResourceSpec(pod)
 }
 
+// dryRunMutateByColocationProfile records what the profile would mutate on the pod without changing it.
+func (h *PodMutatingHandler) dryRunMutateByColocationProfile(ctx context.Context, pod *corev1.Pod, profile *configv1alpha1.ClusterColocationProfile) {
+	simulatedPod := pod.DeepCopy()
+	err := h.doMutateByColocationProfile(ctx, simulatedPod, profile)
+	if err == nil && !extension.ShouldSkipUpdateResource(profile) &&
+		!utilfeature.DefaultFeatureGate.Enabled(features.ColocationProfileSkipMutatingResources) {
+		err = h.mutatePodResourceSpec(simulatedPod)
+	}
+	if err != nil {
+		klog.Warningf("failed to dry-run mutating Pod %s/%s by clusterColocationProfile %s, err: %v", pod.Namespace, pod.Name, profile.Name, err)
+		return
+	}
+	metrics.RecordColocationProfileMutatedPod(profile.Name, true)
+	klog.Infof("dry-run mutating Pod %s/%s by clusterColocationProfile %s, changed fields: %v",
+		pod.Namespace, pod.Name, profile.Name, util.GetPodChangedFields(pod, simulatedPod))
+}
+
 func (h *PodMutatingHandler) matchNamespaceSelector(ctx context.Context, namespaceName string, namespaceSelector *metav1.LabelSelector) (bool, error) {
 	selector, err := util.GetFastLabelSelector(namespaceSelector)
 	if err != nil {
@@ -270,6 +300,20 @@ func (h *PodMutatingHandler) mutatePodResourceSpec(pod *corev1.Pod) error {
 
 			restrictResourceRequestAndLimit(priorityClass, &container.Resources, corev1.ResourceCPU)
 			restrictResourceRequestAndLimit(priorityClass, &container.Resources, corev1.ResourceMemory)
+
+			if isBatchEphemeralStorageEnabled(priorityClass) {
+				replaceResource(container.Resources.Requests, corev1.ResourceEphemeralStorage, extension.BatchEphemeralStorage)
+				replaceResource(container.Resources.Limits, corev1.ResourceEphemeralStorage, extension.BatchEphemeralStorage)
+				restrictResourceRequestAndLimitTo(&container.Resources, extension.BatchEphemeralStorage)
+			}
+
+			if isBatchGPUEnabled(priorityClass) {
+				for resourceName, batchResourceName := range extension.BatchGPUResourceNameMap {
+					replaceResource(container.Resources.Requests, resourceName, batchResourceName)
+					replaceResource(container.Resources.Limits, resourceName, batchResourceName)
+					restrictResourceRequestAndLimitTo(&container.Resources, batchResourceName)
+				}
+			}
 		}
 	}
 
@@ -280,11 +324,29 @@ func (h *PodMutatingHandler) mutatePodResourceSpec(pod *corev1.Pod) error {
 	return nil
 }
 
+// isBatchEphemeralStorageEnabled checks if the ephemeral-storage of the pod should be replaced with the
+// batch-ephemeral-storage, so the Batch pods can be scheduled with the ephemeral storage reclaimed from the HP pods.
+// NOTE: The batch-ephemeral-storage is not limited by the kubelet, but enforced by the koordlet eviction.
+func isBatchEphemeralStorageEnabled(priorityClass extension.PriorityClass) bool {
+	return priorityClass == extension.PriorityBatch && utilfeature.DefaultFeatureGate.Enabled(features.BatchEphemeralStorage)
+}
+
+// isBatchGPUEnabled checks if the shared GPU resources of the pod should be replaced with the batch GPU resources,
+// so the Batch pods can be scheduled with the GPU resources reclaimed from the HP pods.
+// NOTE: The batch GPU resources are enforced by the koordlet eviction when the HP pods' GPU usage rises.
+func isBatchGPUEnabled(priorityClass extension.PriorityClass) bool {
+	return priorityClass == extension.PriorityBatch && utilfeature.DefaultFeatureGate.Enabled(features.BatchGPU)
+}
+
 func replaceAndEraseResource(priorityClass extension.PriorityClass, resourceList corev1.ResourceList, resourceName corev1.ResourceName) {
 	extendResourceName := extension.ResourceNameMap[priorityClass][resourceName]
 	if extendResourceName == "" {
 		return
 	}
+	replaceResource(resourceList, resourceName, extendResourceName)
+}
+
+func replaceResource(resourceList corev1.ResourceList, resourceName, extendResourceName corev1.ResourceName) {
 	quantity, ok := resourceList[resourceName]
 	if ok {
 		if resourceName == corev1.ResourceCPU {
@@ -301,6 +363,10 @@ func restrictResourceRequestAndLimit(priorityClass extension.PriorityClass, requ
 	if extendResourceName == "" {
 		return
 	}
+	restrictResourceRequestAndLimitTo(requirements, extendResourceName)
+}
+
+func restrictResourceRequestAndLimitTo(requirements *corev1.ResourceRequirements, extendResourceName corev1.ResourceName) {
 	_, requestOK := requirements.Requests[extendResourceName]
 	limitQuantity, limitOK := requirements.Limits[extendResourceName]
 	if !requestOK && limitOK {
@@ -169,8 +169,9 @@ func TestClusterColocationProfileMutatingPod(t *testing.T) {
 						extension.LabelPodPriority:   "1111",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":       "valueA",
-						"test-patch-annotation": "patch-b",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -283,8 +284,9 @@ func TestClusterColocationProfileMutatingPod(t *testing.T) {
 						extension.LabelPodPriority:   "1111",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":       "valueA",
-						"test-patch-annotation": "patch-b",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -378,8 +380,9 @@ func TestClusterColocationProfileMutatingPod(t *testing.T) {
 						extension.LabelPodPriority:   "1111",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":       "valueA",
-						"test-patch-annotation": "patch-b",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -493,8 +496,9 @@ func TestClusterColocationProfileMutatingPod(t *testing.T) {
 						extension.LabelPodPriority:   "1111",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":       "valueA",
-						"test-patch-annotation": "patch-b",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -893,8 +897,9 @@ func TestClusterColocationProfileMutatingPod(t *testing.T) {
 						extension.LabelPodPriority:   "1111",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":       "valueA",
-						"test-patch-annotation": "patch-b",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -1033,8 +1038,9 @@ func TestClusterColocationProfileMutatingPod(t *testing.T) {
 						extension.LabelPodPriority:   "1111",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":       "valueA",
-						"test-patch-annotation": "patch-b",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -1172,6 +1178,7 @@ func TestClusterColocationProfileMutatingPod(t *testing.T) {
 						extension.LabelPodPriority:   "1111",
 					},
 					Annotations: map[string]string{
+						extension.AnnotationColocationProfiles: "test-profile",
 						"testAnnotationA":                      "valueA",
 						"test-patch-annotation":                "patch-b",
 						extension.AnnotationSkipUpdateResource: "true",
@@ -1316,8 +1323,9 @@ func TestClusterColocationProfileMutatingPod(t *testing.T) {
 						extension.LabelPodPriority:   "1111",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":       "valueA",
-						"test-patch-annotation": "patch-b",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -1462,8 +1470,9 @@ func TestClusterColocationProfileMutatingPod(t *testing.T) {
 						extension.LabelSchedulerName:   "koordinator-scheduler",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":       "valueA",
-						"test-patch-annotation": "patch-b",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -1605,10 +1614,11 @@ func TestClusterColocationProfileMutatingPod(t *testing.T) {
 						extension.LabelPodPriority:   "1111",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":         "valueA",
-						"test-patch-annotation":   "patch-b",
-						"annotation-key-to-load":  "test-annotation-value",
-						"annotation-key-to-store": "test-annotation-value",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
+						"annotation-key-to-load":               "test-annotation-value",
+						"annotation-key-to-store":              "test-annotation-value",
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -1760,8 +1770,9 @@ func TestClusterColocationProfileMutatingPod(t *testing.T) {
 						extension.LabelSchedulerName:   "koordinator-scheduler",
 					},
 					Annotations: map[string]string{
-						"testAnnotationA":       "valueA",
-						"test-patch-annotation": "patch-b",
+						extension.AnnotationColocationProfiles: "test-profile",
+						"testAnnotationA":                      "valueA",
+						"test-patch-annotation":                "patch-b",
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -1860,3 +1871,264 @@ func TestClusterColocationProfileMutatingPod(t *testing.T) {
 
 	}
 }
+
+func TestClusterColocationProfileMutatingPodDryRun(t *testing.T) {
+	client := fake.NewClientBuilder().Build()
+	handler := &PodMutatingHandler{
+		Client:  client,
+		Decoder: admission.NewDecoder(scheme.Scheme),
+	}
+	dryRunProfile := &configv1alpha1.ClusterColocationProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-profile-a",
+			Annotations: map[string]string{
+				extension.AnnotationColocationProfileDryRun: "true",
+			},
+		},
+		Spec: configv1alpha1.ClusterColocationProfileSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"koordinator-colocation-pod": "true",
+				},
+			},
+			Labels: map[string]string{
+				"testLabelA": "valueA",
+			},
+			QoSClass: string(extension.QoSBE),
+		},
+	}
+	assert.NoError(t, client.Create(context.TODO(), dryRunProfile))
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "test-pod-1",
+			Labels: map[string]string{
+				"koordinator-colocation-pod": "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "test-container-a",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("1"),
+						},
+					},
+				},
+			},
+			Priority: pointer.Int32(extension.PriorityBatchValueMax),
+		},
+	}
+	expected := pod.DeepCopy()
+
+	// pod is not changed by the dry-run profile
+	req := newAdmission(admissionv1.Create, runtime.RawExtension{}, runtime.RawExtension{}, "")
+	err := handler.clusterColocationProfileMutatingPod(context.TODO(), req, pod)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, pod)
+
+	// pod is only mutated by the profile not in dry-run mode
+	profile := &configv1alpha1.ClusterColocationProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-profile-b",
+		},
+		Spec: configv1alpha1.ClusterColocationProfileSpec{
+			Labels: map[string]string{
+				"testLabelB": "valueB",
+			},
+		},
+	}
+	assert.NoError(t, client.Create(context.TODO(), profile))
+	err = handler.clusterColocationProfileMutatingPod(context.TODO(), req, pod)
+	assert.NoError(t, err)
+	expected.Labels["testLabelB"] = "valueB"
+	expected.Annotations = map[string]string{
+		extension.AnnotationColocationProfiles: "test-profile-b",
+	}
+	expected.Spec.Containers[0].Resources.Requests = corev1.ResourceList{
+		extension.BatchCPU: *resource.NewQuantity(1000, resource.DecimalSI),
+	}
+	assert.Equal(t, expected, pod)
+}
+
+func TestMutatePodResourceSpecWithBatchEphemeralStorage(t *testing.T) {
+	tests := []struct {
+		name            string
+		enabled         bool
+		priority        int32
+		wantRequests    corev1.ResourceList
+		wantLimits      corev1.ResourceList
+		wantInitRequest corev1.ResourceList
+	}{
+		{
+			name:     "keep ephemeral-storage when feature disabled",
+			enabled:  false,
+			priority: extension.PriorityBatchValueMax,
+			wantRequests: corev1.ResourceList{
+				extension.BatchCPU:              *resource.NewQuantity(1000, resource.DecimalSI),
+				corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+			},
+			wantLimits: corev1.ResourceList{
+				corev1.ResourceEphemeralStorage: resource.MustParse("20Gi"),
+			},
+			wantInitRequest: corev1.ResourceList{
+				corev1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+			},
+		},
+		{
+			name:     "replace ephemeral-storage of batch pod",
+			enabled:  true,
+			priority: extension.PriorityBatchValueMax,
+			wantRequests: corev1.ResourceList{
+				extension.BatchCPU:              *resource.NewQuantity(1000, resource.DecimalSI),
+				extension.BatchEphemeralStorage: resource.MustParse("10Gi"),
+			},
+			wantLimits: corev1.ResourceList{
+				extension.BatchEphemeralStorage: resource.MustParse("20Gi"),
+			},
+			wantInitRequest: corev1.ResourceList{
+				extension.BatchEphemeralStorage: resource.MustParse("1Gi"),
+			},
+		},
+		{
+			name:     "keep ephemeral-storage of mid pod",
+			enabled:  true,
+			priority: extension.PriorityMidValueMax,
+			wantRequests: corev1.ResourceList{
+				extension.MidCPU:                *resource.NewQuantity(1000, resource.DecimalSI),
+				corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+			},
+			wantLimits: corev1.ResourceList{
+				corev1.ResourceEphemeralStorage: resource.MustParse("20Gi"),
+			},
+			wantInitRequest: corev1.ResourceList{
+				corev1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer feature.SetFeatureGateDuringTest(t, feature.DefaultMutableFeatureGate, features.BatchEphemeralStorage, tt.enabled)()
+			handler := &PodMutatingHandler{}
+			pod := &corev1.Pod{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{
+						{
+							Name: "init",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+								},
+							},
+						},
+					},
+					Containers: []corev1.Container{
+						{
+							Name: "main",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:              resource.MustParse("1"),
+									corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+								},
+								Limits: corev1.ResourceList{
+									corev1.ResourceEphemeralStorage: resource.MustParse("20Gi"),
+								},
+							},
+						},
+					},
+					Priority: pointer.Int32(tt.priority),
+				},
+			}
+			err := handler.mutatePodResourceSpec(pod)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRequests, pod.Spec.Containers[0].Resources.Requests)
+			assert.Equal(t, tt.wantLimits, pod.Spec.Containers[0].Resources.Limits)
+			assert.Equal(t, tt.wantInitRequest, pod.Spec.InitContainers[0].Resources.Requests)
+		})
+	}
+}
+
+func TestMutatePodResourceSpecWithBatchGPU(t *testing.T) {
+	tests := []struct {
+		name         string
+		enabled      bool
+		priority     int32
+		wantRequests corev1.ResourceList
+		wantLimits   corev1.ResourceList
+	}{
+		{
+			name:     "keep gpu resources when feature disabled",
+			enabled:  false,
+			priority: extension.PriorityBatchValueMax,
+			wantRequests: corev1.ResourceList{
+				extension.BatchCPU:          *resource.NewQuantity(1000, resource.DecimalSI),
+				extension.ResourceGPUCore:   resource.MustParse("50"),
+				extension.ResourceGPUMemory: resource.MustParse("8Gi"),
+			},
+			wantLimits: corev1.ResourceList{
+				extension.ResourceGPUCore:   resource.MustParse("50"),
+				extension.ResourceGPUMemory: resource.MustParse("8Gi"),
+			},
+		},
+		{
+			name:     "replace gpu resources of batch pod",
+			enabled:  true,
+			priority: extension.PriorityBatchValueMax,
+			wantRequests: corev1.ResourceList{
+				extension.BatchCPU:       *resource.NewQuantity(1000, resource.DecimalSI),
+				extension.BatchGPUCore:   resource.MustParse("50"),
+				extension.BatchGPUMemory: resource.MustParse("8Gi"),
+			},
+			wantLimits: corev1.ResourceList{
+				extension.BatchGPUCore:   resource.MustParse("50"),
+				extension.BatchGPUMemory: resource.MustParse("8Gi"),
+			},
+		},
+		{
+			name:     "keep gpu resources of mid pod",
+			enabled:  true,
+			priority: extension.PriorityMidValueMax,
+			wantRequests: corev1.ResourceList{
+				extension.MidCPU:            *resource.NewQuantity(1000, resource.DecimalSI),
+				extension.ResourceGPUCore:   resource.MustParse("50"),
+				extension.ResourceGPUMemory: resource.MustParse("8Gi"),
+			},
+			wantLimits: corev1.ResourceList{
+				extension.ResourceGPUCore:   resource.MustParse("50"),
+				extension.ResourceGPUMemory: resource.MustParse("8Gi"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer feature.SetFeatureGateDuringTest(t, feature.DefaultMutableFeatureGate, features.BatchGPU, tt.enabled)()
+			handler := &PodMutatingHandler{}
+			pod := &corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "main",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:          resource.MustParse("1"),
+									extension.ResourceGPUCore:   resource.MustParse("50"),
+									extension.ResourceGPUMemory: resource.MustParse("8Gi"),
+								},
+								Limits: corev1.ResourceList{
+									extension.ResourceGPUCore:   resource.MustParse("50"),
+									extension.ResourceGPUMemory: resource.MustParse("8Gi"),
+								},
+							},
+						},
+					},
+					Priority: pointer.Int32(tt.priority),
+				},
+			}
+			err := handler.mutatePodResourceSpec(pod)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRequests, pod.Spec.Containers[0].Resources.Requests)
+			assert.Equal(t, tt.wantLimits, pod.Spec.Containers[0].Resources.Limits)
+		})
+	}
+}
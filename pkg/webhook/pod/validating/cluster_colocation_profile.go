@@ -72,8 +72,10 @@ func validateRequiredQoSClass(pod *corev1.Pod) field.ErrorList {
 	request := util.GetPodRequest(pod)
 	batchCPUQuantity := request[extension.BatchCPU]
 	batchMemoryQuantity := request[extension.BatchMemory]
+	batchEphemeralStorageQuantity := request[extension.BatchEphemeralStorage]
 
-	if batchCPUQuantity.IsZero() && batchMemoryQuantity.IsZero() {
+	if batchCPUQuantity.IsZero() && batchMemoryQuantity.IsZero() && batchEphemeralStorageQuantity.IsZero() &&
+		!extension.IsPodRequestBatchGPU(pod) {
 		return nil
 	}
 	qosClass := extension.GetPodQoSClassRaw(pod)
@@ -52,6 +52,10 @@ var podResources = []corev1.ResourceName{
 	// batch resource
 	extension.BatchCPU,
 	extension.BatchMemory,
+	extension.BatchEphemeralStorage,
+	extension.BatchGPUCore,
+	extension.BatchGPUMemory,
+	extension.BatchGPUMemoryRatio,
 
 	// mid resource
 	extension.MidCPU,
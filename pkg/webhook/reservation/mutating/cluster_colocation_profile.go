@@ -92,6 +92,10 @@ func (h *ReservationMutatingHandler) clusterColocationProfileMutatingReservation
 			klog.V(4).Infof("skip mutate Reservation %s/%s by clusterColocationProfile %s", reservation.Namespace, reservation.Name, profile.Name)
 			continue
 		}
+		if extension.IsColocationProfileDryRun(profile) {
+			klog.V(4).Infof("skip mutate Reservation %s/%s by dry-run clusterColocationProfile %s", reservation.Namespace, reservation.Name, profile.Name)
+			continue
+		}
 		err = h.doMutateByColocationProfile(ctx, reservation, profile)
 		if err != nil {
 			return err